@@ -0,0 +1,18 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS share_links(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	todo_id BIGINT NOT NULL,
+	token_digest VARCHAR(64) NOT NULL UNIQUE,
+	password VARCHAR(255),
+	expires_at DATETIME,
+	revoked_at DATETIME,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	INDEX idx_share_links_user_id (user_id),
+	INDEX idx_share_links_todo_id (todo_id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS share_links;
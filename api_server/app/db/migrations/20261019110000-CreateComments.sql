@@ -0,0 +1,26 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS comments(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	todo_id BIGINT NOT NULL,
+	body TEXT NOT NULL,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	INDEX idx_comments_todo_id (todo_id)
+);
+
+CREATE TABLE IF NOT EXISTS mention_notifications(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	comment_id BIGINT NOT NULL,
+	read_at DATETIME,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	UNIQUE INDEX idx_mention_notifications_comment_id_user_id (comment_id, user_id),
+	INDEX idx_mention_notifications_user_id (user_id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS mention_notifications;
+DROP TABLE IF EXISTS comments;
@@ -0,0 +1,17 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS attachments(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	todo_id BIGINT NOT NULL,
+	file_name VARCHAR(255) NOT NULL,
+	content_type VARCHAR(255) NOT NULL,
+	size BIGINT NOT NULL,
+	object_path VARCHAR(512) NOT NULL,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	INDEX idx_attachments_todo_id (todo_id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS attachments;
@@ -0,0 +1,16 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS activities(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	actor_id BIGINT NOT NULL,
+	todo_id BIGINT NOT NULL,
+	todo_title VARCHAR(255) NOT NULL,
+	event_type VARCHAR(64) NOT NULL,
+	created_at DATETIME NOT NULL,
+	INDEX idx_activities_user_id (user_id),
+	INDEX idx_activities_todo_id (todo_id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS activities;
@@ -0,0 +1,34 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS webhooks(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	url VARCHAR(2048) NOT NULL,
+	secret VARCHAR(255) NOT NULL,
+	event_types VARCHAR(1024) NOT NULL,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	INDEX idx_webhooks_user_id (user_id)
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	webhook_id BIGINT NOT NULL,
+	event_id BIGINT NOT NULL,
+	event_type VARCHAR(64) NOT NULL,
+	payload TEXT NOT NULL,
+	status VARCHAR(16) NOT NULL,
+	attempts INT NOT NULL DEFAULT 0,
+	next_attempt_at DATETIME NOT NULL,
+	last_attempted_at DATETIME,
+	response_status INT,
+	error_message TEXT,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	INDEX idx_webhook_deliveries_webhook_id (webhook_id),
+	INDEX idx_webhook_deliveries_status_next_attempt_at (status, next_attempt_at)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhooks;
@@ -0,0 +1,19 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS outbox(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	aggregate_type VARCHAR(64) NOT NULL,
+	aggregate_id BIGINT NOT NULL,
+	event_type VARCHAR(64) NOT NULL,
+	payload TEXT NOT NULL,
+	attempts INT NOT NULL DEFAULT 0,
+	last_error TEXT,
+	published_at DATETIME,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	INDEX idx_outbox_published_at (published_at, id),
+	INDEX idx_outbox_aggregate (aggregate_type, aggregate_id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS outbox;
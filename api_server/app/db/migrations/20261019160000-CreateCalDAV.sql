@@ -0,0 +1,28 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS app_passwords(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	name VARCHAR(255) NOT NULL,
+	password_digest VARCHAR(64) NOT NULL UNIQUE,
+	last_used_at DATETIME,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	INDEX idx_app_passwords_user_id (user_id)
+);
+
+CREATE TABLE IF NOT EXISTS caldav_objects(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	todo_id BIGINT NOT NULL UNIQUE,
+	uid VARCHAR(255) NOT NULL,
+	resource_name VARCHAR(255) NOT NULL,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	UNIQUE idx_caldav_objects_user_id_resource_name (user_id, resource_name),
+	UNIQUE idx_caldav_objects_user_id_uid (user_id, uid)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS caldav_objects;
+DROP TABLE IF EXISTS app_passwords;
@@ -0,0 +1,22 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS imports(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	format VARCHAR(16) NOT NULL,
+	status VARCHAR(16) NOT NULL,
+	payload MEDIUMTEXT NOT NULL,
+	total_rows INT NOT NULL DEFAULT 0,
+	processed_rows INT NOT NULL DEFAULT 0,
+	imported_rows INT NOT NULL DEFAULT 0,
+	skipped_rows INT NOT NULL DEFAULT 0,
+	row_errors MEDIUMTEXT NOT NULL,
+	error_message TEXT,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	INDEX idx_imports_user_id (user_id),
+	INDEX idx_imports_status (status)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS imports;
@@ -0,0 +1,14 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS todo_templates(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	name VARCHAR(255) NOT NULL,
+	items MEDIUMTEXT NOT NULL,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	INDEX idx_todo_templates_user_id (user_id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS todo_templates;
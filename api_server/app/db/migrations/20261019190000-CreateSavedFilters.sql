@@ -0,0 +1,14 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS saved_filters(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	name VARCHAR(255) NOT NULL,
+	query VARCHAR(1000) NOT NULL,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	INDEX idx_saved_filters_user_id (user_id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS saved_filters;
@@ -0,0 +1,17 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS time_entries(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	todo_id BIGINT NOT NULL,
+	started_at DATETIME NOT NULL,
+	stopped_at DATETIME,
+	note VARCHAR(255) NOT NULL DEFAULT '',
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	INDEX idx_time_entries_user_id_started_at (user_id, started_at),
+	INDEX idx_time_entries_todo_id (todo_id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS time_entries;
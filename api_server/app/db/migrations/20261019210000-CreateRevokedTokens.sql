@@ -0,0 +1,13 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS revoked_tokens(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	jti VARCHAR(64) NOT NULL,
+	expires_at DATETIME NOT NULL,
+	created_at DATETIME NOT NULL,
+	UNIQUE INDEX idx_revoked_tokens_jti (jti),
+	INDEX idx_revoked_tokens_expires_at (expires_at)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS revoked_tokens;
@@ -0,0 +1,19 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS refresh_tokens(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	family_id VARCHAR(64) NOT NULL,
+	token_hash CHAR(64) NOT NULL,
+	expires_at DATETIME NOT NULL,
+	used_at DATETIME,
+	revoked_at DATETIME,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	UNIQUE INDEX idx_refresh_tokens_token_hash (token_hash),
+	INDEX idx_refresh_tokens_family_id (family_id),
+	INDEX idx_refresh_tokens_user_id (user_id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS refresh_tokens;
@@ -0,0 +1,18 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS sessions(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	family_id VARCHAR(64) NOT NULL,
+	user_agent VARCHAR(512) NOT NULL DEFAULT '',
+	ip_address VARCHAR(45) NOT NULL DEFAULT '',
+	last_seen_at DATETIME NOT NULL,
+	revoked_at DATETIME,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	UNIQUE INDEX idx_sessions_family_id (family_id),
+	INDEX idx_sessions_user_id (user_id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS sessions;
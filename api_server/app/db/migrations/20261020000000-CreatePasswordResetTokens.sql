@@ -0,0 +1,16 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS password_reset_tokens(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	token_hash CHAR(64) NOT NULL,
+	expires_at DATETIME NOT NULL,
+	used_at DATETIME,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	UNIQUE INDEX idx_password_reset_tokens_token_hash (token_hash),
+	INDEX idx_password_reset_tokens_user_id (user_id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS password_reset_tokens;
@@ -0,0 +1,12 @@
+
+-- +migrate Up
+ALTER TABLE users ADD email_verified_at DATETIME AFTER email;
+ALTER TABLE users ADD pending_email VARCHAR(255) AFTER email_verified_at;
+ALTER TABLE users ADD email_verification_sent_at DATETIME AFTER pending_email;
+-- NOTE: 確認の仕組みを導入する前に登録したユーザは確認済みとして扱う
+UPDATE users SET email_verified_at = created_at;
+
+-- +migrate Down
+ALTER TABLE users DROP COLUMN email_verification_sent_at;
+ALTER TABLE users DROP COLUMN pending_email;
+ALTER TABLE users DROP COLUMN email_verified_at;
@@ -0,0 +1,33 @@
+
+-- +migrate Up
+ALTER TABLE users ADD totp_secret VARCHAR(255) AFTER email_verification_sent_at;
+ALTER TABLE users ADD totp_enabled_at DATETIME AFTER totp_secret;
+ALTER TABLE users ADD totp_last_used_step BIGINT AFTER totp_enabled_at;
+CREATE TABLE IF NOT EXISTS recovery_codes(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	code_hash CHAR(64) NOT NULL,
+	used_at DATETIME,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	INDEX idx_recovery_codes_user_id (user_id)
+);
+CREATE TABLE IF NOT EXISTS two_factor_challenges(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	token_hash CHAR(64) NOT NULL,
+	attempts INT NOT NULL DEFAULT 0,
+	expires_at DATETIME NOT NULL,
+	used_at DATETIME,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	UNIQUE INDEX idx_two_factor_challenges_token_hash (token_hash),
+	INDEX idx_two_factor_challenges_user_id (user_id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS two_factor_challenges;
+DROP TABLE IF EXISTS recovery_codes;
+ALTER TABLE users DROP COLUMN totp_last_used_step;
+ALTER TABLE users DROP COLUMN totp_enabled_at;
+ALTER TABLE users DROP COLUMN totp_secret;
@@ -0,0 +1,39 @@
+
+-- +migrate Up
+ALTER TABLE users ADD webauthn_user_handle VARCHAR(64) AFTER totp_last_used_step;
+CREATE UNIQUE INDEX idx_users_webauthn_user_handle ON users (webauthn_user_handle);
+CREATE TABLE IF NOT EXISTS webauthn_credentials(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	credential_id VARCHAR(512) NOT NULL,
+	public_key BLOB NOT NULL,
+	attestation_type VARCHAR(32) NOT NULL,
+	aaguid VARBINARY(16) NOT NULL,
+	sign_count BIGINT NOT NULL DEFAULT 0,
+	transports VARCHAR(255) NOT NULL DEFAULT '',
+	backup_eligible BOOLEAN NOT NULL DEFAULT FALSE,
+	backup_state BOOLEAN NOT NULL DEFAULT FALSE,
+	name VARCHAR(50) NOT NULL,
+	last_used_at DATETIME,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	UNIQUE INDEX idx_webauthn_credentials_credential_id (credential_id),
+	INDEX idx_webauthn_credentials_user_id (user_id)
+);
+CREATE TABLE IF NOT EXISTS webauthn_ceremonies(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT,
+	token_hash CHAR(64) NOT NULL,
+	ceremony VARCHAR(20) NOT NULL,
+	session_data TEXT NOT NULL,
+	expires_at DATETIME NOT NULL,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	UNIQUE INDEX idx_webauthn_ceremonies_token_hash (token_hash)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS webauthn_ceremonies;
+DROP TABLE IF EXISTS webauthn_credentials;
+DROP INDEX idx_users_webauthn_user_handle ON users;
+ALTER TABLE users DROP COLUMN webauthn_user_handle;
@@ -0,0 +1,30 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS sign_in_failures(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	email VARCHAR(255) NOT NULL,
+	ip_address VARCHAR(45) NOT NULL,
+	created_at DATETIME NOT NULL,
+	INDEX idx_sign_in_failures_email_created_at (email, created_at),
+	INDEX idx_sign_in_failures_ip_address_created_at (ip_address, created_at)
+);
+CREATE TABLE IF NOT EXISTS sign_in_lockouts(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	scope VARCHAR(20) NOT NULL,
+	email VARCHAR(255),
+	ip_address VARCHAR(45) NOT NULL,
+	user_id BIGINT,
+	failed_attempts INT NOT NULL,
+	locked_until DATETIME NOT NULL,
+	unlock_token_hash CHAR(64),
+	unlocked_at DATETIME,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	UNIQUE INDEX idx_sign_in_lockouts_unlock_token_hash (unlock_token_hash),
+	INDEX idx_sign_in_lockouts_email (email),
+	INDEX idx_sign_in_lockouts_ip_address (ip_address)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS sign_in_lockouts;
+DROP TABLE IF EXISTS sign_in_failures;
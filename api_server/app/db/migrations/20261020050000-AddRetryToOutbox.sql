@@ -0,0 +1,10 @@
+
+-- +migrate Up
+ALTER TABLE outbox ADD next_attempt_at DATETIME AFTER last_error;
+ALTER TABLE outbox ADD dead_lettered_at DATETIME AFTER next_attempt_at;
+ALTER TABLE outbox ADD INDEX idx_outbox_next_attempt_at (published_at, dead_lettered_at, next_attempt_at);
+
+-- +migrate Down
+ALTER TABLE outbox DROP INDEX idx_outbox_next_attempt_at;
+ALTER TABLE outbox DROP COLUMN dead_lettered_at;
+ALTER TABLE outbox DROP COLUMN next_attempt_at;
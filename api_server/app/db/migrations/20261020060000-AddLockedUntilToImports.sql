@@ -0,0 +1,6 @@
+
+-- +migrate Up
+ALTER TABLE imports ADD locked_until DATETIME AFTER error_message;
+
+-- +migrate Down
+ALTER TABLE imports DROP COLUMN locked_until;
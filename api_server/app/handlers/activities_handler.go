@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	apis "app/openapi"
+	"app/services"
+	"app/utils"
+	"context"
+	"errors"
+	"net/http"
+)
+
+type ActivitiesHandler interface {
+	GetActivities(ctx context.Context, request apis.GetActivitiesRequestObject) (apis.GetActivitiesResponseObject, error)
+}
+
+type activitiesHandler struct {
+	activityService services.ActivityService
+}
+
+func NewActivitiesHandler(activityService services.ActivityService) ActivitiesHandler {
+	return &activitiesHandler{activityService: activityService}
+}
+
+func (activitiesHandler *activitiesHandler) GetActivities(ctx context.Context, request apis.GetActivitiesRequestObject) (apis.GetActivitiesResponseObject, error) {
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.GetActivities500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, activitiesList, nextCursor, err := activitiesHandler.activityService.FetchActivitiesList(ctx, request.Params, userID)
+	switch statusCode {
+	case http.StatusBadRequest:
+		res := apis.BadRequestErrorResponseJSONResponse{Code: http.StatusBadRequest, Message: err.Error()}
+		return apis.GetActivities400JSONResponse{BadRequestErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.GetActivities500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	resActivitiesList := apis.FetchActivitiesResponseJSONResponse{Activities: []apis.Activity{}, NextCursor: nextCursor}
+	for _, activity := range *activitiesList {
+		resActivitiesList.Activities = append(resActivitiesList.Activities, apis.Activity{
+			Id:        int(activity.ID),
+			ActorId:   int(activity.ActorID),
+			TodoId:    int(activity.TodoID),
+			TodoTitle: activity.TodoTitle,
+			EventType: activity.EventType,
+			CreatedAt: activity.CreatedAt,
+		})
+	}
+	return apis.GetActivities200JSONResponse{FetchActivitiesResponseJSONResponse: resActivitiesList}, nil
+}
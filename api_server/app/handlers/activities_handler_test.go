@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	apis "app/openapi"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/oapi-codegen/testutil"
+)
+
+type testActivitiesHandlerSuite struct {
+	WithDBSuite
+}
+
+func (s *testActivitiesHandlerSuite) SetupTest() {
+	s.SetDBCon()
+
+	s.initializeHandlers()
+
+	s.SetCsrfHeaderValues()
+}
+
+func (s *testActivitiesHandlerSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *testActivitiesHandlerSuite) TestGetActivities_StatusOk() {
+	s.SignIn()
+
+	reqBody := apis.StoreTodoInput{Title: "test title 1", Content: "test content 1"}
+	testutil.NewRequest().Post("/todos").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+
+	result := testutil.NewRequest().Get("/activity").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.GetActivities200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Len(s.T(), res.Activities, 1)
+	assert.Equal(s.T(), "todo.created", res.Activities[0].EventType)
+	assert.Equal(s.T(), "test title 1", res.Activities[0].TodoTitle)
+	assert.Equal(s.T(), user.ID, res.Activities[0].ActorId)
+	assert.Nil(s.T(), res.NextCursor)
+}
+
+func (s *testActivitiesHandlerSuite) TestGetActivities_BadRequest() {
+	s.SignIn()
+
+	result := testutil.NewRequest().Get("/activity?eventType=unknown").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusBadRequest, result.Code())
+}
+
+func (s *testActivitiesHandlerSuite) TestGetActivities_StatusUnauthorized() {
+	result := testutil.NewRequest().Get("/activity").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusUnauthorized, result.Code())
+}
+
+func TestActivitiesHandler(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(testActivitiesHandlerSuite))
+}
@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/services"
+	"app/utils"
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type AppPasswordsHandler interface {
+	PostAppPasswords(ctx context.Context, request apis.PostAppPasswordsRequestObject) (apis.PostAppPasswordsResponseObject, error)
+	GetAppPasswords(ctx context.Context, request apis.GetAppPasswordsRequestObject) (apis.GetAppPasswordsResponseObject, error)
+	DeleteAppPassword(ctx context.Context, request apis.DeleteAppPasswordRequestObject) (apis.DeleteAppPasswordResponseObject, error)
+}
+
+type appPasswordsHandler struct {
+	appPasswordService services.AppPasswordService
+}
+
+func NewAppPasswordsHandler(appPasswordService services.AppPasswordService) AppPasswordsHandler {
+	return &appPasswordsHandler{appPasswordService: appPasswordService}
+}
+
+func (appPasswordsHandler *appPasswordsHandler) PostAppPasswords(ctx context.Context, request apis.PostAppPasswordsRequestObject) (apis.PostAppPasswordsResponseObject, error) {
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.PostAppPasswords500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, appPassword, password, err := appPasswordsHandler.appPasswordService.CreateAppPassword(ctx, *request.Body, userID)
+	switch statusCode {
+	case http.StatusBadRequest:
+		validationErrors := appPasswordsHandler.mappingValidationErrorStruct(err)
+		return apis.PostAppPasswords400JSONResponse{Code: http.StatusBadRequest, Errors: validationErrors}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostAppPasswords500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	// NOTE: パスワードは作成時のレスポンスでのみ返す
+	resAppPassword := appPasswordsHandler.mappingAppPassword(appPassword)
+	res := apis.CreateAppPasswordResponseJSONResponse{Code: http.StatusOK, Errors: apis.StoreAppPasswordValidationError{}, AppPassword: &resAppPassword, Password: &password}
+	return apis.PostAppPasswords200JSONResponse{CreateAppPasswordResponseJSONResponse: res}, nil
+}
+
+func (appPasswordsHandler *appPasswordsHandler) GetAppPasswords(ctx context.Context, request apis.GetAppPasswordsRequestObject) (apis.GetAppPasswordsResponseObject, error) {
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.GetAppPasswords500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, appPasswordsList, err := appPasswordsHandler.appPasswordService.FetchAppPasswordsList(ctx, userID)
+	switch statusCode {
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.GetAppPasswords500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	resAppPasswordsList := apis.FetchAppPasswordsResponseJSONResponse{AppPasswords: []apis.AppPassword{}}
+	for _, appPassword := range *appPasswordsList {
+		resAppPasswordsList.AppPasswords = append(resAppPasswordsList.AppPasswords, appPasswordsHandler.mappingAppPassword(appPassword))
+	}
+	return apis.GetAppPasswords200JSONResponse{FetchAppPasswordsResponseJSONResponse: resAppPasswordsList}, nil
+}
+
+func (appPasswordsHandler *appPasswordsHandler) DeleteAppPassword(ctx context.Context, request apis.DeleteAppPasswordRequestObject) (apis.DeleteAppPasswordResponseObject, error) {
+	intID, err := strconv.Atoi(request.Id)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.DeleteAppPassword500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.DeleteAppPassword500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, err := appPasswordsHandler.appPasswordService.RevokeAppPassword(ctx, int64(intID), userID)
+	switch statusCode {
+	case http.StatusNotFound:
+		res := apis.NotFoundErrorResponseJSONResponse{Code: http.StatusNotFound}
+		return apis.DeleteAppPassword404JSONResponse{NotFoundErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.DeleteAppPassword500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	res := apis.DeleteAppPasswordResponseJSONResponse{Code: http.StatusOK, Result: true}
+	return apis.DeleteAppPassword200JSONResponse{DeleteAppPasswordResponseJSONResponse: res}, nil
+}
+
+func (appPasswordsHandler *appPasswordsHandler) mappingAppPassword(appPassword *models.AppPassword) apis.AppPassword {
+	resAppPassword := apis.AppPassword{
+		Id:        int(appPassword.ID),
+		Name:      appPassword.Name,
+		CreatedAt: appPassword.CreatedAt,
+	}
+	if appPassword.LastUsedAt.Valid {
+		resAppPassword.LastUsedAt = &appPassword.LastUsedAt.Time
+	}
+	return resAppPassword
+}
+
+func (appPasswordsHandler *appPasswordsHandler) mappingValidationErrorStruct(err error) apis.StoreAppPasswordValidationError {
+	var validationError apis.StoreAppPasswordValidationError
+	if err == nil {
+		return validationError
+	}
+
+	if errors, ok := err.(validation.Errors); ok {
+		// NOTE: レスポンス用の構造体にマッピング
+		for field, err := range errors {
+			messages := []string{err.Error()}
+			switch field {
+			case "name":
+				validationError.Name = &messages
+			}
+		}
+	}
+	return validationError
+}
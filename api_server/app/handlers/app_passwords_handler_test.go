@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/oapi-codegen/testutil"
+)
+
+type testAppPasswordsHandlerSuite struct {
+	WithDBSuite
+}
+
+func (s *testAppPasswordsHandlerSuite) SetupTest() {
+	s.SetDBCon()
+
+	s.initializeHandlers()
+
+	s.SetCsrfHeaderValues()
+}
+
+func (s *testAppPasswordsHandlerSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *testAppPasswordsHandlerSuite) createAppPassword() apis.PostAppPasswords200JSONResponse {
+	reqBody := apis.StoreAppPasswordInput{Name: "iPhone"}
+	result := testutil.NewRequest().Post("/appPasswords").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.PostAppPasswords200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	return res
+}
+
+func (s *testAppPasswordsHandlerSuite) TestPostAppPasswords_StatusOk() {
+	s.SignIn()
+
+	res := s.createAppPassword()
+
+	assert.Equal(s.T(), int64(http.StatusOK), res.Code)
+	assert.Equal(s.T(), "iPhone", res.AppPassword.Name)
+	assert.NotEmpty(s.T(), *res.Password)
+}
+
+func (s *testAppPasswordsHandlerSuite) TestPostAppPasswords_BadRequest() {
+	s.SignIn()
+
+	reqBody := apis.StoreAppPasswordInput{Name: ""}
+	result := testutil.NewRequest().Post("/appPasswords").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusBadRequest, result.Code())
+
+	var res apis.PostAppPasswords400JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Equal(s.T(), []string{"名前は必須入力です。"}, *res.Errors.Name)
+}
+
+func (s *testAppPasswordsHandlerSuite) TestPostAppPasswords_StatusUnauthorized() {
+	reqBody := apis.StoreAppPasswordInput{Name: "iPhone"}
+	result := testutil.NewRequest().Post("/appPasswords").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusUnauthorized, result.Code())
+}
+
+func (s *testAppPasswordsHandlerSuite) TestGetAppPasswords_StatusOk() {
+	s.SignIn()
+	s.createAppPassword()
+
+	result := testutil.NewRequest().Get("/appPasswords").WithHeader("Cookie", token).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.GetAppPasswords200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Len(s.T(), res.AppPasswords, 1)
+	assert.Equal(s.T(), "iPhone", res.AppPasswords[0].Name)
+	// NOTE: 一覧にはパスワードを含めないこと
+	assert.NotContains(s.T(), string(result.Recorder.Body.Bytes()), "password")
+}
+
+func (s *testAppPasswordsHandlerSuite) TestDeleteAppPassword_StatusOk() {
+	s.SignIn()
+	created := s.createAppPassword()
+
+	result := testutil.NewRequest().Delete("/appPasswords/"+strconv.Itoa(created.AppPassword.Id)).WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	count, _ := models.AppPasswords().Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(0), count)
+}
+
+func (s *testAppPasswordsHandlerSuite) TestDeleteAppPassword_NotFound() {
+	s.SignIn()
+
+	result := testutil.NewRequest().Delete("/appPasswords/0").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusNotFound, result.Code())
+}
+
+func TestAppPasswordsHandler(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(testAppPasswordsHandlerSuite))
+}
@@ -0,0 +1,223 @@
+package handlers
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/services"
+	"app/utils"
+	"app/validator"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type AttachmentsHandler interface {
+	PostTodoAttachments(ctx context.Context, request apis.PostTodoAttachmentsRequestObject) (apis.PostTodoAttachmentsResponseObject, error)
+	GetTodoAttachments(ctx context.Context, request apis.GetTodoAttachmentsRequestObject) (apis.GetTodoAttachmentsResponseObject, error)
+	GetTodoAttachment(ctx context.Context, request apis.GetTodoAttachmentRequestObject) (apis.GetTodoAttachmentResponseObject, error)
+	DeleteTodoAttachment(ctx context.Context, request apis.DeleteTodoAttachmentRequestObject) (apis.DeleteTodoAttachmentResponseObject, error)
+}
+
+type attachmentsHandler struct {
+	attachmentService services.AttachmentService
+}
+
+func NewAttachmentsHandler(attachmentService services.AttachmentService) AttachmentsHandler {
+	return &attachmentsHandler{attachmentService: attachmentService}
+}
+
+func (attachmentsHandler *attachmentsHandler) PostTodoAttachments(ctx context.Context, request apis.PostTodoAttachmentsRequestObject) (apis.PostTodoAttachmentsResponseObject, error) {
+	intTodoID, err := strconv.Atoi(request.Id)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostTodoAttachments500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.PostTodoAttachments500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	inputStruct, mappingErr := attachmentsHandler.mappingInputStruct(request.Body)
+	if mappingErr != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: mappingErr.Error()}
+		return apis.PostTodoAttachments500JSONResponse{InternalServerErrorResponseJSONResponse: res}, nil
+	}
+
+	statusCode, attachment, err := attachmentsHandler.attachmentService.CreateAttachment(ctx, int64(intTodoID), inputStruct, userID)
+	switch statusCode {
+	case http.StatusBadRequest:
+		validationErrors := attachmentsHandler.mappingValidationErrorStruct(err)
+		return apis.PostTodoAttachments400JSONResponse{Code: http.StatusBadRequest, Errors: validationErrors}, nil
+	case http.StatusNotFound:
+		res := apis.NotFoundErrorResponseJSONResponse{Code: http.StatusNotFound}
+		return apis.PostTodoAttachments404JSONResponse{NotFoundErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostTodoAttachments500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	resAttachment := mappingAttachment(attachment)
+	res := apis.StoreAttachmentResponseJSONResponse{Code: http.StatusOK, Errors: apis.StoreAttachmentValidationError{}, Attachment: &resAttachment}
+	return apis.PostTodoAttachments200JSONResponse{StoreAttachmentResponseJSONResponse: res}, nil
+}
+
+func (attachmentsHandler *attachmentsHandler) GetTodoAttachments(ctx context.Context, request apis.GetTodoAttachmentsRequestObject) (apis.GetTodoAttachmentsResponseObject, error) {
+	intTodoID, err := strconv.Atoi(request.Id)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.GetTodoAttachments500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.GetTodoAttachments500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, attachmentsList, err := attachmentsHandler.attachmentService.FetchAttachmentsList(ctx, int64(intTodoID), userID)
+	switch statusCode {
+	case http.StatusNotFound:
+		res := apis.NotFoundErrorResponseJSONResponse{Code: http.StatusNotFound}
+		return apis.GetTodoAttachments404JSONResponse{NotFoundErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.GetTodoAttachments500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	resAttachmentsList := apis.FetchAttachmentsResponseJSONResponse{Attachments: []apis.Attachment{}}
+	for _, attachment := range *attachmentsList {
+		resAttachmentsList.Attachments = append(resAttachmentsList.Attachments, mappingAttachment(attachment))
+	}
+	return apis.GetTodoAttachments200JSONResponse{FetchAttachmentsResponseJSONResponse: resAttachmentsList}, nil
+}
+
+func (attachmentsHandler *attachmentsHandler) GetTodoAttachment(ctx context.Context, request apis.GetTodoAttachmentRequestObject) (apis.GetTodoAttachmentResponseObject, error) {
+	intTodoID, err := strconv.Atoi(request.Id)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.GetTodoAttachment500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+	intID, err := strconv.Atoi(request.AttachmentId)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.GetTodoAttachment500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.GetTodoAttachment500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, attachment, url, expiresAt, err := attachmentsHandler.attachmentService.ShowAttachmentURL(ctx, int64(intTodoID), int64(intID), userID)
+	switch statusCode {
+	case http.StatusNotFound:
+		res := apis.NotFoundErrorResponseJSONResponse{Code: http.StatusNotFound}
+		return apis.GetTodoAttachment404JSONResponse{NotFoundErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.GetTodoAttachment500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	res := apis.ShowAttachmentResponseJSONResponse{Attachment: mappingAttachment(attachment), Url: url, ExpiresAt: expiresAt}
+	return apis.GetTodoAttachment200JSONResponse{ShowAttachmentResponseJSONResponse: res}, nil
+}
+
+func (attachmentsHandler *attachmentsHandler) DeleteTodoAttachment(ctx context.Context, request apis.DeleteTodoAttachmentRequestObject) (apis.DeleteTodoAttachmentResponseObject, error) {
+	intTodoID, err := strconv.Atoi(request.Id)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.DeleteTodoAttachment500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+	intID, err := strconv.Atoi(request.AttachmentId)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.DeleteTodoAttachment500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.DeleteTodoAttachment500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, err := attachmentsHandler.attachmentService.DeleteAttachment(ctx, int64(intTodoID), int64(intID), userID)
+	switch statusCode {
+	case http.StatusNotFound:
+		res := apis.NotFoundErrorResponseJSONResponse{Code: http.StatusNotFound}
+		return apis.DeleteTodoAttachment404JSONResponse{NotFoundErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.DeleteTodoAttachment500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	res := apis.DeleteAttachmentResponseJSONResponse{Code: http.StatusOK, Result: true}
+	return apis.DeleteTodoAttachment200JSONResponse{DeleteAttachmentResponseJSONResponse: res}, nil
+}
+
+func (attachmentsHandler *attachmentsHandler) mappingInputStruct(reader *multipart.Reader) (apis.PostTodoAttachmentsMultipartRequestBody, error) {
+	var inputStruct apis.PostTodoAttachmentsMultipartRequestBody
+
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			// NOTE: 全てのパートを読み終えた場合
+			break
+		}
+		if err != nil {
+			return inputStruct, fmt.Errorf("failed to read multipart part: %w", err)
+		}
+
+		if part.FormName() != "file" {
+			continue
+		}
+
+		// NOTE: 上限サイズを超えたことを検知できるよう1バイトだけ余分に読み込む
+		var buf bytes.Buffer
+		if _, err := io.Copy(&buf, io.LimitReader(part, validator.MaxAttachmentSize+1)); err != nil {
+			return inputStruct, fmt.Errorf("failed to copy content: %w", err)
+		}
+		inputStruct.File.InitFromBytes(buf.Bytes(), part.FileName())
+	}
+
+	return inputStruct, nil
+}
+
+func (attachmentsHandler *attachmentsHandler) mappingValidationErrorStruct(err error) apis.StoreAttachmentValidationError {
+	var validationError apis.StoreAttachmentValidationError
+	if err == nil {
+		return validationError
+	}
+
+	if errors, ok := err.(validation.Errors); ok {
+		// NOTE: レスポンス用の構造体にマッピング
+		for field, err := range errors {
+			messages := []string{err.Error()}
+			switch field {
+			case "file":
+				validationError.File = &messages
+			}
+		}
+	}
+	return validationError
+}
+
+func mappingAttachment(attachment *models.Attachment) apis.Attachment {
+	return apis.Attachment{
+		Id:          int(attachment.ID),
+		TodoId:      int(attachment.TodoID),
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		CreatedAt:   attachment.CreatedAt,
+	}
+}
@@ -0,0 +1,181 @@
+package handlers
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/test/factories"
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/oapi-codegen/testutil"
+)
+
+type testAttachmentsHandlerSuite struct {
+	WithDBSuite
+}
+
+func (s *testAttachmentsHandlerSuite) SetupTest() {
+	s.SetDBCon()
+
+	s.initializeHandlers()
+
+	s.SetCsrfHeaderValues()
+}
+
+func (s *testAttachmentsHandlerSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *testAttachmentsHandlerSuite) createTodo() *models.Todo {
+	todoParam := map[string]interface{}{"UserID": int64(user.ID), "Title": "test title 1", "Content": null.String{String: "test content 1", Valid: true}}
+	todo := factories.TodoFactory.MustCreateWithOption(todoParam).(*models.Todo)
+	if err := todo.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+	return todo
+}
+
+func (s *testAttachmentsHandlerSuite) uploadAttachment(todoID int64, content []byte, filename string) *testutil.CompletedRequest {
+	body := new(bytes.Buffer)
+	// NOTE: フォームデータを作成する
+	mw := multipart.NewWriter(body)
+	w, _ := mw.CreateFormFile("file", filename)
+	w.Write(content)
+	mw.Close()
+
+	return testutil.NewRequest().Post("/todos/"+strconv.Itoa(int(todoID))+"/attachments").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithBody(body.Bytes()).WithContentType(mw.FormDataContentType()).GoWithHTTPHandler(s.T(), e)
+}
+
+func (s *testAttachmentsHandlerSuite) TestPostTodoAttachments_StatusOk() {
+	s.SignIn()
+	todo := s.createTodo()
+
+	result := s.uploadAttachment(todo.ID, []byte{0x89, 0x50, 0x4E, 0x47, 0x0D, 0x0A, 0x1A, 0x0A}, "image.png")
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.PostTodoAttachments200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Equal(s.T(), "image.png", res.Attachment.FileName)
+	assert.Equal(s.T(), "image/png", res.Attachment.ContentType)
+
+	// NOTE: 添付ファイルが登録されていることを確認
+	isExistAttachment, _ := models.Attachments(qm.Where("todo_id = ?", todo.ID)).Exists(ctx, DBCon)
+	assert.True(s.T(), isExistAttachment)
+}
+
+func (s *testAttachmentsHandlerSuite) TestPostTodoAttachments_BadRequest() {
+	s.SignIn()
+	todo := s.createTodo()
+
+	result := s.uploadAttachment(todo.ID, []byte{0x50, 0x4B, 0x03, 0x04}, "archive.png")
+	assert.Equal(s.T(), http.StatusBadRequest, result.Code())
+
+	var res apis.PostTodoAttachments400JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Equal(s.T(), []string{"添付ファイルの形式は画像(webp, png, jpeg, gif), pdf, テキスト, csvのいずれかでお願いします。"}, *res.Errors.File)
+
+	// NOTE: 添付ファイルが登録されていないことを確認
+	isExistAttachment, _ := models.Attachments(qm.Where("todo_id = ?", todo.ID)).Exists(ctx, DBCon)
+	assert.False(s.T(), isExistAttachment)
+}
+
+func (s *testAttachmentsHandlerSuite) TestPostTodoAttachments_StatusNotFound() {
+	s.SignIn()
+	todo := s.createTodo()
+
+	result := s.uploadAttachment(todo.ID+1, []byte("memo"), "memo.txt")
+	assert.Equal(s.T(), http.StatusNotFound, result.Code())
+}
+
+func (s *testAttachmentsHandlerSuite) TestPostTodoAttachments_StatusUnauthorized() {
+	body := new(bytes.Buffer)
+	mw := multipart.NewWriter(body)
+	w, _ := mw.CreateFormFile("file", "memo.txt")
+	w.Write([]byte("memo"))
+	mw.Close()
+
+	result := testutil.NewRequest().Post("/todos/1/attachments").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithBody(body.Bytes()).WithContentType(mw.FormDataContentType()).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusUnauthorized, result.Code())
+}
+
+func (s *testAttachmentsHandlerSuite) TestGetTodoAttachments_StatusOk() {
+	s.SignIn()
+	todo := s.createTodo()
+	s.uploadAttachment(todo.ID, []byte("memo"), "memo.txt")
+
+	result := testutil.NewRequest().Get("/todos/"+strconv.Itoa(int(todo.ID))+"/attachments").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.GetTodoAttachments200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Len(s.T(), res.Attachments, 1)
+	assert.Equal(s.T(), "memo.txt", res.Attachments[0].FileName)
+}
+
+func (s *testAttachmentsHandlerSuite) TestGetTodoAttachment_StatusOk() {
+	s.SignIn()
+	todo := s.createTodo()
+	var uploaded apis.PostTodoAttachments200JSONResponse
+	s.uploadAttachment(todo.ID, []byte("memo"), "memo.txt").UnmarshalBodyToObject(&uploaded)
+
+	result := testutil.NewRequest().Get("/todos/"+strconv.Itoa(int(todo.ID))+"/attachments/"+strconv.Itoa(uploaded.Attachment.Id)).WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.GetTodoAttachment200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+
+	// NOTE: 署名付きURLからダウンロードできることを確認
+	downloadRes, err := http.Get(res.Url)
+	if err != nil {
+		s.T().Fatalf("failed to download attachment %v", err)
+	}
+	defer downloadRes.Body.Close()
+	body, _ := io.ReadAll(downloadRes.Body)
+	assert.Equal(s.T(), "memo", string(body))
+}
+
+func (s *testAttachmentsHandlerSuite) TestGetTodoAttachment_StatusNotFound() {
+	s.SignIn()
+	todo := s.createTodo()
+
+	result := testutil.NewRequest().Get("/todos/"+strconv.Itoa(int(todo.ID))+"/attachments/1").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusNotFound, result.Code())
+}
+
+func (s *testAttachmentsHandlerSuite) TestDeleteTodoAttachment_StatusOk() {
+	s.SignIn()
+	todo := s.createTodo()
+	var uploaded apis.PostTodoAttachments200JSONResponse
+	s.uploadAttachment(todo.ID, []byte("memo"), "memo.txt").UnmarshalBodyToObject(&uploaded)
+
+	result := testutil.NewRequest().Delete("/todos/"+strconv.Itoa(int(todo.ID))+"/attachments/"+strconv.Itoa(uploaded.Attachment.Id)).WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	// NOTE: 添付ファイルが削除されていることを確認
+	isExistAttachment, _ := models.Attachments(qm.Where("id = ?", uploaded.Attachment.Id)).Exists(ctx, DBCon)
+	assert.False(s.T(), isExistAttachment)
+}
+
+func (s *testAttachmentsHandlerSuite) TestDeleteTodoAttachment_StatusNotFound() {
+	s.SignIn()
+	todo := s.createTodo()
+
+	result := testutil.NewRequest().Delete("/todos/"+strconv.Itoa(int(todo.ID))+"/attachments/1").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusNotFound, result.Code())
+}
+
+func TestAttachmentsHandler(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(testAttachmentsHandlerSuite))
+}
@@ -3,11 +3,15 @@ package handlers
 import (
 	apis "app/openapi"
 	"app/services"
+	"app/utils"
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
+	"math"
 	"mime/multipart"
 	"net/http"
 	"time"
@@ -20,6 +24,14 @@ import (
 type AuthHandler interface {
 	GetAuthCsrf(ctx context.Context, request apis.GetAuthCsrfRequestObject) (apis.GetAuthCsrfResponseObject, error)
 	PostAuthSignIn(ctx context.Context, request apis.PostAuthSignInRequestObject) (apis.PostAuthSignInResponseObject, error)
+	PostAuthSignInTwoFactor(ctx context.Context, request apis.PostAuthSignInTwoFactorRequestObject) (apis.PostAuthSignInTwoFactorResponseObject, error)
+	PostAuthRefresh(ctx context.Context, request apis.PostAuthRefreshRequestObject) (apis.PostAuthRefreshResponseObject, error)
+	PostAuthSignOut(ctx context.Context, request apis.PostAuthSignOutRequestObject) (apis.PostAuthSignOutResponseObject, error)
+	PostAuthPasswordForgot(ctx context.Context, request apis.PostAuthPasswordForgotRequestObject) (apis.PostAuthPasswordForgotResponseObject, error)
+	PostAuthPasswordReset(ctx context.Context, request apis.PostAuthPasswordResetRequestObject) (apis.PostAuthPasswordResetResponseObject, error)
+	PostAuthEmailVerify(ctx context.Context, request apis.PostAuthEmailVerifyRequestObject) (apis.PostAuthEmailVerifyResponseObject, error)
+	PostAuthEmailResend(ctx context.Context, request apis.PostAuthEmailResendRequestObject) (apis.PostAuthEmailResendResponseObject, error)
+	PostAuthUnlock(ctx context.Context, request apis.PostAuthUnlockRequestObject) (apis.PostAuthUnlockResponseObject, error)
 	PostAuthValidateSignUp(ctx context.Context, request apis.PostAuthValidateSignUpRequestObject) (apis.PostAuthValidateSignUpResponseObject, error)
 	PostAuthSignUp(ctx context.Context, request apis.PostAuthSignUpRequestObject) (apis.PostAuthSignUpResponseObject, error)
 }
@@ -50,7 +62,8 @@ func (authHandler *authHandler) PostAuthSignIn(ctx context.Context, request apis
 		Password: request.Body.Password,
 	}
 
-	statusCode, tokenString, err := authHandler.authService.SignIn(ctx, inputs)
+	client, _ := utils.ClientContextValue(ctx)
+	statusCode, tokenPair, twoFactorToken, err := authHandler.authService.SignIn(ctx, inputs, client.UserAgent, client.IPAddress)
 	switch (statusCode) {
 	case http.StatusInternalServerError:
 		return apis.PostAuthSignIn500JSONResponse{InternalServerErrorResponseJSONResponse: apis.InternalServerErrorResponseJSONResponse{
@@ -61,23 +74,283 @@ func (authHandler *authHandler) PostAuthSignIn(ctx context.Context, request apis
 		return apis.PostAuthSignIn400JSONResponse{SignInBadRequestResponseJSONResponse: apis.SignInBadRequestResponseJSONResponse{
 			Errors: []string{err.Error()},
 		}}, nil
+	case http.StatusTooManyRequests:
+		return apis.PostAuthSignIn429JSONResponse{TooManyRequestsErrorResponseJSONResponse: newSignInThrottledResponse(err)}, nil
+	}
+
+	// NOTE: 2段階認証が必要な場合はCookieをセットせず、2段階目の認証用のトークンを返す
+	if twoFactorToken != "" {
+		twoFactorRequired := true
+		return authCookiesResponse{body: apis.SignInResult{TwoFactorRequired: &twoFactorRequired, TwoFactorToken: &twoFactorToken}}, nil
 	}
 	
 	// NOTE: Cookieにtokenをセット
-	cookie := &http.Cookie{
+	return authCookiesResponse{cookies: newAuthCookies(tokenPair)}, nil
+}
+
+func (authHandler *authHandler) PostAuthSignInTwoFactor(ctx context.Context, request apis.PostAuthSignInTwoFactorRequestObject) (apis.PostAuthSignInTwoFactorResponseObject, error) {
+	client, _ := utils.ClientContextValue(ctx)
+	statusCode, tokenPair, err := authHandler.authService.SignInTwoFactor(ctx, *request.Body, client.UserAgent, client.IPAddress)
+	switch (statusCode) {
+	case http.StatusInternalServerError:
+		return apis.PostAuthSignInTwoFactor500JSONResponse{InternalServerErrorResponseJSONResponse: apis.InternalServerErrorResponseJSONResponse{
+			Code: http.StatusInternalServerError,
+			Message: err.Error(),
+		}}, nil
+	case http.StatusBadRequest:
+		messages := []string{}
+		if errors, ok := err.(validation.Errors); ok {
+			for _, field := range []string{"twoFactorToken", "code"} {
+				if fieldErr, ok := errors[field]; ok {
+					messages = append(messages, fieldErr.Error())
+				}
+			}
+		} else {
+			messages = append(messages, err.Error())
+		}
+		return apis.PostAuthSignInTwoFactor400JSONResponse{SignInBadRequestResponseJSONResponse: apis.SignInBadRequestResponseJSONResponse{
+			Errors: messages,
+		}}, nil
+	case http.StatusTooManyRequests:
+		return apis.PostAuthSignInTwoFactor429JSONResponse{TooManyRequestsErrorResponseJSONResponse: newSignInThrottledResponse(err)}, nil
+	}
+
+	// NOTE: Cookieにtokenをセット
+	return authCookiesResponse{cookies: newAuthCookies(tokenPair)}, nil
+}
+
+func newSignInThrottledResponse(err error) apis.TooManyRequestsErrorResponseJSONResponse {
+	res := apis.TooManyRequestsErrorResponseJSONResponse{Code: http.StatusTooManyRequests, Message: err.Error()}
+	var throttledErr *services.SignInThrottledError
+	if errors.As(err, &throttledErr) {
+		// NOTE: 1秒未満の待ち時間も再試行できない時間として切り上げる
+		retryAfter := int64(math.Ceil(throttledErr.RetryAfter.Seconds()))
+		res.RetryAfter = &retryAfter
+	}
+	return res
+}
+
+func (authHandler *authHandler) PostAuthRefresh(ctx context.Context, request apis.PostAuthRefreshRequestObject) (apis.PostAuthRefreshResponseObject, error) {
+	var refreshToken string
+	if request.Params.RefreshToken != nil {
+		refreshToken = *request.Params.RefreshToken
+	}
+
+	statusCode, tokenPair, err := authHandler.authService.Refresh(ctx, refreshToken)
+	switch (statusCode) {
+	case http.StatusInternalServerError:
+		return apis.PostAuthRefresh500JSONResponse{InternalServerErrorResponseJSONResponse: apis.InternalServerErrorResponseJSONResponse{
+			Code: http.StatusInternalServerError,
+			Message: err.Error(),
+		}}, nil
+	case http.StatusUnauthorized:
+		res := apis.UnauthorizedErrorResponseJSONResponse{Code: http.StatusUnauthorized, Message: err.Error()}
+		var authTokenErr *services.AuthTokenError
+		if errors.As(err, &authTokenErr) {
+			res.Reason = &authTokenErr.Reason
+		}
+		return apis.PostAuthRefresh401JSONResponse{UnauthorizedErrorResponseJSONResponse: res}, nil
+	}
+
+	// NOTE: ローテーションしたリフレッシュトークンと新しいtokenをCookieにセット
+	return authCookiesResponse{cookies: newAuthCookies(tokenPair)}, nil
+}
+
+func (authHandler *authHandler) PostAuthSignOut(ctx context.Context, request apis.PostAuthSignOutRequestObject) (apis.PostAuthSignOutResponseObject, error) {
+	var accessToken, refreshToken string
+	if request.Params.Token != nil {
+		accessToken = *request.Params.Token
+	}
+	if request.Params.RefreshToken != nil {
+		refreshToken = *request.Params.RefreshToken
+	}
+
+	statusCode, err := authHandler.authService.SignOut(ctx, accessToken, refreshToken)
+	if statusCode == http.StatusInternalServerError {
+		return apis.PostAuthSignOut500JSONResponse{InternalServerErrorResponseJSONResponse: apis.InternalServerErrorResponseJSONResponse{
+			Code: http.StatusInternalServerError,
+			Message: err.Error(),
+		}}, nil
+	}
+
+	// NOTE: トークンが無効な場合も、Cookieのtokenは必ず削除する
+	return authCookiesResponse{cookies: newAuthCookies(nil)}, nil
+}
+
+func (authHandler *authHandler) PostAuthPasswordForgot(ctx context.Context, request apis.PostAuthPasswordForgotRequestObject) (apis.PostAuthPasswordForgotResponseObject, error) {
+	statusCode, err := authHandler.authService.ForgotPassword(ctx, *request.Body)
+	switch statusCode {
+	case http.StatusBadRequest:
+		validationError := apis.ForgotPasswordValidationError{}
+		if errors, ok := err.(validation.Errors); ok {
+			if emailErr, ok := errors["email"]; ok {
+				validationError.Email = &[]string{emailErr.Error()}
+			}
+		}
+		return apis.PostAuthPasswordForgot400JSONResponse{Code: http.StatusBadRequest, Errors: validationError}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostAuthPasswordForgot500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	res := apis.ForgotPasswordResponseJSONResponse{Code: http.StatusOK, Errors: apis.ForgotPasswordValidationError{}}
+	return apis.PostAuthPasswordForgot200JSONResponse{ForgotPasswordResponseJSONResponse: res}, nil
+}
+
+func (authHandler *authHandler) PostAuthPasswordReset(ctx context.Context, request apis.PostAuthPasswordResetRequestObject) (apis.PostAuthPasswordResetResponseObject, error) {
+	statusCode, err := authHandler.authService.ResetPassword(ctx, *request.Body)
+	switch statusCode {
+	case http.StatusBadRequest:
+		validationError := apis.ResetPasswordValidationError{}
+		if errors, ok := err.(validation.Errors); ok {
+			// NOTE: レスポンス用の構造体にマッピング
+			for field, err := range errors {
+				messages := []string{err.Error()}
+				switch field {
+				case "token":
+					validationError.Token = &messages
+				case "password":
+					validationError.Password = &messages
+				}
+			}
+		}
+		return apis.PostAuthPasswordReset400JSONResponse{Code: http.StatusBadRequest, Errors: validationError}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostAuthPasswordReset500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	res := apis.ResetPasswordResponseJSONResponse{Code: http.StatusOK, Errors: apis.ResetPasswordValidationError{}}
+	return apis.PostAuthPasswordReset200JSONResponse{ResetPasswordResponseJSONResponse: res}, nil
+}
+
+func (authHandler *authHandler) PostAuthEmailVerify(ctx context.Context, request apis.PostAuthEmailVerifyRequestObject) (apis.PostAuthEmailVerifyResponseObject, error) {
+	statusCode, err := authHandler.authService.VerifyEmail(ctx, *request.Body)
+	switch statusCode {
+	case http.StatusBadRequest:
+		validationError := apis.VerifyEmailValidationError{}
+		if errors, ok := err.(validation.Errors); ok {
+			if tokenErr, ok := errors["token"]; ok {
+				validationError.Token = &[]string{tokenErr.Error()}
+			}
+		}
+		return apis.PostAuthEmailVerify400JSONResponse{Code: http.StatusBadRequest, Errors: validationError}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostAuthEmailVerify500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	res := apis.VerifyEmailResponseJSONResponse{Code: http.StatusOK, Errors: apis.VerifyEmailValidationError{}}
+	return apis.PostAuthEmailVerify200JSONResponse{VerifyEmailResponseJSONResponse: res}, nil
+}
+
+func (authHandler *authHandler) PostAuthEmailResend(ctx context.Context, request apis.PostAuthEmailResendRequestObject) (apis.PostAuthEmailResendResponseObject, error) {
+	statusCode, err := authHandler.authService.ResendEmailVerification(ctx, *request.Body)
+	switch statusCode {
+	case http.StatusBadRequest:
+		validationError := apis.ResendEmailVerificationValidationError{}
+		if errors, ok := err.(validation.Errors); ok {
+			if emailErr, ok := errors["email"]; ok {
+				validationError.Email = &[]string{emailErr.Error()}
+			}
+		}
+		return apis.PostAuthEmailResend400JSONResponse{Code: http.StatusBadRequest, Errors: validationError}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostAuthEmailResend500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	res := apis.ResendEmailVerificationResponseJSONResponse{Code: http.StatusOK, Errors: apis.ResendEmailVerificationValidationError{}}
+	return apis.PostAuthEmailResend200JSONResponse{ResendEmailVerificationResponseJSONResponse: res}, nil
+}
+
+func (authHandler *authHandler) PostAuthUnlock(ctx context.Context, request apis.PostAuthUnlockRequestObject) (apis.PostAuthUnlockResponseObject, error) {
+	statusCode, err := authHandler.authService.UnlockAccount(ctx, *request.Body)
+	switch statusCode {
+	case http.StatusBadRequest:
+		validationError := apis.UnlockAccountValidationError{}
+		if errors, ok := err.(validation.Errors); ok {
+			if tokenErr, ok := errors["token"]; ok {
+				validationError.Token = &[]string{tokenErr.Error()}
+			}
+		}
+		return apis.PostAuthUnlock400JSONResponse{Code: http.StatusBadRequest, Errors: validationError}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostAuthUnlock500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	res := apis.UnlockAccountResponseJSONResponse{Code: http.StatusOK, Errors: apis.UnlockAccountValidationError{}}
+	return apis.PostAuthUnlock200JSONResponse{UnlockAccountResponseJSONResponse: res}, nil
+}
+
+// NOTE: tokenPairがnilの場合は削除用のCookieを返す
+//     : リフレッシュトークンは/auth配下へのリクエストにのみ送信されるようにする
+func newAuthCookies(tokenPair *services.AuthTokenPair) []*http.Cookie {
+	accessTokenCookie := &http.Cookie{
 		Name:     "token",
-		Value:    tokenString,
-		MaxAge:   3600 * 24,
+		Value:    "",
+		MaxAge:   -1,
 		Path:     "/",
 		Domain:   "localhost",
 		Secure:   false,
 		HttpOnly: true,
 	}
-	return apis.PostAuthSignIn200JSONResponse{SignInOkResponseJSONResponse: apis.SignInOkResponseJSONResponse{
-		Headers: apis.SignInOkResponseResponseHeaders{
-			SetCookie: cookie.String(),
-		},
-	}}, nil
+	refreshTokenCookie := &http.Cookie{
+		Name:     "refresh_token",
+		Value:    "",
+		MaxAge:   -1,
+		Path:     "/auth",
+		Domain:   "localhost",
+		Secure:   false,
+		HttpOnly: true,
+	}
+	if tokenPair != nil {
+		accessTokenCookie.Value = tokenPair.AccessToken
+		accessTokenCookie.MaxAge = int(services.AuthTokenLifetime.Seconds())
+		refreshTokenCookie.Value = tokenPair.RefreshToken
+		refreshTokenCookie.MaxAge = int(services.RefreshTokenLifetime.Seconds())
+	}
+	return []*http.Cookie{accessTokenCookie, refreshTokenCookie}
+}
+
+// NOTE: 生成されたレスポンスはSet-Cookieを1つしか設定できないため、複数のCookieを設定するレスポンスを定義する
+type authCookiesResponse struct {
+	cookies []*http.Cookie
+	body interface{}
+}
+
+func (response authCookiesResponse) visit(w http.ResponseWriter) error {
+	for _, cookie := range response.cookies {
+		http.SetCookie(w, cookie)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if response.body != nil {
+		return json.NewEncoder(w).Encode(response.body)
+	}
+	return json.NewEncoder(w).Encode(map[string]interface{}{})
+}
+
+func (response authCookiesResponse) VisitPostAuthSignInResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response authCookiesResponse) VisitPostAuthSignInTwoFactorResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response authCookiesResponse) VisitPostAuthPasskeysSignInFinishResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response authCookiesResponse) VisitPostAuthRefreshResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response authCookiesResponse) VisitPostAuthSignOutResponse(w http.ResponseWriter) error {
+	return response.visit(w)
 }
 
 func (authHandler *authHandler) PostAuthValidateSignUp(ctx context.Context, request apis.PostAuthValidateSignUpRequestObject) (apis.PostAuthValidateSignUpResponseObject, error) {
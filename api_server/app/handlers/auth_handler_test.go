@@ -3,14 +3,20 @@ package handlers
 import (
 	models "app/models/generated"
 	apis "app/openapi"
+	"app/services"
 	"app/test/factories"
 	"bytes"
 	"encoding/json"
 	"mime/multipart"
 	"net/http"
+	"net/url"
+	"regexp"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/golang-jwt/jwt"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -344,8 +350,9 @@ func (s *TestAuthHandlerSuite) TestPostAuthSignIn_StatusOk() {
 	result := testutil.NewRequest().Post("/auth/signIn").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
 	assert.Equal(s.T(), int(http.StatusOK), result.Code())
 
-	cookieString := result.Recorder.Result().Header.Values("Set-Cookie")[0]
-	assert.NotEmpty(s.T(), cookieString)
+	cookies := result.Recorder.Result().Cookies()
+	assert.NotEmpty(s.T(), findCookie(cookies, "token").Value)
+	assert.NotEmpty(s.T(), findCookie(cookies, "refresh_token").Value)
 }
 
 func (s *TestAuthHandlerSuite) TestPostAuthSignIn_BadRequest() {
@@ -369,6 +376,296 @@ func (s *TestAuthHandlerSuite) TestPostAuthSignIn_BadRequest() {
 	assert.Equal(s.T(), []string{"メールアドレスまたはパスワードに該当するユーザが存在しません。"}, res.Errors)
 }
 
+func (s *TestAuthHandlerSuite) TestPostAuthSignIn_TooManyRequests() {
+	// NOTE: テスト用ユーザの作成
+	user := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	for i := 0; i < services.AccountLockoutThreshold; i++ {
+		failure := &models.SignInFailure{Email: "test@example.com", IPAddress: "198.51.100.1", CreatedAt: time.Now().Add(-10 * time.Minute)}
+		if err := failure.Insert(ctx, DBCon, boil.Infer()); err != nil {
+			s.T().Fatalf("failed to create sign in failure %v", err)
+		}
+	}
+	lockout := &models.SignInLockout{Scope: "account", Email: null.StringFrom("test@example.com"), IPAddress: "198.51.100.1", FailedAttempts: services.AccountLockoutThreshold, LockedUntil: time.Now().Add(10 * time.Minute)}
+	if err := lockout.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create sign in lockout %v", err)
+	}
+
+	reqBody := apis.SignInInput{
+		Email: "test@example.com",
+		Password: "password",
+	}
+	result := testutil.NewRequest().Post("/auth/signIn").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusTooManyRequests, result.Code())
+	assert.Empty(s.T(), findCookie(result.Recorder.Result().Cookies(), "token").Value)
+
+	var res apis.TooManyRequestsErrorResponse
+	err := result.UnmarshalBodyToObject(&res)
+	assert.NoError(s.T(), err, "error unmarshaling response")
+	assert.Equal(s.T(), "サインインの試行回数が上限を超えました。しばらく時間を空けてからお試しください。", res.Message)
+	if assert.NotNil(s.T(), res.RetryAfter) {
+		assert.InDelta(s.T(), 600, *res.RetryAfter, 5)
+	}
+}
+
+func (s *TestAuthHandlerSuite) TestPostAuthSignIn_SpoofedForwardedFor() {
+	// NOTE: X-Forwarded-Forを偽装しても、接続元のIPアドレスで失敗が記録されることを確認
+	reqBody := apis.SignInInput{
+		Email: "test@example.com",
+		Password: "wrongPassword",
+	}
+	result := testutil.NewRequest().Post("/auth/signIn").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithHeader(echo.HeaderXForwardedFor, strings.Repeat("203.0.113.1, ", 10)).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusBadRequest, result.Code())
+
+	failure, err := models.SignInFailures(qm.Where("email = ?", "test@example.com")).One(ctx, DBCon)
+	if assert.NoError(s.T(), err) {
+		assert.Equal(s.T(), "192.0.2.1", failure.IPAddress)
+	}
+}
+
+func (s *TestAuthHandlerSuite) TestPostAuthRefresh_StatusOk() {
+	// NOTE: テスト用ユーザの作成
+	user := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	reqBody := apis.SignInInput{Email: "test@example.com", Password: "password"}
+	result := testutil.NewRequest().Post("/auth/signIn").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	refreshTokenCookie := findCookie(result.Recorder.Result().Cookies(), "refresh_token")
+	assert.Equal(s.T(), "/auth", refreshTokenCookie.Path)
+
+	result = testutil.NewRequest().Post("/auth/refresh").WithHeader("Cookie", refreshTokenCookie.Name+"="+refreshTokenCookie.Value+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	// NOTE: 新しいtokenとローテーションされたリフレッシュトークンがセットされることを確認
+	cookies := result.Recorder.Result().Cookies()
+	accessTokenCookie := findCookie(cookies, "token")
+	assert.NotEmpty(s.T(), accessTokenCookie.Value)
+	assert.NotEqual(s.T(), refreshTokenCookie.Value, findCookie(cookies, "refresh_token").Value)
+
+	result = testutil.NewRequest().Get("/todos").WithHeader("Cookie", "token="+accessTokenCookie.Value+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+}
+
+func (s *TestAuthHandlerSuite) TestPostAuthRefresh_StatusUnauthorized() {
+	result := testutil.NewRequest().Post("/auth/refresh").WithHeader("Cookie", "refresh_token=invalid; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusUnauthorized, result.Code())
+
+	var res apis.UnauthorizedErrorResponse
+	err := result.UnmarshalBodyToObject(&res)
+	assert.NoError(s.T(), err, "error unmarshaling response")
+	if assert.NotNil(s.T(), res.Reason) {
+		assert.Equal(s.T(), apis.RefreshTokenInvalid, *res.Reason)
+	}
+}
+
+func (s *TestAuthHandlerSuite) TestPostAuthSignOut_StatusOk() {
+	s.SignIn()
+
+	result := testutil.NewRequest().Post("/auth/signOut").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	// NOTE: Cookieが削除されることを確認
+	cookies := result.Recorder.Result().Cookies()
+	for _, name := range []string{"token", "refresh_token"} {
+		cookie := findCookie(cookies, name)
+		assert.Equal(s.T(), name, cookie.Name)
+		assert.Equal(s.T(), "", cookie.Value)
+		assert.True(s.T(), cookie.MaxAge < 0)
+	}
+
+	// NOTE: サインアウト前のtokenが利用できないことを確認
+	result = testutil.NewRequest().Get("/todos").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusUnauthorized, result.Code())
+
+	var res apis.UnauthorizedErrorResponse
+	err := result.UnmarshalBodyToObject(&res)
+	assert.NoError(s.T(), err, "error unmarshaling response")
+	if assert.NotNil(s.T(), res.Reason) {
+		assert.Equal(s.T(), apis.TokenRevoked, *res.Reason)
+	}
+}
+
+func (s *TestAuthHandlerSuite) TestPostAuthSignOut_ExpiredToken() {
+	// NOTE: テスト用ユーザの作成
+	user := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	reqBody := apis.SignInInput{Email: "test@example.com", Password: "password"}
+	result := testutil.NewRequest().Post("/auth/signIn").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	refreshTokenCookie := "refresh_token=" + findCookie(result.Recorder.Result().Cookies(), "refresh_token").Value
+
+	// NOTE: アクセストークンの有効期限が切れていてもサインアウトでき、Cookieが削除されることを確認
+	result = testutil.NewRequest().Post("/auth/signOut").WithHeader("Cookie", "token=expired; "+refreshTokenCookie+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+	for _, name := range []string{"token", "refresh_token"} {
+		cookie := findCookie(result.Recorder.Result().Cookies(), name)
+		assert.Equal(s.T(), "", cookie.Value)
+		assert.True(s.T(), cookie.MaxAge < 0)
+	}
+
+	// NOTE: セッションが失効し、リフレッシュトークンが利用できないことを確認
+	result = testutil.NewRequest().Post("/auth/refresh").WithHeader("Cookie", refreshTokenCookie+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusUnauthorized, result.Code())
+}
+
+func (s *TestAuthHandlerSuite) TestPostAuthSignOut_WithoutToken() {
+	result := testutil.NewRequest().Post("/auth/signOut").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+	assert.True(s.T(), findCookie(result.Recorder.Result().Cookies(), "token").MaxAge < 0)
+}
+
+func (s *TestAuthHandlerSuite) TestPostAuthPasswordForgot_SameResponse() {
+	// NOTE: テスト用ユーザの作成
+	user := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	// NOTE: メールアドレスが登録済みかどうかでレスポンスが変わらないことを確認
+	var bodies []string
+	for _, email := range []string{"test@example.com", "unknown@example.com"} {
+		reqBody := apis.PostAuthPasswordForgotJSONRequestBody{Email: email}
+		result := testutil.NewRequest().Post("/auth/password/forgot").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+		assert.Equal(s.T(), http.StatusOK, result.Code())
+		bodies = append(bodies, result.Recorder.Body.String())
+	}
+	assert.Equal(s.T(), bodies[0], bodies[1])
+	assert.Len(s.T(), testMailer.Messages(), 1)
+}
+
+func (s *TestAuthHandlerSuite) TestPostAuthPasswordReset_StatusOk() {
+	s.SignIn()
+
+	reqBody := apis.PostAuthPasswordForgotJSONRequestBody{Email: "test@example.com"}
+	testutil.NewRequest().Post("/auth/password/forgot").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	messages := testMailer.Messages()
+	if !assert.Len(s.T(), messages, 1) {
+		return
+	}
+	resetToken := regexp.MustCompile(`token=([A-Za-z0-9_-]+)`).FindStringSubmatch(messages[0].Body)[1]
+
+	resetReqBody := apis.PostAuthPasswordResetJSONRequestBody{Token: resetToken, Password: "newPassword"}
+	result := testutil.NewRequest().Post("/auth/password/reset").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(resetReqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	// NOTE: 再設定前のtokenが利用できないことを確認
+	result = testutil.NewRequest().Get("/todos").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusUnauthorized, result.Code())
+
+	// NOTE: 同じトークンは再利用できないことを確認
+	result = testutil.NewRequest().Post("/auth/password/reset").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(resetReqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusBadRequest, result.Code())
+
+	var res apis.PostAuthPasswordReset400JSONResponse
+	err := result.UnmarshalBodyToObject(&res)
+	assert.NoError(s.T(), err, "error unmarshaling response")
+	if assert.NotNil(s.T(), res.Errors.Token) {
+		assert.Equal(s.T(), []string{"パスワード再設定用のURLが無効か、有効期限が切れています。"}, *res.Errors.Token)
+	}
+}
+
+func (s *TestAuthHandlerSuite) TestAuthMiddleware_StatusUnauthorized() {
+	// NOTE: テスト用ユーザの作成
+	user := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	expiredToken, _ := services.SignAuthToken(user.ID, 1, time.Now().Add(-48*time.Hour))
+	claims, _ := services.NewAuthTokenClaims(user.ID, 1, time.Now())
+	tamperedToken, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("other-key"))
+
+	testCases := []struct {
+		name       string
+		cookie     string
+		wantReason apis.UnauthorizedReason
+	}{
+		{name: "missing", cookie: csrfTokenCookie, wantReason: apis.TokenMissing},
+		{name: "malformed", cookie: "token=invalid; " + csrfTokenCookie, wantReason: apis.TokenMalformed},
+		{name: "expired", cookie: "token=" + expiredToken + "; " + csrfTokenCookie, wantReason: apis.TokenExpired},
+		{name: "signature invalid", cookie: "token=" + tamperedToken + "; " + csrfTokenCookie, wantReason: apis.SignatureInvalid},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			result := testutil.NewRequest().Get("/todos").WithHeader("Cookie", tc.cookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+			assert.Equal(s.T(), http.StatusUnauthorized, result.Code())
+
+			var res apis.UnauthorizedErrorResponse
+			err := result.UnmarshalBodyToObject(&res)
+			assert.NoError(s.T(), err, "error unmarshaling response")
+			assert.Equal(s.T(), int64(http.StatusUnauthorized), res.Code)
+			if assert.NotNil(s.T(), res.Reason) {
+				assert.Equal(s.T(), tc.wantReason, *res.Reason)
+			}
+		})
+	}
+}
+
+func (s *TestAuthHandlerSuite) TestAuthMiddleware_EmailUnverified() {
+	// NOTE: メールアドレスが未確認のテスト用ユーザの作成
+	user := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com", "EmailVerifiedAt": null.Time{}}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	s.T().Setenv("EMAIL_VERIFICATION_POLICY", string(services.EmailVerificationPolicyRestrict))
+	reqBody := apis.SignInInput{Email: "test@example.com", Password: "password"}
+	result := testutil.NewRequest().Post("/auth/signIn").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	tokenCookie := findCookie(result.Recorder.Result().Cookies(), "token").String()
+
+	// NOTE: 参照はできるが、更新系の操作は制限されることを確認
+	result = testutil.NewRequest().Get("/todos").WithHeader("Cookie", tokenCookie+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+	todoReqBody := apis.StoreTodoInput{Title: "test_title", Content: "test_content"}
+	result = testutil.NewRequest().Post("/todos").WithHeader("Cookie", tokenCookie+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(todoReqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusForbidden, result.Code())
+
+	// NOTE: 確認メールを再送し、記載のトークンで確認する
+	resendReqBody := apis.PostAuthEmailResendJSONRequestBody{Email: "test@example.com"}
+	result = testutil.NewRequest().Post("/auth/email/resend").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(resendReqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+	messages := testMailer.Messages()
+	if !assert.Len(s.T(), messages, 1) {
+		return
+	}
+	verificationToken, _ := url.QueryUnescape(regexp.MustCompile(`token=(\S+)`).FindStringSubmatch(messages[0].Body)[1])
+
+	verifyReqBody := apis.PostAuthEmailVerifyJSONRequestBody{Token: verificationToken}
+	result = testutil.NewRequest().Post("/auth/email/verify").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(verifyReqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	result = testutil.NewRequest().Post("/todos").WithHeader("Cookie", tokenCookie+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(todoReqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+}
+
+func (s *TestAuthHandlerSuite) TestPostAuthEmailVerify_BadRequest() {
+	reqBody := apis.PostAuthEmailVerifyJSONRequestBody{Token: "invalid"}
+	result := testutil.NewRequest().Post("/auth/email/verify").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusBadRequest, result.Code())
+
+	var res apis.PostAuthEmailVerify400JSONResponse
+	err := result.UnmarshalBodyToObject(&res)
+	assert.NoError(s.T(), err, "error unmarshaling response")
+	if assert.NotNil(s.T(), res.Errors.Token) {
+		assert.Equal(s.T(), []string{"メールアドレス確認用のURLが無効か、有効期限が切れています。"}, *res.Errors.Token)
+	}
+}
+
+func (s *TestAuthHandlerSuite) TestPostAuthUnlock_BadRequest() {
+	reqBody := apis.PostAuthUnlockJSONRequestBody{Token: "invalid"}
+	result := testutil.NewRequest().Post("/auth/unlock").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusBadRequest, result.Code())
+
+	var res apis.PostAuthUnlock400JSONResponse
+	err := result.UnmarshalBodyToObject(&res)
+	assert.NoError(s.T(), err, "error unmarshaling response")
+	if assert.NotNil(s.T(), res.Errors.Token) {
+		assert.Equal(s.T(), []string{"ロック解除用のURLが無効か、有効期限が切れています。"}, *res.Errors.Token)
+	}
+}
+
 func TestAuthHandler(t *testing.T) {
 	// テストスイートを実施
 	suite.Run(t, new(TestAuthHandlerSuite))
@@ -0,0 +1,455 @@
+package handlers
+
+import (
+	"app/middlewares"
+	"app/services"
+	"app/utils"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	calDAVRootPath       = "/caldav/"
+	calDAVPrincipalPath  = "/caldav/principal/"
+	calDAVHomePath       = "/caldav/calendars/"
+	calDAVCollectionPath = "/caldav/calendars/todos/"
+	// NOTE: VTODO 1件分としては十分な上限
+	calDAVMaxBodySize = 1024 * 1024
+)
+
+const (
+	davNamespace            = "DAV:"
+	calDAVNamespace         = "urn:ietf:params:xml:ns:caldav"
+	calendarServerNamespace = "http://calendarserver.org/ns/"
+)
+
+var (
+	davResourceType                 = xml.Name{Space: davNamespace, Local: "resourcetype"}
+	davDisplayName                  = xml.Name{Space: davNamespace, Local: "displayname"}
+	davCurrentUserPrincipal         = xml.Name{Space: davNamespace, Local: "current-user-principal"}
+	davPrincipalURL                 = xml.Name{Space: davNamespace, Local: "principal-URL"}
+	davCurrentUserPrivilegeSet      = xml.Name{Space: davNamespace, Local: "current-user-privilege-set"}
+	davSupportedReportSet           = xml.Name{Space: davNamespace, Local: "supported-report-set"}
+	davGetETag                      = xml.Name{Space: davNamespace, Local: "getetag"}
+	davGetContentType               = xml.Name{Space: davNamespace, Local: "getcontenttype"}
+	calDAVCalendarHomeSet           = xml.Name{Space: calDAVNamespace, Local: "calendar-home-set"}
+	calDAVSupportedComponentSet     = xml.Name{Space: calDAVNamespace, Local: "supported-calendar-component-set"}
+	calDAVCalendarData              = xml.Name{Space: calDAVNamespace, Local: "calendar-data"}
+	calendarServerGetCTag           = xml.Name{Space: calendarServerNamespace, Local: "getctag"}
+	calDAVCalendarQueryReport       = xml.Name{Space: calDAVNamespace, Local: "calendar-query"}
+	calDAVCalendarMultigetReport    = xml.Name{Space: calDAVNamespace, Local: "calendar-multiget"}
+	calDAVObjectContentType         = "text/calendar; charset=utf-8; component=VTODO"
+	calDAVCollectionDisplayName     = "Todo"
+	calDAVCollectionPrivilegeValues = "<d:privilege><d:read/></d:privilege><d:privilege><d:write/></d:privilege><d:privilege><d:write-content/></d:privilege><d:privilege><d:bind/></d:privilege><d:privilege><d:unbind/></d:privilege>"
+)
+
+// NOTE: OpenAPIで定義できないPROPFIND/REPORTを扱うため、StrictHandlerを介さずEchoのハンドラとして実装する
+type CalDAVHandler interface {
+	Options(c echo.Context) error
+	Propfind(c echo.Context) error
+	Report(c echo.Context) error
+	GetObject(c echo.Context) error
+	PutObject(c echo.Context) error
+	DeleteObject(c echo.Context) error
+}
+
+type calDAVHandler struct {
+	calDAVService services.CalDAVService
+}
+
+func NewCalDAVHandler(calDAVService services.CalDAVService) CalDAVHandler {
+	return &calDAVHandler{calDAVService: calDAVService}
+}
+
+func RegisterCalDAVHandlers(e *echo.Echo, calDAVHandler CalDAVHandler, appPasswordService services.AppPasswordService, userService services.UserService) {
+	// NOTE: クライアントの自動検出用(RFC 6764)
+	e.Any("/.well-known/caldav", func(c echo.Context) error {
+		return c.Redirect(http.StatusMovedPermanently, calDAVRootPath)
+	})
+	// NOTE: OPTIONSは認証前に対応機能を確認するために使われる
+	e.OPTIONS("/caldav", calDAVHandler.Options)
+	e.OPTIONS("/caldav/*", calDAVHandler.Options)
+
+	g := e.Group("/caldav", middlewares.CalDAVAuthMiddleware(appPasswordService, userService))
+	for _, collectionPath := range []string{"", "/", "/principal", "/principal/", "/calendars", "/calendars/", "/calendars/todos", "/calendars/todos/"} {
+		g.Add(echo.PROPFIND, collectionPath, calDAVHandler.Propfind)
+	}
+	g.Add(echo.REPORT, "/calendars/todos", calDAVHandler.Report)
+	g.Add(echo.REPORT, "/calendars/todos/", calDAVHandler.Report)
+	g.Add(echo.PROPFIND, "/calendars/todos/:name", calDAVHandler.Propfind)
+	g.GET("/calendars/todos/:name", calDAVHandler.GetObject)
+	g.PUT("/calendars/todos/:name", calDAVHandler.PutObject)
+	g.DELETE("/calendars/todos/:name", calDAVHandler.DeleteObject)
+}
+
+type davPropNames struct {
+	Names []davPropName `xml:",any"`
+}
+
+type davPropName struct {
+	XMLName xml.Name
+}
+
+type davPropfindRequest struct {
+	XMLName xml.Name      `xml:"DAV: propfind"`
+	Prop    *davPropNames `xml:"DAV: prop"`
+}
+
+type calDAVReportRequest struct {
+	XMLName xml.Name
+	Prop    *davPropNames `xml:"DAV: prop"`
+	Hrefs   []string      `xml:"DAV: href"`
+}
+
+type davProp struct {
+	name xml.Name
+	// NOTE: エスケープ済みのXML
+	value string
+}
+
+type davResponse struct {
+	href  string
+	props []davProp
+	// NOTE: リソースが存在しない場合のステータス
+	status int
+}
+
+func (calDAVHandler *calDAVHandler) Options(c echo.Context) error {
+	c.Response().Header().Set("DAV", "1, 3, calendar-access")
+	c.Response().Header().Set("Allow", "OPTIONS, GET, PUT, DELETE, PROPFIND, REPORT")
+	return c.NoContent(http.StatusOK)
+}
+
+func (calDAVHandler *calDAVHandler) Propfind(c echo.Context) error {
+	userID, ok := utils.ContextValue(c.Request().Context())
+	if !ok {
+		return errors.New("fail to load context value")
+	}
+
+	var request davPropfindRequest
+	if err := calDAVHandler.decodeRequest(c, &request); err != nil {
+		return c.String(http.StatusBadRequest, err.Error())
+	}
+	// NOTE: propの指定が無い場合はallprop(定義済みのプロパティを全て返す)として扱う
+	var requestedProps []xml.Name
+	if request.Prop != nil {
+		requestedProps = calDAVHandler.propNames(request.Prop)
+	}
+	// NOTE: Depth: infinityは子要素までとして扱う
+	withChildren := c.Request().Header.Get("Depth") != "0"
+
+	var responses []davResponse
+	switch calDAVHandler.normalizePath(c.Request().URL.Path) {
+	case calDAVRootPath:
+		responses = append(responses, davResponse{href: calDAVRootPath, props: []davProp{
+			{davResourceType, "<d:collection/>"},
+			{davCurrentUserPrincipal, calDAVHandler.href(calDAVPrincipalPath)},
+		}})
+	case calDAVPrincipalPath:
+		responses = append(responses, davResponse{href: calDAVPrincipalPath, props: []davProp{
+			{davResourceType, "<d:principal/>"},
+			{davCurrentUserPrincipal, calDAVHandler.href(calDAVPrincipalPath)},
+			{davPrincipalURL, calDAVHandler.href(calDAVPrincipalPath)},
+			{calDAVCalendarHomeSet, calDAVHandler.href(calDAVHomePath)},
+		}})
+	case calDAVHomePath:
+		responses = append(responses, davResponse{href: calDAVHomePath, props: []davProp{
+			{davResourceType, "<d:collection/>"},
+			{davCurrentUserPrincipal, calDAVHandler.href(calDAVPrincipalPath)},
+		}})
+		if withChildren {
+			collection, err := calDAVHandler.collectionResponse(c, userID)
+			if err != nil {
+				return err
+			}
+			responses = append(responses, collection)
+		}
+	case calDAVCollectionPath:
+		collection, err := calDAVHandler.collectionResponse(c, userID)
+		if err != nil {
+			return err
+		}
+		responses = append(responses, collection)
+		if withChildren {
+			statusCode, objectsList, err := calDAVHandler.calDAVService.FetchObjectsList(c.Request().Context(), userID)
+			if statusCode != http.StatusOK {
+				return err
+			}
+			for _, object := range objectsList {
+				responses = append(responses, calDAVHandler.objectResponse(object, false))
+			}
+		}
+	default:
+		statusCode, object, err := calDAVHandler.calDAVService.ShowObject(c.Request().Context(), c.Param("name"), userID)
+		switch statusCode {
+		case http.StatusNotFound:
+			return c.NoContent(http.StatusNotFound)
+		case http.StatusInternalServerError:
+			return err
+		}
+		responses = append(responses, calDAVHandler.objectResponse(object, false))
+	}
+	return calDAVHandler.writeMultistatus(c, responses, requestedProps)
+}
+
+func (calDAVHandler *calDAVHandler) Report(c echo.Context) error {
+	userID, ok := utils.ContextValue(c.Request().Context())
+	if !ok {
+		return errors.New("fail to load context value")
+	}
+
+	var request calDAVReportRequest
+	if err := calDAVHandler.decodeRequest(c, &request); err != nil {
+		return c.String(http.StatusBadRequest, err.Error())
+	}
+	var requestedProps []xml.Name
+	if request.Prop != nil {
+		requestedProps = calDAVHandler.propNames(request.Prop)
+	}
+
+	var responses []davResponse
+	switch request.XMLName {
+	case calDAVCalendarQueryReport:
+		// NOTE: コレクションにはVTODOしか無いため、フィルタ条件によらず全件を返す
+		statusCode, objectsList, err := calDAVHandler.calDAVService.FetchObjectsList(c.Request().Context(), userID)
+		if statusCode != http.StatusOK {
+			return err
+		}
+		for _, object := range objectsList {
+			responses = append(responses, calDAVHandler.objectResponse(object, true))
+		}
+	case calDAVCalendarMultigetReport:
+		for _, href := range request.Hrefs {
+			name, _ := url.PathUnescape(path.Base(href))
+			statusCode, object, err := calDAVHandler.calDAVService.ShowObject(c.Request().Context(), name, userID)
+			switch statusCode {
+			case http.StatusNotFound:
+				responses = append(responses, davResponse{href: href, status: http.StatusNotFound})
+				continue
+			case http.StatusInternalServerError:
+				return err
+			}
+			responses = append(responses, calDAVHandler.objectResponse(object, true))
+		}
+	default:
+		return c.String(http.StatusForbidden, "unsupported report")
+	}
+	return calDAVHandler.writeMultistatus(c, responses, requestedProps)
+}
+
+func (calDAVHandler *calDAVHandler) GetObject(c echo.Context) error {
+	userID, ok := utils.ContextValue(c.Request().Context())
+	if !ok {
+		return errors.New("fail to load context value")
+	}
+
+	statusCode, object, err := calDAVHandler.calDAVService.ShowObject(c.Request().Context(), c.Param("name"), userID)
+	switch statusCode {
+	case http.StatusNotFound:
+		return c.NoContent(http.StatusNotFound)
+	case http.StatusInternalServerError:
+		return err
+	}
+
+	c.Response().Header().Set("ETag", object.ETag)
+	return c.Blob(http.StatusOK, calDAVObjectContentType, []byte(object.Data))
+}
+
+func (calDAVHandler *calDAVHandler) PutObject(c echo.Context) error {
+	userID, ok := utils.ContextValue(c.Request().Context())
+	if !ok {
+		return errors.New("fail to load context value")
+	}
+
+	body, err := io.ReadAll(io.LimitReader(c.Request().Body, calDAVMaxBodySize+1))
+	if err != nil {
+		return err
+	}
+	if len(body) > calDAVMaxBodySize {
+		return c.NoContent(http.StatusRequestEntityTooLarge)
+	}
+
+	// NOTE: 保存時にTodoに対応しないプロパティを落とすため、レスポンスにETagは含めない(RFC 4791 5.3.4)
+	statusCode, err := calDAVHandler.calDAVService.PutObject(c.Request().Context(), c.Param("name"), string(body), c.Request().Header.Get("If-Match"), c.Request().Header.Get("If-None-Match"), userID)
+	switch statusCode {
+	case http.StatusBadRequest, http.StatusForbidden, http.StatusConflict, http.StatusPreconditionFailed:
+		return c.String(int(statusCode), err.Error())
+	case http.StatusNotFound:
+		return c.NoContent(http.StatusNotFound)
+	case http.StatusInternalServerError:
+		return err
+	}
+	return c.NoContent(int(statusCode))
+}
+
+func (calDAVHandler *calDAVHandler) DeleteObject(c echo.Context) error {
+	userID, ok := utils.ContextValue(c.Request().Context())
+	if !ok {
+		return errors.New("fail to load context value")
+	}
+
+	statusCode, err := calDAVHandler.calDAVService.DeleteObject(c.Request().Context(), c.Param("name"), c.Request().Header.Get("If-Match"), userID)
+	switch statusCode {
+	case http.StatusPreconditionFailed:
+		return c.String(http.StatusPreconditionFailed, err.Error())
+	case http.StatusNotFound:
+		return c.NoContent(http.StatusNotFound)
+	case http.StatusInternalServerError:
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (calDAVHandler *calDAVHandler) collectionResponse(c echo.Context, userID int64) (davResponse, error) {
+	statusCode, ctag, err := calDAVHandler.calDAVService.FetchCollectionCTag(c.Request().Context(), userID)
+	if statusCode != http.StatusOK {
+		return davResponse{}, err
+	}
+
+	return davResponse{href: calDAVCollectionPath, props: []davProp{
+		{davResourceType, "<d:collection/><c:calendar/>"},
+		{davDisplayName, calDAVHandler.escape(calDAVCollectionDisplayName)},
+		{davCurrentUserPrincipal, calDAVHandler.href(calDAVPrincipalPath)},
+		{davCurrentUserPrivilegeSet, calDAVCollectionPrivilegeValues},
+		{davSupportedReportSet, "<d:supported-report><d:report><c:calendar-query/></d:report></d:supported-report><d:supported-report><d:report><c:calendar-multiget/></d:report></d:supported-report>"},
+		{calDAVSupportedComponentSet, `<c:comp name="VTODO"/>`},
+		{calendarServerGetCTag, calDAVHandler.escape(ctag)},
+	}}, nil
+}
+
+func (calDAVHandler *calDAVHandler) objectResponse(object *services.CalDAVObject, withData bool) davResponse {
+	props := []davProp{
+		{davResourceType, ""},
+		{davGetETag, calDAVHandler.escape(object.ETag)},
+		{davGetContentType, calDAVHandler.escape(calDAVObjectContentType)},
+	}
+	if withData {
+		props = append(props, davProp{calDAVCalendarData, calDAVHandler.escape(object.Data)})
+	}
+	return davResponse{href: calDAVCollectionPath + url.PathEscape(object.Name), props: props}
+}
+
+// NOTE: ボディが空のリクエストも許容する
+func (calDAVHandler *calDAVHandler) decodeRequest(c echo.Context, request interface{}) error {
+	body, err := io.ReadAll(io.LimitReader(c.Request().Body, calDAVMaxBodySize))
+	if err != nil {
+		return err
+	}
+	if len(strings.TrimSpace(string(body))) == 0 {
+		return nil
+	}
+	return xml.Unmarshal(body, request)
+}
+
+func (calDAVHandler *calDAVHandler) propNames(props *davPropNames) []xml.Name {
+	names := []xml.Name{}
+	for _, prop := range props.Names {
+		names = append(names, prop.XMLName)
+	}
+	return names
+}
+
+func (calDAVHandler *calDAVHandler) writeMultistatus(c echo.Context, responses []davResponse, requestedProps []xml.Name) error {
+	var builder strings.Builder
+	builder.WriteString(xml.Header)
+	builder.WriteString(`<d:multistatus xmlns:d="DAV:" xmlns:c="` + calDAVNamespace + `" xmlns:cs="` + calendarServerNamespace + `">`)
+	for _, response := range responses {
+		builder.WriteString("<d:response>")
+		builder.WriteString("<d:href>" + calDAVHandler.escape(response.href) + "</d:href>")
+		if response.status != 0 {
+			builder.WriteString("<d:status>HTTP/1.1 " + calDAVHandler.statusLine(response.status) + "</d:status>")
+			builder.WriteString("</d:response>")
+			continue
+		}
+
+		found := response.props
+		var notFound []xml.Name
+		if requestedProps != nil {
+			found = nil
+			for _, name := range requestedProps {
+				prop, ok := calDAVHandler.findProp(response.props, name)
+				if !ok {
+					notFound = append(notFound, name)
+					continue
+				}
+				found = append(found, prop)
+			}
+		}
+
+		if len(found) > 0 {
+			builder.WriteString("<d:propstat><d:prop>")
+			for _, prop := range found {
+				builder.WriteString(calDAVHandler.element(prop.name, prop.value))
+			}
+			builder.WriteString("</d:prop><d:status>HTTP/1.1 200 OK</d:status></d:propstat>")
+		}
+		if len(notFound) > 0 {
+			builder.WriteString("<d:propstat><d:prop>")
+			for _, name := range notFound {
+				builder.WriteString(calDAVHandler.element(name, ""))
+			}
+			builder.WriteString("</d:prop><d:status>HTTP/1.1 404 Not Found</d:status></d:propstat>")
+		}
+		builder.WriteString("</d:response>")
+	}
+	builder.WriteString("</d:multistatus>")
+
+	return c.Blob(http.StatusMultiStatus, "application/xml; charset=utf-8", []byte(builder.String()))
+}
+
+func (calDAVHandler *calDAVHandler) findProp(props []davProp, name xml.Name) (davProp, bool) {
+	for _, prop := range props {
+		if prop.name == name {
+			return prop, true
+		}
+	}
+	return davProp{}, false
+}
+
+func (calDAVHandler *calDAVHandler) element(name xml.Name, value string) string {
+	var tag, namespaceAttr string
+	switch name.Space {
+	case davNamespace:
+		tag = "d:" + name.Local
+	case calDAVNamespace:
+		tag = "c:" + name.Local
+	case calendarServerNamespace:
+		tag = "cs:" + name.Local
+	default:
+		tag = "x:" + name.Local
+		namespaceAttr = ` xmlns:x="` + calDAVHandler.escape(name.Space) + `"`
+	}
+	if value == "" {
+		return "<" + tag + namespaceAttr + "/>"
+	}
+	return "<" + tag + namespaceAttr + ">" + value + "</" + tag + ">"
+}
+
+func (calDAVHandler *calDAVHandler) href(hrefPath string) string {
+	return "<d:href>" + calDAVHandler.escape(hrefPath) + "</d:href>"
+}
+
+func (calDAVHandler *calDAVHandler) escape(text string) string {
+	var builder strings.Builder
+	xml.EscapeText(&builder, []byte(text))
+	return builder.String()
+}
+
+func (calDAVHandler *calDAVHandler) statusLine(status int) string {
+	return strconv.Itoa(status) + " " + http.StatusText(status)
+}
+
+func (calDAVHandler *calDAVHandler) normalizePath(requestPath string) string {
+	if !strings.HasSuffix(requestPath, "/") {
+		return requestPath + "/"
+	}
+	return requestPath
+}
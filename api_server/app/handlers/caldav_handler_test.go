@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	apis "app/openapi"
+	"encoding/base64"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"github.com/oapi-codegen/testutil"
+)
+
+type testCalDAVHandlerSuite struct {
+	WithDBSuite
+}
+
+var appPassword string
+
+const testICalTodo = "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//test//EN\r\nBEGIN:VTODO\r\nUID:client-uid-1\r\nSUMMARY:caldav title\r\nEND:VTODO\r\nEND:VCALENDAR\r\n"
+
+func (s *testCalDAVHandlerSuite) SetupTest() {
+	s.SetDBCon()
+
+	s.initializeHandlers()
+
+	s.SetCsrfHeaderValues()
+
+	s.SignIn()
+
+	// NOTE: CalDAVクライアント用のアプリパスワードを発行
+	reqBody := apis.StoreAppPasswordInput{Name: "caldav client"}
+	result := testutil.NewRequest().Post("/appPasswords").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	var res apis.PostAppPasswords200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	appPassword = *res.Password
+}
+
+func (s *testCalDAVHandlerSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *testCalDAVHandlerSuite) calDAVRequest(method string, path string, body string) *testutil.RequestBuilder {
+	request := testutil.NewRequest().WithMethod(method, path).WithHeader("Authorization", "Basic "+basicAuthCredentials("test@example.com", appPassword))
+	if body != "" {
+		request = request.WithContentType("application/xml; charset=utf-8").WithBody([]byte(body))
+	}
+	return request
+}
+
+func basicAuthCredentials(email string, password string) string {
+	return base64.StdEncoding.EncodeToString([]byte(email + ":" + password))
+}
+
+func (s *testCalDAVHandlerSuite) TestPropfind_StatusUnauthorized() {
+	result := testutil.NewRequest().WithMethod(echo.PROPFIND, "/caldav/").GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusUnauthorized, result.Code())
+
+	// NOTE: ログイン用のパスワードでは認証できないこと
+	result = testutil.NewRequest().WithMethod(echo.PROPFIND, "/caldav/").WithHeader("Authorization", "Basic "+basicAuthCredentials("test@example.com", "password")).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusUnauthorized, result.Code())
+}
+
+func (s *testCalDAVHandlerSuite) TestOptions() {
+	result := testutil.NewRequest().WithMethod(http.MethodOptions, "/caldav/calendars/todos/").GoWithHTTPHandler(s.T(), e)
+
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+	assert.Contains(s.T(), result.Recorder.Header().Get("DAV"), "calendar-access")
+}
+
+func (s *testCalDAVHandlerSuite) TestPropfind_Principal() {
+	body := `<?xml version="1.0"?><d:propfind xmlns:d="DAV:" xmlns:c="urn:ietf:params:xml:ns:caldav"><d:prop><c:calendar-home-set/><d:unknown-prop/></d:prop></d:propfind>`
+	result := s.calDAVRequest(echo.PROPFIND, "/caldav/principal/", body).WithHeader("Depth", "0").GoWithHTTPHandler(s.T(), e)
+
+	assert.Equal(s.T(), http.StatusMultiStatus, result.Code())
+	resBody := result.Recorder.Body.String()
+	assert.Contains(s.T(), resBody, "<c:calendar-home-set><d:href>/caldav/calendars/</d:href></c:calendar-home-set>")
+	// NOTE: 未対応のプロパティは404として返すこと
+	assert.Contains(s.T(), resBody, "<d:prop><d:unknown-prop/></d:prop><d:status>HTTP/1.1 404 Not Found</d:status>")
+}
+
+func (s *testCalDAVHandlerSuite) TestPutGetDeleteObject() {
+	result := s.calDAVRequest(http.MethodPut, "/caldav/calendars/todos/client.ics", "").WithContentType("text/calendar").WithBody([]byte(testICalTodo)).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusCreated, result.Code())
+
+	result = s.calDAVRequest(http.MethodGet, "/caldav/calendars/todos/client.ics", "").GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+	assert.Contains(s.T(), result.Recorder.Body.String(), "SUMMARY:caldav title\r\n")
+	etag := result.Recorder.Header().Get("ETag")
+	assert.NotEmpty(s.T(), etag)
+
+	// NOTE: CalDAVから作成したTodoがAPIからも参照できること
+	todosResult := testutil.NewRequest().Get("/todos").WithHeader("Cookie", token).GoWithHTTPHandler(s.T(), e)
+	var todosRes apis.GetTodos200JSONResponse
+	todosResult.UnmarshalBodyToObject(&todosRes)
+	assert.Equal(s.T(), "caldav title", todosRes.Todos[0].Title)
+
+	result = s.calDAVRequest(http.MethodDelete, "/caldav/calendars/todos/client.ics", "").WithHeader("If-Match", `"stale"`).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusPreconditionFailed, result.Code())
+
+	result = s.calDAVRequest(http.MethodDelete, "/caldav/calendars/todos/client.ics", "").WithHeader("If-Match", etag).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusNoContent, result.Code())
+
+	result = s.calDAVRequest(http.MethodGet, "/caldav/calendars/todos/client.ics", "").GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusNotFound, result.Code())
+}
+
+func (s *testCalDAVHandlerSuite) TestPutObject_Completed() {
+	// NOTE: 完了にした変更が失われないよう、保存できないプロパティは403で拒否する
+	completed := strings.Replace(testICalTodo, "END:VTODO", "STATUS:COMPLETED\r\nEND:VTODO", 1)
+	result := s.calDAVRequest(http.MethodPut, "/caldav/calendars/todos/client.ics", "").WithContentType("text/calendar").WithBody([]byte(completed)).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusForbidden, result.Code())
+	assert.Equal(s.T(), "STATUS is not supported", result.Recorder.Body.String())
+}
+
+func (s *testCalDAVHandlerSuite) TestPropfind_Collection() {
+	s.calDAVRequest(http.MethodPut, "/caldav/calendars/todos/client.ics", "").WithBody([]byte(testICalTodo)).GoWithHTTPHandler(s.T(), e)
+
+	result := s.calDAVRequest(echo.PROPFIND, "/caldav/calendars/todos/", "").WithHeader("Depth", "1").GoWithHTTPHandler(s.T(), e)
+
+	assert.Equal(s.T(), http.StatusMultiStatus, result.Code())
+	resBody := result.Recorder.Body.String()
+	assert.Contains(s.T(), resBody, `<c:comp name="VTODO"/>`)
+	assert.Contains(s.T(), resBody, "<cs:getctag>")
+	assert.Contains(s.T(), resBody, "<d:href>/caldav/calendars/todos/client.ics</d:href>")
+}
+
+func (s *testCalDAVHandlerSuite) TestReport_Multiget() {
+	s.calDAVRequest(http.MethodPut, "/caldav/calendars/todos/client.ics", "").WithBody([]byte(testICalTodo)).GoWithHTTPHandler(s.T(), e)
+
+	body := `<?xml version="1.0"?><c:calendar-multiget xmlns:d="DAV:" xmlns:c="urn:ietf:params:xml:ns:caldav"><d:prop><d:getetag/><c:calendar-data/></d:prop><d:href>/caldav/calendars/todos/client.ics</d:href><d:href>/caldav/calendars/todos/missing.ics</d:href></c:calendar-multiget>`
+	result := s.calDAVRequest(echo.REPORT, "/caldav/calendars/todos/", body).GoWithHTTPHandler(s.T(), e)
+
+	assert.Equal(s.T(), http.StatusMultiStatus, result.Code())
+	resBody := result.Recorder.Body.String()
+	assert.Contains(s.T(), resBody, "SUMMARY:caldav title")
+	assert.Contains(s.T(), resBody, "<d:href>/caldav/calendars/todos/missing.ics</d:href><d:status>HTTP/1.1 404 Not Found</d:status>")
+}
+
+func (s *testCalDAVHandlerSuite) TestReport_CalendarQuery() {
+	s.calDAVRequest(http.MethodPut, "/caldav/calendars/todos/client.ics", "").WithBody([]byte(testICalTodo)).GoWithHTTPHandler(s.T(), e)
+
+	body := `<?xml version="1.0"?><c:calendar-query xmlns:d="DAV:" xmlns:c="urn:ietf:params:xml:ns:caldav"><d:prop><d:getetag/></d:prop><c:filter><c:comp-filter name="VCALENDAR"><c:comp-filter name="VTODO"/></c:comp-filter></c:filter></c:calendar-query>`
+	result := s.calDAVRequest(echo.REPORT, "/caldav/calendars/todos/", body).GoWithHTTPHandler(s.T(), e)
+
+	assert.Equal(s.T(), http.StatusMultiStatus, result.Code())
+	resBody := result.Recorder.Body.String()
+	assert.Equal(s.T(), 1, strings.Count(resBody, "<d:getetag>"))
+	assert.NotContains(s.T(), resBody, "calendar-data")
+}
+
+func (s *testCalDAVHandlerSuite) TestPutObject_EmailUnverified() {
+	// NOTE: メールアドレスが未確認のユーザ
+	user.EmailVerifiedAt = null.Time{}
+	if _, err := user.Update(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to update test user %v", err)
+	}
+
+	// NOTE: AuthMiddlewareと同じく、参照はできるが更新はできないことを確認
+	result := s.calDAVRequest(http.MethodPut, "/caldav/calendars/todos/client.ics", "").WithContentType("text/calendar").WithBody([]byte(testICalTodo)).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusForbidden, result.Code())
+	result = s.calDAVRequest(echo.PROPFIND, "/caldav/calendars/todos/", "").WithHeader("Depth", "1").GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusMultiStatus, result.Code())
+	assert.NotContains(s.T(), result.Recorder.Body.String(), "client.ics")
+}
+
+func TestCalDAVHandler(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(testCalDAVHandlerSuite))
+}
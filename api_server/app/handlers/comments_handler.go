@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/services"
+	"app/utils"
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type CommentsHandler interface {
+	PostTodoComments(ctx context.Context, request apis.PostTodoCommentsRequestObject) (apis.PostTodoCommentsResponseObject, error)
+	PatchTodoComment(ctx context.Context, request apis.PatchTodoCommentRequestObject) (apis.PatchTodoCommentResponseObject, error)
+	DeleteTodoComment(ctx context.Context, request apis.DeleteTodoCommentRequestObject) (apis.DeleteTodoCommentResponseObject, error)
+}
+
+type commentsHandler struct {
+	commentService services.CommentService
+}
+
+func NewCommentsHandler(commentService services.CommentService) CommentsHandler {
+	return &commentsHandler{commentService: commentService}
+}
+
+func (commentsHandler *commentsHandler) PostTodoComments(ctx context.Context, request apis.PostTodoCommentsRequestObject) (apis.PostTodoCommentsResponseObject, error) {
+	intTodoID, err := strconv.Atoi(request.Id)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostTodoComments500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.PostTodoComments500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, comment, err := commentsHandler.commentService.CreateComment(ctx, int64(intTodoID), *request.Body, userID)
+	switch statusCode {
+	case http.StatusBadRequest:
+		validationErrors := commentsHandler.mappingValidationErrorStruct(err)
+		return apis.PostTodoComments400JSONResponse{Code: http.StatusBadRequest, Errors: validationErrors}, nil
+	case http.StatusNotFound:
+		res := apis.NotFoundErrorResponseJSONResponse{Code: http.StatusNotFound}
+		return apis.PostTodoComments404JSONResponse{NotFoundErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostTodoComments500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	resComment := mappingComment(comment)
+	res := apis.StoreCommentResponseJSONResponse{Code: http.StatusOK, Errors: apis.StoreCommentValidationError{}, Comment: &resComment}
+	return apis.PostTodoComments200JSONResponse{StoreCommentResponseJSONResponse: res}, nil
+}
+
+func (commentsHandler *commentsHandler) PatchTodoComment(ctx context.Context, request apis.PatchTodoCommentRequestObject) (apis.PatchTodoCommentResponseObject, error) {
+	intTodoID, err := strconv.Atoi(request.Id)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PatchTodoComment500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+	intID, err := strconv.Atoi(request.CommentId)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PatchTodoComment500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.PatchTodoComment500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, comment, err := commentsHandler.commentService.UpdateComment(ctx, int64(intTodoID), int64(intID), *request.Body, userID)
+	switch statusCode {
+	case http.StatusBadRequest:
+		validationErrors := commentsHandler.mappingValidationErrorStruct(err)
+		return apis.PatchTodoComment400JSONResponse{Code: http.StatusBadRequest, Errors: validationErrors}, nil
+	case http.StatusNotFound:
+		res := apis.NotFoundErrorResponseJSONResponse{Code: http.StatusNotFound}
+		return apis.PatchTodoComment404JSONResponse{NotFoundErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PatchTodoComment500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	resComment := mappingComment(comment)
+	res := apis.StoreCommentResponseJSONResponse{Code: http.StatusOK, Errors: apis.StoreCommentValidationError{}, Comment: &resComment}
+	return apis.PatchTodoComment200JSONResponse{StoreCommentResponseJSONResponse: res}, nil
+}
+
+func (commentsHandler *commentsHandler) DeleteTodoComment(ctx context.Context, request apis.DeleteTodoCommentRequestObject) (apis.DeleteTodoCommentResponseObject, error) {
+	intTodoID, err := strconv.Atoi(request.Id)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.DeleteTodoComment500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+	intID, err := strconv.Atoi(request.CommentId)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.DeleteTodoComment500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.DeleteTodoComment500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, err := commentsHandler.commentService.DeleteComment(ctx, int64(intTodoID), int64(intID), userID)
+	switch statusCode {
+	case http.StatusNotFound:
+		res := apis.NotFoundErrorResponseJSONResponse{Code: http.StatusNotFound}
+		return apis.DeleteTodoComment404JSONResponse{NotFoundErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.DeleteTodoComment500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	res := apis.DeleteCommentResponseJSONResponse{Code: http.StatusOK, Result: true}
+	return apis.DeleteTodoComment200JSONResponse{DeleteCommentResponseJSONResponse: res}, nil
+}
+
+func (commentsHandler *commentsHandler) mappingValidationErrorStruct(err error) apis.StoreCommentValidationError {
+	var validationError apis.StoreCommentValidationError
+	if err == nil {
+		return validationError
+	}
+
+	if errors, ok := err.(validation.Errors); ok {
+		// NOTE: レスポンス用の構造体にマッピング
+		for field, err := range errors {
+			messages := []string{err.Error()}
+			switch field {
+			case "body":
+				validationError.Body = &messages
+			}
+		}
+	}
+	return validationError
+}
+
+func mappingComment(comment *models.Comment) apis.Comment {
+	return apis.Comment{
+		Id:        int(comment.ID),
+		UserId:    int(comment.UserID),
+		Body:      comment.Body,
+		CreatedAt: comment.CreatedAt,
+		UpdatedAt: comment.UpdatedAt,
+	}
+}
@@ -0,0 +1,180 @@
+package handlers
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/test/factories"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/oapi-codegen/testutil"
+)
+
+type testCommentsHandlerSuite struct {
+	WithDBSuite
+}
+
+func (s *testCommentsHandlerSuite) SetupTest() {
+	s.SetDBCon()
+
+	s.initializeHandlers()
+
+	s.SetCsrfHeaderValues()
+}
+
+func (s *testCommentsHandlerSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *testCommentsHandlerSuite) createTodo() *models.Todo {
+	todoParam := map[string]interface{}{"UserID": int64(user.ID), "Title": "test title 1", "Content": null.String{String: "test content 1", Valid: true}}
+	todo := factories.TodoFactory.MustCreateWithOption(todoParam).(*models.Todo)
+	if err := todo.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+	return todo
+}
+
+func (s *testCommentsHandlerSuite) createComment(todo *models.Todo, body string) *models.Comment {
+	comment := &models.Comment{UserID: int64(user.ID), TodoID: todo.ID, Body: body}
+	if err := comment.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test comment %v", err)
+	}
+	return comment
+}
+
+func (s *testCommentsHandlerSuite) TestPostTodoComments_StatusOk() {
+	s.SignIn()
+	todo := s.createTodo()
+
+	reqBody := apis.StoreCommentInput{Body: "test comment @test@example.com"}
+	result := testutil.NewRequest().Post("/todos/"+strconv.Itoa(int(todo.ID))+"/comments").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.PostTodoComments200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Equal(s.T(), "test comment @test@example.com", res.Comment.Body)
+	assert.Equal(s.T(), user.ID, res.Comment.UserId)
+
+	// NOTE: メンション通知が記録されていることを確認
+	isExistNotification, _ := models.MentionNotifications(qm.Where("comment_id = ? AND user_id = ?", res.Comment.Id, user.ID)).Exists(ctx, DBCon)
+	assert.True(s.T(), isExistNotification)
+}
+
+func (s *testCommentsHandlerSuite) TestPostTodoComments_BadRequest() {
+	s.SignIn()
+	todo := s.createTodo()
+
+	reqBody := apis.StoreCommentInput{Body: ""}
+	result := testutil.NewRequest().Post("/todos/"+strconv.Itoa(int(todo.ID))+"/comments").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusBadRequest, result.Code())
+
+	var res apis.PostTodoComments400JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Equal(s.T(), []string{"コメントは必須入力です。"}, *res.Errors.Body)
+}
+
+func (s *testCommentsHandlerSuite) TestPostTodoComments_StatusUnauthorized() {
+	result := testutil.NewRequest().Post("/todos/1/comments").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(apis.StoreCommentInput{Body: "test comment"}).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusUnauthorized, result.Code())
+}
+
+func (s *testCommentsHandlerSuite) TestPostTodoComments_StatusNotFound() {
+	s.SignIn()
+	todo := s.createTodo()
+
+	result := testutil.NewRequest().Post("/todos/"+strconv.Itoa(int(todo.ID+1))+"/comments").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(apis.StoreCommentInput{Body: "test comment"}).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusNotFound, result.Code())
+}
+
+func (s *testCommentsHandlerSuite) TestPatchTodoComment_StatusOk() {
+	s.SignIn()
+	todo := s.createTodo()
+	comment := s.createComment(todo, "test comment")
+
+	reqBody := apis.StoreCommentInput{Body: "updated comment"}
+	result := testutil.NewRequest().Patch("/todos/"+strconv.Itoa(int(todo.ID))+"/comments/"+strconv.Itoa(int(comment.ID))).WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	// NOTE: コメントが更新されていることを確認
+	comment.Reload(ctx, DBCon)
+	assert.Equal(s.T(), "updated comment", comment.Body)
+}
+
+func (s *testCommentsHandlerSuite) TestPatchTodoComment_StatusNotFound() {
+	s.SignIn()
+	todo := s.createTodo()
+	comment := s.createComment(todo, "test comment")
+
+	reqBody := apis.StoreCommentInput{Body: "updated comment"}
+	result := testutil.NewRequest().Patch("/todos/"+strconv.Itoa(int(todo.ID))+"/comments/"+strconv.Itoa(int(comment.ID+1))).WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusNotFound, result.Code())
+}
+
+func (s *testCommentsHandlerSuite) TestDeleteTodoComment_StatusOk() {
+	s.SignIn()
+	todo := s.createTodo()
+	comment := s.createComment(todo, "test comment")
+
+	result := testutil.NewRequest().Delete("/todos/"+strconv.Itoa(int(todo.ID))+"/comments/"+strconv.Itoa(int(comment.ID))).WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	// NOTE: コメントが削除されていることを確認
+	isExistComment, _ := models.Comments(qm.Where("id = ?", comment.ID)).Exists(ctx, DBCon)
+	assert.False(s.T(), isExistComment)
+}
+
+func (s *testCommentsHandlerSuite) TestDeleteTodoComment_StatusNotFound() {
+	s.SignIn()
+	todo := s.createTodo()
+
+	result := testutil.NewRequest().Delete("/todos/"+strconv.Itoa(int(todo.ID))+"/comments/1").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusNotFound, result.Code())
+}
+
+func (s *testCommentsHandlerSuite) TestGetTodo_WithComments() {
+	s.SignIn()
+	todo := s.createTodo()
+	for _, body := range []string{"comment 1", "comment 2", "comment 3"} {
+		s.createComment(todo, body)
+	}
+
+	result := testutil.NewRequest().Get("/todos/"+strconv.Itoa(int(todo.ID))+"?commentsLimit=2").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.GetTodo200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Len(s.T(), *res.Comments, 2)
+	assert.Equal(s.T(), "comment 1", (*res.Comments)[0].Body)
+
+	// NOTE: 次ページのコメントを取得
+	result = testutil.NewRequest().Get("/todos/"+strconv.Itoa(int(todo.ID))+"?commentsLimit=2&commentsCursor="+*res.CommentsNextCursor).WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var nextRes apis.GetTodo200JSONResponse
+	result.UnmarshalBodyToObject(&nextRes)
+	assert.Len(s.T(), *nextRes.Comments, 1)
+	assert.Equal(s.T(), "comment 3", (*nextRes.Comments)[0].Body)
+	assert.Nil(s.T(), nextRes.CommentsNextCursor)
+}
+
+func (s *testCommentsHandlerSuite) TestGetTodo_InvalidCommentsCursor() {
+	s.SignIn()
+	todo := s.createTodo()
+
+	result := testutil.NewRequest().Get("/todos/"+strconv.Itoa(int(todo.ID))+"?commentsCursor=invalid").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusBadRequest, result.Code())
+}
+
+func TestCommentsHandler(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(testCommentsHandlerSuite))
+}
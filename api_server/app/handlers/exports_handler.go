@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	apis "app/openapi"
+	"app/services"
+	"app/utils"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+)
+
+type ExportsHandler interface {
+	GetExports(ctx context.Context, request apis.GetExportsRequestObject) (apis.GetExportsResponseObject, error)
+}
+
+type exportsHandler struct {
+	exportService services.ExportService
+}
+
+func NewExportsHandler(exportService services.ExportService) ExportsHandler {
+	return &exportsHandler{exportService: exportService}
+}
+
+// NOTE: 生成コードのJSONレスポンスはボディ全体をエンコードしてから書き込むため、ストリーミング用に独自に実装する
+type getExports200JSONStreamResponse struct {
+	body    io.ReadCloser
+	headers apis.ExportTodosResponseResponseHeaders
+}
+
+func (response getExports200JSONStreamResponse) VisitGetExportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", response.headers.ContentDisposition)
+	w.WriteHeader(200)
+
+	defer response.body.Close()
+	_, err := io.Copy(w, response.body)
+	return err
+}
+
+func (exportsHandler *exportsHandler) GetExports(ctx context.Context, request apis.GetExportsRequestObject) (apis.GetExportsResponseObject, error) {
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.GetExports500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	format := string(request.Params.Format)
+	statusCode, body, fileName, err := exportsHandler.exportService.ExportTodos(ctx, request.Params, userID)
+	switch statusCode {
+	case http.StatusBadRequest:
+		res := apis.BadRequestErrorResponseJSONResponse{Code: http.StatusBadRequest, Message: err.Error()}
+		return apis.GetExports400JSONResponse{BadRequestErrorResponseJSONResponse: res}, nil
+	case http.StatusNotFound:
+		res := apis.NotFoundErrorResponseJSONResponse{Code: http.StatusNotFound}
+		return apis.GetExports404JSONResponse{NotFoundErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.GetExports500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	headers := apis.ExportTodosResponseResponseHeaders{ContentDisposition: `attachment; filename="` + fileName + `"`}
+	switch format {
+	case services.ExportFormatJSON:
+		return getExports200JSONStreamResponse{body: body, headers: headers}, nil
+	case services.ExportFormatMarkdown:
+		return apis.GetExports200TextmarkdownResponse{ExportTodosResponseTextmarkdownResponse: apis.ExportTodosResponseTextmarkdownResponse{Body: body, Headers: headers}}, nil
+	}
+	return apis.GetExports200TextcsvResponse{ExportTodosResponseTextcsvResponse: apis.ExportTodosResponseTextcsvResponse{Body: body, Headers: headers}}, nil
+}
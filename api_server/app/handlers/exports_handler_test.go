@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	apis "app/openapi"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/oapi-codegen/testutil"
+)
+
+type testExportsHandlerSuite struct {
+	WithDBSuite
+}
+
+func (s *testExportsHandlerSuite) SetupTest() {
+	s.SetDBCon()
+
+	s.initializeHandlers()
+
+	s.SetCsrfHeaderValues()
+}
+
+func (s *testExportsHandlerSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *testExportsHandlerSuite) createTodo() {
+	reqBody := apis.StoreTodoInput{Title: "test title 1", Content: "test content 1"}
+	testutil.NewRequest().Post("/todos").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+}
+
+func (s *testExportsHandlerSuite) TestGetExports_CSV() {
+	s.SignIn()
+	s.createTodo()
+
+	result := testutil.NewRequest().Get("/exports?format=csv").WithHeader("Cookie", token).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+	assert.Equal(s.T(), "text/csv", result.Recorder.Header().Get("Content-Type"))
+	assert.True(s.T(), strings.HasPrefix(result.Recorder.Header().Get("Content-Disposition"), `attachment; filename="todos-`))
+	assert.Contains(s.T(), result.Recorder.Body.String(), ",test title 1,test content 1,")
+}
+
+func (s *testExportsHandlerSuite) TestGetExports_Filtered() {
+	s.SignIn()
+	s.createTodo()
+
+	result := testutil.NewRequest().Get("/exports?format=csv&q="+url.QueryEscape("title:other")).WithHeader("Cookie", token).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+	assert.Equal(s.T(), "id,title,content,createdAt,updatedAt\n", result.Recorder.Body.String())
+
+	result = testutil.NewRequest().Get("/exports?format=csv&filterId=0").WithHeader("Cookie", token).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusNotFound, result.Code())
+}
+
+func (s *testExportsHandlerSuite) TestGetExports_JSON() {
+	s.SignIn()
+	s.createTodo()
+
+	result := testutil.NewRequest().Get("/exports?format=json").WithHeader("Cookie", token).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+	assert.Equal(s.T(), "application/json", result.Recorder.Header().Get("Content-Type"))
+
+	var res struct {
+		Todos []struct {
+			Title   string `json:"title"`
+			Content string `json:"content"`
+		} `json:"todos"`
+	}
+	result.UnmarshalBodyToObject(&res)
+	assert.Len(s.T(), res.Todos, 1)
+	assert.Equal(s.T(), "test title 1", res.Todos[0].Title)
+}
+
+func (s *testExportsHandlerSuite) TestGetExports_Markdown() {
+	s.SignIn()
+	s.createTodo()
+
+	result := testutil.NewRequest().Get("/exports?format=markdown").WithHeader("Cookie", token).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+	assert.Equal(s.T(), "text/markdown", result.Recorder.Header().Get("Content-Type"))
+	assert.Contains(s.T(), result.Recorder.Body.String(), "- [ ] test title 1\n  test content 1\n")
+}
+
+func (s *testExportsHandlerSuite) TestGetExports_BadRequest() {
+	s.SignIn()
+
+	result := testutil.NewRequest().Get("/exports?format=xlsx").WithHeader("Cookie", token).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusBadRequest, result.Code())
+}
+
+func (s *testExportsHandlerSuite) TestGetExports_StatusUnauthorized() {
+	result := testutil.NewRequest().Get("/exports?format=csv").GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusUnauthorized, result.Code())
+}
+
+func TestExportsHandler(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(testExportsHandlerSuite))
+}
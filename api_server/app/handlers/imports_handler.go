@@ -0,0 +1,174 @@
+package handlers
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/services"
+	"app/utils"
+	"app/validator"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// NOTE: format等のテキストフィールドの上限サイズ
+const maxImportFieldSize = 1024
+
+type ImportsHandler interface {
+	PostImports(ctx context.Context, request apis.PostImportsRequestObject) (apis.PostImportsResponseObject, error)
+	GetImport(ctx context.Context, request apis.GetImportRequestObject) (apis.GetImportResponseObject, error)
+}
+
+type importsHandler struct {
+	importService services.ImportService
+}
+
+func NewImportsHandler(importService services.ImportService) ImportsHandler {
+	return &importsHandler{importService: importService}
+}
+
+func (importsHandler *importsHandler) PostImports(ctx context.Context, request apis.PostImportsRequestObject) (apis.PostImportsResponseObject, error) {
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.PostImports500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	inputStruct, mappingErr := importsHandler.mappingInputStruct(request.Body)
+	if mappingErr != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: mappingErr.Error()}
+		return apis.PostImports500JSONResponse{InternalServerErrorResponseJSONResponse: res}, nil
+	}
+
+	statusCode, importJob, err := importsHandler.importService.CreateImport(ctx, inputStruct, userID)
+	switch statusCode {
+	case http.StatusBadRequest:
+		validationErrors := importsHandler.mappingValidationErrorStruct(err)
+		return apis.PostImports400JSONResponse{Code: http.StatusBadRequest, Errors: validationErrors}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostImports500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	resImport, err := importsHandler.mappingImport(importJob)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostImports500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+	res := apis.StoreImportResponseJSONResponse{Code: http.StatusOK, Errors: apis.StoreImportValidationError{}, Import: &resImport}
+	return apis.PostImports200JSONResponse{StoreImportResponseJSONResponse: res}, nil
+}
+
+func (importsHandler *importsHandler) GetImport(ctx context.Context, request apis.GetImportRequestObject) (apis.GetImportResponseObject, error) {
+	intID, err := strconv.Atoi(request.Id)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.GetImport500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.GetImport500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, importJob, _ := importsHandler.importService.ShowImport(ctx, int64(intID), userID)
+	if statusCode == http.StatusNotFound {
+		res := apis.NotFoundErrorResponseJSONResponse{Code: http.StatusNotFound}
+		return apis.GetImport404JSONResponse{NotFoundErrorResponseJSONResponse: res}, nil
+	}
+
+	resImport, err := importsHandler.mappingImport(importJob)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.GetImport500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+	return apis.GetImport200JSONResponse{ShowImportResponseJSONResponse: apis.ShowImportResponseJSONResponse{Import: resImport}}, nil
+}
+
+func (importsHandler *importsHandler) mappingInputStruct(reader *multipart.Reader) (apis.PostImportsMultipartRequestBody, error) {
+	var inputStruct apis.PostImportsMultipartRequestBody
+
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			// NOTE: 全てのパートを読み終えた場合
+			break
+		}
+		if err != nil {
+			return inputStruct, fmt.Errorf("failed to read multipart part: %w", err)
+		}
+
+		switch part.FormName() {
+		case "format":
+			value, err := io.ReadAll(io.LimitReader(part, maxImportFieldSize))
+			if err != nil {
+				return inputStruct, fmt.Errorf("failed to read format: %w", err)
+			}
+			inputStruct.Format = string(value)
+		case "file":
+			// NOTE: 上限サイズを超えたことを検知できるよう1バイトだけ余分に読み込む
+			var buf bytes.Buffer
+			if _, err := io.Copy(&buf, io.LimitReader(part, validator.MaxImportSize+1)); err != nil {
+				return inputStruct, fmt.Errorf("failed to copy content: %w", err)
+			}
+			inputStruct.File.InitFromBytes(buf.Bytes(), part.FileName())
+		}
+	}
+
+	return inputStruct, nil
+}
+
+func (importsHandler *importsHandler) mappingValidationErrorStruct(err error) apis.StoreImportValidationError {
+	var validationError apis.StoreImportValidationError
+	if err == nil {
+		return validationError
+	}
+
+	if errors, ok := err.(validation.Errors); ok {
+		// NOTE: レスポンス用の構造体にマッピング
+		for field, err := range errors {
+			messages := []string{err.Error()}
+			switch field {
+			case "format":
+				validationError.Format = &messages
+			case "file":
+				validationError.File = &messages
+			}
+		}
+	}
+	return validationError
+}
+
+func (importsHandler *importsHandler) mappingImport(importJob *models.Import) (apis.Import, error) {
+	// NOTE: 行ごとのエラーはJSONで保存されている
+	rowErrors := []apis.ImportRowError{}
+	if err := json.Unmarshal([]byte(importJob.RowErrors), &rowErrors); err != nil {
+		return apis.Import{}, err
+	}
+
+	resImport := apis.Import{
+		Id:            int(importJob.ID),
+		Format:        apis.ImportFormat(importJob.Format),
+		Status:        apis.ImportStatus(importJob.Status),
+		TotalRows:     importJob.TotalRows,
+		ProcessedRows: importJob.ProcessedRows,
+		ImportedRows:  importJob.ImportedRows,
+		SkippedRows:   importJob.SkippedRows,
+		Errors:        rowErrors,
+		CreatedAt:     importJob.CreatedAt,
+		UpdatedAt:     importJob.UpdatedAt,
+	}
+	if importJob.ErrorMessage.Valid {
+		resImport.ErrorMessage = &importJob.ErrorMessage.String
+	}
+	return resImport, nil
+}
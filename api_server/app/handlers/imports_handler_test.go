@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	apis "app/openapi"
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/oapi-codegen/testutil"
+)
+
+type testImportsHandlerSuite struct {
+	WithDBSuite
+}
+
+func (s *testImportsHandlerSuite) SetupTest() {
+	s.SetDBCon()
+
+	s.initializeHandlers()
+
+	s.SetCsrfHeaderValues()
+}
+
+func (s *testImportsHandlerSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *testImportsHandlerSuite) importFile(cookie string, format string, content []byte) *testutil.CompletedRequest {
+	body := new(bytes.Buffer)
+	// NOTE: フォームデータを作成する
+	mw := multipart.NewWriter(body)
+	mw.WriteField("format", format)
+	w, _ := mw.CreateFormFile("file", "import."+format)
+	w.Write(content)
+	mw.Close()
+
+	return testutil.NewRequest().Post("/imports").WithHeader("Cookie", cookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithBody(body.Bytes()).WithContentType(mw.FormDataContentType()).GoWithHTTPHandler(s.T(), e)
+}
+
+func (s *testImportsHandlerSuite) TestPostImports_StatusOk() {
+	s.SignIn()
+
+	result := s.importFile(token+"; "+csrfTokenCookie, "csv", []byte("title,content\ntest title 1,test content 1\n"))
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.PostImports200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Equal(s.T(), apis.ImportFormat("csv"), res.Import.Format)
+	assert.Equal(s.T(), apis.ImportStatusQueued, res.Import.Status)
+	assert.Equal(s.T(), []apis.ImportRowError{}, res.Import.Errors)
+}
+
+func (s *testImportsHandlerSuite) TestPostImports_BadRequest() {
+	s.SignIn()
+
+	result := s.importFile(token+"; "+csrfTokenCookie, "xlsx", []byte{0xff, 0xfe, 0x00})
+	assert.Equal(s.T(), http.StatusBadRequest, result.Code())
+
+	var res apis.PostImports400JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Equal(s.T(), []string{"形式はcsv, json, todoist, trelloのいずれかでお願いします。"}, *res.Errors.Format)
+	assert.Equal(s.T(), []string{"インポートファイルはUTF-8のテキストでお願いします。"}, *res.Errors.File)
+}
+
+func (s *testImportsHandlerSuite) TestPostImports_StatusUnauthorized() {
+	result := s.importFile(csrfTokenCookie, "csv", []byte("title\ntest title 1\n"))
+	assert.Equal(s.T(), http.StatusUnauthorized, result.Code())
+}
+
+func (s *testImportsHandlerSuite) TestGetImport_StatusOk() {
+	s.SignIn()
+	var created apis.PostImports200JSONResponse
+	s.importFile(token+"; "+csrfTokenCookie, "csv", []byte("title\ntest title 1\n")).UnmarshalBodyToObject(&created)
+
+	result := testutil.NewRequest().Get("/imports/"+strconv.Itoa(created.Import.Id)).WithHeader("Cookie", token).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.GetImport200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Equal(s.T(), created.Import.Id, res.Import.Id)
+}
+
+func (s *testImportsHandlerSuite) TestGetImport_StatusNotFound() {
+	s.SignIn()
+
+	result := testutil.NewRequest().Get("/imports/0").WithHeader("Cookie", token).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusNotFound, result.Code())
+}
+
+func TestImportsHandler(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(testImportsHandlerSuite))
+}
@@ -9,6 +9,27 @@ type MainHandler interface {
 	// handlers /auth
 	GetAuthCsrf(ctx context.Context, request apis.GetAuthCsrfRequestObject) (apis.GetAuthCsrfResponseObject, error)
 	PostAuthSignIn(ctx context.Context, request apis.PostAuthSignInRequestObject) (apis.PostAuthSignInResponseObject, error)
+	PostAuthSignInTwoFactor(ctx context.Context, request apis.PostAuthSignInTwoFactorRequestObject) (apis.PostAuthSignInTwoFactorResponseObject, error)
+	PostAuthRefresh(ctx context.Context, request apis.PostAuthRefreshRequestObject) (apis.PostAuthRefreshResponseObject, error)
+	PostAuthSignOut(ctx context.Context, request apis.PostAuthSignOutRequestObject) (apis.PostAuthSignOutResponseObject, error)
+	PostAuthPasswordForgot(ctx context.Context, request apis.PostAuthPasswordForgotRequestObject) (apis.PostAuthPasswordForgotResponseObject, error)
+	PostAuthPasswordReset(ctx context.Context, request apis.PostAuthPasswordResetRequestObject) (apis.PostAuthPasswordResetResponseObject, error)
+	PostAuthEmailVerify(ctx context.Context, request apis.PostAuthEmailVerifyRequestObject) (apis.PostAuthEmailVerifyResponseObject, error)
+	PostAuthEmailResend(ctx context.Context, request apis.PostAuthEmailResendRequestObject) (apis.PostAuthEmailResendResponseObject, error)
+	PostAuthUnlock(ctx context.Context, request apis.PostAuthUnlockRequestObject) (apis.PostAuthUnlockResponseObject, error)
+	PostAuthTwoFactorSetup(ctx context.Context, request apis.PostAuthTwoFactorSetupRequestObject) (apis.PostAuthTwoFactorSetupResponseObject, error)
+	PostAuthTwoFactorConfirm(ctx context.Context, request apis.PostAuthTwoFactorConfirmRequestObject) (apis.PostAuthTwoFactorConfirmResponseObject, error)
+	PostAuthTwoFactorDisable(ctx context.Context, request apis.PostAuthTwoFactorDisableRequestObject) (apis.PostAuthTwoFactorDisableResponseObject, error)
+	PostAuthTwoFactorRecoveryCodes(ctx context.Context, request apis.PostAuthTwoFactorRecoveryCodesRequestObject) (apis.PostAuthTwoFactorRecoveryCodesResponseObject, error)
+	GetAuthPasskeys(ctx context.Context, request apis.GetAuthPasskeysRequestObject) (apis.GetAuthPasskeysResponseObject, error)
+	PostAuthPasskeysRegisterBegin(ctx context.Context, request apis.PostAuthPasskeysRegisterBeginRequestObject) (apis.PostAuthPasskeysRegisterBeginResponseObject, error)
+	PostAuthPasskeysRegisterFinish(ctx context.Context, request apis.PostAuthPasskeysRegisterFinishRequestObject) (apis.PostAuthPasskeysRegisterFinishResponseObject, error)
+	PostAuthPasskeysSignInBegin(ctx context.Context, request apis.PostAuthPasskeysSignInBeginRequestObject) (apis.PostAuthPasskeysSignInBeginResponseObject, error)
+	PostAuthPasskeysSignInFinish(ctx context.Context, request apis.PostAuthPasskeysSignInFinishRequestObject) (apis.PostAuthPasskeysSignInFinishResponseObject, error)
+	DeleteAuthPasskey(ctx context.Context, request apis.DeleteAuthPasskeyRequestObject) (apis.DeleteAuthPasskeyResponseObject, error)
+	GetAuthSessions(ctx context.Context, request apis.GetAuthSessionsRequestObject) (apis.GetAuthSessionsResponseObject, error)
+	DeleteAuthSession(ctx context.Context, request apis.DeleteAuthSessionRequestObject) (apis.DeleteAuthSessionResponseObject, error)
+	PostAuthSessionsRevokeOthers(ctx context.Context, request apis.PostAuthSessionsRevokeOthersRequestObject) (apis.PostAuthSessionsRevokeOthersResponseObject, error)
 	PostAuthValidateSignUp(ctx context.Context, request apis.PostAuthValidateSignUpRequestObject) (apis.PostAuthValidateSignUpResponseObject, error)
 	PostAuthSignUp(ctx context.Context, request apis.PostAuthSignUpRequestObject) (apis.PostAuthSignUpResponseObject, error)
 
@@ -18,15 +39,96 @@ type MainHandler interface {
 	GetTodo(ctx context.Context, request apis.GetTodoRequestObject) (apis.GetTodoResponseObject, error)
 	PatchTodo(ctx context.Context, request apis.PatchTodoRequestObject) (apis.PatchTodoResponseObject, error)
 	DeleteTodo(ctx context.Context, request apis.DeleteTodoRequestObject) (apis.DeleteTodoResponseObject, error)
+
+	// handlers /shareLinks
+	PostTodoShareLinks(ctx context.Context, request apis.PostTodoShareLinksRequestObject) (apis.PostTodoShareLinksResponseObject, error)
+	GetShareLinks(ctx context.Context, request apis.GetShareLinksRequestObject) (apis.GetShareLinksResponseObject, error)
+	DeleteShareLink(ctx context.Context, request apis.DeleteShareLinkRequestObject) (apis.DeleteShareLinkResponseObject, error)
+	GetSharedTodo(ctx context.Context, request apis.GetSharedTodoRequestObject) (apis.GetSharedTodoResponseObject, error)
+
+	// handlers /todos/{id}/comments
+	PostTodoComments(ctx context.Context, request apis.PostTodoCommentsRequestObject) (apis.PostTodoCommentsResponseObject, error)
+	PatchTodoComment(ctx context.Context, request apis.PatchTodoCommentRequestObject) (apis.PatchTodoCommentResponseObject, error)
+	DeleteTodoComment(ctx context.Context, request apis.DeleteTodoCommentRequestObject) (apis.DeleteTodoCommentResponseObject, error)
+
+	// handlers /todos/{id}/attachments
+	PostTodoAttachments(ctx context.Context, request apis.PostTodoAttachmentsRequestObject) (apis.PostTodoAttachmentsResponseObject, error)
+	GetTodoAttachments(ctx context.Context, request apis.GetTodoAttachmentsRequestObject) (apis.GetTodoAttachmentsResponseObject, error)
+	GetTodoAttachment(ctx context.Context, request apis.GetTodoAttachmentRequestObject) (apis.GetTodoAttachmentResponseObject, error)
+	DeleteTodoAttachment(ctx context.Context, request apis.DeleteTodoAttachmentRequestObject) (apis.DeleteTodoAttachmentResponseObject, error)
+
+	// handlers /activity
+	GetActivities(ctx context.Context, request apis.GetActivitiesRequestObject) (apis.GetActivitiesResponseObject, error)
+
+	// handlers /webhooks
+	PostWebhooks(ctx context.Context, request apis.PostWebhooksRequestObject) (apis.PostWebhooksResponseObject, error)
+	GetWebhooks(ctx context.Context, request apis.GetWebhooksRequestObject) (apis.GetWebhooksResponseObject, error)
+	DeleteWebhook(ctx context.Context, request apis.DeleteWebhookRequestObject) (apis.DeleteWebhookResponseObject, error)
+	GetWebhookDeliveries(ctx context.Context, request apis.GetWebhookDeliveriesRequestObject) (apis.GetWebhookDeliveriesResponseObject, error)
+	PostWebhookDeliveryRedeliver(ctx context.Context, request apis.PostWebhookDeliveryRedeliverRequestObject) (apis.PostWebhookDeliveryRedeliverResponseObject, error)
+
+	// handlers /appPasswords
+	PostAppPasswords(ctx context.Context, request apis.PostAppPasswordsRequestObject) (apis.PostAppPasswordsResponseObject, error)
+	GetAppPasswords(ctx context.Context, request apis.GetAppPasswordsRequestObject) (apis.GetAppPasswordsResponseObject, error)
+	DeleteAppPassword(ctx context.Context, request apis.DeleteAppPasswordRequestObject) (apis.DeleteAppPasswordResponseObject, error)
+
+	// handlers /imports
+	PostImports(ctx context.Context, request apis.PostImportsRequestObject) (apis.PostImportsResponseObject, error)
+	GetImport(ctx context.Context, request apis.GetImportRequestObject) (apis.GetImportResponseObject, error)
+
+	// handlers /exports
+	GetExports(ctx context.Context, request apis.GetExportsRequestObject) (apis.GetExportsResponseObject, error)
+
+	// handlers /todoTemplates
+	PostTodoTemplates(ctx context.Context, request apis.PostTodoTemplatesRequestObject) (apis.PostTodoTemplatesResponseObject, error)
+	GetTodoTemplates(ctx context.Context, request apis.GetTodoTemplatesRequestObject) (apis.GetTodoTemplatesResponseObject, error)
+	PutTodoTemplate(ctx context.Context, request apis.PutTodoTemplateRequestObject) (apis.PutTodoTemplateResponseObject, error)
+	DeleteTodoTemplate(ctx context.Context, request apis.DeleteTodoTemplateRequestObject) (apis.DeleteTodoTemplateResponseObject, error)
+	PostTodoTemplateInstantiate(ctx context.Context, request apis.PostTodoTemplateInstantiateRequestObject) (apis.PostTodoTemplateInstantiateResponseObject, error)
+
+	// handlers /savedFilters
+	PostSavedFilters(ctx context.Context, request apis.PostSavedFiltersRequestObject) (apis.PostSavedFiltersResponseObject, error)
+	GetSavedFilters(ctx context.Context, request apis.GetSavedFiltersRequestObject) (apis.GetSavedFiltersResponseObject, error)
+	PutSavedFilter(ctx context.Context, request apis.PutSavedFilterRequestObject) (apis.PutSavedFilterResponseObject, error)
+	DeleteSavedFilter(ctx context.Context, request apis.DeleteSavedFilterRequestObject) (apis.DeleteSavedFilterResponseObject, error)
+
+	// handlers /timeEntries
+	PostTodoTimerStart(ctx context.Context, request apis.PostTodoTimerStartRequestObject) (apis.PostTodoTimerStartResponseObject, error)
+	PostTodoTimerStop(ctx context.Context, request apis.PostTodoTimerStopRequestObject) (apis.PostTodoTimerStopResponseObject, error)
+	PostTimeEntries(ctx context.Context, request apis.PostTimeEntriesRequestObject) (apis.PostTimeEntriesResponseObject, error)
+	GetTimeEntries(ctx context.Context, request apis.GetTimeEntriesRequestObject) (apis.GetTimeEntriesResponseObject, error)
+	DeleteTimeEntry(ctx context.Context, request apis.DeleteTimeEntryRequestObject) (apis.DeleteTimeEntryResponseObject, error)
+	GetTimeReports(ctx context.Context, request apis.GetTimeReportsRequestObject) (apis.GetTimeReportsResponseObject, error)
+	GetTimeReportsCsv(ctx context.Context, request apis.GetTimeReportsCsvRequestObject) (apis.GetTimeReportsCsvResponseObject, error)
+
+	// handlers /users
+	GetUsersMe(ctx context.Context, request apis.GetUsersMeRequestObject) (apis.GetUsersMeResponseObject, error)
+	PatchUsersMe(ctx context.Context, request apis.PatchUsersMeRequestObject) (apis.PatchUsersMeResponseObject, error)
+	PostUsersMeEmail(ctx context.Context, request apis.PostUsersMeEmailRequestObject) (apis.PostUsersMeEmailResponseObject, error)
 }
 
 type mainHandler struct {
 	authHandler AuthHandler
 	todosHandler TodosHandler
+	shareLinksHandler ShareLinksHandler
+	commentsHandler CommentsHandler
+	attachmentsHandler AttachmentsHandler
+	activitiesHandler ActivitiesHandler
+	webhooksHandler WebhooksHandler
+	appPasswordsHandler AppPasswordsHandler
+	importsHandler ImportsHandler
+	exportsHandler ExportsHandler
+	todoTemplatesHandler TodoTemplatesHandler
+	savedFiltersHandler SavedFiltersHandler
+	timeEntriesHandler TimeEntriesHandler
+	sessionsHandler SessionsHandler
+	usersHandler UsersHandler
+	twoFactorHandler TwoFactorHandler
+	passkeysHandler PasskeysHandler
 }
 
-func NewMainHandler(authHandler AuthHandler, todosHandler TodosHandler) MainHandler {
-	return &mainHandler{authHandler: authHandler, todosHandler: todosHandler}
+func NewMainHandler(authHandler AuthHandler, todosHandler TodosHandler, shareLinksHandler ShareLinksHandler, commentsHandler CommentsHandler, attachmentsHandler AttachmentsHandler, activitiesHandler ActivitiesHandler, webhooksHandler WebhooksHandler, appPasswordsHandler AppPasswordsHandler, importsHandler ImportsHandler, exportsHandler ExportsHandler, todoTemplatesHandler TodoTemplatesHandler, savedFiltersHandler SavedFiltersHandler, timeEntriesHandler TimeEntriesHandler, sessionsHandler SessionsHandler, usersHandler UsersHandler, twoFactorHandler TwoFactorHandler, passkeysHandler PasskeysHandler) MainHandler {
+	return &mainHandler{authHandler: authHandler, todosHandler: todosHandler, shareLinksHandler: shareLinksHandler, commentsHandler: commentsHandler, attachmentsHandler: attachmentsHandler, activitiesHandler: activitiesHandler, webhooksHandler: webhooksHandler, appPasswordsHandler: appPasswordsHandler, importsHandler: importsHandler, exportsHandler: exportsHandler, todoTemplatesHandler: todoTemplatesHandler, savedFiltersHandler: savedFiltersHandler, timeEntriesHandler: timeEntriesHandler, sessionsHandler: sessionsHandler, usersHandler: usersHandler, twoFactorHandler: twoFactorHandler, passkeysHandler: passkeysHandler}
 }
 
 func (mh *mainHandler) GetAuthCsrf(ctx context.Context, request apis.GetAuthCsrfRequestObject) (apis.GetAuthCsrfResponseObject, error) {
@@ -39,6 +141,111 @@ func (mh *mainHandler) PostAuthSignIn(ctx context.Context, request apis.PostAuth
 	return res, err
 }
 
+func (mh *mainHandler) PostAuthSignInTwoFactor(ctx context.Context, request apis.PostAuthSignInTwoFactorRequestObject) (apis.PostAuthSignInTwoFactorResponseObject, error) {
+	res, err := mh.authHandler.PostAuthSignInTwoFactor(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PostAuthRefresh(ctx context.Context, request apis.PostAuthRefreshRequestObject) (apis.PostAuthRefreshResponseObject, error) {
+	res, err := mh.authHandler.PostAuthRefresh(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PostAuthSignOut(ctx context.Context, request apis.PostAuthSignOutRequestObject) (apis.PostAuthSignOutResponseObject, error) {
+	res, err := mh.authHandler.PostAuthSignOut(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PostAuthPasswordForgot(ctx context.Context, request apis.PostAuthPasswordForgotRequestObject) (apis.PostAuthPasswordForgotResponseObject, error) {
+	res, err := mh.authHandler.PostAuthPasswordForgot(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PostAuthPasswordReset(ctx context.Context, request apis.PostAuthPasswordResetRequestObject) (apis.PostAuthPasswordResetResponseObject, error) {
+	res, err := mh.authHandler.PostAuthPasswordReset(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PostAuthEmailVerify(ctx context.Context, request apis.PostAuthEmailVerifyRequestObject) (apis.PostAuthEmailVerifyResponseObject, error) {
+	res, err := mh.authHandler.PostAuthEmailVerify(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PostAuthEmailResend(ctx context.Context, request apis.PostAuthEmailResendRequestObject) (apis.PostAuthEmailResendResponseObject, error) {
+	res, err := mh.authHandler.PostAuthEmailResend(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PostAuthUnlock(ctx context.Context, request apis.PostAuthUnlockRequestObject) (apis.PostAuthUnlockResponseObject, error) {
+	res, err := mh.authHandler.PostAuthUnlock(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PostAuthTwoFactorSetup(ctx context.Context, request apis.PostAuthTwoFactorSetupRequestObject) (apis.PostAuthTwoFactorSetupResponseObject, error) {
+	res, err := mh.twoFactorHandler.PostAuthTwoFactorSetup(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PostAuthTwoFactorConfirm(ctx context.Context, request apis.PostAuthTwoFactorConfirmRequestObject) (apis.PostAuthTwoFactorConfirmResponseObject, error) {
+	res, err := mh.twoFactorHandler.PostAuthTwoFactorConfirm(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PostAuthTwoFactorDisable(ctx context.Context, request apis.PostAuthTwoFactorDisableRequestObject) (apis.PostAuthTwoFactorDisableResponseObject, error) {
+	res, err := mh.twoFactorHandler.PostAuthTwoFactorDisable(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PostAuthTwoFactorRecoveryCodes(ctx context.Context, request apis.PostAuthTwoFactorRecoveryCodesRequestObject) (apis.PostAuthTwoFactorRecoveryCodesResponseObject, error) {
+	res, err := mh.twoFactorHandler.PostAuthTwoFactorRecoveryCodes(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) GetAuthPasskeys(ctx context.Context, request apis.GetAuthPasskeysRequestObject) (apis.GetAuthPasskeysResponseObject, error) {
+	res, err := mh.passkeysHandler.GetAuthPasskeys(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PostAuthPasskeysRegisterBegin(ctx context.Context, request apis.PostAuthPasskeysRegisterBeginRequestObject) (apis.PostAuthPasskeysRegisterBeginResponseObject, error) {
+	res, err := mh.passkeysHandler.PostAuthPasskeysRegisterBegin(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PostAuthPasskeysRegisterFinish(ctx context.Context, request apis.PostAuthPasskeysRegisterFinishRequestObject) (apis.PostAuthPasskeysRegisterFinishResponseObject, error) {
+	res, err := mh.passkeysHandler.PostAuthPasskeysRegisterFinish(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PostAuthPasskeysSignInBegin(ctx context.Context, request apis.PostAuthPasskeysSignInBeginRequestObject) (apis.PostAuthPasskeysSignInBeginResponseObject, error) {
+	res, err := mh.passkeysHandler.PostAuthPasskeysSignInBegin(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PostAuthPasskeysSignInFinish(ctx context.Context, request apis.PostAuthPasskeysSignInFinishRequestObject) (apis.PostAuthPasskeysSignInFinishResponseObject, error) {
+	res, err := mh.passkeysHandler.PostAuthPasskeysSignInFinish(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) DeleteAuthPasskey(ctx context.Context, request apis.DeleteAuthPasskeyRequestObject) (apis.DeleteAuthPasskeyResponseObject, error) {
+	res, err := mh.passkeysHandler.DeleteAuthPasskey(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) GetAuthSessions(ctx context.Context, request apis.GetAuthSessionsRequestObject) (apis.GetAuthSessionsResponseObject, error) {
+	res, err := mh.sessionsHandler.GetAuthSessions(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) DeleteAuthSession(ctx context.Context, request apis.DeleteAuthSessionRequestObject) (apis.DeleteAuthSessionResponseObject, error) {
+	res, err := mh.sessionsHandler.DeleteAuthSession(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PostAuthSessionsRevokeOthers(ctx context.Context, request apis.PostAuthSessionsRevokeOthersRequestObject) (apis.PostAuthSessionsRevokeOthersResponseObject, error) {
+	res, err := mh.sessionsHandler.PostAuthSessionsRevokeOthers(ctx, request)
+	return res, err
+}
+
 func (mh *mainHandler) PostAuthValidateSignUp(ctx context.Context, request apis.PostAuthValidateSignUpRequestObject) (apis.PostAuthValidateSignUpResponseObject, error) {
 	res, err := mh.authHandler.PostAuthValidateSignUp(ctx, request)
 	return res, err
@@ -73,3 +280,213 @@ func (mh *mainHandler) DeleteTodo(ctx context.Context, request apis.DeleteTodoRe
 	res, err := mh.todosHandler.DeleteTodo(ctx, request)
 	return res, err
 }
+
+func (mh *mainHandler) PostTodoShareLinks(ctx context.Context, request apis.PostTodoShareLinksRequestObject) (apis.PostTodoShareLinksResponseObject, error) {
+	res, err := mh.shareLinksHandler.PostTodoShareLinks(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) GetShareLinks(ctx context.Context, request apis.GetShareLinksRequestObject) (apis.GetShareLinksResponseObject, error) {
+	res, err := mh.shareLinksHandler.GetShareLinks(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) DeleteShareLink(ctx context.Context, request apis.DeleteShareLinkRequestObject) (apis.DeleteShareLinkResponseObject, error) {
+	res, err := mh.shareLinksHandler.DeleteShareLink(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) GetSharedTodo(ctx context.Context, request apis.GetSharedTodoRequestObject) (apis.GetSharedTodoResponseObject, error) {
+	res, err := mh.shareLinksHandler.GetSharedTodo(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PostTodoComments(ctx context.Context, request apis.PostTodoCommentsRequestObject) (apis.PostTodoCommentsResponseObject, error) {
+	res, err := mh.commentsHandler.PostTodoComments(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PatchTodoComment(ctx context.Context, request apis.PatchTodoCommentRequestObject) (apis.PatchTodoCommentResponseObject, error) {
+	res, err := mh.commentsHandler.PatchTodoComment(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) DeleteTodoComment(ctx context.Context, request apis.DeleteTodoCommentRequestObject) (apis.DeleteTodoCommentResponseObject, error) {
+	res, err := mh.commentsHandler.DeleteTodoComment(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PostTodoAttachments(ctx context.Context, request apis.PostTodoAttachmentsRequestObject) (apis.PostTodoAttachmentsResponseObject, error) {
+	res, err := mh.attachmentsHandler.PostTodoAttachments(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) GetTodoAttachments(ctx context.Context, request apis.GetTodoAttachmentsRequestObject) (apis.GetTodoAttachmentsResponseObject, error) {
+	res, err := mh.attachmentsHandler.GetTodoAttachments(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) GetTodoAttachment(ctx context.Context, request apis.GetTodoAttachmentRequestObject) (apis.GetTodoAttachmentResponseObject, error) {
+	res, err := mh.attachmentsHandler.GetTodoAttachment(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) DeleteTodoAttachment(ctx context.Context, request apis.DeleteTodoAttachmentRequestObject) (apis.DeleteTodoAttachmentResponseObject, error) {
+	res, err := mh.attachmentsHandler.DeleteTodoAttachment(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) GetActivities(ctx context.Context, request apis.GetActivitiesRequestObject) (apis.GetActivitiesResponseObject, error) {
+	res, err := mh.activitiesHandler.GetActivities(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PostWebhooks(ctx context.Context, request apis.PostWebhooksRequestObject) (apis.PostWebhooksResponseObject, error) {
+	res, err := mh.webhooksHandler.PostWebhooks(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) GetWebhooks(ctx context.Context, request apis.GetWebhooksRequestObject) (apis.GetWebhooksResponseObject, error) {
+	res, err := mh.webhooksHandler.GetWebhooks(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) DeleteWebhook(ctx context.Context, request apis.DeleteWebhookRequestObject) (apis.DeleteWebhookResponseObject, error) {
+	res, err := mh.webhooksHandler.DeleteWebhook(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) GetWebhookDeliveries(ctx context.Context, request apis.GetWebhookDeliveriesRequestObject) (apis.GetWebhookDeliveriesResponseObject, error) {
+	res, err := mh.webhooksHandler.GetWebhookDeliveries(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PostWebhookDeliveryRedeliver(ctx context.Context, request apis.PostWebhookDeliveryRedeliverRequestObject) (apis.PostWebhookDeliveryRedeliverResponseObject, error) {
+	res, err := mh.webhooksHandler.PostWebhookDeliveryRedeliver(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PostAppPasswords(ctx context.Context, request apis.PostAppPasswordsRequestObject) (apis.PostAppPasswordsResponseObject, error) {
+	res, err := mh.appPasswordsHandler.PostAppPasswords(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) GetAppPasswords(ctx context.Context, request apis.GetAppPasswordsRequestObject) (apis.GetAppPasswordsResponseObject, error) {
+	res, err := mh.appPasswordsHandler.GetAppPasswords(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) DeleteAppPassword(ctx context.Context, request apis.DeleteAppPasswordRequestObject) (apis.DeleteAppPasswordResponseObject, error) {
+	res, err := mh.appPasswordsHandler.DeleteAppPassword(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PostImports(ctx context.Context, request apis.PostImportsRequestObject) (apis.PostImportsResponseObject, error) {
+	res, err := mh.importsHandler.PostImports(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) GetImport(ctx context.Context, request apis.GetImportRequestObject) (apis.GetImportResponseObject, error) {
+	res, err := mh.importsHandler.GetImport(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) GetExports(ctx context.Context, request apis.GetExportsRequestObject) (apis.GetExportsResponseObject, error) {
+	res, err := mh.exportsHandler.GetExports(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PostTodoTemplates(ctx context.Context, request apis.PostTodoTemplatesRequestObject) (apis.PostTodoTemplatesResponseObject, error) {
+	res, err := mh.todoTemplatesHandler.PostTodoTemplates(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) GetTodoTemplates(ctx context.Context, request apis.GetTodoTemplatesRequestObject) (apis.GetTodoTemplatesResponseObject, error) {
+	res, err := mh.todoTemplatesHandler.GetTodoTemplates(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PutTodoTemplate(ctx context.Context, request apis.PutTodoTemplateRequestObject) (apis.PutTodoTemplateResponseObject, error) {
+	res, err := mh.todoTemplatesHandler.PutTodoTemplate(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) DeleteTodoTemplate(ctx context.Context, request apis.DeleteTodoTemplateRequestObject) (apis.DeleteTodoTemplateResponseObject, error) {
+	res, err := mh.todoTemplatesHandler.DeleteTodoTemplate(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PostTodoTemplateInstantiate(ctx context.Context, request apis.PostTodoTemplateInstantiateRequestObject) (apis.PostTodoTemplateInstantiateResponseObject, error) {
+	res, err := mh.todoTemplatesHandler.PostTodoTemplateInstantiate(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PostSavedFilters(ctx context.Context, request apis.PostSavedFiltersRequestObject) (apis.PostSavedFiltersResponseObject, error) {
+	res, err := mh.savedFiltersHandler.PostSavedFilters(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) GetSavedFilters(ctx context.Context, request apis.GetSavedFiltersRequestObject) (apis.GetSavedFiltersResponseObject, error) {
+	res, err := mh.savedFiltersHandler.GetSavedFilters(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PutSavedFilter(ctx context.Context, request apis.PutSavedFilterRequestObject) (apis.PutSavedFilterResponseObject, error) {
+	res, err := mh.savedFiltersHandler.PutSavedFilter(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) DeleteSavedFilter(ctx context.Context, request apis.DeleteSavedFilterRequestObject) (apis.DeleteSavedFilterResponseObject, error) {
+	res, err := mh.savedFiltersHandler.DeleteSavedFilter(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PostTodoTimerStart(ctx context.Context, request apis.PostTodoTimerStartRequestObject) (apis.PostTodoTimerStartResponseObject, error) {
+	res, err := mh.timeEntriesHandler.PostTodoTimerStart(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PostTodoTimerStop(ctx context.Context, request apis.PostTodoTimerStopRequestObject) (apis.PostTodoTimerStopResponseObject, error) {
+	res, err := mh.timeEntriesHandler.PostTodoTimerStop(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PostTimeEntries(ctx context.Context, request apis.PostTimeEntriesRequestObject) (apis.PostTimeEntriesResponseObject, error) {
+	res, err := mh.timeEntriesHandler.PostTimeEntries(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) GetTimeEntries(ctx context.Context, request apis.GetTimeEntriesRequestObject) (apis.GetTimeEntriesResponseObject, error) {
+	res, err := mh.timeEntriesHandler.GetTimeEntries(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) DeleteTimeEntry(ctx context.Context, request apis.DeleteTimeEntryRequestObject) (apis.DeleteTimeEntryResponseObject, error) {
+	res, err := mh.timeEntriesHandler.DeleteTimeEntry(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) GetTimeReports(ctx context.Context, request apis.GetTimeReportsRequestObject) (apis.GetTimeReportsResponseObject, error) {
+	res, err := mh.timeEntriesHandler.GetTimeReports(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) GetTimeReportsCsv(ctx context.Context, request apis.GetTimeReportsCsvRequestObject) (apis.GetTimeReportsCsvResponseObject, error) {
+	res, err := mh.timeEntriesHandler.GetTimeReportsCsv(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) GetUsersMe(ctx context.Context, request apis.GetUsersMeRequestObject) (apis.GetUsersMeResponseObject, error) {
+	res, err := mh.usersHandler.GetUsersMe(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PatchUsersMe(ctx context.Context, request apis.PatchUsersMeRequestObject) (apis.PatchUsersMeResponseObject, error) {
+	res, err := mh.usersHandler.PatchUsersMe(ctx, request)
+	return res, err
+}
+
+func (mh *mainHandler) PostUsersMeEmail(ctx context.Context, request apis.PostUsersMeEmailRequestObject) (apis.PostUsersMeEmailResponseObject, error) {
+	res, err := mh.usersHandler.PostUsersMeEmail(ctx, request)
+	return res, err
+}
@@ -0,0 +1,237 @@
+package handlers
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/services"
+	"app/utils"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type PasskeysHandler interface {
+	GetAuthPasskeys(ctx context.Context, request apis.GetAuthPasskeysRequestObject) (apis.GetAuthPasskeysResponseObject, error)
+	PostAuthPasskeysRegisterBegin(ctx context.Context, request apis.PostAuthPasskeysRegisterBeginRequestObject) (apis.PostAuthPasskeysRegisterBeginResponseObject, error)
+	PostAuthPasskeysRegisterFinish(ctx context.Context, request apis.PostAuthPasskeysRegisterFinishRequestObject) (apis.PostAuthPasskeysRegisterFinishResponseObject, error)
+	PostAuthPasskeysSignInBegin(ctx context.Context, request apis.PostAuthPasskeysSignInBeginRequestObject) (apis.PostAuthPasskeysSignInBeginResponseObject, error)
+	PostAuthPasskeysSignInFinish(ctx context.Context, request apis.PostAuthPasskeysSignInFinishRequestObject) (apis.PostAuthPasskeysSignInFinishResponseObject, error)
+	DeleteAuthPasskey(ctx context.Context, request apis.DeleteAuthPasskeyRequestObject) (apis.DeleteAuthPasskeyResponseObject, error)
+}
+
+type passkeysHandler struct {
+	passkeyService services.PasskeyService
+}
+
+func NewPasskeysHandler(passkeyService services.PasskeyService) PasskeysHandler {
+	return &passkeysHandler{passkeyService: passkeyService}
+}
+
+func (passkeysHandler *passkeysHandler) GetAuthPasskeys(ctx context.Context, request apis.GetAuthPasskeysRequestObject) (apis.GetAuthPasskeysResponseObject, error) {
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.GetAuthPasskeys500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, passkeys, err := passkeysHandler.passkeyService.FetchPasskeysList(ctx, userID)
+	switch statusCode {
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.GetAuthPasskeys500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	resPasskeys := make([]apis.Passkey, 0, len(passkeys))
+	for _, passkey := range passkeys {
+		resPasskeys = append(resPasskeys, passkeysHandler.mappingPasskey(passkey))
+	}
+	res := apis.FetchPasskeysResponseJSONResponse{Passkeys: resPasskeys}
+	return apis.GetAuthPasskeys200JSONResponse{FetchPasskeysResponseJSONResponse: res}, nil
+}
+
+func (passkeysHandler *passkeysHandler) PostAuthPasskeysRegisterBegin(ctx context.Context, request apis.PostAuthPasskeysRegisterBeginRequestObject) (apis.PostAuthPasskeysRegisterBeginResponseObject, error) {
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.PostAuthPasskeysRegisterBegin500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	client, _ := utils.ClientContextValue(ctx)
+	statusCode, ceremonyToken, creationOptions, err := passkeysHandler.passkeyService.BeginRegistration(ctx, *request.Body, userID, client.IPAddress)
+	switch statusCode {
+	case http.StatusBadRequest:
+		res := apis.PasskeyResponseJSONResponse{Code: http.StatusBadRequest, Errors: passkeysHandler.mappingValidationErrors(err)}
+		return apis.PostAuthPasskeysRegisterBegin400JSONResponse{PasskeyResponseJSONResponse: res}, nil
+	case http.StatusTooManyRequests:
+		return apis.PostAuthPasskeysRegisterBegin429JSONResponse{TooManyRequestsErrorResponseJSONResponse: newSignInThrottledResponse(err)}, nil
+	case http.StatusNotFound:
+		res := apis.UnauthorizedErrorResponseJSONResponse{Code: http.StatusUnauthorized, Message: err.Error()}
+		return apis.PostAuthPasskeysRegisterBegin401JSONResponse{UnauthorizedErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostAuthPasskeysRegisterBegin500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	options, err := passkeysHandler.mappingOptions(creationOptions)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostAuthPasskeysRegisterBegin500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+	res := apis.PasskeyOptionsResponseJSONResponse{CeremonyToken: ceremonyToken, Options: options}
+	return apis.PostAuthPasskeysRegisterBegin200JSONResponse{PasskeyOptionsResponseJSONResponse: res}, nil
+}
+
+func (passkeysHandler *passkeysHandler) PostAuthPasskeysRegisterFinish(ctx context.Context, request apis.PostAuthPasskeysRegisterFinishRequestObject) (apis.PostAuthPasskeysRegisterFinishResponseObject, error) {
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.PostAuthPasskeysRegisterFinish500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, passkey, err := passkeysHandler.passkeyService.FinishRegistration(ctx, *request.Body, userID)
+	switch statusCode {
+	case http.StatusBadRequest:
+		return apis.PostAuthPasskeysRegisterFinish400JSONResponse{Code: http.StatusBadRequest, Errors: passkeysHandler.mappingValidationErrors(err)}, nil
+	case http.StatusNotFound:
+		res := apis.UnauthorizedErrorResponseJSONResponse{Code: http.StatusUnauthorized, Message: err.Error()}
+		return apis.PostAuthPasskeysRegisterFinish401JSONResponse{UnauthorizedErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostAuthPasskeysRegisterFinish500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	resPasskey := passkeysHandler.mappingPasskey(passkey)
+	res := apis.PasskeyResponseJSONResponse{Code: http.StatusOK, Errors: apis.PasskeyValidationError{}, Passkey: &resPasskey}
+	return apis.PostAuthPasskeysRegisterFinish200JSONResponse{PasskeyResponseJSONResponse: res}, nil
+}
+
+func (passkeysHandler *passkeysHandler) PostAuthPasskeysSignInBegin(ctx context.Context, request apis.PostAuthPasskeysSignInBeginRequestObject) (apis.PostAuthPasskeysSignInBeginResponseObject, error) {
+	statusCode, ceremonyToken, requestOptions, err := passkeysHandler.passkeyService.BeginSignIn(ctx)
+	switch statusCode {
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostAuthPasskeysSignInBegin500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	options, err := passkeysHandler.mappingOptions(requestOptions)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostAuthPasskeysSignInBegin500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+	res := apis.PasskeyOptionsResponseJSONResponse{CeremonyToken: ceremonyToken, Options: options}
+	return apis.PostAuthPasskeysSignInBegin200JSONResponse{PasskeyOptionsResponseJSONResponse: res}, nil
+}
+
+func (passkeysHandler *passkeysHandler) PostAuthPasskeysSignInFinish(ctx context.Context, request apis.PostAuthPasskeysSignInFinishRequestObject) (apis.PostAuthPasskeysSignInFinishResponseObject, error) {
+	client, _ := utils.ClientContextValue(ctx)
+	statusCode, tokenPair, err := passkeysHandler.passkeyService.FinishSignIn(ctx, *request.Body, client.UserAgent, client.IPAddress)
+	switch statusCode {
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostAuthPasskeysSignInFinish500JSONResponse{InternalServerErrorResponseJSONResponse: res}, nil
+	case http.StatusBadRequest:
+		messages := []string{}
+		if errors, ok := err.(validation.Errors); ok {
+			for _, field := range []string{"ceremonyToken", "credential"} {
+				if fieldErr, ok := errors[field]; ok {
+					messages = append(messages, fieldErr.Error())
+				}
+			}
+		} else {
+			messages = append(messages, err.Error())
+		}
+		res := apis.SignInBadRequestResponseJSONResponse{Errors: messages}
+		return apis.PostAuthPasskeysSignInFinish400JSONResponse{SignInBadRequestResponseJSONResponse: res}, nil
+	}
+
+	// NOTE: パスワードでのサインインと同様に、Cookieにtokenをセット
+	return authCookiesResponse{cookies: newAuthCookies(tokenPair)}, nil
+}
+
+func (passkeysHandler *passkeysHandler) DeleteAuthPasskey(ctx context.Context, request apis.DeleteAuthPasskeyRequestObject) (apis.DeleteAuthPasskeyResponseObject, error) {
+	intID, err := strconv.Atoi(request.Id)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.DeleteAuthPasskey500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.DeleteAuthPasskey500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, err := passkeysHandler.passkeyService.DeletePasskey(ctx, int64(intID), userID)
+	switch statusCode {
+	case http.StatusNotFound:
+		res := apis.NotFoundErrorResponseJSONResponse{Code: http.StatusNotFound}
+		return apis.DeleteAuthPasskey404JSONResponse{NotFoundErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.DeleteAuthPasskey500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	res := apis.DeletePasskeyResponseJSONResponse{Code: http.StatusOK, Result: true}
+	return apis.DeleteAuthPasskey200JSONResponse{DeletePasskeyResponseJSONResponse: res}, nil
+}
+
+// NOTE: ブラウザのPublicKeyCredential.parseCreationOptionsFromJSON等にそのまま渡せるJSONの形に変換する
+func (passkeysHandler *passkeysHandler) mappingOptions(options interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+	var mappedOptions map[string]interface{}
+	if err := json.Unmarshal(data, &mappedOptions); err != nil {
+		return nil, err
+	}
+	return mappedOptions, nil
+}
+
+func (passkeysHandler *passkeysHandler) mappingPasskey(passkey *models.WebauthnCredential) apis.Passkey {
+	transports := []string{}
+	for _, transport := range strings.Split(passkey.Transports, ",") {
+		if transport != "" {
+			transports = append(transports, transport)
+		}
+	}
+	resPasskey := apis.Passkey{
+		Id:             int(passkey.ID),
+		Name:           passkey.Name,
+		Transports:     transports,
+		BackupEligible: passkey.BackupEligible,
+		BackupState:    passkey.BackupState,
+		CreatedAt:      passkey.CreatedAt,
+	}
+	if passkey.LastUsedAt.Valid {
+		resPasskey.LastUsedAt = &passkey.LastUsedAt.Time
+	}
+	return resPasskey
+}
+
+func (passkeysHandler *passkeysHandler) mappingValidationErrors(err error) apis.PasskeyValidationError {
+	validationError := apis.PasskeyValidationError{}
+	if errors, ok := err.(validation.Errors); ok {
+		if ceremonyTokenErr, ok := errors["ceremonyToken"]; ok {
+			validationError.CeremonyToken = &[]string{ceremonyTokenErr.Error()}
+		}
+		if nameErr, ok := errors["name"]; ok {
+			validationError.Name = &[]string{nameErr.Error()}
+		}
+		if credentialErr, ok := errors["credential"]; ok {
+			validationError.Credential = &[]string{credentialErr.Error()}
+		}
+		if passwordErr, ok := errors["password"]; ok {
+			validationError.Password = &[]string{passwordErr.Error()}
+		}
+		if codeErr, ok := errors["code"]; ok {
+			validationError.Code = &[]string{codeErr.Error()}
+		}
+	}
+	return validationError
+}
@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	apis "app/openapi"
+	"app/test/authenticators"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/oapi-codegen/testutil"
+)
+
+type testPasskeysHandlerSuite struct {
+	WithDBSuite
+}
+
+func (s *testPasskeysHandlerSuite) SetupTest() {
+	s.SetDBCon()
+
+	s.initializeHandlers()
+
+	s.SetCsrfHeaderValues()
+}
+
+func (s *testPasskeysHandlerSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *testPasskeysHandlerSuite) post(path string, cookie string, body interface{}) *testutil.CompletedRequest {
+	return testutil.NewRequest().Post(path).WithHeader("Cookie", cookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(body).GoWithHTTPHandler(s.T(), e)
+}
+
+// NOTE: サインイン済みのユーザでパスキーを登録する
+func (s *testPasskeysHandlerSuite) registerPasskey(authenticator *authenticators.SoftwareAuthenticator) apis.Passkey {
+	result := s.post("/auth/passkeys/register/begin", token+"; "+csrfTokenCookie, apis.PostAuthPasskeysRegisterBeginJSONRequestBody{Password: "password"})
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+	var beginRes apis.PasskeyOptionsResponseJSONResponse
+	result.UnmarshalBodyToObject(&beginRes)
+
+	credential, err := authenticator.Register(beginRes.Options)
+	if err != nil {
+		s.T().Fatalf("failed to create credential %v", err)
+	}
+	name := "MacBook"
+	result = s.post("/auth/passkeys/register/finish", token+"; "+csrfTokenCookie, apis.PostAuthPasskeysRegisterFinishJSONRequestBody{CeremonyToken: beginRes.CeremonyToken, Name: &name, Credential: credential})
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+	var finishRes apis.PasskeyResponseJSONResponse
+	result.UnmarshalBodyToObject(&finishRes)
+	if !assert.NotNil(s.T(), finishRes.Passkey) {
+		s.T().FailNow()
+	}
+	return *finishRes.Passkey
+}
+
+func (s *testPasskeysHandlerSuite) TestPostAuthPasskeysRegister_StatusOk() {
+	s.SignIn()
+
+	passkey := s.registerPasskey(authenticators.NewSoftwareAuthenticator("http://localhost:5173"))
+
+	assert.Equal(s.T(), "MacBook", passkey.Name)
+	assert.Equal(s.T(), []string{"internal"}, passkey.Transports)
+	result := testutil.NewRequest().Get("/auth/passkeys").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+	var res apis.FetchPasskeysResponseJSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Len(s.T(), res.Passkeys, 1)
+}
+
+func (s *testPasskeysHandlerSuite) TestPostAuthPasskeysRegisterFinish_BadRequest() {
+	s.SignIn()
+
+	result := s.post("/auth/passkeys/register/finish", token+"; "+csrfTokenCookie, apis.PostAuthPasskeysRegisterFinishJSONRequestBody{CeremonyToken: "", Credential: map[string]interface{}{}})
+
+	assert.Equal(s.T(), http.StatusBadRequest, result.Code())
+	var res apis.PasskeyResponseJSONResponse
+	result.UnmarshalBodyToObject(&res)
+	if assert.NotNil(s.T(), res.Errors.CeremonyToken) {
+		assert.Equal(s.T(), []string{"トークンは必須入力です。"}, *res.Errors.CeremonyToken)
+	}
+	if assert.NotNil(s.T(), res.Errors.Credential) {
+		assert.Equal(s.T(), []string{"パスキーの認証情報は必須入力です。"}, *res.Errors.Credential)
+	}
+}
+
+func (s *testPasskeysHandlerSuite) TestPostAuthPasskeysRegisterBegin_BadRequest() {
+	s.SignIn()
+
+	result := s.post("/auth/passkeys/register/begin", token+"; "+csrfTokenCookie, apis.PostAuthPasskeysRegisterBeginJSONRequestBody{Password: "wrongPassword"})
+
+	assert.Equal(s.T(), http.StatusBadRequest, result.Code())
+	var res apis.PasskeyResponseJSONResponse
+	result.UnmarshalBodyToObject(&res)
+	if assert.NotNil(s.T(), res.Errors.Password) {
+		assert.Equal(s.T(), []string{"パスワードが正しくありません。"}, *res.Errors.Password)
+	}
+}
+
+func (s *testPasskeysHandlerSuite) TestPostAuthPasskeysRegisterBegin_StatusUnauthorized() {
+	result := s.post("/auth/passkeys/register/begin", csrfTokenCookie, apis.PostAuthPasskeysRegisterBeginJSONRequestBody{Password: "password"})
+	assert.Equal(s.T(), http.StatusUnauthorized, result.Code())
+}
+
+func (s *testPasskeysHandlerSuite) TestPostAuthPasskeysSignIn_StatusOk() {
+	s.SignIn()
+	authenticator := authenticators.NewSoftwareAuthenticator("http://localhost:5173")
+	s.registerPasskey(authenticator)
+
+	// NOTE: メールアドレスを入力せずにサインインを開始する
+	result := s.post("/auth/passkeys/signIn/begin", csrfTokenCookie, map[string]interface{}{})
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+	var beginRes apis.PasskeyOptionsResponseJSONResponse
+	result.UnmarshalBodyToObject(&beginRes)
+	assert.Equal(s.T(), "required", beginRes.Options["userVerification"])
+
+	credential, err := authenticator.SignIn(beginRes.Options)
+	if err != nil {
+		s.T().Fatalf("failed to create assertion %v", err)
+	}
+	result = s.post("/auth/passkeys/signIn/finish", csrfTokenCookie, apis.PostAuthPasskeysSignInFinishJSONRequestBody{CeremonyToken: beginRes.CeremonyToken, Credential: credential})
+
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+	assert.NotEmpty(s.T(), findCookie(result.Recorder.Result().Cookies(), "token").Value)
+	assert.NotEmpty(s.T(), findCookie(result.Recorder.Result().Cookies(), "refresh_token").Value)
+}
+
+func (s *testPasskeysHandlerSuite) TestPostAuthPasskeysSignInFinish_BadRequest() {
+	credential := map[string]interface{}{"id": "invalid"}
+
+	result := s.post("/auth/passkeys/signIn/finish", csrfTokenCookie, apis.PostAuthPasskeysSignInFinishJSONRequestBody{CeremonyToken: "invalid", Credential: credential})
+
+	assert.Equal(s.T(), http.StatusBadRequest, result.Code())
+	assert.Empty(s.T(), findCookie(result.Recorder.Result().Cookies(), "token").Value)
+	var res apis.SignInBadRequestResponseJSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Equal(s.T(), []string{"パスキーの操作の有効期限が切れました。もう一度お試しください。"}, res.Errors)
+}
+
+func (s *testPasskeysHandlerSuite) TestDeleteAuthPasskey_StatusOk() {
+	s.SignIn()
+	passkey := s.registerPasskey(authenticators.NewSoftwareAuthenticator("http://localhost:5173"))
+
+	result := testutil.NewRequest().Delete("/auth/passkeys/"+strconv.Itoa(passkey.Id)).WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	result = testutil.NewRequest().Delete("/auth/passkeys/"+strconv.Itoa(passkey.Id)).WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusNotFound, result.Code())
+}
+
+func TestPasskeysHandler(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(testPasskeysHandlerSuite))
+}
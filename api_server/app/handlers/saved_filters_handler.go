@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/services"
+	"app/utils"
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type SavedFiltersHandler interface {
+	PostSavedFilters(ctx context.Context, request apis.PostSavedFiltersRequestObject) (apis.PostSavedFiltersResponseObject, error)
+	GetSavedFilters(ctx context.Context, request apis.GetSavedFiltersRequestObject) (apis.GetSavedFiltersResponseObject, error)
+	PutSavedFilter(ctx context.Context, request apis.PutSavedFilterRequestObject) (apis.PutSavedFilterResponseObject, error)
+	DeleteSavedFilter(ctx context.Context, request apis.DeleteSavedFilterRequestObject) (apis.DeleteSavedFilterResponseObject, error)
+}
+
+type savedFiltersHandler struct {
+	savedFilterService services.SavedFilterService
+}
+
+func NewSavedFiltersHandler(savedFilterService services.SavedFilterService) SavedFiltersHandler {
+	return &savedFiltersHandler{savedFilterService: savedFilterService}
+}
+
+func (savedFiltersHandler *savedFiltersHandler) PostSavedFilters(ctx context.Context, request apis.PostSavedFiltersRequestObject) (apis.PostSavedFiltersResponseObject, error) {
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.PostSavedFilters500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, savedFilter, err := savedFiltersHandler.savedFilterService.CreateSavedFilter(ctx, *request.Body, userID)
+	switch statusCode {
+	case http.StatusBadRequest:
+		validationErrors := savedFiltersHandler.mappingValidationErrorStruct(err)
+		return apis.PostSavedFilters400JSONResponse{Code: http.StatusBadRequest, Errors: validationErrors}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostSavedFilters500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	resSavedFilter := savedFiltersHandler.mappingSavedFilter(savedFilter)
+	res := apis.StoreSavedFilterResponseJSONResponse{Code: http.StatusOK, Errors: apis.StoreSavedFilterValidationError{}, SavedFilter: &resSavedFilter}
+	return apis.PostSavedFilters200JSONResponse{StoreSavedFilterResponseJSONResponse: res}, nil
+}
+
+func (savedFiltersHandler *savedFiltersHandler) GetSavedFilters(ctx context.Context, request apis.GetSavedFiltersRequestObject) (apis.GetSavedFiltersResponseObject, error) {
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.GetSavedFilters500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, savedFiltersList, err := savedFiltersHandler.savedFilterService.FetchSavedFiltersList(ctx, userID)
+	switch statusCode {
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.GetSavedFilters500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	resSavedFiltersList := apis.FetchSavedFiltersResponseJSONResponse{SavedFilters: []apis.SavedFilter{}}
+	for _, savedFilter := range *savedFiltersList {
+		resSavedFiltersList.SavedFilters = append(resSavedFiltersList.SavedFilters, savedFiltersHandler.mappingSavedFilter(savedFilter))
+	}
+	return apis.GetSavedFilters200JSONResponse{FetchSavedFiltersResponseJSONResponse: resSavedFiltersList}, nil
+}
+
+func (savedFiltersHandler *savedFiltersHandler) PutSavedFilter(ctx context.Context, request apis.PutSavedFilterRequestObject) (apis.PutSavedFilterResponseObject, error) {
+	intID, err := strconv.Atoi(request.Id)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PutSavedFilter500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.PutSavedFilter500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, savedFilter, err := savedFiltersHandler.savedFilterService.UpdateSavedFilter(ctx, int64(intID), *request.Body, userID)
+	switch statusCode {
+	case http.StatusBadRequest:
+		validationErrors := savedFiltersHandler.mappingValidationErrorStruct(err)
+		return apis.PutSavedFilter400JSONResponse{Code: http.StatusBadRequest, Errors: validationErrors}, nil
+	case http.StatusNotFound:
+		res := apis.NotFoundErrorResponseJSONResponse{Code: http.StatusNotFound}
+		return apis.PutSavedFilter404JSONResponse{NotFoundErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PutSavedFilter500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	resSavedFilter := savedFiltersHandler.mappingSavedFilter(savedFilter)
+	res := apis.StoreSavedFilterResponseJSONResponse{Code: http.StatusOK, Errors: apis.StoreSavedFilterValidationError{}, SavedFilter: &resSavedFilter}
+	return apis.PutSavedFilter200JSONResponse{StoreSavedFilterResponseJSONResponse: res}, nil
+}
+
+func (savedFiltersHandler *savedFiltersHandler) DeleteSavedFilter(ctx context.Context, request apis.DeleteSavedFilterRequestObject) (apis.DeleteSavedFilterResponseObject, error) {
+	intID, err := strconv.Atoi(request.Id)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.DeleteSavedFilter500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.DeleteSavedFilter500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, err := savedFiltersHandler.savedFilterService.DeleteSavedFilter(ctx, int64(intID), userID)
+	switch statusCode {
+	case http.StatusNotFound:
+		res := apis.NotFoundErrorResponseJSONResponse{Code: http.StatusNotFound}
+		return apis.DeleteSavedFilter404JSONResponse{NotFoundErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.DeleteSavedFilter500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	res := apis.DeleteSavedFilterResponseJSONResponse{Code: http.StatusOK, Result: true}
+	return apis.DeleteSavedFilter200JSONResponse{DeleteSavedFilterResponseJSONResponse: res}, nil
+}
+
+func (savedFiltersHandler *savedFiltersHandler) mappingValidationErrorStruct(err error) apis.StoreSavedFilterValidationError {
+	var validationError apis.StoreSavedFilterValidationError
+	if err == nil {
+		return validationError
+	}
+
+	if errors, ok := err.(validation.Errors); ok {
+		// NOTE: レスポンス用の構造体にマッピング
+		for field, err := range errors {
+			messages := []string{err.Error()}
+			switch field {
+			case "name":
+				validationError.Name = &messages
+			case "query":
+				validationError.Query = &messages
+			}
+		}
+	}
+	return validationError
+}
+
+func (savedFiltersHandler *savedFiltersHandler) mappingSavedFilter(savedFilter *models.SavedFilter) apis.SavedFilter {
+	return apis.SavedFilter{
+		Id:        int(savedFilter.ID),
+		Name:      savedFilter.Name,
+		Query:     savedFilter.Query,
+		CreatedAt: savedFilter.CreatedAt,
+		UpdatedAt: savedFilter.UpdatedAt,
+	}
+}
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/oapi-codegen/testutil"
+)
+
+type testSavedFiltersHandlerSuite struct {
+	WithDBSuite
+}
+
+func (s *testSavedFiltersHandlerSuite) SetupTest() {
+	s.SetDBCon()
+
+	s.initializeHandlers()
+
+	s.SetCsrfHeaderValues()
+}
+
+func (s *testSavedFiltersHandlerSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *testSavedFiltersHandlerSuite) createSavedFilter() apis.PostSavedFilters200JSONResponse {
+	reqBody := apis.StoreSavedFilterInput{Name: "今週の会議", Query: "title:会議 AND created<7d"}
+	result := testutil.NewRequest().Post("/savedFilters").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.PostSavedFilters200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	return res
+}
+
+func (s *testSavedFiltersHandlerSuite) TestPostSavedFilters_StatusOk() {
+	s.SignIn()
+
+	res := s.createSavedFilter()
+
+	assert.Equal(s.T(), int64(http.StatusOK), res.Code)
+	assert.Equal(s.T(), "今週の会議", res.SavedFilter.Name)
+	assert.Equal(s.T(), "title:会議 AND created<7d", res.SavedFilter.Query)
+}
+
+func (s *testSavedFiltersHandlerSuite) TestPostSavedFilters_BadRequest() {
+	s.SignIn()
+
+	reqBody := apis.StoreSavedFilterInput{Name: "仕事", Query: "tag:work"}
+	result := testutil.NewRequest().Post("/savedFilters").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusBadRequest, result.Code())
+
+	var res apis.PostSavedFilters400JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Nil(s.T(), res.Errors.Name)
+	assert.Equal(s.T(), []string{"1文字目: 不明なフィールドです: tag（指定できるフィールド: content, created, title, updated）"}, *res.Errors.Query)
+}
+
+func (s *testSavedFiltersHandlerSuite) TestPostSavedFilters_StatusUnauthorized() {
+	reqBody := apis.StoreSavedFilterInput{Name: "今週の会議", Query: "title:会議"}
+	result := testutil.NewRequest().Post("/savedFilters").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusUnauthorized, result.Code())
+}
+
+func (s *testSavedFiltersHandlerSuite) TestGetSavedFilters_StatusOk() {
+	s.SignIn()
+	s.createSavedFilter()
+
+	result := testutil.NewRequest().Get("/savedFilters").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.GetSavedFilters200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Len(s.T(), res.SavedFilters, 1)
+}
+
+func (s *testSavedFiltersHandlerSuite) TestPutSavedFilter_StatusOk() {
+	s.SignIn()
+	savedFilter := s.createSavedFilter()
+
+	reqBody := apis.StoreSavedFilterInput{Name: "買い物", Query: "title:買い物"}
+	result := testutil.NewRequest().Put("/savedFilters/"+strconv.Itoa(savedFilter.SavedFilter.Id)).WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.PutSavedFilter200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Equal(s.T(), "買い物", res.SavedFilter.Name)
+	assert.Equal(s.T(), "title:買い物", res.SavedFilter.Query)
+}
+
+func (s *testSavedFiltersHandlerSuite) TestDeleteSavedFilter_StatusOk() {
+	s.SignIn()
+	savedFilter := s.createSavedFilter()
+
+	result := testutil.NewRequest().Delete("/savedFilters/"+strconv.Itoa(savedFilter.SavedFilter.Id)).WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	// NOTE: フィルタが削除されていることを確認
+	isExistSavedFilter, _ := models.SavedFilters(qm.Where("id = ?", savedFilter.SavedFilter.Id)).Exists(ctx, DBCon)
+	assert.False(s.T(), isExistSavedFilter)
+}
+
+func (s *testSavedFiltersHandlerSuite) TestDeleteSavedFilter_StatusNotFound() {
+	s.SignIn()
+
+	result := testutil.NewRequest().Delete("/savedFilters/1").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusNotFound, result.Code())
+}
+
+func TestSavedFiltersHandler(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(testSavedFiltersHandlerSuite))
+}
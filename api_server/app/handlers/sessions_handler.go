@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/services"
+	"app/utils"
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+type SessionsHandler interface {
+	GetAuthSessions(ctx context.Context, request apis.GetAuthSessionsRequestObject) (apis.GetAuthSessionsResponseObject, error)
+	DeleteAuthSession(ctx context.Context, request apis.DeleteAuthSessionRequestObject) (apis.DeleteAuthSessionResponseObject, error)
+	PostAuthSessionsRevokeOthers(ctx context.Context, request apis.PostAuthSessionsRevokeOthersRequestObject) (apis.PostAuthSessionsRevokeOthersResponseObject, error)
+}
+
+type sessionsHandler struct {
+	sessionService services.SessionService
+}
+
+func NewSessionsHandler(sessionService services.SessionService) SessionsHandler {
+	return &sessionsHandler{sessionService: sessionService}
+}
+
+func (sessionsHandler *sessionsHandler) GetAuthSessions(ctx context.Context, request apis.GetAuthSessionsRequestObject) (apis.GetAuthSessionsResponseObject, error) {
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.GetAuthSessions500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+	authToken, ok := utils.AuthTokenContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.GetAuthSessions500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, sessions, err := sessionsHandler.sessionService.FetchSessionsList(ctx, userID)
+	switch statusCode {
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.GetAuthSessions500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	resSessionsList := apis.FetchSessionsResponseJSONResponse{Sessions: []apis.Session{}}
+	for _, session := range sessions {
+		resSessionsList.Sessions = append(resSessionsList.Sessions, sessionsHandler.mappingSession(session, authToken.SessionID))
+	}
+	return apis.GetAuthSessions200JSONResponse{FetchSessionsResponseJSONResponse: resSessionsList}, nil
+}
+
+func (sessionsHandler *sessionsHandler) DeleteAuthSession(ctx context.Context, request apis.DeleteAuthSessionRequestObject) (apis.DeleteAuthSessionResponseObject, error) {
+	intID, err := strconv.Atoi(request.Id)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.DeleteAuthSession500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.DeleteAuthSession500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, err := sessionsHandler.sessionService.RevokeSession(ctx, int64(intID), userID)
+	switch statusCode {
+	case http.StatusNotFound:
+		res := apis.NotFoundErrorResponseJSONResponse{Code: http.StatusNotFound}
+		return apis.DeleteAuthSession404JSONResponse{NotFoundErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.DeleteAuthSession500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	res := apis.RevokeSessionResponseJSONResponse{Code: http.StatusOK, Result: true}
+	return apis.DeleteAuthSession200JSONResponse{RevokeSessionResponseJSONResponse: res}, nil
+}
+
+func (sessionsHandler *sessionsHandler) PostAuthSessionsRevokeOthers(ctx context.Context, request apis.PostAuthSessionsRevokeOthersRequestObject) (apis.PostAuthSessionsRevokeOthersResponseObject, error) {
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.PostAuthSessionsRevokeOthers500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+	authToken, ok := utils.AuthTokenContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.PostAuthSessionsRevokeOthers500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, err := sessionsHandler.sessionService.RevokeOtherSessions(ctx, authToken.SessionID, userID)
+	switch statusCode {
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostAuthSessionsRevokeOthers500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	res := apis.RevokeSessionResponseJSONResponse{Code: http.StatusOK, Result: true}
+	return apis.PostAuthSessionsRevokeOthers200JSONResponse{RevokeSessionResponseJSONResponse: res}, nil
+}
+
+func (sessionsHandler *sessionsHandler) mappingSession(session *models.Session, currentSessionID int64) apis.Session {
+	return apis.Session{
+		Id:         int(session.ID),
+		UserAgent:  session.UserAgent,
+		IpAddress:  session.IPAddress,
+		Current:    session.ID == currentSessionID,
+		CreatedAt:  session.CreatedAt,
+		LastSeenAt: session.LastSeenAt,
+	}
+}
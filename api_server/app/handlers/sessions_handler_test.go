@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/oapi-codegen/testutil"
+)
+
+type testSessionsHandlerSuite struct {
+	WithDBSuite
+}
+
+func (s *testSessionsHandlerSuite) SetupTest() {
+	s.SetDBCon()
+
+	s.initializeHandlers()
+
+	s.SetCsrfHeaderValues()
+}
+
+func (s *testSessionsHandlerSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+// NOTE: 別の端末からサインインし、そのtokenのCookieを返す
+func (s *testSessionsHandlerSuite) signInFromOtherDevice() string {
+	reqBody := apis.SignInInput{Email: "test@example.com", Password: "password"}
+	result := testutil.NewRequest().Post("/auth/signIn").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithHeader("User-Agent", "OtherDevice/1.0").WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+	return findCookie(result.Recorder.Result().Cookies(), "token").String()
+}
+
+func (s *testSessionsHandlerSuite) fetchSessions(tokenCookie string) apis.GetAuthSessions200JSONResponse {
+	result := testutil.NewRequest().Get("/auth/sessions").WithHeader("Cookie", tokenCookie+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.GetAuthSessions200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	return res
+}
+
+func (s *testSessionsHandlerSuite) TestGetAuthSessions_StatusOk() {
+	s.SignIn()
+	s.signInFromOtherDevice()
+
+	res := s.fetchSessions(token)
+
+	assert.Len(s.T(), res.Sessions, 2)
+	var currentCount int
+	for _, session := range res.Sessions {
+		if session.Current {
+			currentCount++
+			assert.NotEqual(s.T(), "OtherDevice/1.0", session.UserAgent)
+		}
+	}
+	assert.Equal(s.T(), 1, currentCount)
+}
+
+func (s *testSessionsHandlerSuite) TestGetAuthSessions_StatusUnauthorized() {
+	result := testutil.NewRequest().Get("/auth/sessions").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusUnauthorized, result.Code())
+}
+
+func (s *testSessionsHandlerSuite) TestDeleteAuthSession_StatusOk() {
+	s.SignIn()
+	otherDeviceToken := s.signInFromOtherDevice()
+	otherSession, _ := models.Sessions(qm.Where("user_agent = ?", "OtherDevice/1.0")).One(ctx, DBCon)
+
+	result := testutil.NewRequest().Delete("/auth/sessions/"+strconv.FormatInt(otherSession.ID, 10)).WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	// NOTE: 失効したセッションのtokenが利用できないことを確認
+	result = testutil.NewRequest().Get("/todos").WithHeader("Cookie", otherDeviceToken+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusUnauthorized, result.Code())
+
+	var res apis.UnauthorizedErrorResponse
+	result.UnmarshalBodyToObject(&res)
+	if assert.NotNil(s.T(), res.Reason) {
+		assert.Equal(s.T(), apis.SessionRevoked, *res.Reason)
+	}
+
+	// NOTE: 現在のセッションは引き続き利用できることを確認
+	assert.Len(s.T(), s.fetchSessions(token).Sessions, 1)
+}
+
+func (s *testSessionsHandlerSuite) TestDeleteAuthSession_StatusNotFound() {
+	s.SignIn()
+
+	result := testutil.NewRequest().Delete("/auth/sessions/0").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusNotFound, result.Code())
+}
+
+func (s *testSessionsHandlerSuite) TestPostAuthSessionsRevokeOthers_StatusOk() {
+	s.SignIn()
+	otherDeviceToken := s.signInFromOtherDevice()
+
+	result := testutil.NewRequest().Post("/auth/sessions/revokeOthers").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	res := s.fetchSessions(token)
+	if assert.Len(s.T(), res.Sessions, 1) {
+		assert.True(s.T(), res.Sessions[0].Current)
+	}
+	result = testutil.NewRequest().Get("/todos").WithHeader("Cookie", otherDeviceToken+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusUnauthorized, result.Code())
+}
+
+func TestSessionsHandler(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(testSessionsHandlerSuite))
+}
@@ -0,0 +1,172 @@
+package handlers
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/services"
+	"app/utils"
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type ShareLinksHandler interface {
+	PostTodoShareLinks(ctx context.Context, request apis.PostTodoShareLinksRequestObject) (apis.PostTodoShareLinksResponseObject, error)
+	GetShareLinks(ctx context.Context, request apis.GetShareLinksRequestObject) (apis.GetShareLinksResponseObject, error)
+	DeleteShareLink(ctx context.Context, request apis.DeleteShareLinkRequestObject) (apis.DeleteShareLinkResponseObject, error)
+	GetSharedTodo(ctx context.Context, request apis.GetSharedTodoRequestObject) (apis.GetSharedTodoResponseObject, error)
+}
+
+type shareLinksHandler struct {
+	shareLinkService services.ShareLinkService
+}
+
+func NewShareLinksHandler(shareLinkService services.ShareLinkService) ShareLinksHandler {
+	return &shareLinksHandler{shareLinkService: shareLinkService}
+}
+
+func (shareLinksHandler *shareLinksHandler) PostTodoShareLinks(ctx context.Context, request apis.PostTodoShareLinksRequestObject) (apis.PostTodoShareLinksResponseObject, error) {
+	intID, err := strconv.Atoi(request.Id)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostTodoShareLinks500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.PostTodoShareLinks500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, shareLink, token, err := shareLinksHandler.shareLinkService.CreateShareLink(ctx, int64(intID), *request.Body, userID)
+	switch statusCode {
+	case http.StatusBadRequest:
+		validationErrors := shareLinksHandler.mappingValidationErrorStruct(err)
+		return apis.PostTodoShareLinks400JSONResponse{Code: http.StatusBadRequest, Errors: validationErrors}, nil
+	case http.StatusNotFound:
+		res := apis.NotFoundErrorResponseJSONResponse{Code: http.StatusNotFound}
+		return apis.PostTodoShareLinks404JSONResponse{NotFoundErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostTodoShareLinks500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	resShareLink := shareLinksHandler.mappingShareLink(shareLink)
+	res := apis.CreateShareLinkResponseJSONResponse{Code: http.StatusOK, Errors: apis.StoreShareLinkValidationError{}, ShareLink: &resShareLink, Token: &token}
+	return apis.PostTodoShareLinks200JSONResponse{CreateShareLinkResponseJSONResponse: res}, nil
+}
+
+func (shareLinksHandler *shareLinksHandler) GetShareLinks(ctx context.Context, request apis.GetShareLinksRequestObject) (apis.GetShareLinksResponseObject, error) {
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.GetShareLinks500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, shareLinksList, err := shareLinksHandler.shareLinkService.FetchShareLinksList(ctx, userID)
+	switch statusCode {
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.GetShareLinks500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	resShareLinksList := apis.FetchShareLinksResponseJSONResponse{ShareLinks: []apis.ShareLink{}}
+	for _, shareLink := range *shareLinksList {
+		resShareLinksList.ShareLinks = append(resShareLinksList.ShareLinks, shareLinksHandler.mappingShareLink(shareLink))
+	}
+	return apis.GetShareLinks200JSONResponse{FetchShareLinksResponseJSONResponse: resShareLinksList}, nil
+}
+
+func (shareLinksHandler *shareLinksHandler) DeleteShareLink(ctx context.Context, request apis.DeleteShareLinkRequestObject) (apis.DeleteShareLinkResponseObject, error) {
+	intID, err := strconv.Atoi(request.Id)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.DeleteShareLink500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.DeleteShareLink500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, err := shareLinksHandler.shareLinkService.RevokeShareLink(ctx, int64(intID), userID)
+	switch statusCode {
+	case http.StatusNotFound:
+		res := apis.NotFoundErrorResponseJSONResponse{Code: http.StatusNotFound}
+		return apis.DeleteShareLink404JSONResponse{NotFoundErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.DeleteShareLink500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	res := apis.RevokeShareLinkResponseJSONResponse{Code: http.StatusOK, Result: true}
+	return apis.DeleteShareLink200JSONResponse{RevokeShareLinkResponseJSONResponse: res}, nil
+}
+
+func (shareLinksHandler *shareLinksHandler) GetSharedTodo(ctx context.Context, request apis.GetSharedTodoRequestObject) (apis.GetSharedTodoResponseObject, error) {
+	client, _ := utils.ClientContextValue(ctx)
+	statusCode, todo, err := shareLinksHandler.shareLinkService.ShowSharedTodo(ctx, request.Token, request.Params.XSharePassword, client.IPAddress)
+	switch statusCode {
+	case http.StatusUnauthorized:
+		res := apis.UnauthorizedErrorResponseJSONResponse{Code: http.StatusUnauthorized, Message: err.Error()}
+		return apis.GetSharedTodo401JSONResponse{UnauthorizedErrorResponseJSONResponse: res}, nil
+	case http.StatusNotFound:
+		res := apis.NotFoundErrorResponseJSONResponse{Code: http.StatusNotFound}
+		return apis.GetSharedTodo404JSONResponse{NotFoundErrorResponseJSONResponse: res}, nil
+	case http.StatusTooManyRequests:
+		res := apis.TooManyRequestsErrorResponseJSONResponse{Code: http.StatusTooManyRequests, Message: err.Error()}
+		var throttledErr *services.ShareLinkThrottledError
+		if errors.As(err, &throttledErr) {
+			// NOTE: 1秒未満の待ち時間も再試行できない時間として切り上げる
+			retryAfter := int64(math.Ceil(throttledErr.RetryAfter.Seconds()))
+			res.RetryAfter = &retryAfter
+		}
+		return apis.GetSharedTodo429JSONResponse{TooManyRequestsErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.GetSharedTodo500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	resTodo := apis.Todo{Id: int(todo.ID), Title: todo.Title, Content: todo.Content.String}
+	res := apis.ShowTodoResponseJSONResponse{Todo: resTodo}
+	return apis.GetSharedTodo200JSONResponse{ShowTodoResponseJSONResponse: res}, nil
+}
+
+func (shareLinksHandler *shareLinksHandler) mappingShareLink(shareLink *models.ShareLink) apis.ShareLink {
+	resShareLink := apis.ShareLink{
+		Id:          int(shareLink.ID),
+		TodoId:      int(shareLink.TodoID),
+		HasPassword: shareLink.Password.Valid,
+		CreatedAt:   shareLink.CreatedAt,
+	}
+	if shareLink.ExpiresAt.Valid {
+		resShareLink.ExpiresAt = &shareLink.ExpiresAt.Time
+	}
+	return resShareLink
+}
+
+func (shareLinksHandler *shareLinksHandler) mappingValidationErrorStruct(err error) apis.StoreShareLinkValidationError {
+	var validationError apis.StoreShareLinkValidationError
+	if err == nil {
+		return validationError
+	}
+
+	if errors, ok := err.(validation.Errors); ok {
+		// NOTE: レスポンス用の構造体にマッピング
+		for field, err := range errors {
+			messages := []string{err.Error()}
+			switch field {
+			case "expiresAt":
+				validationError.ExpiresAt = &messages
+			case "password":
+				validationError.Password = &messages
+			}
+		}
+	}
+	return validationError
+}
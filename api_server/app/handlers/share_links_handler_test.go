@@ -0,0 +1,209 @@
+package handlers
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/test/factories"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/oapi-codegen/testutil"
+)
+
+type testShareLinksHandlerSuite struct {
+	WithDBSuite
+}
+
+func (s *testShareLinksHandlerSuite) SetupTest() {
+	s.SetDBCon()
+
+	s.initializeHandlers()
+
+	s.SetCsrfHeaderValues()
+}
+
+func (s *testShareLinksHandlerSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *testShareLinksHandlerSuite) createTodo() *models.Todo {
+	todoParam := map[string]interface{}{"UserID": int64(user.ID), "Title": "test title 1", "Content": null.String{String: "test content 1", Valid: true}}
+	todo := factories.TodoFactory.MustCreateWithOption(todoParam).(*models.Todo)
+	if err := todo.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+	return todo
+}
+
+func (s *testShareLinksHandlerSuite) createShareLink(todo *models.Todo, reqBody apis.StoreShareLinkInput) apis.PostTodoShareLinks200JSONResponse {
+	result := testutil.NewRequest().Post("/todos/"+strconv.Itoa(int(todo.ID))+"/shareLinks").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.PostTodoShareLinks200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	return res
+}
+
+func (s *testShareLinksHandlerSuite) TestPostTodoShareLinks_StatusOk() {
+	s.SignIn()
+	todo := s.createTodo()
+
+	res := s.createShareLink(todo, apis.StoreShareLinkInput{})
+
+	assert.Equal(s.T(), int64(http.StatusOK), res.Code)
+	assert.NotEmpty(s.T(), *res.Token)
+	assert.Equal(s.T(), int(todo.ID), res.ShareLink.TodoId)
+	assert.False(s.T(), res.ShareLink.HasPassword)
+
+	// NOTE: 共有リンクが作成されていることを確認
+	isExistShareLink, _ := models.ShareLinks(qm.Where("todo_id = ?", todo.ID)).Exists(ctx, DBCon)
+	assert.True(s.T(), isExistShareLink)
+}
+
+func (s *testShareLinksHandlerSuite) TestPostTodoShareLinks_BadRequest() {
+	s.SignIn()
+	todo := s.createTodo()
+
+	password := "short"
+	reqBody := apis.StoreShareLinkInput{Password: &password}
+	result := testutil.NewRequest().Post("/todos/"+strconv.Itoa(int(todo.ID))+"/shareLinks").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusBadRequest, result.Code())
+
+	var res apis.PostTodoShareLinks400JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Equal(s.T(), []string{"パスワードは8 ~ 24文字での入力をお願いします。"}, *res.Errors.Password)
+
+	// NOTE: 共有リンクが作成されていないことを確認
+	isExistShareLink, _ := models.ShareLinks(qm.Where("todo_id = ?", todo.ID)).Exists(ctx, DBCon)
+	assert.False(s.T(), isExistShareLink)
+}
+
+func (s *testShareLinksHandlerSuite) TestPostTodoShareLinks_StatusNotFound() {
+	s.SignIn()
+	todo := s.createTodo()
+
+	result := testutil.NewRequest().Post("/todos/"+strconv.Itoa(int(todo.ID+1))+"/shareLinks").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(apis.StoreShareLinkInput{}).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusNotFound, result.Code())
+}
+
+func (s *testShareLinksHandlerSuite) TestPostTodoShareLinks_StatusUnauthorized() {
+	result := testutil.NewRequest().Post("/todos/1/shareLinks").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(apis.StoreShareLinkInput{}).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusUnauthorized, result.Code())
+}
+
+func (s *testShareLinksHandlerSuite) TestGetShareLinks_StatusOk() {
+	s.SignIn()
+	todo := s.createTodo()
+	s.createShareLink(todo, apis.StoreShareLinkInput{})
+	revokedShareLink := s.createShareLink(todo, apis.StoreShareLinkInput{})
+	testutil.NewRequest().Delete("/shareLinks/"+strconv.Itoa(revokedShareLink.ShareLink.Id)).WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+
+	result := testutil.NewRequest().Get("/shareLinks").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.GetShareLinks200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Len(s.T(), res.ShareLinks, 1)
+	assert.Equal(s.T(), int(todo.ID), res.ShareLinks[0].TodoId)
+}
+
+func (s *testShareLinksHandlerSuite) TestDeleteShareLink_StatusOk() {
+	s.SignIn()
+	todo := s.createTodo()
+	shareLink := s.createShareLink(todo, apis.StoreShareLinkInput{})
+
+	result := testutil.NewRequest().Delete("/shareLinks/"+strconv.Itoa(shareLink.ShareLink.Id)).WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.DeleteShareLink200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Equal(s.T(), true, res.Result)
+
+	// NOTE: 失効した共有リンクでは閲覧できないことを確認
+	sharedResult := testutil.NewRequest().Get("/shared/"+*shareLink.Token).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusNotFound, sharedResult.Code())
+}
+
+func (s *testShareLinksHandlerSuite) TestDeleteShareLink_StatusNotFound() {
+	s.SignIn()
+
+	result := testutil.NewRequest().Delete("/shareLinks/1").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusNotFound, result.Code())
+}
+
+func (s *testShareLinksHandlerSuite) TestGetSharedTodo_StatusOk() {
+	s.SignIn()
+	todo := s.createTodo()
+	shareLink := s.createShareLink(todo, apis.StoreShareLinkInput{})
+
+	// NOTE: 認証Cookieなしで閲覧できること
+	result := testutil.NewRequest().Get("/shared/"+*shareLink.Token).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.GetSharedTodo200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Equal(s.T(), "test title 1", res.Todo.Title)
+	assert.Equal(s.T(), "test content 1", res.Todo.Content)
+}
+
+func (s *testShareLinksHandlerSuite) TestGetSharedTodo_WithPassword() {
+	s.SignIn()
+	todo := s.createTodo()
+	password := "password"
+	shareLink := s.createShareLink(todo, apis.StoreShareLinkInput{Password: &password})
+
+	result := testutil.NewRequest().Get("/shared/"+*shareLink.Token).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusUnauthorized, result.Code())
+
+	result = testutil.NewRequest().Get("/shared/"+*shareLink.Token).WithHeader("X-Share-Password", password).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+}
+
+func (s *testShareLinksHandlerSuite) TestGetSharedTodo_TooManyRequests() {
+	s.SignIn()
+	todo := s.createTodo()
+	password := "password"
+	shareLink := s.createShareLink(todo, apis.StoreShareLinkInput{Password: &password})
+
+	// NOTE: 続けて失敗すると、正しいパスワードでも待ち時間が経過するまで照合しない
+	for i := 0; i < 3; i++ {
+		result := testutil.NewRequest().Get("/shared/"+*shareLink.Token).WithHeader("X-Share-Password", "wrong_password").GoWithHTTPHandler(s.T(), e)
+		assert.Equal(s.T(), http.StatusUnauthorized, result.Code())
+	}
+	result := testutil.NewRequest().Get("/shared/"+*shareLink.Token).WithHeader("X-Share-Password", password).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusTooManyRequests, result.Code())
+	var res apis.TooManyRequestsErrorResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Equal(s.T(), "パスワードの試行回数が上限を超えました。しばらく時間を空けてからお試しください。", res.Message)
+	assert.NotNil(s.T(), res.RetryAfter)
+}
+
+func (s *testShareLinksHandlerSuite) TestGetSharedTodo_Expired() {
+	s.SignIn()
+	todo := s.createTodo()
+	expiresAt := time.Now().Add(time.Hour)
+	shareLink := s.createShareLink(todo, apis.StoreShareLinkInput{ExpiresAt: &expiresAt})
+
+	// NOTE: 有効期限を過去に変更
+	_, err := models.ShareLinks(qm.Where("id = ?", shareLink.ShareLink.Id)).UpdateAll(ctx, DBCon, models.M{"expires_at": time.Now().Add(-time.Minute)})
+	if err != nil {
+		s.T().Fatalf("failed to update share link %v", err)
+	}
+
+	result := testutil.NewRequest().Get("/shared/"+*shareLink.Token).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusNotFound, result.Code())
+}
+
+func TestShareLinksHandler(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(testShareLinksHandlerSuite))
+}
@@ -0,0 +1,262 @@
+package handlers
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/services"
+	"app/utils"
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+type TimeEntriesHandler interface {
+	PostTodoTimerStart(ctx context.Context, request apis.PostTodoTimerStartRequestObject) (apis.PostTodoTimerStartResponseObject, error)
+	PostTodoTimerStop(ctx context.Context, request apis.PostTodoTimerStopRequestObject) (apis.PostTodoTimerStopResponseObject, error)
+	PostTimeEntries(ctx context.Context, request apis.PostTimeEntriesRequestObject) (apis.PostTimeEntriesResponseObject, error)
+	GetTimeEntries(ctx context.Context, request apis.GetTimeEntriesRequestObject) (apis.GetTimeEntriesResponseObject, error)
+	DeleteTimeEntry(ctx context.Context, request apis.DeleteTimeEntryRequestObject) (apis.DeleteTimeEntryResponseObject, error)
+	GetTimeReports(ctx context.Context, request apis.GetTimeReportsRequestObject) (apis.GetTimeReportsResponseObject, error)
+	GetTimeReportsCsv(ctx context.Context, request apis.GetTimeReportsCsvRequestObject) (apis.GetTimeReportsCsvResponseObject, error)
+}
+
+type timeEntriesHandler struct {
+	timeEntryService services.TimeEntryService
+}
+
+func NewTimeEntriesHandler(timeEntryService services.TimeEntryService) TimeEntriesHandler {
+	return &timeEntriesHandler{timeEntryService: timeEntryService}
+}
+
+func (timeEntriesHandler *timeEntriesHandler) PostTodoTimerStart(ctx context.Context, request apis.PostTodoTimerStartRequestObject) (apis.PostTodoTimerStartResponseObject, error) {
+	intID, err := strconv.Atoi(request.Id)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostTodoTimerStart500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.PostTodoTimerStart500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, timeEntry, err := timeEntriesHandler.timeEntryService.StartTimer(ctx, int64(intID), userID)
+	switch statusCode {
+	case http.StatusBadRequest:
+		res := apis.BadRequestErrorResponseJSONResponse{Code: http.StatusBadRequest, Message: err.Error()}
+		return apis.PostTodoTimerStart400JSONResponse{BadRequestErrorResponseJSONResponse: res}, nil
+	case http.StatusNotFound:
+		res := apis.NotFoundErrorResponseJSONResponse{Code: http.StatusNotFound}
+		return apis.PostTodoTimerStart404JSONResponse{NotFoundErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostTodoTimerStart500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	res := apis.TimeEntryResponseJSONResponse{Code: http.StatusOK, TimeEntry: timeEntriesHandler.mappingTimeEntry(timeEntry, time.Now())}
+	return apis.PostTodoTimerStart200JSONResponse{TimeEntryResponseJSONResponse: res}, nil
+}
+
+func (timeEntriesHandler *timeEntriesHandler) PostTodoTimerStop(ctx context.Context, request apis.PostTodoTimerStopRequestObject) (apis.PostTodoTimerStopResponseObject, error) {
+	intID, err := strconv.Atoi(request.Id)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostTodoTimerStop500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.PostTodoTimerStop500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, timeEntry, err := timeEntriesHandler.timeEntryService.StopTimer(ctx, int64(intID), userID)
+	switch statusCode {
+	case http.StatusNotFound:
+		res := apis.NotFoundErrorResponseJSONResponse{Code: http.StatusNotFound}
+		return apis.PostTodoTimerStop404JSONResponse{NotFoundErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostTodoTimerStop500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	res := apis.TimeEntryResponseJSONResponse{Code: http.StatusOK, TimeEntry: timeEntriesHandler.mappingTimeEntry(timeEntry, time.Now())}
+	return apis.PostTodoTimerStop200JSONResponse{TimeEntryResponseJSONResponse: res}, nil
+}
+
+func (timeEntriesHandler *timeEntriesHandler) PostTimeEntries(ctx context.Context, request apis.PostTimeEntriesRequestObject) (apis.PostTimeEntriesResponseObject, error) {
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.PostTimeEntries500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, timeEntry, err := timeEntriesHandler.timeEntryService.CreateTimeEntry(ctx, *request.Body, userID)
+	switch statusCode {
+	case http.StatusBadRequest:
+		validationErrors := timeEntriesHandler.mappingValidationErrorStruct(err)
+		return apis.PostTimeEntries400JSONResponse{Code: http.StatusBadRequest, Errors: validationErrors}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostTimeEntries500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	resTimeEntry := timeEntriesHandler.mappingTimeEntry(timeEntry, time.Now())
+	res := apis.StoreTimeEntryResponseJSONResponse{Code: http.StatusOK, Errors: apis.StoreTimeEntryValidationError{}, TimeEntry: &resTimeEntry}
+	return apis.PostTimeEntries200JSONResponse{StoreTimeEntryResponseJSONResponse: res}, nil
+}
+
+func (timeEntriesHandler *timeEntriesHandler) GetTimeEntries(ctx context.Context, request apis.GetTimeEntriesRequestObject) (apis.GetTimeEntriesResponseObject, error) {
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.GetTimeEntries500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, timeEntriesList, err := timeEntriesHandler.timeEntryService.FetchTimeEntriesList(ctx, request.Params, userID)
+	switch statusCode {
+	case http.StatusBadRequest:
+		res := apis.BadRequestErrorResponseJSONResponse{Code: http.StatusBadRequest, Message: err.Error()}
+		return apis.GetTimeEntries400JSONResponse{BadRequestErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.GetTimeEntries500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	// NOTE: 実行中のタイマーは現在までの経過時間を合計に含める
+	now := time.Now()
+	resTimeEntriesList := apis.FetchTimeEntriesResponseJSONResponse{TimeEntries: []apis.TimeEntry{}}
+	for _, timeEntry := range *timeEntriesList {
+		resTimeEntry := timeEntriesHandler.mappingTimeEntry(timeEntry, now)
+		resTimeEntriesList.TimeEntries = append(resTimeEntriesList.TimeEntries, resTimeEntry)
+		resTimeEntriesList.TotalSeconds += resTimeEntry.DurationSeconds
+	}
+	return apis.GetTimeEntries200JSONResponse{FetchTimeEntriesResponseJSONResponse: resTimeEntriesList}, nil
+}
+
+func (timeEntriesHandler *timeEntriesHandler) DeleteTimeEntry(ctx context.Context, request apis.DeleteTimeEntryRequestObject) (apis.DeleteTimeEntryResponseObject, error) {
+	intID, err := strconv.Atoi(request.Id)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.DeleteTimeEntry500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.DeleteTimeEntry500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, err := timeEntriesHandler.timeEntryService.DeleteTimeEntry(ctx, int64(intID), userID)
+	switch statusCode {
+	case http.StatusNotFound:
+		res := apis.NotFoundErrorResponseJSONResponse{Code: http.StatusNotFound}
+		return apis.DeleteTimeEntry404JSONResponse{NotFoundErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.DeleteTimeEntry500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	res := apis.DeleteTimeEntryResponseJSONResponse{Code: http.StatusOK, Result: true}
+	return apis.DeleteTimeEntry200JSONResponse{DeleteTimeEntryResponseJSONResponse: res}, nil
+}
+
+func (timeEntriesHandler *timeEntriesHandler) GetTimeReports(ctx context.Context, request apis.GetTimeReportsRequestObject) (apis.GetTimeReportsResponseObject, error) {
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.GetTimeReports500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, timeReport, err := timeEntriesHandler.timeEntryService.FetchTimeReport(ctx, request.Params.From, request.Params.To, userID)
+	switch statusCode {
+	case http.StatusBadRequest:
+		res := apis.BadRequestErrorResponseJSONResponse{Code: http.StatusBadRequest, Message: err.Error()}
+		return apis.GetTimeReports400JSONResponse{BadRequestErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.GetTimeReports500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	res := apis.FetchTimeReportResponseJSONResponse{
+		From:         openapi_types.Date{Time: timeReport.From},
+		To:           openapi_types.Date{Time: timeReport.To},
+		TotalSeconds: timeReport.TotalSeconds,
+		Days:         []apis.TimeReportDay{},
+	}
+	for _, reportDay := range timeReport.Days {
+		resReportDay := apis.TimeReportDay{Date: openapi_types.Date{Time: reportDay.Date}, TotalSeconds: reportDay.TotalSeconds, Todos: []apis.TimeReportTodo{}}
+		for _, reportTodo := range reportDay.Todos {
+			resReportDay.Todos = append(resReportDay.Todos, apis.TimeReportTodo{TodoId: int(reportTodo.TodoID), Title: reportTodo.Title, TotalSeconds: reportTodo.TotalSeconds})
+		}
+		res.Days = append(res.Days, resReportDay)
+	}
+	return apis.GetTimeReports200JSONResponse{FetchTimeReportResponseJSONResponse: res}, nil
+}
+
+func (timeEntriesHandler *timeEntriesHandler) GetTimeReportsCsv(ctx context.Context, request apis.GetTimeReportsCsvRequestObject) (apis.GetTimeReportsCsvResponseObject, error) {
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.GetTimeReportsCsv500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, body, fileName, err := timeEntriesHandler.timeEntryService.ExportTimeReport(ctx, request.Params.From, request.Params.To, userID)
+	switch statusCode {
+	case http.StatusBadRequest:
+		res := apis.BadRequestErrorResponseJSONResponse{Code: http.StatusBadRequest, Message: err.Error()}
+		return apis.GetTimeReportsCsv400JSONResponse{BadRequestErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.GetTimeReportsCsv500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	headers := apis.ExportTimeReportResponseResponseHeaders{ContentDisposition: `attachment; filename="` + fileName + `"`}
+	res := apis.ExportTimeReportResponseTextcsvResponse{Body: body, Headers: headers}
+	return apis.GetTimeReportsCsv200TextcsvResponse{ExportTimeReportResponseTextcsvResponse: res}, nil
+}
+
+func (timeEntriesHandler *timeEntriesHandler) mappingValidationErrorStruct(err error) apis.StoreTimeEntryValidationError {
+	var validationError apis.StoreTimeEntryValidationError
+	if err == nil {
+		return validationError
+	}
+
+	if errors, ok := err.(validation.Errors); ok {
+		// NOTE: レスポンス用の構造体にマッピング
+		for field, err := range errors {
+			messages := []string{err.Error()}
+			switch field {
+			case "todoId":
+				validationError.TodoId = &messages
+			case "startedAt":
+				validationError.StartedAt = &messages
+			case "stoppedAt":
+				validationError.StoppedAt = &messages
+			case "note":
+				validationError.Note = &messages
+			}
+		}
+	}
+	return validationError
+}
+
+func (timeEntriesHandler *timeEntriesHandler) mappingTimeEntry(timeEntry *models.TimeEntry, now time.Time) apis.TimeEntry {
+	resTimeEntry := apis.TimeEntry{
+		Id:              int(timeEntry.ID),
+		TodoId:          int(timeEntry.TodoID),
+		StartedAt:       timeEntry.StartedAt,
+		DurationSeconds: services.TimeEntryDurationSeconds(timeEntry, now),
+		Note:            timeEntry.Note,
+	}
+	if timeEntry.StoppedAt.Valid {
+		resTimeEntry.StoppedAt = &timeEntry.StoppedAt.Time
+	}
+	return resTimeEntry
+}
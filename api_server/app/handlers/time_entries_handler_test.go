@@ -0,0 +1,167 @@
+package handlers
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/test/factories"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"github.com/oapi-codegen/testutil"
+)
+
+type testTimeEntriesHandlerSuite struct {
+	WithDBSuite
+}
+
+var timeEntryTodo *models.Todo
+
+func (s *testTimeEntriesHandlerSuite) SetupTest() {
+	s.SetDBCon()
+
+	s.initializeHandlers()
+
+	s.SetCsrfHeaderValues()
+}
+
+func (s *testTimeEntriesHandlerSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *testTimeEntriesHandlerSuite) createTodo() {
+	timeEntryTodo = factories.TodoFactory.MustCreateWithOption(map[string]interface{}{"UserID": int64(user.ID), "Title": "設計"}).(*models.Todo)
+	if err := timeEntryTodo.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+}
+
+func (s *testTimeEntriesHandlerSuite) createTimeEntry(startedAt time.Time, duration time.Duration) *testutil.CompletedRequest {
+	reqBody := apis.StoreTimeEntryInput{TodoId: int(timeEntryTodo.ID), StartedAt: startedAt, StoppedAt: startedAt.Add(duration)}
+	return testutil.NewRequest().Post("/timeEntries").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+}
+
+func (s *testTimeEntriesHandlerSuite) TestPostTodoTimerStartAndStop_StatusOk() {
+	s.SignIn()
+	s.createTodo()
+
+	result := testutil.NewRequest().Post("/todos/"+strconv.Itoa(int(timeEntryTodo.ID))+"/timer/start").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var startRes apis.PostTodoTimerStart200JSONResponse
+	result.UnmarshalBodyToObject(&startRes)
+	assert.Nil(s.T(), startRes.TimeEntry.StoppedAt)
+
+	// NOTE: 実行中のタイマーがある間は開始できない
+	result = testutil.NewRequest().Post("/todos/"+strconv.Itoa(int(timeEntryTodo.ID))+"/timer/start").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusBadRequest, result.Code())
+
+	result = testutil.NewRequest().Post("/todos/"+strconv.Itoa(int(timeEntryTodo.ID))+"/timer/stop").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var stopRes apis.PostTodoTimerStop200JSONResponse
+	result.UnmarshalBodyToObject(&stopRes)
+	assert.Equal(s.T(), startRes.TimeEntry.Id, stopRes.TimeEntry.Id)
+	assert.NotNil(s.T(), stopRes.TimeEntry.StoppedAt)
+}
+
+func (s *testTimeEntriesHandlerSuite) TestPostTodoTimerStop_StatusNotFound() {
+	s.SignIn()
+	s.createTodo()
+
+	result := testutil.NewRequest().Post("/todos/"+strconv.Itoa(int(timeEntryTodo.ID))+"/timer/stop").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusNotFound, result.Code())
+}
+
+func (s *testTimeEntriesHandlerSuite) TestPostTimeEntries_BadRequest() {
+	s.SignIn()
+	s.createTodo()
+
+	result := s.createTimeEntry(time.Now().Add(time.Hour), time.Hour)
+	assert.Equal(s.T(), http.StatusBadRequest, result.Code())
+
+	var res apis.PostTimeEntries400JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Equal(s.T(), []string{"終了日時に未来の日時は指定できません。"}, *res.Errors.StoppedAt)
+}
+
+func (s *testTimeEntriesHandlerSuite) TestPostTimeEntries_StatusUnauthorized() {
+	reqBody := apis.StoreTimeEntryInput{TodoId: 1, StartedAt: time.Now().Add(-time.Hour), StoppedAt: time.Now()}
+	result := testutil.NewRequest().Post("/timeEntries").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusUnauthorized, result.Code())
+}
+
+func (s *testTimeEntriesHandlerSuite) TestGetTimeEntries_StatusOk() {
+	s.SignIn()
+	s.createTodo()
+	s.createTimeEntry(time.Now().Add(-3*time.Hour), time.Hour)
+	s.createTimeEntry(time.Now().Add(-time.Hour), 30*time.Minute)
+
+	result := testutil.NewRequest().Get("/timeEntries?todoId="+strconv.Itoa(int(timeEntryTodo.ID))).WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.GetTimeEntries200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Len(s.T(), res.TimeEntries, 2)
+	assert.Equal(s.T(), int64(5400), res.TotalSeconds)
+}
+
+func (s *testTimeEntriesHandlerSuite) TestDeleteTimeEntry_StatusOk() {
+	s.SignIn()
+	s.createTodo()
+	var created apis.PostTimeEntries200JSONResponse
+	s.createTimeEntry(time.Now().Add(-time.Hour), time.Minute).UnmarshalBodyToObject(&created)
+
+	result := testutil.NewRequest().Delete("/timeEntries/"+strconv.Itoa(created.TimeEntry.Id)).WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	// NOTE: 記録が削除されていることを確認
+	isExistTimeEntry, _ := models.TimeEntryExists(ctx, DBCon, int64(created.TimeEntry.Id))
+	assert.False(s.T(), isExistTimeEntry)
+}
+
+func (s *testTimeEntriesHandlerSuite) TestGetTimeReports_StatusOk() {
+	s.SignIn()
+	s.createTodo()
+	s.createTimeEntry(time.Date(2026, 10, 1, 9, 0, 0, 0, time.Local), time.Hour)
+
+	result := testutil.NewRequest().Get("/timeReports?from=2026-10-01&to=2026-10-07").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.GetTimeReports200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Equal(s.T(), int64(3600), res.TotalSeconds)
+	assert.Len(s.T(), res.Days, 1)
+	assert.Equal(s.T(), "2026-10-01", res.Days[0].Date.String())
+	assert.Equal(s.T(), "設計", res.Days[0].Todos[0].Title)
+}
+
+func (s *testTimeEntriesHandlerSuite) TestGetTimeReports_BadRequest() {
+	s.SignIn()
+
+	result := testutil.NewRequest().Get("/timeReports?from=2026-10-07&to=2026-10-01").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusBadRequest, result.Code())
+}
+
+func (s *testTimeEntriesHandlerSuite) TestGetTimeReportsCsv_StatusOk() {
+	s.SignIn()
+	s.createTodo()
+	s.createTimeEntry(time.Date(2026, 10, 1, 9, 0, 0, 0, time.Local), time.Hour)
+
+	result := testutil.NewRequest().Get("/timeReports/csv?from=2026-10-01&to=2026-10-07").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+	assert.Equal(s.T(), "text/csv", result.Recorder.Header().Get("Content-Type"))
+	assert.Equal(s.T(), `attachment; filename="time-report-20261001-20261007.csv"`, result.Recorder.Header().Get("Content-Disposition"))
+	assert.Equal(s.T(), "date,todoId,title,seconds,hours\n2026-10-01,"+strconv.Itoa(int(timeEntryTodo.ID))+",設計,3600,1.00\n", result.Recorder.Body.String())
+}
+
+func TestTimeEntriesHandler(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(testTimeEntriesHandlerSuite))
+}
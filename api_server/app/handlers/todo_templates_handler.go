@@ -0,0 +1,215 @@
+package handlers
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/services"
+	"app/utils"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type TodoTemplatesHandler interface {
+	PostTodoTemplates(ctx context.Context, request apis.PostTodoTemplatesRequestObject) (apis.PostTodoTemplatesResponseObject, error)
+	GetTodoTemplates(ctx context.Context, request apis.GetTodoTemplatesRequestObject) (apis.GetTodoTemplatesResponseObject, error)
+	PutTodoTemplate(ctx context.Context, request apis.PutTodoTemplateRequestObject) (apis.PutTodoTemplateResponseObject, error)
+	DeleteTodoTemplate(ctx context.Context, request apis.DeleteTodoTemplateRequestObject) (apis.DeleteTodoTemplateResponseObject, error)
+	PostTodoTemplateInstantiate(ctx context.Context, request apis.PostTodoTemplateInstantiateRequestObject) (apis.PostTodoTemplateInstantiateResponseObject, error)
+}
+
+type todoTemplatesHandler struct {
+	todoTemplateService services.TodoTemplateService
+}
+
+func NewTodoTemplatesHandler(todoTemplateService services.TodoTemplateService) TodoTemplatesHandler {
+	return &todoTemplatesHandler{todoTemplateService: todoTemplateService}
+}
+
+func (todoTemplatesHandler *todoTemplatesHandler) PostTodoTemplates(ctx context.Context, request apis.PostTodoTemplatesRequestObject) (apis.PostTodoTemplatesResponseObject, error) {
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.PostTodoTemplates500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, todoTemplate, err := todoTemplatesHandler.todoTemplateService.CreateTodoTemplate(ctx, *request.Body, userID)
+	switch statusCode {
+	case http.StatusBadRequest:
+		validationErrors := todoTemplatesHandler.mappingValidationErrorStruct(err)
+		return apis.PostTodoTemplates400JSONResponse{Code: http.StatusBadRequest, Errors: validationErrors}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostTodoTemplates500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	resTodoTemplate, err := todoTemplatesHandler.mappingTodoTemplate(todoTemplate)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostTodoTemplates500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+	res := apis.StoreTodoTemplateResponseJSONResponse{Code: http.StatusOK, Errors: apis.StoreTodoTemplateValidationError{}, TodoTemplate: &resTodoTemplate}
+	return apis.PostTodoTemplates200JSONResponse{StoreTodoTemplateResponseJSONResponse: res}, nil
+}
+
+func (todoTemplatesHandler *todoTemplatesHandler) GetTodoTemplates(ctx context.Context, request apis.GetTodoTemplatesRequestObject) (apis.GetTodoTemplatesResponseObject, error) {
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.GetTodoTemplates500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, todoTemplatesList, err := todoTemplatesHandler.todoTemplateService.FetchTodoTemplatesList(ctx, userID)
+	switch statusCode {
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.GetTodoTemplates500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	resTodoTemplatesList := apis.FetchTodoTemplatesResponseJSONResponse{TodoTemplates: []apis.TodoTemplate{}}
+	for _, todoTemplate := range *todoTemplatesList {
+		resTodoTemplate, err := todoTemplatesHandler.mappingTodoTemplate(todoTemplate)
+		if err != nil {
+			res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+			return apis.GetTodoTemplates500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+		}
+		resTodoTemplatesList.TodoTemplates = append(resTodoTemplatesList.TodoTemplates, resTodoTemplate)
+	}
+	return apis.GetTodoTemplates200JSONResponse{FetchTodoTemplatesResponseJSONResponse: resTodoTemplatesList}, nil
+}
+
+func (todoTemplatesHandler *todoTemplatesHandler) PutTodoTemplate(ctx context.Context, request apis.PutTodoTemplateRequestObject) (apis.PutTodoTemplateResponseObject, error) {
+	intID, err := strconv.Atoi(request.Id)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PutTodoTemplate500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.PutTodoTemplate500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, todoTemplate, err := todoTemplatesHandler.todoTemplateService.UpdateTodoTemplate(ctx, int64(intID), *request.Body, userID)
+	switch statusCode {
+	case http.StatusBadRequest:
+		validationErrors := todoTemplatesHandler.mappingValidationErrorStruct(err)
+		return apis.PutTodoTemplate400JSONResponse{Code: http.StatusBadRequest, Errors: validationErrors}, nil
+	case http.StatusNotFound:
+		res := apis.NotFoundErrorResponseJSONResponse{Code: http.StatusNotFound}
+		return apis.PutTodoTemplate404JSONResponse{NotFoundErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PutTodoTemplate500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	resTodoTemplate, err := todoTemplatesHandler.mappingTodoTemplate(todoTemplate)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PutTodoTemplate500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+	res := apis.StoreTodoTemplateResponseJSONResponse{Code: http.StatusOK, Errors: apis.StoreTodoTemplateValidationError{}, TodoTemplate: &resTodoTemplate}
+	return apis.PutTodoTemplate200JSONResponse{StoreTodoTemplateResponseJSONResponse: res}, nil
+}
+
+func (todoTemplatesHandler *todoTemplatesHandler) DeleteTodoTemplate(ctx context.Context, request apis.DeleteTodoTemplateRequestObject) (apis.DeleteTodoTemplateResponseObject, error) {
+	intID, err := strconv.Atoi(request.Id)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.DeleteTodoTemplate500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.DeleteTodoTemplate500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, err := todoTemplatesHandler.todoTemplateService.DeleteTodoTemplate(ctx, int64(intID), userID)
+	switch statusCode {
+	case http.StatusNotFound:
+		res := apis.NotFoundErrorResponseJSONResponse{Code: http.StatusNotFound}
+		return apis.DeleteTodoTemplate404JSONResponse{NotFoundErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.DeleteTodoTemplate500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	res := apis.DeleteTodoTemplateResponseJSONResponse{Code: http.StatusOK, Result: true}
+	return apis.DeleteTodoTemplate200JSONResponse{DeleteTodoTemplateResponseJSONResponse: res}, nil
+}
+
+func (todoTemplatesHandler *todoTemplatesHandler) PostTodoTemplateInstantiate(ctx context.Context, request apis.PostTodoTemplateInstantiateRequestObject) (apis.PostTodoTemplateInstantiateResponseObject, error) {
+	intID, err := strconv.Atoi(request.Id)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostTodoTemplateInstantiate500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.PostTodoTemplateInstantiate500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, todosList, err := todoTemplatesHandler.todoTemplateService.InstantiateTodoTemplate(ctx, int64(intID), *request.Body, userID)
+	switch statusCode {
+	case http.StatusBadRequest:
+		res := apis.BadRequestErrorResponseJSONResponse{Code: http.StatusBadRequest, Message: err.Error()}
+		return apis.PostTodoTemplateInstantiate400JSONResponse{BadRequestErrorResponseJSONResponse: res}, nil
+	case http.StatusNotFound:
+		res := apis.NotFoundErrorResponseJSONResponse{Code: http.StatusNotFound}
+		return apis.PostTodoTemplateInstantiate404JSONResponse{NotFoundErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostTodoTemplateInstantiate500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	res := apis.InstantiateTodoTemplateResponseJSONResponse{Code: http.StatusOK, Todos: []apis.Todo{}}
+	for _, todo := range *todosList {
+		res.Todos = append(res.Todos, apis.Todo{Id: int(todo.ID), Title: todo.Title, Content: todo.Content.String})
+	}
+	return apis.PostTodoTemplateInstantiate200JSONResponse{InstantiateTodoTemplateResponseJSONResponse: res}, nil
+}
+
+func (todoTemplatesHandler *todoTemplatesHandler) mappingValidationErrorStruct(err error) apis.StoreTodoTemplateValidationError {
+	var validationError apis.StoreTodoTemplateValidationError
+	if err == nil {
+		return validationError
+	}
+
+	if errors, ok := err.(validation.Errors); ok {
+		// NOTE: レスポンス用の構造体にマッピング
+		for field, err := range errors {
+			messages := []string{err.Error()}
+			switch field {
+			case "name":
+				validationError.Name = &messages
+			case "items":
+				validationError.Items = &messages
+			}
+		}
+	}
+	return validationError
+}
+
+func (todoTemplatesHandler *todoTemplatesHandler) mappingTodoTemplate(todoTemplate *models.TodoTemplate) (apis.TodoTemplate, error) {
+	// NOTE: 項目はJSONで保存されている
+	items := []apis.TodoTemplateItem{}
+	if err := json.Unmarshal([]byte(todoTemplate.Items), &items); err != nil {
+		return apis.TodoTemplate{}, err
+	}
+
+	return apis.TodoTemplate{
+		Id:        int(todoTemplate.ID),
+		Name:      todoTemplate.Name,
+		Items:     items,
+		CreatedAt: todoTemplate.CreatedAt,
+		UpdatedAt: todoTemplate.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/oapi-codegen/testutil"
+)
+
+type testTodoTemplatesHandlerSuite struct {
+	WithDBSuite
+}
+
+func (s *testTodoTemplatesHandlerSuite) SetupTest() {
+	s.SetDBCon()
+
+	s.initializeHandlers()
+
+	s.SetCsrfHeaderValues()
+}
+
+func (s *testTodoTemplatesHandlerSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *testTodoTemplatesHandlerSuite) createTodoTemplate() apis.PostTodoTemplates200JSONResponse {
+	reqBody := apis.StoreTodoTemplateInput{Name: "打ち合わせ", Items: []apis.TodoTemplateItem{{Title: "{{client}}との打ち合わせ", Content: "{{date}}"}, {Title: "議事録の送付", Content: ""}}}
+	result := testutil.NewRequest().Post("/todoTemplates").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.PostTodoTemplates200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	return res
+}
+
+func (s *testTodoTemplatesHandlerSuite) TestPostTodoTemplates_StatusOk() {
+	s.SignIn()
+
+	res := s.createTodoTemplate()
+
+	assert.Equal(s.T(), int64(http.StatusOK), res.Code)
+	assert.Equal(s.T(), "打ち合わせ", res.TodoTemplate.Name)
+	assert.Equal(s.T(), []apis.TodoTemplateItem{{Title: "{{client}}との打ち合わせ", Content: "{{date}}"}, {Title: "議事録の送付", Content: ""}}, res.TodoTemplate.Items)
+}
+
+func (s *testTodoTemplatesHandlerSuite) TestPostTodoTemplates_BadRequest() {
+	s.SignIn()
+
+	reqBody := apis.StoreTodoTemplateInput{Name: "", Items: []apis.TodoTemplateItem{}}
+	result := testutil.NewRequest().Post("/todoTemplates").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusBadRequest, result.Code())
+
+	var res apis.PostTodoTemplates400JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Equal(s.T(), []string{"名前は必須入力です。"}, *res.Errors.Name)
+	assert.Equal(s.T(), []string{"項目は1つ以上入力してください。"}, *res.Errors.Items)
+}
+
+func (s *testTodoTemplatesHandlerSuite) TestPostTodoTemplates_StatusUnauthorized() {
+	reqBody := apis.StoreTodoTemplateInput{Name: "打ち合わせ", Items: []apis.TodoTemplateItem{{Title: "議事録の送付"}}}
+	result := testutil.NewRequest().Post("/todoTemplates").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusUnauthorized, result.Code())
+}
+
+func (s *testTodoTemplatesHandlerSuite) TestGetTodoTemplates_StatusOk() {
+	s.SignIn()
+	s.createTodoTemplate()
+
+	result := testutil.NewRequest().Get("/todoTemplates").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.GetTodoTemplates200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Len(s.T(), res.TodoTemplates, 1)
+	assert.Len(s.T(), res.TodoTemplates[0].Items, 2)
+}
+
+func (s *testTodoTemplatesHandlerSuite) TestPutTodoTemplate_StatusOk() {
+	s.SignIn()
+	todoTemplate := s.createTodoTemplate()
+
+	reqBody := apis.StoreTodoTemplateInput{Name: "定例", Items: []apis.TodoTemplateItem{{Title: "資料の準備", Content: ""}}}
+	result := testutil.NewRequest().Put("/todoTemplates/"+strconv.Itoa(todoTemplate.TodoTemplate.Id)).WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.PutTodoTemplate200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Equal(s.T(), "定例", res.TodoTemplate.Name)
+	assert.Len(s.T(), res.TodoTemplate.Items, 1)
+}
+
+func (s *testTodoTemplatesHandlerSuite) TestDeleteTodoTemplate_StatusOk() {
+	s.SignIn()
+	todoTemplate := s.createTodoTemplate()
+
+	result := testutil.NewRequest().Delete("/todoTemplates/"+strconv.Itoa(todoTemplate.TodoTemplate.Id)).WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	// NOTE: テンプレートが削除されていることを確認
+	isExistTodoTemplate, _ := models.TodoTemplates(qm.Where("id = ?", todoTemplate.TodoTemplate.Id)).Exists(ctx, DBCon)
+	assert.False(s.T(), isExistTodoTemplate)
+}
+
+func (s *testTodoTemplatesHandlerSuite) TestDeleteTodoTemplate_StatusNotFound() {
+	s.SignIn()
+
+	result := testutil.NewRequest().Delete("/todoTemplates/1").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusNotFound, result.Code())
+}
+
+func (s *testTodoTemplatesHandlerSuite) TestPostTodoTemplateInstantiate_StatusOk() {
+	s.SignIn()
+	todoTemplate := s.createTodoTemplate()
+
+	variables := map[string]string{"client": "A社"}
+	reqBody := apis.InstantiateTodoTemplateInput{Variables: &variables}
+	result := testutil.NewRequest().Post("/todoTemplates/"+strconv.Itoa(todoTemplate.TodoTemplate.Id)+"/instantiate").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.PostTodoTemplateInstantiate200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Len(s.T(), res.Todos, 2)
+	assert.Equal(s.T(), "A社との打ち合わせ", res.Todos[0].Title)
+	assert.Equal(s.T(), "議事録の送付", res.Todos[1].Title)
+
+	// NOTE: Todoが作成されていることを確認
+	count, _ := models.Todos(qm.Where("user_id = ?", user.ID)).Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(2), count)
+}
+
+func (s *testTodoTemplatesHandlerSuite) TestPostTodoTemplateInstantiate_BadRequest() {
+	s.SignIn()
+	todoTemplate := s.createTodoTemplate()
+
+	reqBody := apis.InstantiateTodoTemplateInput{}
+	result := testutil.NewRequest().Post("/todoTemplates/"+strconv.Itoa(todoTemplate.TodoTemplate.Id)+"/instantiate").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusBadRequest, result.Code())
+
+	var res apis.PostTodoTemplateInstantiate400JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Equal(s.T(), "変数が指定されていません: client", res.Message)
+}
+
+func (s *testTodoTemplatesHandlerSuite) TestPostTodoTemplateInstantiate_StatusNotFound() {
+	s.SignIn()
+
+	reqBody := apis.InstantiateTodoTemplateInput{}
+	result := testutil.NewRequest().Post("/todoTemplates/1/instantiate").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusNotFound, result.Code())
+}
+
+func TestTodoTemplatesHandler(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(testTodoTemplatesHandlerSuite))
+}
@@ -21,11 +21,12 @@ type TodosHandler interface {
 }
 
 type todosHandler struct {
-	todoService services.TodoService
+	todoService    services.TodoService
+	commentService services.CommentService
 }
 
-func NewTodosHandler(todoService services.TodoService) TodosHandler {
-	return &todosHandler{todoService: todoService}
+func NewTodosHandler(todoService services.TodoService, commentService services.CommentService) TodosHandler {
+	return &todosHandler{todoService: todoService, commentService: commentService}
 }
 
 func (todosHandler *todosHandler) GetTodos(ctx context.Context, request apis.GetTodosRequestObject) (apis.GetTodosResponseObject, error) {
@@ -35,8 +36,14 @@ func (todosHandler *todosHandler) GetTodos(ctx context.Context, request apis.Get
 		return apis.GetTodos500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
 	}
 
-	statusCode, todosList, err := todosHandler.todoService.FetchTodosList(ctx, userID)
+	statusCode, todosList, err := todosHandler.todoService.FetchTodosList(ctx, request.Params, userID)
 	switch statusCode {
+	case http.StatusBadRequest:
+		res := apis.BadRequestErrorResponseJSONResponse{Code: http.StatusBadRequest, Message: err.Error()}
+		return apis.GetTodos400JSONResponse{BadRequestErrorResponseJSONResponse: res}, nil
+	case http.StatusNotFound:
+		res := apis.NotFoundErrorResponseJSONResponse{Code: http.StatusNotFound}
+		return apis.GetTodos404JSONResponse{NotFoundErrorResponseJSONResponse: res}, nil
 	case http.StatusInternalServerError:
 		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
 		return apis.GetTodos500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
@@ -55,7 +62,7 @@ func (todosHandler *todosHandler) PostTodos(ctx context.Context, request apis.Po
 		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
 		return apis.PostTodos500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
 	}
-	statusCode, err := todosHandler.todoService.CreateTodo(ctx, *request.Body, userID)
+	statusCode, _, err := todosHandler.todoService.CreateTodo(ctx, *request.Body, userID)
 
 	switch statusCode {
 	case http.StatusBadRequest:
@@ -90,8 +97,27 @@ func (todosHandler *todosHandler) GetTodo(ctx context.Context, request apis.GetT
 		return apis.GetTodo404JSONResponse{NotFoundErrorResponseJSONResponse: res}, nil
 	}
 
+	// NOTE: Todo詳細にはコメントをカーソルページネーションで含める
+	statusCode, commentsList, nextCursor, err := todosHandler.commentService.FetchCommentsList(ctx, todo.ID, userID, request.Params.CommentsCursor, request.Params.CommentsLimit)
+	switch statusCode {
+	case http.StatusBadRequest:
+		res := apis.BadRequestErrorResponseJSONResponse{Code: http.StatusBadRequest, Message: err.Error()}
+		return apis.GetTodo400JSONResponse{BadRequestErrorResponseJSONResponse: res}, nil
+	case http.StatusNotFound:
+		res := apis.NotFoundErrorResponseJSONResponse{Code: http.StatusNotFound}
+		return apis.GetTodo404JSONResponse{NotFoundErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.GetTodo500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	resComments := []apis.Comment{}
+	for _, comment := range *commentsList {
+		resComments = append(resComments, mappingComment(comment))
+	}
+
 	resTodo := apis.Todo{Id: int(todo.ID), Title: todo.Title, Content: todo.Content.String}
-	res := apis.ShowTodoResponseJSONResponse{Todo: resTodo}
+	res := apis.ShowTodoResponseJSONResponse{Todo: resTodo, Comments: &resComments, CommentsNextCursor: nextCursor}
 	return apis.GetTodo200JSONResponse{ShowTodoResponseJSONResponse: res}, nil
 }
 
@@ -5,6 +5,7 @@ import (
 	apis "app/openapi"
 	"app/test/factories"
 	"net/http"
+	"net/url"
 	"strconv"
 	"testing"
 
@@ -128,6 +129,36 @@ func (s *testTodosHandlerSuite) TestGetTodos_StatusOk() {
 	assert.Equal(s.T(), "test content 1", res.Todos[0].Content)
 }
 
+func (s *testTodosHandlerSuite) TestGetTodos_Query() {
+	s.SignIn()
+
+	for _, title := range []string{"定例会議", "買い物"} {
+		todo := factories.TodoFactory.MustCreateWithOption(map[string]interface{}{"UserID": int64(user.ID), "Title": title}).(*models.Todo)
+		if err := todo.Insert(ctx, DBCon, boil.Infer()); err != nil {
+			s.T().Fatalf("failed to create test todos %v", err)
+		}
+	}
+
+	result := testutil.NewRequest().Get("/todos?q="+url.QueryEscape("title:会議")).WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.GetTodos200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Len(s.T(), res.Todos, 1)
+	assert.Equal(s.T(), "定例会議", res.Todos[0].Title)
+}
+
+func (s *testTodosHandlerSuite) TestGetTodos_InvalidQuery() {
+	s.SignIn()
+
+	result := testutil.NewRequest().Get("/todos?q="+url.QueryEscape("due<7d")).WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusBadRequest, result.Code())
+
+	var res apis.GetTodos400JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Equal(s.T(), "1文字目: 不明なフィールドです: due（指定できるフィールド: content, created, title, updated）", res.Message)
+}
+
 func (s *testTodosHandlerSuite) TestGetTodos_StatusUnauthorized() {
 	result := testutil.NewRequest().Get("/todos").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
 	assert.Equal(s.T(), http.StatusUnauthorized, result.Code())
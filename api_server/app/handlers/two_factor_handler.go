@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	apis "app/openapi"
+	"app/services"
+	"app/utils"
+	"context"
+	"errors"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type TwoFactorHandler interface {
+	PostAuthTwoFactorSetup(ctx context.Context, request apis.PostAuthTwoFactorSetupRequestObject) (apis.PostAuthTwoFactorSetupResponseObject, error)
+	PostAuthTwoFactorConfirm(ctx context.Context, request apis.PostAuthTwoFactorConfirmRequestObject) (apis.PostAuthTwoFactorConfirmResponseObject, error)
+	PostAuthTwoFactorDisable(ctx context.Context, request apis.PostAuthTwoFactorDisableRequestObject) (apis.PostAuthTwoFactorDisableResponseObject, error)
+	PostAuthTwoFactorRecoveryCodes(ctx context.Context, request apis.PostAuthTwoFactorRecoveryCodesRequestObject) (apis.PostAuthTwoFactorRecoveryCodesResponseObject, error)
+}
+
+type twoFactorHandler struct {
+	twoFactorService services.TwoFactorService
+}
+
+func NewTwoFactorHandler(twoFactorService services.TwoFactorService) TwoFactorHandler {
+	return &twoFactorHandler{twoFactorService: twoFactorService}
+}
+
+func (twoFactorHandler *twoFactorHandler) PostAuthTwoFactorSetup(ctx context.Context, request apis.PostAuthTwoFactorSetupRequestObject) (apis.PostAuthTwoFactorSetupResponseObject, error) {
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.PostAuthTwoFactorSetup500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, secret, otpauthURI, err := twoFactorHandler.twoFactorService.SetupTwoFactor(ctx, userID)
+	switch statusCode {
+	case http.StatusBadRequest:
+		res := apis.BadRequestErrorResponseJSONResponse{Code: http.StatusBadRequest, Message: err.Error()}
+		return apis.PostAuthTwoFactorSetup400JSONResponse{BadRequestErrorResponseJSONResponse: res}, nil
+	case http.StatusNotFound:
+		res := apis.UnauthorizedErrorResponseJSONResponse{Code: http.StatusUnauthorized, Message: err.Error()}
+		return apis.PostAuthTwoFactorSetup401JSONResponse{UnauthorizedErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostAuthTwoFactorSetup500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	res := apis.TwoFactorSetupResponseJSONResponse{Secret: secret, OtpauthUri: otpauthURI}
+	return apis.PostAuthTwoFactorSetup200JSONResponse{TwoFactorSetupResponseJSONResponse: res}, nil
+}
+
+func (twoFactorHandler *twoFactorHandler) PostAuthTwoFactorConfirm(ctx context.Context, request apis.PostAuthTwoFactorConfirmRequestObject) (apis.PostAuthTwoFactorConfirmResponseObject, error) {
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.PostAuthTwoFactorConfirm500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, recoveryCodes, err := twoFactorHandler.twoFactorService.ConfirmTwoFactor(ctx, *request.Body, userID)
+	switch statusCode {
+	case http.StatusBadRequest:
+		return apis.PostAuthTwoFactorConfirm400JSONResponse{Code: http.StatusBadRequest, Errors: twoFactorHandler.mappingValidationErrors(err)}, nil
+	case http.StatusNotFound:
+		res := apis.UnauthorizedErrorResponseJSONResponse{Code: http.StatusUnauthorized, Message: err.Error()}
+		return apis.PostAuthTwoFactorConfirm401JSONResponse{UnauthorizedErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostAuthTwoFactorConfirm500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	res := apis.TwoFactorRecoveryCodesResponseJSONResponse{Code: http.StatusOK, Errors: apis.TwoFactorValidationError{}, RecoveryCodes: &recoveryCodes}
+	return apis.PostAuthTwoFactorConfirm200JSONResponse{TwoFactorRecoveryCodesResponseJSONResponse: res}, nil
+}
+
+func (twoFactorHandler *twoFactorHandler) PostAuthTwoFactorDisable(ctx context.Context, request apis.PostAuthTwoFactorDisableRequestObject) (apis.PostAuthTwoFactorDisableResponseObject, error) {
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.PostAuthTwoFactorDisable500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	client, _ := utils.ClientContextValue(ctx)
+	statusCode, err := twoFactorHandler.twoFactorService.DisableTwoFactor(ctx, *request.Body, userID, client.IPAddress)
+	switch statusCode {
+	case http.StatusBadRequest:
+		return apis.PostAuthTwoFactorDisable400JSONResponse{Code: http.StatusBadRequest, Errors: twoFactorHandler.mappingValidationErrors(err)}, nil
+	case http.StatusNotFound:
+		res := apis.UnauthorizedErrorResponseJSONResponse{Code: http.StatusUnauthorized, Message: err.Error()}
+		return apis.PostAuthTwoFactorDisable401JSONResponse{UnauthorizedErrorResponseJSONResponse: res}, nil
+	case http.StatusTooManyRequests:
+		return apis.PostAuthTwoFactorDisable429JSONResponse{TooManyRequestsErrorResponseJSONResponse: newSignInThrottledResponse(err)}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostAuthTwoFactorDisable500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	res := apis.TwoFactorResponseJSONResponse{Code: http.StatusOK, Errors: apis.TwoFactorValidationError{}}
+	return apis.PostAuthTwoFactorDisable200JSONResponse{TwoFactorResponseJSONResponse: res}, nil
+}
+
+func (twoFactorHandler *twoFactorHandler) PostAuthTwoFactorRecoveryCodes(ctx context.Context, request apis.PostAuthTwoFactorRecoveryCodesRequestObject) (apis.PostAuthTwoFactorRecoveryCodesResponseObject, error) {
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.PostAuthTwoFactorRecoveryCodes500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	client, _ := utils.ClientContextValue(ctx)
+	statusCode, recoveryCodes, err := twoFactorHandler.twoFactorService.RegenerateRecoveryCodes(ctx, *request.Body, userID, client.IPAddress)
+	switch statusCode {
+	case http.StatusBadRequest:
+		return apis.PostAuthTwoFactorRecoveryCodes400JSONResponse{Code: http.StatusBadRequest, Errors: twoFactorHandler.mappingValidationErrors(err)}, nil
+	case http.StatusNotFound:
+		res := apis.UnauthorizedErrorResponseJSONResponse{Code: http.StatusUnauthorized, Message: err.Error()}
+		return apis.PostAuthTwoFactorRecoveryCodes401JSONResponse{UnauthorizedErrorResponseJSONResponse: res}, nil
+	case http.StatusTooManyRequests:
+		return apis.PostAuthTwoFactorRecoveryCodes429JSONResponse{TooManyRequestsErrorResponseJSONResponse: newSignInThrottledResponse(err)}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostAuthTwoFactorRecoveryCodes500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	res := apis.TwoFactorRecoveryCodesResponseJSONResponse{Code: http.StatusOK, Errors: apis.TwoFactorValidationError{}, RecoveryCodes: &recoveryCodes}
+	return apis.PostAuthTwoFactorRecoveryCodes200JSONResponse{TwoFactorRecoveryCodesResponseJSONResponse: res}, nil
+}
+
+func (twoFactorHandler *twoFactorHandler) mappingValidationErrors(err error) apis.TwoFactorValidationError {
+	validationError := apis.TwoFactorValidationError{}
+	if errors, ok := err.(validation.Errors); ok {
+		if codeErr, ok := errors["code"]; ok {
+			validationError.Code = &[]string{codeErr.Error()}
+		}
+		if passwordErr, ok := errors["password"]; ok {
+			validationError.Password = &[]string{passwordErr.Error()}
+		}
+	}
+	return validationError
+}
@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	apis "app/openapi"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/oapi-codegen/testutil"
+)
+
+type testTwoFactorHandlerSuite struct {
+	WithDBSuite
+}
+
+func (s *testTwoFactorHandlerSuite) SetupTest() {
+	s.SetDBCon()
+
+	s.initializeHandlers()
+
+	s.SetCsrfHeaderValues()
+}
+
+func (s *testTwoFactorHandlerSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+// NOTE: 認証アプリと同様に、秘密鍵から指定した時刻のTOTPのコードを計算する
+func totpCode(secret string, now time.Time) string {
+	key, _ := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(now.Unix()/30))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	return fmt.Sprintf("%06d", (binary.BigEndian.Uint32(sum[offset:offset+4])&0x7fffffff)%1000000)
+}
+
+func (s *testTwoFactorHandlerSuite) post(path string, body interface{}) *testutil.CompletedRequest {
+	return testutil.NewRequest().Post(path).WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(body).GoWithHTTPHandler(s.T(), e)
+}
+
+// NOTE: 2段階認証を有効にし、秘密鍵とリカバリーコードを返す
+func (s *testTwoFactorHandlerSuite) enableTwoFactor() (string, []string) {
+	result := s.post("/auth/twoFactor/setup", map[string]interface{}{})
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+	var setupRes apis.TwoFactorSetupResponseJSONResponse
+	result.UnmarshalBodyToObject(&setupRes)
+	assert.Contains(s.T(), setupRes.OtpauthUri, "otpauth://totp/")
+
+	result = s.post("/auth/twoFactor/confirm", apis.TwoFactorCodeInput{Code: totpCode(setupRes.Secret, time.Now())})
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+	var confirmRes apis.TwoFactorRecoveryCodesResponseJSONResponse
+	result.UnmarshalBodyToObject(&confirmRes)
+	if !assert.NotNil(s.T(), confirmRes.RecoveryCodes) {
+		s.T().FailNow()
+	}
+	return setupRes.Secret, *confirmRes.RecoveryCodes
+}
+
+func (s *testTwoFactorHandlerSuite) TestPostAuthTwoFactorConfirm_StatusOk() {
+	s.SignIn()
+
+	_, recoveryCodes := s.enableTwoFactor()
+
+	assert.Len(s.T(), recoveryCodes, 10)
+	result := testutil.NewRequest().Get("/users/me").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	var res apis.FetchMeResponseJSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.True(s.T(), res.User.TwoFactorEnabled)
+}
+
+func (s *testTwoFactorHandlerSuite) TestPostAuthTwoFactorConfirm_BadRequest() {
+	s.SignIn()
+	s.post("/auth/twoFactor/setup", map[string]interface{}{})
+
+	result := s.post("/auth/twoFactor/confirm", apis.TwoFactorCodeInput{Code: ""})
+
+	assert.Equal(s.T(), http.StatusBadRequest, result.Code())
+	var res apis.TwoFactorRecoveryCodesResponseJSONResponse
+	result.UnmarshalBodyToObject(&res)
+	if assert.NotNil(s.T(), res.Errors.Code) {
+		assert.Equal(s.T(), []string{"認証コードは必須入力です。"}, *res.Errors.Code)
+	}
+}
+
+func (s *testTwoFactorHandlerSuite) TestPostAuthSignInTwoFactor_StatusOk() {
+	s.SignIn()
+	_, recoveryCodes := s.enableTwoFactor()
+
+	// NOTE: パスワードの照合後はCookieをセットせず、2段階目の認証用のトークンを返す
+	result := testutil.NewRequest().Post("/auth/signIn").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(apis.SignInInput{Email: "test@example.com", Password: "password"}).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+	assert.Empty(s.T(), findCookie(result.Recorder.Result().Cookies(), "token").Value)
+	var signInRes apis.SignInResult
+	result.UnmarshalBodyToObject(&signInRes)
+	if !assert.NotNil(s.T(), signInRes.TwoFactorToken) {
+		return
+	}
+	assert.True(s.T(), *signInRes.TwoFactorRequired)
+
+	reqBody := apis.TwoFactorSignInInput{TwoFactorToken: *signInRes.TwoFactorToken, Code: recoveryCodes[0]}
+	result = testutil.NewRequest().Post("/auth/signIn/twoFactor").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+	assert.NotEmpty(s.T(), findCookie(result.Recorder.Result().Cookies(), "token").Value)
+	assert.NotEmpty(s.T(), findCookie(result.Recorder.Result().Cookies(), "refresh_token").Value)
+}
+
+func (s *testTwoFactorHandlerSuite) TestPostAuthSignInTwoFactor_BadRequest() {
+	reqBody := apis.TwoFactorSignInInput{TwoFactorToken: "invalid", Code: "123456"}
+	result := testutil.NewRequest().Post("/auth/signIn/twoFactor").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+
+	assert.Equal(s.T(), http.StatusBadRequest, result.Code())
+	var res apis.SignInBadRequestResponseJSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Equal(s.T(), []string{"認証の有効期限が切れました。再度サインインしてください。"}, res.Errors)
+}
+
+func (s *testTwoFactorHandlerSuite) TestPostAuthSignInTwoFactor_TooManyRequests() {
+	s.SignIn()
+	s.enableTwoFactor()
+	result := testutil.NewRequest().Post("/auth/signIn").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(apis.SignInInput{Email: "test@example.com", Password: "password"}).GoWithHTTPHandler(s.T(), e)
+	var signInRes apis.SignInResult
+	result.UnmarshalBodyToObject(&signInRes)
+	if !assert.NotNil(s.T(), signInRes.TwoFactorToken) {
+		return
+	}
+
+	// NOTE: 2段階目の失敗もパスワードの失敗と同じく、続けて失敗すると待ち時間が発生する
+	reqBody := apis.TwoFactorSignInInput{TwoFactorToken: *signInRes.TwoFactorToken, Code: "000000"}
+	for i := 0; i < 3; i++ {
+		result = testutil.NewRequest().Post("/auth/signIn/twoFactor").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+		assert.Equal(s.T(), http.StatusBadRequest, result.Code())
+	}
+	result = testutil.NewRequest().Post("/auth/signIn/twoFactor").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusTooManyRequests, result.Code())
+	var res apis.TooManyRequestsErrorResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Equal(s.T(), "サインインの試行回数が上限を超えました。しばらく時間を空けてからお試しください。", res.Message)
+	assert.NotNil(s.T(), res.RetryAfter)
+}
+
+func (s *testTwoFactorHandlerSuite) TestPostAuthTwoFactorDisable_StatusOk() {
+	s.SignIn()
+	_, recoveryCodes := s.enableTwoFactor()
+
+	result := s.post("/auth/twoFactor/disable", apis.DisableTwoFactorInput{Password: "password", Code: recoveryCodes[0]})
+
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+	result = testutil.NewRequest().Post("/auth/signIn").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(apis.SignInInput{Email: "test@example.com", Password: "password"}).GoWithHTTPHandler(s.T(), e)
+	assert.NotEmpty(s.T(), findCookie(result.Recorder.Result().Cookies(), "token").Value)
+}
+
+func (s *testTwoFactorHandlerSuite) TestPostAuthTwoFactorRecoveryCodes() {
+	s.SignIn()
+	_, recoveryCodes := s.enableTwoFactor()
+
+	// NOTE: セッションのみではリカバリーコードを再発行できないこと
+	result := s.post("/auth/twoFactor/recoveryCodes", apis.RegenerateRecoveryCodesInput{Password: "wrongPassword", Code: recoveryCodes[0]})
+	assert.Equal(s.T(), http.StatusBadRequest, result.Code())
+	var badRequestRes apis.TwoFactorRecoveryCodesResponseJSONResponse
+	result.UnmarshalBodyToObject(&badRequestRes)
+	if assert.NotNil(s.T(), badRequestRes.Errors.Password) {
+		assert.Equal(s.T(), []string{"パスワードが正しくありません。"}, *badRequestRes.Errors.Password)
+	}
+
+	result = s.post("/auth/twoFactor/recoveryCodes", apis.RegenerateRecoveryCodesInput{Password: "password", Code: recoveryCodes[0]})
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+	var res apis.TwoFactorRecoveryCodesResponseJSONResponse
+	result.UnmarshalBodyToObject(&res)
+	if assert.NotNil(s.T(), res.RecoveryCodes) {
+		assert.Len(s.T(), *res.RecoveryCodes, 10)
+	}
+}
+
+func TestTwoFactorHandler(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(testTwoFactorHandlerSuite))
+}
@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/services"
+	"app/utils"
+	"context"
+	"errors"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+type UsersHandler interface {
+	GetUsersMe(ctx context.Context, request apis.GetUsersMeRequestObject) (apis.GetUsersMeResponseObject, error)
+	PatchUsersMe(ctx context.Context, request apis.PatchUsersMeRequestObject) (apis.PatchUsersMeResponseObject, error)
+	PostUsersMeEmail(ctx context.Context, request apis.PostUsersMeEmailRequestObject) (apis.PostUsersMeEmailResponseObject, error)
+}
+
+type usersHandler struct {
+	userService services.UserService
+}
+
+func NewUsersHandler(userService services.UserService) UsersHandler {
+	return &usersHandler{userService: userService}
+}
+
+func (usersHandler *usersHandler) GetUsersMe(ctx context.Context, request apis.GetUsersMeRequestObject) (apis.GetUsersMeResponseObject, error) {
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.GetUsersMe500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, user, err := usersHandler.userService.FetchMe(ctx, userID)
+	switch statusCode {
+	// NOTE: tokenは有効でもユーザが存在しない場合は未認証として扱う
+	case http.StatusNotFound:
+		res := apis.UnauthorizedErrorResponseJSONResponse{Code: http.StatusUnauthorized, Message: err.Error()}
+		return apis.GetUsersMe401JSONResponse{UnauthorizedErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.GetUsersMe500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	res := apis.FetchMeResponseJSONResponse{User: usersHandler.mappingUser(user)}
+	return apis.GetUsersMe200JSONResponse{FetchMeResponseJSONResponse: res}, nil
+}
+
+func (usersHandler *usersHandler) PatchUsersMe(ctx context.Context, request apis.PatchUsersMeRequestObject) (apis.PatchUsersMeResponseObject, error) {
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.PatchUsersMe500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, user, err := usersHandler.userService.UpdateMe(ctx, *request.Body, userID)
+	switch statusCode {
+	case http.StatusBadRequest:
+		validationErrors := usersHandler.mappingValidationErrorStruct(err)
+		return apis.PatchUsersMe400JSONResponse{Code: http.StatusBadRequest, Errors: validationErrors}, nil
+	case http.StatusNotFound:
+		res := apis.UnauthorizedErrorResponseJSONResponse{Code: http.StatusUnauthorized, Message: err.Error()}
+		return apis.PatchUsersMe401JSONResponse{UnauthorizedErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PatchUsersMe500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	resUser := usersHandler.mappingUser(user)
+	res := apis.UpdateMeResponseJSONResponse{Code: http.StatusOK, Errors: apis.UpdateMeValidationError{}, User: &resUser}
+	return apis.PatchUsersMe200JSONResponse{UpdateMeResponseJSONResponse: res}, nil
+}
+
+func (usersHandler *usersHandler) PostUsersMeEmail(ctx context.Context, request apis.PostUsersMeEmailRequestObject) (apis.PostUsersMeEmailResponseObject, error) {
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.PostUsersMeEmail500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	client, _ := utils.ClientContextValue(ctx)
+	statusCode, user, err := usersHandler.userService.ChangeEmail(ctx, *request.Body, userID, client.IPAddress)
+	switch statusCode {
+	case http.StatusBadRequest:
+		validationError := apis.ChangeEmailValidationError{}
+		if errors, ok := err.(validation.Errors); ok {
+			if emailErr, ok := errors["email"]; ok {
+				validationError.Email = &[]string{emailErr.Error()}
+			}
+			if passwordErr, ok := errors["password"]; ok {
+				validationError.Password = &[]string{passwordErr.Error()}
+			}
+		}
+		return apis.PostUsersMeEmail400JSONResponse{Code: http.StatusBadRequest, Errors: validationError}, nil
+	case http.StatusNotFound:
+		res := apis.UnauthorizedErrorResponseJSONResponse{Code: http.StatusUnauthorized, Message: err.Error()}
+		return apis.PostUsersMeEmail401JSONResponse{UnauthorizedErrorResponseJSONResponse: res}, nil
+	case http.StatusTooManyRequests:
+		return apis.PostUsersMeEmail429JSONResponse{TooManyRequestsErrorResponseJSONResponse: newSignInThrottledResponse(err)}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostUsersMeEmail500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	resUser := usersHandler.mappingUser(user)
+	res := apis.ChangeEmailResponseJSONResponse{Code: http.StatusOK, Errors: apis.ChangeEmailValidationError{}, User: &resUser}
+	return apis.PostUsersMeEmail200JSONResponse{ChangeEmailResponseJSONResponse: res}, nil
+}
+
+// NOTE: 身分証明書はファイルの保存先を返さず、提出済みかどうかのみ返す
+func (usersHandler *usersHandler) mappingUser(user *models.User) apis.User {
+	resUser := apis.User{
+		Id:                     user.ID,
+		FirstName:              user.FirstName,
+		LastName:               user.LastName,
+		Email:                  user.Email,
+		EmailVerified:          user.EmailVerifiedAt.Valid,
+		TwoFactorEnabled:       user.TotpEnabledAt.Valid,
+		HasFrontIdentification: user.FrontIdentification != "",
+		HasBackIdentification:  user.BackIdentification != "",
+		CreatedAt:              user.CreatedAt,
+	}
+	if user.PendingEmail.Valid {
+		resUser.PendingEmail = &user.PendingEmail.String
+	}
+	if user.Birthday.Valid {
+		resUser.Birthday = &openapi_types.Date{Time: user.Birthday.Time}
+	}
+	return resUser
+}
+
+func (usersHandler *usersHandler) mappingValidationErrorStruct(err error) apis.UpdateMeValidationError {
+	var validationError apis.UpdateMeValidationError
+	if err == nil {
+		return validationError
+	}
+
+	if errors, ok := err.(validation.Errors); ok {
+		// NOTE: レスポンス用の構造体にマッピング
+		for field, err := range errors {
+			messages := []string{err.Error()}
+			switch field {
+			case "firstName":
+				validationError.FirstName = &messages
+			case "lastName":
+				validationError.LastName = &messages
+			case "birthday":
+				validationError.Birthday = &messages
+			}
+		}
+	}
+	return validationError
+}
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/oapi-codegen/testutil"
+)
+
+type testUsersHandlerSuite struct {
+	WithDBSuite
+}
+
+func (s *testUsersHandlerSuite) SetupTest() {
+	s.SetDBCon()
+
+	s.initializeHandlers()
+
+	s.SetCsrfHeaderValues()
+}
+
+func (s *testUsersHandlerSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *testUsersHandlerSuite) TestGetUsersMe_StatusOk() {
+	s.SignIn()
+
+	result := testutil.NewRequest().Get("/users/me").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.GetUsersMe200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Equal(s.T(), user.ID, res.User.Id)
+	assert.Equal(s.T(), "test@example.com", res.User.Email)
+	assert.False(s.T(), res.User.HasFrontIdentification)
+	assert.False(s.T(), res.User.HasBackIdentification)
+}
+
+func (s *testUsersHandlerSuite) TestGetUsersMe_StatusUnauthorized() {
+	result := testutil.NewRequest().Get("/users/me").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusUnauthorized, result.Code())
+}
+
+func (s *testUsersHandlerSuite) TestPatchUsersMe_StatusOk() {
+	s.SignIn()
+
+	firstName := "花子"
+	lastName := "山田"
+	reqBody := apis.PatchUsersMeJSONRequestBody{FirstName: &firstName, LastName: &lastName}
+	result := testutil.NewRequest().Patch("/users/me").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.PatchUsersMe200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	if assert.NotNil(s.T(), res.User) {
+		assert.Equal(s.T(), "花子", res.User.FirstName)
+		assert.Equal(s.T(), "山田", res.User.LastName)
+	}
+
+	updatedUser, _ := models.FindUser(ctx, DBCon, user.ID)
+	assert.Equal(s.T(), "花子", updatedUser.FirstName)
+}
+
+func (s *testUsersHandlerSuite) TestPatchUsersMe_BadRequest() {
+	s.SignIn()
+
+	firstName := ""
+	reqBody := apis.PatchUsersMeJSONRequestBody{FirstName: &firstName}
+	result := testutil.NewRequest().Patch("/users/me").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusBadRequest, result.Code())
+
+	var res apis.PatchUsersMe400JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	if assert.NotNil(s.T(), res.Errors.FirstName) {
+		assert.Equal(s.T(), []string{"名は必須入力です。"}, *res.Errors.FirstName)
+	}
+}
+
+func (s *testUsersHandlerSuite) TestPostUsersMeEmail_StatusOk() {
+	s.SignIn()
+
+	reqBody := apis.PostUsersMeEmailJSONRequestBody{Email: "new@example.com", Password: "password"}
+	result := testutil.NewRequest().Post("/users/me/email").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	// NOTE: 確認が完了するまでは変更前のメールアドレスのままであることを確認
+	var res apis.PostUsersMeEmail200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	if assert.NotNil(s.T(), res.User) {
+		assert.Equal(s.T(), "test@example.com", res.User.Email)
+		assert.True(s.T(), res.User.EmailVerified)
+		if assert.NotNil(s.T(), res.User.PendingEmail) {
+			assert.Equal(s.T(), "new@example.com", *res.User.PendingEmail)
+		}
+	}
+	messages := testMailer.Messages()
+	if assert.Len(s.T(), messages, 2) {
+		assert.Equal(s.T(), "test@example.com", messages[0].To)
+		assert.Equal(s.T(), "new@example.com", messages[1].To)
+	}
+
+	result = testutil.NewRequest().Post("/users/me/email").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusTooManyRequests, result.Code())
+}
+
+func (s *testUsersHandlerSuite) TestPostUsersMeEmail_IncorrectPassword() {
+	s.SignIn()
+
+	reqBody := apis.PostUsersMeEmailJSONRequestBody{Email: "new@example.com", Password: "wrongPassword"}
+	result := testutil.NewRequest().Post("/users/me/email").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusBadRequest, result.Code())
+
+	var res apis.PostUsersMeEmail400JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	if assert.NotNil(s.T(), res.Errors.Password) {
+		assert.Equal(s.T(), []string{"パスワードが正しくありません。"}, *res.Errors.Password)
+	}
+	assert.Len(s.T(), testMailer.Messages(), 0)
+}
+
+func TestUsersHandler(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(testUsersHandlerSuite))
+}
@@ -0,0 +1,223 @@
+package handlers
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/services"
+	"app/utils"
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type WebhooksHandler interface {
+	PostWebhooks(ctx context.Context, request apis.PostWebhooksRequestObject) (apis.PostWebhooksResponseObject, error)
+	GetWebhooks(ctx context.Context, request apis.GetWebhooksRequestObject) (apis.GetWebhooksResponseObject, error)
+	DeleteWebhook(ctx context.Context, request apis.DeleteWebhookRequestObject) (apis.DeleteWebhookResponseObject, error)
+	GetWebhookDeliveries(ctx context.Context, request apis.GetWebhookDeliveriesRequestObject) (apis.GetWebhookDeliveriesResponseObject, error)
+	PostWebhookDeliveryRedeliver(ctx context.Context, request apis.PostWebhookDeliveryRedeliverRequestObject) (apis.PostWebhookDeliveryRedeliverResponseObject, error)
+}
+
+type webhooksHandler struct {
+	webhookService services.WebhookService
+}
+
+func NewWebhooksHandler(webhookService services.WebhookService) WebhooksHandler {
+	return &webhooksHandler{webhookService: webhookService}
+}
+
+func (webhooksHandler *webhooksHandler) PostWebhooks(ctx context.Context, request apis.PostWebhooksRequestObject) (apis.PostWebhooksResponseObject, error) {
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.PostWebhooks500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, webhook, err := webhooksHandler.webhookService.CreateWebhook(ctx, *request.Body, userID)
+	switch statusCode {
+	case http.StatusBadRequest:
+		validationErrors := webhooksHandler.mappingValidationErrorStruct(err)
+		return apis.PostWebhooks400JSONResponse{Code: http.StatusBadRequest, Errors: validationErrors}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostWebhooks500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	resWebhook := webhooksHandler.mappingWebhook(webhook)
+	res := apis.StoreWebhookResponseJSONResponse{Code: http.StatusOK, Errors: apis.StoreWebhookValidationError{}, Webhook: &resWebhook}
+	return apis.PostWebhooks200JSONResponse{StoreWebhookResponseJSONResponse: res}, nil
+}
+
+func (webhooksHandler *webhooksHandler) GetWebhooks(ctx context.Context, request apis.GetWebhooksRequestObject) (apis.GetWebhooksResponseObject, error) {
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.GetWebhooks500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, webhooksList, err := webhooksHandler.webhookService.FetchWebhooksList(ctx, userID)
+	switch statusCode {
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.GetWebhooks500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	resWebhooksList := apis.FetchWebhooksResponseJSONResponse{Webhooks: []apis.Webhook{}}
+	for _, webhook := range *webhooksList {
+		resWebhooksList.Webhooks = append(resWebhooksList.Webhooks, webhooksHandler.mappingWebhook(webhook))
+	}
+	return apis.GetWebhooks200JSONResponse{FetchWebhooksResponseJSONResponse: resWebhooksList}, nil
+}
+
+func (webhooksHandler *webhooksHandler) DeleteWebhook(ctx context.Context, request apis.DeleteWebhookRequestObject) (apis.DeleteWebhookResponseObject, error) {
+	intID, err := strconv.Atoi(request.Id)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.DeleteWebhook500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.DeleteWebhook500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, err := webhooksHandler.webhookService.DeleteWebhook(ctx, int64(intID), userID)
+	switch statusCode {
+	case http.StatusNotFound:
+		res := apis.NotFoundErrorResponseJSONResponse{Code: http.StatusNotFound}
+		return apis.DeleteWebhook404JSONResponse{NotFoundErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.DeleteWebhook500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	res := apis.DeleteWebhookResponseJSONResponse{Code: http.StatusOK, Result: true}
+	return apis.DeleteWebhook200JSONResponse{DeleteWebhookResponseJSONResponse: res}, nil
+}
+
+func (webhooksHandler *webhooksHandler) GetWebhookDeliveries(ctx context.Context, request apis.GetWebhookDeliveriesRequestObject) (apis.GetWebhookDeliveriesResponseObject, error) {
+	intID, err := strconv.Atoi(request.Id)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.GetWebhookDeliveries500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.GetWebhookDeliveries500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, deliveriesList, nextCursor, err := webhooksHandler.webhookService.FetchWebhookDeliveriesList(ctx, int64(intID), request.Params, userID)
+	switch statusCode {
+	case http.StatusBadRequest:
+		res := apis.BadRequestErrorResponseJSONResponse{Code: http.StatusBadRequest, Message: err.Error()}
+		return apis.GetWebhookDeliveries400JSONResponse{BadRequestErrorResponseJSONResponse: res}, nil
+	case http.StatusNotFound:
+		res := apis.NotFoundErrorResponseJSONResponse{Code: http.StatusNotFound}
+		return apis.GetWebhookDeliveries404JSONResponse{NotFoundErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.GetWebhookDeliveries500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	resDeliveriesList := apis.FetchWebhookDeliveriesResponseJSONResponse{Deliveries: []apis.WebhookDelivery{}, NextCursor: nextCursor}
+	for _, delivery := range *deliveriesList {
+		resDeliveriesList.Deliveries = append(resDeliveriesList.Deliveries, webhooksHandler.mappingWebhookDelivery(delivery))
+	}
+	return apis.GetWebhookDeliveries200JSONResponse{FetchWebhookDeliveriesResponseJSONResponse: resDeliveriesList}, nil
+}
+
+func (webhooksHandler *webhooksHandler) PostWebhookDeliveryRedeliver(ctx context.Context, request apis.PostWebhookDeliveryRedeliverRequestObject) (apis.PostWebhookDeliveryRedeliverResponseObject, error) {
+	intID, err := strconv.Atoi(request.Id)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostWebhookDeliveryRedeliver500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	intDeliveryID, err := strconv.Atoi(request.DeliveryId)
+	if err != nil {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostWebhookDeliveryRedeliver500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	userID, ok := utils.ContextValue(ctx)
+	if !ok {
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError}
+		return apis.PostWebhookDeliveryRedeliver500JSONResponse{InternalServerErrorResponseJSONResponse: res}, errors.New("fail to load context value")
+	}
+
+	statusCode, delivery, err := webhooksHandler.webhookService.RedeliverWebhook(ctx, int64(intID), int64(intDeliveryID), userID)
+	switch statusCode {
+	case http.StatusNotFound:
+		res := apis.NotFoundErrorResponseJSONResponse{Code: http.StatusNotFound}
+		return apis.PostWebhookDeliveryRedeliver404JSONResponse{NotFoundErrorResponseJSONResponse: res}, nil
+	case http.StatusInternalServerError:
+		res := apis.InternalServerErrorResponseJSONResponse{Code: http.StatusInternalServerError, Message: err.Error()}
+		return apis.PostWebhookDeliveryRedeliver500JSONResponse{InternalServerErrorResponseJSONResponse: res}, err
+	}
+
+	res := apis.RedeliverWebhookResponseJSONResponse{Delivery: webhooksHandler.mappingWebhookDelivery(delivery)}
+	return apis.PostWebhookDeliveryRedeliver200JSONResponse{RedeliverWebhookResponseJSONResponse: res}, nil
+}
+
+// NOTE: シークレットはレスポンスに含めない
+func (webhooksHandler *webhooksHandler) mappingWebhook(webhook *models.Webhook) apis.Webhook {
+	return apis.Webhook{
+		Id:         int(webhook.ID),
+		Url:        webhook.URL,
+		EventTypes: strings.Split(webhook.EventTypes, ","),
+		CreatedAt:  webhook.CreatedAt,
+	}
+}
+
+func (webhooksHandler *webhooksHandler) mappingWebhookDelivery(delivery *models.WebhookDelivery) apis.WebhookDelivery {
+	resDelivery := apis.WebhookDelivery{
+		Id:            int(delivery.ID),
+		WebhookId:     int(delivery.WebhookID),
+		EventId:       int(delivery.EventID),
+		EventType:     delivery.EventType,
+		Status:        apis.WebhookDeliveryStatus(delivery.Status),
+		Attempts:      delivery.Attempts,
+		NextAttemptAt: delivery.NextAttemptAt,
+		CreatedAt:     delivery.CreatedAt,
+	}
+	if delivery.LastAttemptedAt.Valid {
+		resDelivery.LastAttemptedAt = &delivery.LastAttemptedAt.Time
+	}
+	if delivery.ResponseStatus.Valid {
+		resDelivery.ResponseStatus = &delivery.ResponseStatus.Int
+	}
+	if delivery.ErrorMessage.Valid {
+		resDelivery.ErrorMessage = &delivery.ErrorMessage.String
+	}
+	return resDelivery
+}
+
+func (webhooksHandler *webhooksHandler) mappingValidationErrorStruct(err error) apis.StoreWebhookValidationError {
+	var validationError apis.StoreWebhookValidationError
+	if err == nil {
+		return validationError
+	}
+
+	if errors, ok := err.(validation.Errors); ok {
+		// NOTE: レスポンス用の構造体にマッピング
+		for field, err := range errors {
+			messages := []string{err.Error()}
+			switch field {
+			case "url":
+				validationError.Url = &messages
+			case "secret":
+				validationError.Secret = &messages
+			case "eventTypes":
+				validationError.EventTypes = &messages
+			}
+		}
+	}
+	return validationError
+}
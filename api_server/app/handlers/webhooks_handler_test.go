@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/oapi-codegen/testutil"
+)
+
+type testWebhooksHandlerSuite struct {
+	WithDBSuite
+}
+
+var receiver *httptest.Server
+
+func (s *testWebhooksHandlerSuite) SetupTest() {
+	s.SetDBCon()
+
+	s.initializeHandlers()
+
+	s.SetCsrfHeaderValues()
+
+	// NOTE: Webhookの受信側のスタブ
+	receiver = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func (s *testWebhooksHandlerSuite) TearDownTest() {
+	receiver.Close()
+	s.CloseDB()
+}
+
+func (s *testWebhooksHandlerSuite) createWebhook() apis.PostWebhooks200JSONResponse {
+	reqBody := apis.StoreWebhookInput{Url: receiver.URL, Secret: "test-webhook-secret", EventTypes: []string{"todo.created"}}
+	result := testutil.NewRequest().Post("/webhooks").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.PostWebhooks200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	return res
+}
+
+func (s *testWebhooksHandlerSuite) createTodo() {
+	reqBody := apis.StoreTodoInput{Title: "test title 1", Content: "test content 1"}
+	testutil.NewRequest().Post("/todos").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+}
+
+func (s *testWebhooksHandlerSuite) TestPostWebhooks_StatusOk() {
+	s.SignIn()
+
+	res := s.createWebhook()
+
+	assert.Equal(s.T(), int64(http.StatusOK), res.Code)
+	assert.Equal(s.T(), receiver.URL, res.Webhook.Url)
+	assert.Equal(s.T(), []string{"todo.created"}, res.Webhook.EventTypes)
+}
+
+func (s *testWebhooksHandlerSuite) TestPostWebhooks_BadRequest() {
+	s.SignIn()
+
+	reqBody := apis.StoreWebhookInput{Url: "", Secret: "test-webhook-secret", EventTypes: []string{}}
+	result := testutil.NewRequest().Post("/webhooks").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusBadRequest, result.Code())
+
+	var res apis.PostWebhooks400JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Equal(s.T(), []string{"URLは必須入力です。"}, *res.Errors.Url)
+	assert.Equal(s.T(), []string{"イベントは1つ以上選択してください。"}, *res.Errors.EventTypes)
+
+	// NOTE: Webhookが作成されていないことを確認
+	isExistWebhook, _ := models.Webhooks(qm.Where("user_id = ?", user.ID)).Exists(ctx, DBCon)
+	assert.False(s.T(), isExistWebhook)
+}
+
+func (s *testWebhooksHandlerSuite) TestPostWebhooks_StatusUnauthorized() {
+	reqBody := apis.StoreWebhookInput{Url: receiver.URL, Secret: "test-webhook-secret", EventTypes: []string{"todo.created"}}
+	result := testutil.NewRequest().Post("/webhooks").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusUnauthorized, result.Code())
+}
+
+func (s *testWebhooksHandlerSuite) TestGetWebhooks_StatusOk() {
+	s.SignIn()
+	s.createWebhook()
+
+	result := testutil.NewRequest().Get("/webhooks").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.GetWebhooks200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Len(s.T(), res.Webhooks, 1)
+	// NOTE: シークレットがレスポンスに含まれないことを確認
+	assert.NotContains(s.T(), string(result.Recorder.Body.Bytes()), "test-webhook-secret")
+}
+
+func (s *testWebhooksHandlerSuite) TestDeleteWebhook_StatusOk() {
+	s.SignIn()
+	webhook := s.createWebhook()
+
+	result := testutil.NewRequest().Delete("/webhooks/"+strconv.Itoa(webhook.Webhook.Id)).WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	// NOTE: Webhookが削除されていることを確認
+	isExistWebhook, _ := models.Webhooks(qm.Where("id = ?", webhook.Webhook.Id)).Exists(ctx, DBCon)
+	assert.False(s.T(), isExistWebhook)
+}
+
+func (s *testWebhooksHandlerSuite) TestDeleteWebhook_StatusNotFound() {
+	s.SignIn()
+
+	result := testutil.NewRequest().Delete("/webhooks/1").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusNotFound, result.Code())
+}
+
+func (s *testWebhooksHandlerSuite) TestGetWebhookDeliveries_StatusOk() {
+	s.SignIn()
+	webhook := s.createWebhook()
+	s.createTodo()
+
+	result := testutil.NewRequest().Get("/webhooks/"+strconv.Itoa(webhook.Webhook.Id)+"/deliveries").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.GetWebhookDeliveries200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.Len(s.T(), res.Deliveries, 1)
+	assert.Equal(s.T(), "todo.created", res.Deliveries[0].EventType)
+	assert.Equal(s.T(), apis.WebhookDeliveryStatus("pending"), res.Deliveries[0].Status)
+}
+
+func (s *testWebhooksHandlerSuite) TestGetWebhookDeliveries_StatusNotFound() {
+	s.SignIn()
+
+	result := testutil.NewRequest().Get("/webhooks/1/deliveries").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusNotFound, result.Code())
+}
+
+func (s *testWebhooksHandlerSuite) TestPostWebhookDeliveryRedeliver_StatusOk() {
+	s.SignIn()
+	webhook := s.createWebhook()
+	s.createTodo()
+	delivery, _ := models.WebhookDeliveries(qm.Where("webhook_id = ?", webhook.Webhook.Id)).One(ctx, DBCon)
+
+	result := testutil.NewRequest().Post("/webhooks/"+strconv.Itoa(webhook.Webhook.Id)+"/deliveries/"+strconv.Itoa(int(delivery.ID))+"/redeliver").WithHeader("Cookie", token+"; "+csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).GoWithHTTPHandler(s.T(), e)
+	assert.Equal(s.T(), http.StatusOK, result.Code())
+
+	var res apis.PostWebhookDeliveryRedeliver200JSONResponse
+	result.UnmarshalBodyToObject(&res)
+	assert.NotEqual(s.T(), int(delivery.ID), res.Delivery.Id)
+	assert.Equal(s.T(), int(delivery.EventID), res.Delivery.EventId)
+
+	// NOTE: 再送用の配信が追加されていることを確認
+	count, _ := models.WebhookDeliveries(qm.Where("webhook_id = ?", webhook.Webhook.Id)).Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(2), count)
+}
+
+func TestWebhooksHandler(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(testWebhooksHandlerSuite))
+}
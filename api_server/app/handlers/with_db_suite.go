@@ -10,6 +10,7 @@ import (
 	"app/utils/routers"
 	"context"
 	"database/sql"
+	"net/http"
 
 	"github.com/DATA-DOG/go-txdb"
 	"github.com/labstack/echo/v4"
@@ -30,6 +31,7 @@ var (
 	e *echo.Echo
 	csrfToken string
 	csrfTokenCookie string
+	testMailer *services.MemoryMailer
 )
 
 // func (s *WithDBSuite) SetupSuite()                           {} // テストスイート実施前の処理
@@ -70,7 +72,17 @@ func (s *WithDBSuite) SignIn() {
 		Password: "password",
 	}
 	result := testutil.NewRequest().Post("/auth/signIn").WithHeader("Cookie", csrfTokenCookie).WithHeader(echo.HeaderXCSRFToken, csrfToken).WithJsonBody(reqBody).GoWithHTTPHandler(s.T(), e)
-	token = result.Recorder.Result().Header.Values("Set-Cookie")[0]	
+	token = findCookie(result.Recorder.Result().Cookies(), "token").String()
+}
+
+// NOTE: レスポンスにはCSRFトークンのCookieも含まれるため、名前で取得する
+func findCookie(cookies []*http.Cookie, name string) *http.Cookie {
+	for _, cookie := range cookies {
+		if cookie.Name == name {
+			return cookie
+		}
+	}
+	return &http.Cookie{}
 }
 
 func (s *WithDBSuite) SetCsrfHeaderValues() {
@@ -87,14 +99,66 @@ func (s *WithDBSuite) SetCsrfHeaderValues() {
 }
 
 func (s *WithDBSuite) initializeHandlers() {
-	authService := services.NewAuthService(DBCon)
+	// NOTE: テストでは失効リストをメモリ上に保持する
+	tokenDenylist := services.NewMemoryTokenDenylistStore()
+	// NOTE: テストではメールを送信せず、送信内容をメモリ上に保持する
+	testMailer = services.NewMemoryMailer()
+	authService := services.NewAuthService(DBCon, tokenDenylist, testMailer)
 	testAuthHandler := NewAuthHandler(authService)
 
 	todoService := services.NewTodoService(DBCon)
-	testTodosHandler := NewTodosHandler(todoService)
+	commentService := services.NewCommentService(DBCon)
+	testTodosHandler := NewTodosHandler(todoService, commentService)
+
+	shareLinkService := services.NewShareLinkService(DBCon)
+	testShareLinksHandler := NewShareLinksHandler(shareLinkService)
+
+	testCommentsHandler := NewCommentsHandler(commentService)
+
+	attachmentService := services.NewAttachmentService(DBCon)
+	testAttachmentsHandler := NewAttachmentsHandler(attachmentService)
+
+	activityService := services.NewActivityService(DBCon)
+	testActivitiesHandler := NewActivitiesHandler(activityService)
+
+	webhookService := services.NewWebhookService(DBCon)
+	testWebhooksHandler := NewWebhooksHandler(webhookService)
+
+	appPasswordService := services.NewAppPasswordService(DBCon)
+	testAppPasswordsHandler := NewAppPasswordsHandler(appPasswordService)
+
+	calDAVService := services.NewCalDAVService(DBCon, todoService)
+	testCalDAVHandler := NewCalDAVHandler(calDAVService)
+
+	importService := services.NewImportService(DBCon, todoService)
+	testImportsHandler := NewImportsHandler(importService)
+
+	exportService := services.NewExportService(DBCon)
+	testExportsHandler := NewExportsHandler(exportService)
+
+	todoTemplateService := services.NewTodoTemplateService(DBCon)
+	testTodoTemplatesHandler := NewTodoTemplatesHandler(todoTemplateService)
+
+	savedFilterService := services.NewSavedFilterService(DBCon)
+	testSavedFiltersHandler := NewSavedFiltersHandler(savedFilterService)
+
+	timeEntryService := services.NewTimeEntryService(DBCon)
+	testTimeEntriesHandler := NewTimeEntriesHandler(timeEntryService)
+
+	sessionService := services.NewSessionService(DBCon)
+	testSessionsHandler := NewSessionsHandler(sessionService)
+
+	userService := services.NewUserService(DBCon, testMailer)
+	testUsersHandler := NewUsersHandler(userService)
+
+	twoFactorService := services.NewTwoFactorService(DBCon, testMailer)
+	testTwoFactorHandler := NewTwoFactorHandler(twoFactorService)
+	passkeyService := services.NewPasskeyService(DBCon, testMailer)
+	testPasskeysHandler := NewPasskeysHandler(passkeyService)
 
-	mainHandler := NewMainHandler(testAuthHandler, testTodosHandler)
+	mainHandler := NewMainHandler(testAuthHandler, testTodosHandler, testShareLinksHandler, testCommentsHandler, testAttachmentsHandler, testActivitiesHandler, testWebhooksHandler, testAppPasswordsHandler, testImportsHandler, testExportsHandler, testTodoTemplatesHandler, testSavedFiltersHandler, testTimeEntriesHandler, testSessionsHandler, testUsersHandler, testTwoFactorHandler, testPasskeysHandler)
 
-	strictHandler := apis.NewStrictHandler(mainHandler, []apis.StrictMiddlewareFunc{middlewares.AuthMiddleware})
+	strictHandler := apis.NewStrictHandler(mainHandler, []apis.StrictMiddlewareFunc{middlewares.AuthMiddleware(tokenDenylist, sessionService, userService)})
 	apis.RegisterHandlers(e, strictHandler)
+	RegisterCalDAVHandlers(e, testCalDAVHandler, appPasswordService, userService)
 }
@@ -7,6 +7,7 @@ import (
 	apis "app/openapi"
 	"app/services"
 	"app/utils/routers"
+	"context"
 	"os"
 
 	"github.com/joho/godotenv"
@@ -20,18 +21,64 @@ func main() {
 	e := echo.New()
 
 	// NOTE: service層のインスタンス
-	authService := services.NewAuthService(dbCon)
+	tokenDenylist := services.NewMySQLTokenDenylistStore(dbCon)
+	mailer := services.NewSMTPMailerFromEnv()
+	authService := services.NewAuthService(dbCon, tokenDenylist, mailer)
 	todoService := services.NewTodoService(dbCon)
+	shareLinkService := services.NewShareLinkService(dbCon)
+	commentService := services.NewCommentService(dbCon)
+	attachmentService := services.NewAttachmentService(dbCon)
+	activityService := services.NewActivityService(dbCon)
+	webhookService := services.NewWebhookService(dbCon)
+	appPasswordService := services.NewAppPasswordService(dbCon)
+	calDAVService := services.NewCalDAVService(dbCon, todoService)
+	importService := services.NewImportService(dbCon, todoService)
+	exportService := services.NewExportService(dbCon)
+	todoTemplateService := services.NewTodoTemplateService(dbCon)
+	savedFilterService := services.NewSavedFilterService(dbCon)
+	timeEntryService := services.NewTimeEntryService(dbCon)
+	sessionService := services.NewSessionService(dbCon)
+	userService := services.NewUserService(dbCon, mailer)
+	twoFactorService := services.NewTwoFactorService(dbCon, mailer)
+	passkeyService := services.NewPasskeyService(dbCon, mailer)
+	outboxRelay := services.NewOutboxRelay(dbCon, services.NewLogOutboxSink())
 
 	// NOTE: Handlerのインスタンス化
 	authHandler := handlers.NewAuthHandler(authService)
-	todosHandler := handlers.NewTodosHandler(todoService)
-	mainHandler := handlers.NewMainHandler(authHandler, todosHandler)
+	todosHandler := handlers.NewTodosHandler(todoService, commentService)
+	shareLinksHandler := handlers.NewShareLinksHandler(shareLinkService)
+	commentsHandler := handlers.NewCommentsHandler(commentService)
+	attachmentsHandler := handlers.NewAttachmentsHandler(attachmentService)
+	activitiesHandler := handlers.NewActivitiesHandler(activityService)
+	webhooksHandler := handlers.NewWebhooksHandler(webhookService)
+	appPasswordsHandler := handlers.NewAppPasswordsHandler(appPasswordService)
+	calDAVHandler := handlers.NewCalDAVHandler(calDAVService)
+	importsHandler := handlers.NewImportsHandler(importService)
+	exportsHandler := handlers.NewExportsHandler(exportService)
+	todoTemplatesHandler := handlers.NewTodoTemplatesHandler(todoTemplateService)
+	savedFiltersHandler := handlers.NewSavedFiltersHandler(savedFilterService)
+	timeEntriesHandler := handlers.NewTimeEntriesHandler(timeEntryService)
+	sessionsHandler := handlers.NewSessionsHandler(sessionService)
+	usersHandler := handlers.NewUsersHandler(userService)
+	twoFactorHandler := handlers.NewTwoFactorHandler(twoFactorService)
+	passkeysHandler := handlers.NewPasskeysHandler(passkeyService)
+	mainHandler := handlers.NewMainHandler(authHandler, todosHandler, shareLinksHandler, commentsHandler, attachmentsHandler, activitiesHandler, webhooksHandler, appPasswordsHandler, importsHandler, exportsHandler, todoTemplatesHandler, savedFiltersHandler, timeEntriesHandler, sessionsHandler, usersHandler, twoFactorHandler, passkeysHandler)
 	
-	mainStrictHandler := apis.NewStrictHandler(mainHandler, []apis.StrictMiddlewareFunc{middlewares.AuthMiddleware})
+	mainStrictHandler := apis.NewStrictHandler(mainHandler, []apis.StrictMiddlewareFunc{middlewares.AuthMiddleware(tokenDenylist, sessionService, userService)})
+
+	// NOTE: Webhookの配信キューを処理するワーカーを起動
+	go services.RunWebhookWorker(context.Background(), webhookService)
+	// NOTE: outboxに記録されたドメインイベントを配信先に送るリレーを起動
+	go services.RunOutboxRelay(context.Background(), outboxRelay)
+	// NOTE: 登録されたインポートを非同期に処理するワーカーを起動
+	go services.RunImportWorker(context.Background(), importService)
+	// NOTE: 有効期限を過ぎた失効トークンを定期的に削除する
+	go services.RunTokenDenylistPurger(context.Background(), tokenDenylist)
 
 	appliedMiddlewareEcho := routers.ApplyMiddlewares(e)
 	apis.RegisterHandlers(appliedMiddlewareEcho, mainStrictHandler)
+	// NOTE: CalDAVはOpenAPIの定義外のため個別にルーティングする
+	handlers.RegisterCalDAVHandlers(appliedMiddlewareEcho, calDAVHandler, appPasswordService, userService)
 
 	appliedMiddlewareEcho.Logger.Fatal(appliedMiddlewareEcho.Start(":" + os.Getenv("SERVER_PORT")))
 }
@@ -2,54 +2,116 @@ package middlewares
 
 import (
 	apis "app/openapi"
+	"app/services"
 	"app/utils"
 	"context"
-	"fmt"
+	"errors"
 	"net/http"
-	"os"
+	"time"
 
-	"github.com/golang-jwt/jwt"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"golang.org/x/exp/slices"
 )
 
-func AuthMiddleware(f apis.StrictHandlerFunc, operationID string) apis.StrictHandlerFunc {
-    return func(ctx echo.Context, i interface{}) (interface{}, error) {
-		if !needsAuthenticate(operationID) {
-			// NOTE: 認証が不要なURIは認証をスキップ
+// AuthMiddleware ... 認証トークンを検証し、失効済みでなければuserIDをcontextに格納する
+func AuthMiddleware(tokenDenylist services.TokenDenylistStore, sessionService services.SessionService, userService services.UserService) apis.StrictMiddlewareFunc {
+	return func(f apis.StrictHandlerFunc, operationID string) apis.StrictHandlerFunc {
+		return func(ctx echo.Context, i interface{}) (interface{}, error) {
+			// NOTE: サインイン時にセッションへ記録するため、端末の情報をcontextに格納する
+			client := utils.Client{UserAgent: ctx.Request().UserAgent(), IPAddress: ctx.RealIP()}
+			ctx.SetRequest(ctx.Request().WithContext(utils.NewClientContext(ctx.Request().Context(), client)))
+
+			if !needsAuthenticate(operationID) {
+				// NOTE: 認証が不要なURIは認証をスキップ
+				return f(ctx, i)
+			}
+
+			// NOTE: Cookieからtokenを取得し、JWTの検証
+			var tokenString string
+			if cookie, _ := ctx.Cookie("token"); cookie != nil {
+				tokenString = cookie.Value
+			}
+			now := time.Now()
+			authToken, err := services.VerifyAuthToken(tokenString, now)
+			if err != nil {
+				return nil, unauthorizedError(err)
+			}
+
+			// NOTE: サインアウト済みのトークンは拒否する
+			isRevoked, err := tokenDenylist.IsRevoked(ctx.Request().Context(), authToken.ID, now)
+			if err != nil {
+				return nil, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+			}
+			if isRevoked {
+				return nil, unauthorizedError(&services.AuthTokenError{Reason: apis.TokenRevoked, Message: "認証トークンは失効しています。"})
+			}
+
+			// NOTE: 失効したセッションのトークンを拒否し、最終アクセス日時を更新する
+			isActive, err := sessionService.TouchSession(ctx.Request().Context(), authToken.SessionID, int64(authToken.UserID), now)
+			if err != nil {
+				return nil, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+			}
+			if !isActive {
+				return nil, unauthorizedError(&services.AuthTokenError{Reason: apis.SessionRevoked, Message: "セッションは失効しています。"})
+			}
+
+			// NOTE: メールアドレスが未確認のユーザには、参照とアカウント関連以外の操作を許可しない
+			if services.CurrentEmailVerificationPolicy() == services.EmailVerificationPolicyRestrict && isRestrictedForUnverifiedEmail(operationID, ctx.Request().Method) {
+				isVerified, err := userService.IsEmailVerified(ctx.Request().Context(), int64(authToken.UserID))
+				if err != nil {
+					return nil, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+				}
+				if !isVerified {
+					return nil, echo.NewHTTPError(http.StatusForbidden, "メールアドレスの確認が完了していません。確認メールに記載のURLを開いてください。")
+				}
+			}
+
+			// NOTE: contextにuserIDを格納する
+			//     : コントローラ側ではcontext.Context型のため、withValue - Valueで行う
+			c := utils.NewContext(ctx.Request().Context(), authToken.UserID)
+			c = utils.NewAuthTokenContext(c, utils.AuthToken{SessionID: authToken.SessionID, ID: authToken.ID, ExpiresAt: authToken.ExpiresAt})
+			ctx.SetRequest(ctx.Request().WithContext(c))
 			return f(ctx, i)
 		}
-		
-        // NOTE: Cookieからtokenを取得し、JWTの復号
-		tokenString, _ := ctx.Cookie("token")
-		if tokenString == nil {
-			return nil, echo.ErrUnauthorized
-		}
+	}
+}
 
-		token, _ := jwt.Parse(tokenString.Value, func(token *jwt.Token) (interface{}, error) {
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+// NOTE: 参照系の操作と、auth・usersタグの操作は制限しない
+func isRestrictedForUnverifiedEmail(operationID string, method string) bool {
+	if method == http.MethodGet || method == http.MethodHead {
+		return false
+	}
+	spec, _ := apis.GetSwagger()
+	for _, pathItem := range spec.Paths.Map() {
+		for _, op := range pathItem.Operations() {
+			if op.OperationID != operationID {
+				continue
 			}
-			return []byte(os.Getenv("JWT_TOKEN_KEY")), nil
-		})
-
-		// NOTE: userIDをContextにセット
-		var userID int
-		if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-			userID = int(claims["user_id"].(float64))
+			return !slices.Contains(op.Tags, "auth") && !slices.Contains(op.Tags, "users")
 		}
+	}
+	return true
+}
 
-		// NOTE: contextにuserIDを格納する
-		//     : コントローラ側ではcontext.Context型のため、withValue - Valueで行う
-		c := utils.NewContext(ctx.Request().Context(), userID)
-		ctx.SetRequest(ctx.Request().WithContext(c))
-        return f(ctx, i)
-    }
+// NOTE: 検証に失敗した理由をレスポンスに含めて401を返す
+func unauthorizedError(err error) error {
+	res := apis.UnauthorizedErrorResponse{Code: http.StatusUnauthorized, Message: err.Error()}
+	var authTokenErr *services.AuthTokenError
+	if errors.As(err, &authTokenErr) {
+		res.Reason = &authTokenErr.Reason
+	}
+	return echo.NewHTTPError(http.StatusUnauthorized, res)
 }
 
 // CSRFContextMiddleware ... CSRFトークンを context.Context に埋め込むミドルウェア
 func CSRFContextMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
+		// NOTE: CSRF対策の対象外のリクエストはトークンが発行されないためスキップ
+		if IsCalDAVRequest(c) {
+			return next(c)
+		}
+
 		// NOTE: EchoのcontextからCSRFトークンを取得
 		token, ok := c.Get(middleware.DefaultCSRFConfig.ContextKey).(string)
 		if !ok {
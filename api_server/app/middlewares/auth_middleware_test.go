@@ -0,0 +1,178 @@
+package middlewares
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/services"
+	"app/test/factories"
+	"app/utils"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type TestAuthMiddlewareSuite struct {
+	WithDBSuite
+}
+
+var (
+	user              *models.User
+	session           *models.Session
+	testTokenDenylist services.TokenDenylistStore
+	testMiddleware    apis.StrictMiddlewareFunc
+)
+
+func (s *TestAuthMiddlewareSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザとセッションの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	session = &models.Session{UserID: int64(user.ID), FamilyID: "test-family", UserAgent: "test agent", IPAddress: "192.0.2.1", LastSeenAt: time.Now().Add(-time.Hour).Truncate(time.Second)}
+	if err := session.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test session %v", err)
+	}
+
+	testTokenDenylist = services.NewMemoryTokenDenylistStore()
+	testMiddleware = AuthMiddleware(testTokenDenylist, services.NewSessionService(DBCon), services.NewUserService(DBCon, services.NewMemoryMailer()))
+}
+
+func (s *TestAuthMiddlewareSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *TestAuthMiddlewareSuite) signAuthToken(now time.Time) string {
+	tokenString, err := services.SignAuthToken(user.ID, session.ID, now)
+	if err != nil {
+		s.T().Fatalf("failed to sign auth token %v", err)
+	}
+	return tokenString
+}
+
+// NOTE: ミドルウェアを通してハンドラを呼び出し、ハンドラに渡されたuserIDを返す
+func (s *TestAuthMiddlewareSuite) serve(method string, operationID string, tokenString string) (userID int64, isCalled bool, err error) {
+	req := httptest.NewRequest(method, "/", nil)
+	if tokenString != "" {
+		req.AddCookie(&http.Cookie{Name: "token", Value: tokenString})
+	}
+	c := echo.New().NewContext(req, httptest.NewRecorder())
+
+	handler := testMiddleware(func(ctx echo.Context, i interface{}) (interface{}, error) {
+		isCalled = true
+		userID, _ = utils.ContextValue(ctx.Request().Context())
+		return nil, nil
+	}, operationID)
+	_, err = handler(c, nil)
+	return userID, isCalled, err
+}
+
+func (s *TestAuthMiddlewareSuite) assertUnauthorized(err error, reason apis.UnauthorizedReason) {
+	httpErr, ok := err.(*echo.HTTPError)
+	if !assert.True(s.T(), ok) {
+		return
+	}
+	assert.Equal(s.T(), http.StatusUnauthorized, httpErr.Code)
+	res, ok := httpErr.Message.(apis.UnauthorizedErrorResponse)
+	if assert.True(s.T(), ok) && assert.NotNil(s.T(), res.Reason) {
+		assert.Equal(s.T(), reason, *res.Reason)
+	}
+}
+
+func (s *TestAuthMiddlewareSuite) TestAuthMiddleware_StatusOk() {
+	userID, isCalled, err := s.serve(http.MethodGet, "GetTodos", s.signAuthToken(time.Now()))
+
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), isCalled)
+	assert.Equal(s.T(), int64(user.ID), userID)
+
+	// NOTE: セッションの最終アクセス日時が更新されていることを確認
+	session.Reload(ctx, DBCon)
+	assert.WithinDuration(s.T(), time.Now(), session.LastSeenAt, 2*time.Second)
+}
+
+func (s *TestAuthMiddlewareSuite) TestAuthMiddleware_SkipAuthentication() {
+	// NOTE: 認証が不要な操作はトークンがなくても呼び出せること
+	_, isCalled, err := s.serve(http.MethodPost, "PostAuthSignIn", "")
+
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), isCalled)
+}
+
+func (s *TestAuthMiddlewareSuite) TestAuthMiddleware_InvalidToken() {
+	_, isCalled, err := s.serve(http.MethodGet, "GetTodos", "")
+	assert.False(s.T(), isCalled)
+	s.assertUnauthorized(err, apis.TokenMissing)
+
+	_, _, err = s.serve(http.MethodGet, "GetTodos", s.signAuthToken(time.Now())+"x")
+	s.assertUnauthorized(err, apis.SignatureInvalid)
+}
+
+func (s *TestAuthMiddlewareSuite) TestAuthMiddleware_ExpiredToken() {
+	_, isCalled, err := s.serve(http.MethodGet, "GetTodos", s.signAuthToken(time.Now().Add(-time.Hour)))
+
+	assert.False(s.T(), isCalled)
+	s.assertUnauthorized(err, apis.TokenExpired)
+}
+
+func (s *TestAuthMiddlewareSuite) TestAuthMiddleware_RevokedToken() {
+	tokenString := s.signAuthToken(time.Now())
+	authToken, _ := services.VerifyAuthToken(tokenString, time.Now())
+	if err := testTokenDenylist.Revoke(ctx, authToken.ID, authToken.ExpiresAt); err != nil {
+		s.T().Fatalf("failed to revoke auth token %v", err)
+	}
+
+	_, isCalled, err := s.serve(http.MethodGet, "GetTodos", tokenString)
+
+	assert.False(s.T(), isCalled)
+	s.assertUnauthorized(err, apis.TokenRevoked)
+}
+
+func (s *TestAuthMiddlewareSuite) TestAuthMiddleware_RevokedSession() {
+	session.RevokedAt = null.TimeFrom(time.Now())
+	if _, err := session.Update(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to revoke test session %v", err)
+	}
+
+	_, isCalled, err := s.serve(http.MethodGet, "GetTodos", s.signAuthToken(time.Now()))
+
+	assert.False(s.T(), isCalled)
+	s.assertUnauthorized(err, apis.SessionRevoked)
+}
+
+func (s *TestAuthMiddlewareSuite) TestAuthMiddleware_EmailUnverified() {
+	s.T().Setenv("EMAIL_VERIFICATION_POLICY", string(services.EmailVerificationPolicyRestrict))
+	user.EmailVerifiedAt = null.Time{}
+	if _, err := user.Update(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to update test user %v", err)
+	}
+	tokenString := s.signAuthToken(time.Now())
+
+	// NOTE: 更新系の操作は403となること
+	_, isCalled, err := s.serve(http.MethodPost, "PostTodos", tokenString)
+	assert.False(s.T(), isCalled)
+	if httpErr, ok := err.(*echo.HTTPError); assert.True(s.T(), ok) {
+		assert.Equal(s.T(), http.StatusForbidden, httpErr.Code)
+	}
+
+	// NOTE: 参照系とauthタグの操作は許可されること
+	_, isCalled, err = s.serve(http.MethodGet, "GetTodos", tokenString)
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), isCalled)
+	_, isCalled, err = s.serve(http.MethodPost, "PostAuthSignOut", tokenString)
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), isCalled)
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestAuthMiddlewareSuite))
+}
@@ -0,0 +1,60 @@
+package middlewares
+
+import (
+	"app/services"
+	"app/utils"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+// IsCalDAVRequest ... CalDAVクライアントからのリクエストか判定する
+//                  : ブラウザ以外からのアクセスのため、CORS・CSRF対策の対象外とする
+func IsCalDAVRequest(c echo.Context) bool {
+	path := c.Request().URL.Path
+	return path == "/caldav" || strings.HasPrefix(path, "/caldav/") || path == "/.well-known/caldav"
+}
+
+// CalDAVAuthMiddleware ... メールアドレスとアプリパスワードによるBasic認証
+func CalDAVAuthMiddleware(appPasswordService services.AppPasswordService, userService services.UserService) echo.MiddlewareFunc {
+	return middleware.BasicAuthWithConfig(middleware.BasicAuthConfig{
+		Realm: "CalDAV",
+		Validator: func(email string, password string, c echo.Context) (bool, error) {
+			statusCode, userID, err := appPasswordService.Authenticate(c.Request().Context(), email, password)
+			switch statusCode {
+			case http.StatusUnauthorized:
+				return false, nil
+			case http.StatusInternalServerError:
+				return false, err
+			}
+
+			// NOTE: AuthMiddlewareと同じく、メールアドレスが未確認のユーザの操作を制限する
+			policy := services.CurrentEmailVerificationPolicy()
+			if policy == services.EmailVerificationPolicyBlock || (policy == services.EmailVerificationPolicyRestrict && isCalDAVWriteMethod(c.Request().Method)) {
+				isVerified, err := userService.IsEmailVerified(c.Request().Context(), userID)
+				if err != nil {
+					return false, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+				}
+				if !isVerified {
+					return false, echo.NewHTTPError(http.StatusForbidden, "メールアドレスの確認が完了していません。確認メールに記載のURLを開いてください。")
+				}
+			}
+
+			// NOTE: contextにuserIDを格納する
+			ctx := utils.NewContext(c.Request().Context(), int(userID))
+			c.SetRequest(c.Request().WithContext(ctx))
+			return true, nil
+		},
+	})
+}
+
+// NOTE: PROPFIND・REPORTは参照系の操作のため、GETと同様に制限しない
+func isCalDAVWriteMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, echo.PROPFIND, echo.REPORT:
+		return false
+	}
+	return true
+}
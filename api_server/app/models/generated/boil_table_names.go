@@ -4,11 +4,59 @@
 package models
 
 var TableNames = struct {
-	GorpMigrations string
-	Todos          string
-	Users          string
+	Activities           string
+	AppPasswords         string
+	Attachments          string
+	CaldavObjects        string
+	Comments             string
+	GorpMigrations       string
+	Imports              string
+	MentionNotifications string
+	Outbox               string
+	PasswordResetTokens  string
+	RecoveryCodes        string
+	RefreshTokens        string
+	RevokedTokens        string
+	SavedFilters         string
+	Sessions             string
+	ShareLinks           string
+	SignInFailures       string
+	SignInLockouts       string
+	TimeEntries          string
+	TodoTemplates        string
+	Todos                string
+	TwoFactorChallenges  string
+	Users                string
+	WebauthnCeremonies   string
+	WebauthnCredentials  string
+	WebhookDeliveries    string
+	Webhooks             string
 }{
-	GorpMigrations: "gorp_migrations",
-	Todos:          "todos",
-	Users:          "users",
+	Activities:           "activities",
+	AppPasswords:         "app_passwords",
+	Attachments:          "attachments",
+	CaldavObjects:        "caldav_objects",
+	Comments:             "comments",
+	GorpMigrations:       "gorp_migrations",
+	Imports:              "imports",
+	MentionNotifications: "mention_notifications",
+	Outbox:               "outbox",
+	PasswordResetTokens:  "password_reset_tokens",
+	RecoveryCodes:        "recovery_codes",
+	RefreshTokens:        "refresh_tokens",
+	RevokedTokens:        "revoked_tokens",
+	SavedFilters:         "saved_filters",
+	Sessions:             "sessions",
+	ShareLinks:           "share_links",
+	SignInFailures:       "sign_in_failures",
+	SignInLockouts:       "sign_in_lockouts",
+	TimeEntries:          "time_entries",
+	TodoTemplates:        "todo_templates",
+	Todos:                "todos",
+	TwoFactorChallenges:  "two_factor_challenges",
+	Users:                "users",
+	WebauthnCeremonies:   "webauthn_ceremonies",
+	WebauthnCredentials:  "webauthn_credentials",
+	WebhookDeliveries:    "webhook_deliveries",
+	Webhooks:             "webhooks",
 }
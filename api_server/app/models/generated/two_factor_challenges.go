@@ -0,0 +1,1545 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// TwoFactorChallenge is an object representing the database table.
+type TwoFactorChallenge struct {
+	ID        int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID    int64     `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	TokenHash string    `boil:"token_hash" json:"token_hash" toml:"token_hash" yaml:"token_hash"`
+	Attempts  int       `boil:"attempts" json:"attempts" toml:"attempts" yaml:"attempts"`
+	ExpiresAt time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	UsedAt    null.Time `boil:"used_at" json:"used_at,omitempty" toml:"used_at" yaml:"used_at,omitempty"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *twoFactorChallengeR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L twoFactorChallengeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TwoFactorChallengeColumns = struct {
+	ID        string
+	UserID    string
+	TokenHash string
+	Attempts  string
+	ExpiresAt string
+	UsedAt    string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "id",
+	UserID:    "user_id",
+	TokenHash: "token_hash",
+	Attempts:  "attempts",
+	ExpiresAt: "expires_at",
+	UsedAt:    "used_at",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+var TwoFactorChallengeTableColumns = struct {
+	ID        string
+	UserID    string
+	TokenHash string
+	Attempts  string
+	ExpiresAt string
+	UsedAt    string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "two_factor_challenges.id",
+	UserID:    "two_factor_challenges.user_id",
+	TokenHash: "two_factor_challenges.token_hash",
+	Attempts:  "two_factor_challenges.attempts",
+	ExpiresAt: "two_factor_challenges.expires_at",
+	UsedAt:    "two_factor_challenges.used_at",
+	CreatedAt: "two_factor_challenges.created_at",
+	UpdatedAt: "two_factor_challenges.updated_at",
+}
+
+// Generated where
+
+var TwoFactorChallengeWhere = struct {
+	ID        whereHelperint64
+	UserID    whereHelperint64
+	TokenHash whereHelperstring
+	Attempts  whereHelperint
+	ExpiresAt whereHelpertime_Time
+	UsedAt    whereHelpernull_Time
+	CreatedAt whereHelpertime_Time
+	UpdatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperint64{field: "`two_factor_challenges`.`id`"},
+	UserID:    whereHelperint64{field: "`two_factor_challenges`.`user_id`"},
+	TokenHash: whereHelperstring{field: "`two_factor_challenges`.`token_hash`"},
+	Attempts:  whereHelperint{field: "`two_factor_challenges`.`attempts`"},
+	ExpiresAt: whereHelpertime_Time{field: "`two_factor_challenges`.`expires_at`"},
+	UsedAt:    whereHelpernull_Time{field: "`two_factor_challenges`.`used_at`"},
+	CreatedAt: whereHelpertime_Time{field: "`two_factor_challenges`.`created_at`"},
+	UpdatedAt: whereHelpertime_Time{field: "`two_factor_challenges`.`updated_at`"},
+}
+
+// TwoFactorChallengeRels is where relationship names are stored.
+var TwoFactorChallengeRels = struct {
+}{}
+
+// twoFactorChallengeR is where relationships are stored.
+type twoFactorChallengeR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*twoFactorChallengeR) NewStruct() *twoFactorChallengeR {
+	return &twoFactorChallengeR{}
+}
+
+// twoFactorChallengeL is where Load methods for each relationship are stored.
+type twoFactorChallengeL struct{}
+
+var (
+	twoFactorChallengeAllColumns            = []string{"id", "user_id", "token_hash", "attempts", "expires_at", "used_at", "created_at", "updated_at"}
+	twoFactorChallengeColumnsWithoutDefault = []string{"user_id", "token_hash", "expires_at", "used_at", "created_at", "updated_at"}
+	twoFactorChallengeColumnsWithDefault    = []string{"id", "attempts"}
+	twoFactorChallengePrimaryKeyColumns     = []string{"id"}
+	twoFactorChallengeGeneratedColumns      = []string{}
+)
+
+type (
+	// TwoFactorChallengeSlice is an alias for a slice of pointers to TwoFactorChallenge.
+	// This should almost always be used instead of []TwoFactorChallenge.
+	TwoFactorChallengeSlice []*TwoFactorChallenge
+	// TwoFactorChallengeHook is the signature for custom TwoFactorChallenge hook methods
+	TwoFactorChallengeHook func(context.Context, boil.ContextExecutor, *TwoFactorChallenge) error
+
+	twoFactorChallengeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	twoFactorChallengeType                 = reflect.TypeOf(&TwoFactorChallenge{})
+	twoFactorChallengeMapping              = queries.MakeStructMapping(twoFactorChallengeType)
+	twoFactorChallengePrimaryKeyMapping, _ = queries.BindMapping(twoFactorChallengeType, twoFactorChallengeMapping, twoFactorChallengePrimaryKeyColumns)
+	twoFactorChallengeInsertCacheMut       sync.RWMutex
+	twoFactorChallengeInsertCache          = make(map[string]insertCache)
+	twoFactorChallengeUpdateCacheMut       sync.RWMutex
+	twoFactorChallengeUpdateCache          = make(map[string]updateCache)
+	twoFactorChallengeUpsertCacheMut       sync.RWMutex
+	twoFactorChallengeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var twoFactorChallengeAfterSelectMu sync.Mutex
+var twoFactorChallengeAfterSelectHooks []TwoFactorChallengeHook
+
+var twoFactorChallengeBeforeInsertMu sync.Mutex
+var twoFactorChallengeBeforeInsertHooks []TwoFactorChallengeHook
+var twoFactorChallengeAfterInsertMu sync.Mutex
+var twoFactorChallengeAfterInsertHooks []TwoFactorChallengeHook
+
+var twoFactorChallengeBeforeUpdateMu sync.Mutex
+var twoFactorChallengeBeforeUpdateHooks []TwoFactorChallengeHook
+var twoFactorChallengeAfterUpdateMu sync.Mutex
+var twoFactorChallengeAfterUpdateHooks []TwoFactorChallengeHook
+
+var twoFactorChallengeBeforeDeleteMu sync.Mutex
+var twoFactorChallengeBeforeDeleteHooks []TwoFactorChallengeHook
+var twoFactorChallengeAfterDeleteMu sync.Mutex
+var twoFactorChallengeAfterDeleteHooks []TwoFactorChallengeHook
+
+var twoFactorChallengeBeforeUpsertMu sync.Mutex
+var twoFactorChallengeBeforeUpsertHooks []TwoFactorChallengeHook
+var twoFactorChallengeAfterUpsertMu sync.Mutex
+var twoFactorChallengeAfterUpsertHooks []TwoFactorChallengeHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TwoFactorChallenge) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range twoFactorChallengeAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TwoFactorChallenge) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range twoFactorChallengeBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TwoFactorChallenge) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range twoFactorChallengeAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TwoFactorChallenge) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range twoFactorChallengeBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TwoFactorChallenge) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range twoFactorChallengeAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TwoFactorChallenge) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range twoFactorChallengeBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TwoFactorChallenge) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range twoFactorChallengeAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TwoFactorChallenge) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range twoFactorChallengeBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TwoFactorChallenge) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range twoFactorChallengeAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTwoFactorChallengeHook registers your hook function for all future operations.
+func AddTwoFactorChallengeHook(hookPoint boil.HookPoint, twoFactorChallengeHook TwoFactorChallengeHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		twoFactorChallengeAfterSelectMu.Lock()
+		twoFactorChallengeAfterSelectHooks = append(twoFactorChallengeAfterSelectHooks, twoFactorChallengeHook)
+		twoFactorChallengeAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		twoFactorChallengeBeforeInsertMu.Lock()
+		twoFactorChallengeBeforeInsertHooks = append(twoFactorChallengeBeforeInsertHooks, twoFactorChallengeHook)
+		twoFactorChallengeBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		twoFactorChallengeAfterInsertMu.Lock()
+		twoFactorChallengeAfterInsertHooks = append(twoFactorChallengeAfterInsertHooks, twoFactorChallengeHook)
+		twoFactorChallengeAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		twoFactorChallengeBeforeUpdateMu.Lock()
+		twoFactorChallengeBeforeUpdateHooks = append(twoFactorChallengeBeforeUpdateHooks, twoFactorChallengeHook)
+		twoFactorChallengeBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		twoFactorChallengeAfterUpdateMu.Lock()
+		twoFactorChallengeAfterUpdateHooks = append(twoFactorChallengeAfterUpdateHooks, twoFactorChallengeHook)
+		twoFactorChallengeAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		twoFactorChallengeBeforeDeleteMu.Lock()
+		twoFactorChallengeBeforeDeleteHooks = append(twoFactorChallengeBeforeDeleteHooks, twoFactorChallengeHook)
+		twoFactorChallengeBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		twoFactorChallengeAfterDeleteMu.Lock()
+		twoFactorChallengeAfterDeleteHooks = append(twoFactorChallengeAfterDeleteHooks, twoFactorChallengeHook)
+		twoFactorChallengeAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		twoFactorChallengeBeforeUpsertMu.Lock()
+		twoFactorChallengeBeforeUpsertHooks = append(twoFactorChallengeBeforeUpsertHooks, twoFactorChallengeHook)
+		twoFactorChallengeBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		twoFactorChallengeAfterUpsertMu.Lock()
+		twoFactorChallengeAfterUpsertHooks = append(twoFactorChallengeAfterUpsertHooks, twoFactorChallengeHook)
+		twoFactorChallengeAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single twoFactorChallenge record from the query.
+func (q twoFactorChallengeQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TwoFactorChallenge, error) {
+	o := &TwoFactorChallenge{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for two_factor_challenges")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TwoFactorChallenge records from the query.
+func (q twoFactorChallengeQuery) All(ctx context.Context, exec boil.ContextExecutor) (TwoFactorChallengeSlice, error) {
+	var o []*TwoFactorChallenge
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to TwoFactorChallenge slice")
+	}
+
+	if len(twoFactorChallengeAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TwoFactorChallenge records in the query.
+func (q twoFactorChallengeQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count two_factor_challenges rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q twoFactorChallengeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if two_factor_challenges exists")
+	}
+
+	return count > 0, nil
+}
+
+// TwoFactorChallenges retrieves all the records using an executor.
+func TwoFactorChallenges(mods ...qm.QueryMod) twoFactorChallengeQuery {
+	mods = append(mods, qm.From("`two_factor_challenges`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`two_factor_challenges`.*"})
+	}
+
+	return twoFactorChallengeQuery{q}
+}
+
+// FindTwoFactorChallenge retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTwoFactorChallenge(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*TwoFactorChallenge, error) {
+	twoFactorChallengeObj := &TwoFactorChallenge{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `two_factor_challenges` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, twoFactorChallengeObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from two_factor_challenges")
+	}
+
+	if err = twoFactorChallengeObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return twoFactorChallengeObj, err
+	}
+
+	return twoFactorChallengeObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TwoFactorChallenge) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no two_factor_challenges provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(twoFactorChallengeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	twoFactorChallengeInsertCacheMut.RLock()
+	cache, cached := twoFactorChallengeInsertCache[key]
+	twoFactorChallengeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			twoFactorChallengeAllColumns,
+			twoFactorChallengeColumnsWithDefault,
+			twoFactorChallengeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(twoFactorChallengeType, twoFactorChallengeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(twoFactorChallengeType, twoFactorChallengeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `two_factor_challenges` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `two_factor_challenges` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `two_factor_challenges` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, twoFactorChallengePrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into two_factor_challenges")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == twoFactorChallengeMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for two_factor_challenges")
+	}
+
+CacheNoHooks:
+	if !cached {
+		twoFactorChallengeInsertCacheMut.Lock()
+		twoFactorChallengeInsertCache[key] = cache
+		twoFactorChallengeInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TwoFactorChallenge.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TwoFactorChallenge) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	twoFactorChallengeUpdateCacheMut.RLock()
+	cache, cached := twoFactorChallengeUpdateCache[key]
+	twoFactorChallengeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			twoFactorChallengeAllColumns,
+			twoFactorChallengePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update two_factor_challenges, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `two_factor_challenges` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, twoFactorChallengePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(twoFactorChallengeType, twoFactorChallengeMapping, append(wl, twoFactorChallengePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update two_factor_challenges row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for two_factor_challenges")
+	}
+
+	if !cached {
+		twoFactorChallengeUpdateCacheMut.Lock()
+		twoFactorChallengeUpdateCache[key] = cache
+		twoFactorChallengeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q twoFactorChallengeQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for two_factor_challenges")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for two_factor_challenges")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TwoFactorChallengeSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), twoFactorChallengePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `two_factor_challenges` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, twoFactorChallengePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in twoFactorChallenge slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all twoFactorChallenge")
+	}
+	return rowsAff, nil
+}
+
+var mySQLTwoFactorChallengeUniqueColumns = []string{
+	"id",
+	"token_hash",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TwoFactorChallenge) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no two_factor_challenges provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(twoFactorChallengeColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLTwoFactorChallengeUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	twoFactorChallengeUpsertCacheMut.RLock()
+	cache, cached := twoFactorChallengeUpsertCache[key]
+	twoFactorChallengeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			twoFactorChallengeAllColumns,
+			twoFactorChallengeColumnsWithDefault,
+			twoFactorChallengeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			twoFactorChallengeAllColumns,
+			twoFactorChallengePrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert two_factor_challenges, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(twoFactorChallengeAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`two_factor_challenges`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `two_factor_challenges` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(twoFactorChallengeType, twoFactorChallengeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(twoFactorChallengeType, twoFactorChallengeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for two_factor_challenges")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == twoFactorChallengeMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(twoFactorChallengeType, twoFactorChallengeMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for two_factor_challenges")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for two_factor_challenges")
+	}
+
+CacheNoHooks:
+	if !cached {
+		twoFactorChallengeUpsertCacheMut.Lock()
+		twoFactorChallengeUpsertCache[key] = cache
+		twoFactorChallengeUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TwoFactorChallenge record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TwoFactorChallenge) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no TwoFactorChallenge provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), twoFactorChallengePrimaryKeyMapping)
+	sql := "DELETE FROM `two_factor_challenges` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from two_factor_challenges")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for two_factor_challenges")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q twoFactorChallengeQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no twoFactorChallengeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from two_factor_challenges")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for two_factor_challenges")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TwoFactorChallengeSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(twoFactorChallengeBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), twoFactorChallengePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `two_factor_challenges` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, twoFactorChallengePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from twoFactorChallenge slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for two_factor_challenges")
+	}
+
+	if len(twoFactorChallengeAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TwoFactorChallenge) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTwoFactorChallenge(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TwoFactorChallengeSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TwoFactorChallengeSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), twoFactorChallengePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `two_factor_challenges`.* FROM `two_factor_challenges` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, twoFactorChallengePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in TwoFactorChallengeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TwoFactorChallengeExists checks if the TwoFactorChallenge row exists.
+func TwoFactorChallengeExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `two_factor_challenges` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if two_factor_challenges exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TwoFactorChallenge row exists.
+func (o *TwoFactorChallenge) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TwoFactorChallengeExists(ctx, exec, o.ID)
+}
+
+// /////////////////////////////// BEGIN EXTENSIONS /////////////////////////////////
+// Expose table columns
+var (
+	TwoFactorChallengeAllColumns            = twoFactorChallengeAllColumns
+	TwoFactorChallengeColumnsWithoutDefault = twoFactorChallengeColumnsWithoutDefault
+	TwoFactorChallengeColumnsWithDefault    = twoFactorChallengeColumnsWithDefault
+	TwoFactorChallengePrimaryKeyColumns     = twoFactorChallengePrimaryKeyColumns
+	TwoFactorChallengeGeneratedColumns      = twoFactorChallengeGeneratedColumns
+)
+
+// GetID get ID from model object
+func (o *TwoFactorChallenge) GetID() int64 {
+	return o.ID
+}
+
+// GetIDs extract IDs from model objects
+func (s TwoFactorChallengeSlice) GetIDs() []int64 {
+	result := make([]int64, len(s))
+	for i := range s {
+		result[i] = s[i].ID
+	}
+	return result
+}
+
+// GetIntfIDs extract IDs from model objects as interface slice
+func (s TwoFactorChallengeSlice) GetIntfIDs() []interface{} {
+	result := make([]interface{}, len(s))
+	for i := range s {
+		result[i] = s[i].ID
+	}
+	return result
+}
+
+// ToIDMap convert a slice of model objects to a map with ID as key
+func (s TwoFactorChallengeSlice) ToIDMap() map[int64]*TwoFactorChallenge {
+	result := make(map[int64]*TwoFactorChallenge, len(s))
+	for _, o := range s {
+		result[o.ID] = o
+	}
+	return result
+}
+
+// ToUniqueItems construct a slice of unique items from the given slice
+func (s TwoFactorChallengeSlice) ToUniqueItems() TwoFactorChallengeSlice {
+	result := make(TwoFactorChallengeSlice, 0, len(s))
+	mapChk := make(map[int64]struct{}, len(s))
+	for i := len(s) - 1; i >= 0; i-- {
+		o := s[i]
+		if _, ok := mapChk[o.ID]; !ok {
+			mapChk[o.ID] = struct{}{}
+			result = append(result, o)
+		}
+	}
+	return result
+}
+
+// FindItemByID find item by ID in the slice
+func (s TwoFactorChallengeSlice) FindItemByID(id int64) *TwoFactorChallenge {
+	for _, o := range s {
+		if o.ID == id {
+			return o
+		}
+	}
+	return nil
+}
+
+// FindMissingItemIDs find all item IDs that are not in the list
+// NOTE: the input ID slice should contain unique values
+func (s TwoFactorChallengeSlice) FindMissingItemIDs(expectedIDs []int64) []int64 {
+	if len(s) == 0 {
+		return expectedIDs
+	}
+	result := []int64{}
+	mapChk := s.ToIDMap()
+	for _, id := range expectedIDs {
+		if _, ok := mapChk[id]; !ok {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// InsertAll inserts all rows with the specified column values, using an executor.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o TwoFactorChallengeSlice) InsertAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	// Calculate the widest columns from all rows need to insert
+	wlCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		wl, _ := columns.InsertColumnSet(
+			twoFactorChallengeAllColumns,
+			twoFactorChallengeColumnsWithDefault,
+			twoFactorChallengeColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(twoFactorChallengeColumnsWithDefault, row),
+		)
+		for _, col := range wl {
+			wlCols[col] = struct{}{}
+		}
+	}
+	wl := make([]string, 0, len(wlCols))
+	for _, col := range twoFactorChallengeAllColumns {
+		if _, ok := wlCols[col]; ok {
+			wl = append(wl, col)
+		}
+	}
+
+	var sql string
+	vals := []interface{}{}
+	for i, row := range o {
+		if !boil.TimestampsAreSkipped(ctx) {
+			currTime := time.Now().In(boil.GetLocation())
+			if row.CreatedAt.IsZero() {
+				row.CreatedAt = currTime
+			}
+			if row.UpdatedAt.IsZero() {
+				row.UpdatedAt = currTime
+			}
+		}
+
+		if err := row.doBeforeInsertHooks(ctx, exec); err != nil {
+			return 0, err
+		}
+
+		if i == 0 {
+			sql = "INSERT INTO `two_factor_challenges` " + "(`" + strings.Join(wl, "`,`") + "`)" + " VALUES "
+		}
+		sql += strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), len(vals)+1, len(wl))
+		if i != len(o)-1 {
+			sql += ","
+		}
+		valMapping, err := queries.BindMapping(twoFactorChallengeType, twoFactorChallengeMapping, wl)
+		if err != nil {
+			return 0, err
+		}
+
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valMapping)...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, vals...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to insert all from twoFactorChallenge slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by insertall for two_factor_challenges")
+	}
+
+	if len(twoFactorChallengeAfterInsertHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterInsertHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// InsertIgnoreAll inserts all rows with ignoring the existing ones having the same primary key values.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o TwoFactorChallengeSlice) InsertIgnoreAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	return o.UpsertAll(ctx, exec, boil.None(), columns)
+}
+
+// UpsertAll inserts or updates all rows
+// Currently it doesn't support "NoContext" and "NoRowsAffected"
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o TwoFactorChallengeSlice) UpsertAll(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	// Calculate the widest columns from all rows need to upsert
+	insertCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		nzUniques := queries.NonZeroDefaultSet(mySQLTwoFactorChallengeUniqueColumns, row)
+		if len(nzUniques) == 0 {
+			return 0, errors.New("cannot upsert with a table that cannot conflict on a unique column")
+		}
+		insert, _ := insertColumns.InsertColumnSet(
+			twoFactorChallengeAllColumns,
+			twoFactorChallengeColumnsWithDefault,
+			twoFactorChallengeColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(twoFactorChallengeColumnsWithDefault, row),
+		)
+		for _, col := range insert {
+			insertCols[col] = struct{}{}
+		}
+	}
+	insert := make([]string, 0, len(insertCols))
+	for _, col := range twoFactorChallengeAllColumns {
+		if _, ok := insertCols[col]; ok {
+			insert = append(insert, col)
+		}
+	}
+
+	update := updateColumns.UpdateColumnSet(
+		twoFactorChallengeAllColumns,
+		twoFactorChallengePrimaryKeyColumns,
+	)
+	if !updateColumns.IsNone() && len(update) == 0 {
+		return 0, errors.New("models: unable to upsert two_factor_challenges, could not build update column list")
+	}
+
+	buf := strmangle.GetBuffer()
+	defer strmangle.PutBuffer(buf)
+
+	if len(update) == 0 {
+		fmt.Fprintf(
+			buf,
+			"INSERT IGNORE INTO `two_factor_challenges`(%s) VALUES %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
+			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
+		)
+	} else {
+		fmt.Fprintf(
+			buf,
+			"INSERT INTO `two_factor_challenges`(%s) VALUES %s ON DUPLICATE KEY UPDATE ",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
+			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
+		)
+
+		for i, v := range update {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			quoted := strmangle.IdentQuote(dialect.LQ, dialect.RQ, v)
+			buf.WriteString(quoted)
+			buf.WriteString(" = VALUES(")
+			buf.WriteString(quoted)
+			buf.WriteByte(')')
+		}
+	}
+
+	query := buf.String()
+	valueMapping, err := queries.BindMapping(twoFactorChallengeType, twoFactorChallengeMapping, insert)
+	if err != nil {
+		return 0, err
+	}
+
+	var vals []interface{}
+	for _, row := range o {
+		if !boil.TimestampsAreSkipped(ctx) {
+			currTime := time.Now().In(boil.GetLocation())
+			if row.CreatedAt.IsZero() {
+				row.CreatedAt = currTime
+			}
+
+			row.UpdatedAt = currTime
+		}
+
+		if err := row.doBeforeUpsertHooks(ctx, exec); err != nil {
+			return 0, err
+		}
+
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valueMapping)...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, query, vals...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to upsert for two_factor_challenges")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by upsert for two_factor_challenges")
+	}
+
+	if len(twoFactorChallengeAfterUpsertHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterUpsertHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllByPage delete all TwoFactorChallenge records from the slice.
+// This function deletes data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s TwoFactorChallengeSlice) DeleteAllByPage(ctx context.Context, exec boil.ContextExecutor, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// MySQL max placeholders = 65535
+	chunkSize := DefaultPageSize
+	if len(limits) > 0 && limits[0] > 0 && limits[0] <= MaxPageSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.DeleteAll(ctx, exec)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].DeleteAll(ctx, exec)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// UpdateAllByPage update all TwoFactorChallenge records from the slice.
+// This function updates data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s TwoFactorChallengeSlice) UpdateAllByPage(ctx context.Context, exec boil.ContextExecutor, cols M, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// MySQL max placeholders = 65535
+	// NOTE (eric): len(cols) should not be too big
+	chunkSize := DefaultPageSize
+	if len(limits) > 0 && limits[0] > 0 && limits[0] <= MaxPageSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.UpdateAll(ctx, exec, cols)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].UpdateAll(ctx, exec, cols)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// InsertAllByPage insert all TwoFactorChallenge records from the slice.
+// This function inserts data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s TwoFactorChallengeSlice) InsertAllByPage(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// MySQL max placeholders = 65535
+	chunkSize := MaxPageSize / reflect.ValueOf(&TwoFactorChallengeColumns).Elem().NumField()
+	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.InsertAll(ctx, exec, columns)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].InsertAll(ctx, exec, columns)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// InsertIgnoreAllByPage insert all TwoFactorChallenge records from the slice.
+// This function inserts data by pages to avoid exceeding Postgres limitation (max parameters: 65535)
+func (s TwoFactorChallengeSlice) InsertIgnoreAllByPage(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// max number of parameters = 65535
+	chunkSize := MaxPageSize / reflect.ValueOf(&TwoFactorChallengeColumns).Elem().NumField()
+	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.InsertIgnoreAll(ctx, exec, columns)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].InsertIgnoreAll(ctx, exec, columns)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// UpsertAllByPage upsert all TwoFactorChallenge records from the slice.
+// This function upserts data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s TwoFactorChallengeSlice) UpsertAllByPage(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// MySQL max placeholders = 65535
+	chunkSize := MaxPageSize / reflect.ValueOf(&TwoFactorChallengeColumns).Elem().NumField()
+	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.UpsertAll(ctx, exec, updateColumns, insertColumns)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].UpsertAll(ctx, exec, updateColumns, insertColumns)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+///////////////////////////////// END EXTENSIONS /////////////////////////////////
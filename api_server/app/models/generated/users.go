@@ -24,116 +24,142 @@ import (
 
 // User is an object representing the database table.
 type User struct {
-	ID                  int       `boil:"id" json:"id" toml:"id" yaml:"id"`
-	FirstName           string    `boil:"first_name" json:"first_name" toml:"first_name" yaml:"first_name"`
-	LastName            string    `boil:"last_name" json:"last_name" toml:"last_name" yaml:"last_name"`
-	Email               string    `boil:"email" json:"email" toml:"email" yaml:"email"`
-	Password            string    `boil:"password" json:"password" toml:"password" yaml:"password"`
-	Birthday            null.Time `boil:"birthday" json:"birthday,omitempty" toml:"birthday" yaml:"birthday,omitempty"`
-	FrontIdentification string    `boil:"front_identification" json:"front_identification" toml:"front_identification" yaml:"front_identification"`
-	BackIdentification  string    `boil:"back_identification" json:"back_identification" toml:"back_identification" yaml:"back_identification"`
-	CreatedAt           time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt           time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID                      int         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	FirstName               string      `boil:"first_name" json:"first_name" toml:"first_name" yaml:"first_name"`
+	LastName                string      `boil:"last_name" json:"last_name" toml:"last_name" yaml:"last_name"`
+	Email                   string      `boil:"email" json:"email" toml:"email" yaml:"email"`
+	EmailVerifiedAt         null.Time   `boil:"email_verified_at" json:"email_verified_at,omitempty" toml:"email_verified_at" yaml:"email_verified_at,omitempty"`
+	PendingEmail            null.String `boil:"pending_email" json:"pending_email,omitempty" toml:"pending_email" yaml:"pending_email,omitempty"`
+	EmailVerificationSentAt null.Time   `boil:"email_verification_sent_at" json:"email_verification_sent_at,omitempty" toml:"email_verification_sent_at" yaml:"email_verification_sent_at,omitempty"`
+	TotpSecret              null.String `boil:"totp_secret" json:"totp_secret,omitempty" toml:"totp_secret" yaml:"totp_secret,omitempty"`
+	TotpEnabledAt           null.Time   `boil:"totp_enabled_at" json:"totp_enabled_at,omitempty" toml:"totp_enabled_at" yaml:"totp_enabled_at,omitempty"`
+	TotpLastUsedStep        null.Int64  `boil:"totp_last_used_step" json:"totp_last_used_step,omitempty" toml:"totp_last_used_step" yaml:"totp_last_used_step,omitempty"`
+	WebauthnUserHandle      null.String `boil:"webauthn_user_handle" json:"webauthn_user_handle,omitempty" toml:"webauthn_user_handle" yaml:"webauthn_user_handle,omitempty"`
+	Password                string      `boil:"password" json:"password" toml:"password" yaml:"password"`
+	Birthday                null.Time   `boil:"birthday" json:"birthday,omitempty" toml:"birthday" yaml:"birthday,omitempty"`
+	FrontIdentification     string      `boil:"front_identification" json:"front_identification" toml:"front_identification" yaml:"front_identification"`
+	BackIdentification      string      `boil:"back_identification" json:"back_identification" toml:"back_identification" yaml:"back_identification"`
+	CreatedAt               time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt               time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 
 	R *userR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var UserColumns = struct {
-	ID                  string
-	FirstName           string
-	LastName            string
-	Email               string
-	Password            string
-	Birthday            string
-	FrontIdentification string
-	BackIdentification  string
-	CreatedAt           string
-	UpdatedAt           string
+	ID                      string
+	FirstName               string
+	LastName                string
+	Email                   string
+	EmailVerifiedAt         string
+	PendingEmail            string
+	EmailVerificationSentAt string
+	TotpSecret              string
+	TotpEnabledAt           string
+	TotpLastUsedStep        string
+	WebauthnUserHandle      string
+	Password                string
+	Birthday                string
+	FrontIdentification     string
+	BackIdentification      string
+	CreatedAt               string
+	UpdatedAt               string
 }{
-	ID:                  "id",
-	FirstName:           "first_name",
-	LastName:            "last_name",
-	Email:               "email",
-	Password:            "password",
-	Birthday:            "birthday",
-	FrontIdentification: "front_identification",
-	BackIdentification:  "back_identification",
-	CreatedAt:           "created_at",
-	UpdatedAt:           "updated_at",
+	ID:                      "id",
+	FirstName:               "first_name",
+	LastName:                "last_name",
+	Email:                   "email",
+	EmailVerifiedAt:         "email_verified_at",
+	PendingEmail:            "pending_email",
+	EmailVerificationSentAt: "email_verification_sent_at",
+	TotpSecret:              "totp_secret",
+	TotpEnabledAt:           "totp_enabled_at",
+	TotpLastUsedStep:        "totp_last_used_step",
+	WebauthnUserHandle:      "webauthn_user_handle",
+	Password:                "password",
+	Birthday:                "birthday",
+	FrontIdentification:     "front_identification",
+	BackIdentification:      "back_identification",
+	CreatedAt:               "created_at",
+	UpdatedAt:               "updated_at",
 }
 
 var UserTableColumns = struct {
-	ID                  string
-	FirstName           string
-	LastName            string
-	Email               string
-	Password            string
-	Birthday            string
-	FrontIdentification string
-	BackIdentification  string
-	CreatedAt           string
-	UpdatedAt           string
+	ID                      string
+	FirstName               string
+	LastName                string
+	Email                   string
+	EmailVerifiedAt         string
+	PendingEmail            string
+	EmailVerificationSentAt string
+	TotpSecret              string
+	TotpEnabledAt           string
+	TotpLastUsedStep        string
+	WebauthnUserHandle      string
+	Password                string
+	Birthday                string
+	FrontIdentification     string
+	BackIdentification      string
+	CreatedAt               string
+	UpdatedAt               string
 }{
-	ID:                  "users.id",
-	FirstName:           "users.first_name",
-	LastName:            "users.last_name",
-	Email:               "users.email",
-	Password:            "users.password",
-	Birthday:            "users.birthday",
-	FrontIdentification: "users.front_identification",
-	BackIdentification:  "users.back_identification",
-	CreatedAt:           "users.created_at",
-	UpdatedAt:           "users.updated_at",
+	ID:                      "users.id",
+	FirstName:               "users.first_name",
+	LastName:                "users.last_name",
+	Email:                   "users.email",
+	EmailVerifiedAt:         "users.email_verified_at",
+	PendingEmail:            "users.pending_email",
+	EmailVerificationSentAt: "users.email_verification_sent_at",
+	TotpSecret:              "users.totp_secret",
+	TotpEnabledAt:           "users.totp_enabled_at",
+	TotpLastUsedStep:        "users.totp_last_used_step",
+	WebauthnUserHandle:      "users.webauthn_user_handle",
+	Password:                "users.password",
+	Birthday:                "users.birthday",
+	FrontIdentification:     "users.front_identification",
+	BackIdentification:      "users.back_identification",
+	CreatedAt:               "users.created_at",
+	UpdatedAt:               "users.updated_at",
 }
 
 // Generated where
 
-type whereHelperint struct{ field string }
-
-func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint) IN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint) NIN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 var UserWhere = struct {
-	ID                  whereHelperint
-	FirstName           whereHelperstring
-	LastName            whereHelperstring
-	Email               whereHelperstring
-	Password            whereHelperstring
-	Birthday            whereHelpernull_Time
-	FrontIdentification whereHelperstring
-	BackIdentification  whereHelperstring
-	CreatedAt           whereHelpertime_Time
-	UpdatedAt           whereHelpertime_Time
+	ID                      whereHelperint
+	FirstName               whereHelperstring
+	LastName                whereHelperstring
+	Email                   whereHelperstring
+	EmailVerifiedAt         whereHelpernull_Time
+	PendingEmail            whereHelpernull_String
+	EmailVerificationSentAt whereHelpernull_Time
+	TotpSecret              whereHelpernull_String
+	TotpEnabledAt           whereHelpernull_Time
+	TotpLastUsedStep        whereHelpernull_Int64
+	WebauthnUserHandle      whereHelpernull_String
+	Password                whereHelperstring
+	Birthday                whereHelpernull_Time
+	FrontIdentification     whereHelperstring
+	BackIdentification      whereHelperstring
+	CreatedAt               whereHelpertime_Time
+	UpdatedAt               whereHelpertime_Time
 }{
-	ID:                  whereHelperint{field: "`users`.`id`"},
-	FirstName:           whereHelperstring{field: "`users`.`first_name`"},
-	LastName:            whereHelperstring{field: "`users`.`last_name`"},
-	Email:               whereHelperstring{field: "`users`.`email`"},
-	Password:            whereHelperstring{field: "`users`.`password`"},
-	Birthday:            whereHelpernull_Time{field: "`users`.`birthday`"},
-	FrontIdentification: whereHelperstring{field: "`users`.`front_identification`"},
-	BackIdentification:  whereHelperstring{field: "`users`.`back_identification`"},
-	CreatedAt:           whereHelpertime_Time{field: "`users`.`created_at`"},
-	UpdatedAt:           whereHelpertime_Time{field: "`users`.`updated_at`"},
+	ID:                      whereHelperint{field: "`users`.`id`"},
+	FirstName:               whereHelperstring{field: "`users`.`first_name`"},
+	LastName:                whereHelperstring{field: "`users`.`last_name`"},
+	Email:                   whereHelperstring{field: "`users`.`email`"},
+	EmailVerifiedAt:         whereHelpernull_Time{field: "`users`.`email_verified_at`"},
+	PendingEmail:            whereHelpernull_String{field: "`users`.`pending_email`"},
+	EmailVerificationSentAt: whereHelpernull_Time{field: "`users`.`email_verification_sent_at`"},
+	TotpSecret:              whereHelpernull_String{field: "`users`.`totp_secret`"},
+	TotpEnabledAt:           whereHelpernull_Time{field: "`users`.`totp_enabled_at`"},
+	TotpLastUsedStep:        whereHelpernull_Int64{field: "`users`.`totp_last_used_step`"},
+	WebauthnUserHandle:      whereHelpernull_String{field: "`users`.`webauthn_user_handle`"},
+	Password:                whereHelperstring{field: "`users`.`password`"},
+	Birthday:                whereHelpernull_Time{field: "`users`.`birthday`"},
+	FrontIdentification:     whereHelperstring{field: "`users`.`front_identification`"},
+	BackIdentification:      whereHelperstring{field: "`users`.`back_identification`"},
+	CreatedAt:               whereHelpertime_Time{field: "`users`.`created_at`"},
+	UpdatedAt:               whereHelpertime_Time{field: "`users`.`updated_at`"},
 }
 
 // UserRels is where relationship names are stored.
@@ -153,8 +179,8 @@ func (*userR) NewStruct() *userR {
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"id", "first_name", "last_name", "email", "password", "birthday", "front_identification", "back_identification", "created_at", "updated_at"}
-	userColumnsWithoutDefault = []string{"first_name", "last_name", "email", "password", "birthday", "front_identification", "back_identification", "created_at", "updated_at"}
+	userAllColumns            = []string{"id", "first_name", "last_name", "email", "email_verified_at", "pending_email", "email_verification_sent_at", "totp_secret", "totp_enabled_at", "totp_last_used_step", "webauthn_user_handle", "password", "birthday", "front_identification", "back_identification", "created_at", "updated_at"}
+	userColumnsWithoutDefault = []string{"first_name", "last_name", "email", "email_verified_at", "pending_email", "email_verification_sent_at", "totp_secret", "totp_enabled_at", "totp_last_used_step", "webauthn_user_handle", "password", "birthday", "front_identification", "back_identification", "created_at", "updated_at"}
 	userColumnsWithDefault    = []string{"id"}
 	userPrimaryKeyColumns     = []string{"id"}
 	userGeneratedColumns      = []string{}
@@ -759,6 +785,7 @@ func (o UserSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, col
 var mySQLUserUniqueColumns = []string{
 	"id",
 	"email",
+	"webauthn_user_handle",
 }
 
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
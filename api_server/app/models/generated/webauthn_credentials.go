@@ -0,0 +1,1605 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// WebauthnCredential is an object representing the database table.
+type WebauthnCredential struct {
+	ID              int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID          int64     `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	CredentialID    string    `boil:"credential_id" json:"credential_id" toml:"credential_id" yaml:"credential_id"`
+	PublicKey       []byte    `boil:"public_key" json:"public_key" toml:"public_key" yaml:"public_key"`
+	AttestationType string    `boil:"attestation_type" json:"attestation_type" toml:"attestation_type" yaml:"attestation_type"`
+	Aaguid          []byte    `boil:"aaguid" json:"aaguid" toml:"aaguid" yaml:"aaguid"`
+	SignCount       int64     `boil:"sign_count" json:"sign_count" toml:"sign_count" yaml:"sign_count"`
+	Transports      string    `boil:"transports" json:"transports" toml:"transports" yaml:"transports"`
+	BackupEligible  bool      `boil:"backup_eligible" json:"backup_eligible" toml:"backup_eligible" yaml:"backup_eligible"`
+	BackupState     bool      `boil:"backup_state" json:"backup_state" toml:"backup_state" yaml:"backup_state"`
+	Name            string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	LastUsedAt      null.Time `boil:"last_used_at" json:"last_used_at,omitempty" toml:"last_used_at" yaml:"last_used_at,omitempty"`
+	CreatedAt       time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt       time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *webauthnCredentialR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L webauthnCredentialL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WebauthnCredentialColumns = struct {
+	ID              string
+	UserID          string
+	CredentialID    string
+	PublicKey       string
+	AttestationType string
+	Aaguid          string
+	SignCount       string
+	Transports      string
+	BackupEligible  string
+	BackupState     string
+	Name            string
+	LastUsedAt      string
+	CreatedAt       string
+	UpdatedAt       string
+}{
+	ID:              "id",
+	UserID:          "user_id",
+	CredentialID:    "credential_id",
+	PublicKey:       "public_key",
+	AttestationType: "attestation_type",
+	Aaguid:          "aaguid",
+	SignCount:       "sign_count",
+	Transports:      "transports",
+	BackupEligible:  "backup_eligible",
+	BackupState:     "backup_state",
+	Name:            "name",
+	LastUsedAt:      "last_used_at",
+	CreatedAt:       "created_at",
+	UpdatedAt:       "updated_at",
+}
+
+var WebauthnCredentialTableColumns = struct {
+	ID              string
+	UserID          string
+	CredentialID    string
+	PublicKey       string
+	AttestationType string
+	Aaguid          string
+	SignCount       string
+	Transports      string
+	BackupEligible  string
+	BackupState     string
+	Name            string
+	LastUsedAt      string
+	CreatedAt       string
+	UpdatedAt       string
+}{
+	ID:              "webauthn_credentials.id",
+	UserID:          "webauthn_credentials.user_id",
+	CredentialID:    "webauthn_credentials.credential_id",
+	PublicKey:       "webauthn_credentials.public_key",
+	AttestationType: "webauthn_credentials.attestation_type",
+	Aaguid:          "webauthn_credentials.aaguid",
+	SignCount:       "webauthn_credentials.sign_count",
+	Transports:      "webauthn_credentials.transports",
+	BackupEligible:  "webauthn_credentials.backup_eligible",
+	BackupState:     "webauthn_credentials.backup_state",
+	Name:            "webauthn_credentials.name",
+	LastUsedAt:      "webauthn_credentials.last_used_at",
+	CreatedAt:       "webauthn_credentials.created_at",
+	UpdatedAt:       "webauthn_credentials.updated_at",
+}
+
+// Generated where
+
+type whereHelper__byte struct{ field string }
+
+func (w whereHelper__byte) EQ(x []byte) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelper__byte) NEQ(x []byte) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelper__byte) LT(x []byte) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelper__byte) LTE(x []byte) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelper__byte) GT(x []byte) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelper__byte) GTE(x []byte) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
+type whereHelperbool struct{ field string }
+
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
+var WebauthnCredentialWhere = struct {
+	ID              whereHelperint64
+	UserID          whereHelperint64
+	CredentialID    whereHelperstring
+	PublicKey       whereHelper__byte
+	AttestationType whereHelperstring
+	Aaguid          whereHelper__byte
+	SignCount       whereHelperint64
+	Transports      whereHelperstring
+	BackupEligible  whereHelperbool
+	BackupState     whereHelperbool
+	Name            whereHelperstring
+	LastUsedAt      whereHelpernull_Time
+	CreatedAt       whereHelpertime_Time
+	UpdatedAt       whereHelpertime_Time
+}{
+	ID:              whereHelperint64{field: "`webauthn_credentials`.`id`"},
+	UserID:          whereHelperint64{field: "`webauthn_credentials`.`user_id`"},
+	CredentialID:    whereHelperstring{field: "`webauthn_credentials`.`credential_id`"},
+	PublicKey:       whereHelper__byte{field: "`webauthn_credentials`.`public_key`"},
+	AttestationType: whereHelperstring{field: "`webauthn_credentials`.`attestation_type`"},
+	Aaguid:          whereHelper__byte{field: "`webauthn_credentials`.`aaguid`"},
+	SignCount:       whereHelperint64{field: "`webauthn_credentials`.`sign_count`"},
+	Transports:      whereHelperstring{field: "`webauthn_credentials`.`transports`"},
+	BackupEligible:  whereHelperbool{field: "`webauthn_credentials`.`backup_eligible`"},
+	BackupState:     whereHelperbool{field: "`webauthn_credentials`.`backup_state`"},
+	Name:            whereHelperstring{field: "`webauthn_credentials`.`name`"},
+	LastUsedAt:      whereHelpernull_Time{field: "`webauthn_credentials`.`last_used_at`"},
+	CreatedAt:       whereHelpertime_Time{field: "`webauthn_credentials`.`created_at`"},
+	UpdatedAt:       whereHelpertime_Time{field: "`webauthn_credentials`.`updated_at`"},
+}
+
+// WebauthnCredentialRels is where relationship names are stored.
+var WebauthnCredentialRels = struct {
+}{}
+
+// webauthnCredentialR is where relationships are stored.
+type webauthnCredentialR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*webauthnCredentialR) NewStruct() *webauthnCredentialR {
+	return &webauthnCredentialR{}
+}
+
+// webauthnCredentialL is where Load methods for each relationship are stored.
+type webauthnCredentialL struct{}
+
+var (
+	webauthnCredentialAllColumns            = []string{"id", "user_id", "credential_id", "public_key", "attestation_type", "aaguid", "sign_count", "transports", "backup_eligible", "backup_state", "name", "last_used_at", "created_at", "updated_at"}
+	webauthnCredentialColumnsWithoutDefault = []string{"user_id", "credential_id", "public_key", "attestation_type", "aaguid", "transports", "name", "last_used_at", "created_at", "updated_at"}
+	webauthnCredentialColumnsWithDefault    = []string{"id", "sign_count", "backup_eligible", "backup_state"}
+	webauthnCredentialPrimaryKeyColumns     = []string{"id"}
+	webauthnCredentialGeneratedColumns      = []string{}
+)
+
+type (
+	// WebauthnCredentialSlice is an alias for a slice of pointers to WebauthnCredential.
+	// This should almost always be used instead of []WebauthnCredential.
+	WebauthnCredentialSlice []*WebauthnCredential
+	// WebauthnCredentialHook is the signature for custom WebauthnCredential hook methods
+	WebauthnCredentialHook func(context.Context, boil.ContextExecutor, *WebauthnCredential) error
+
+	webauthnCredentialQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	webauthnCredentialType                 = reflect.TypeOf(&WebauthnCredential{})
+	webauthnCredentialMapping              = queries.MakeStructMapping(webauthnCredentialType)
+	webauthnCredentialPrimaryKeyMapping, _ = queries.BindMapping(webauthnCredentialType, webauthnCredentialMapping, webauthnCredentialPrimaryKeyColumns)
+	webauthnCredentialInsertCacheMut       sync.RWMutex
+	webauthnCredentialInsertCache          = make(map[string]insertCache)
+	webauthnCredentialUpdateCacheMut       sync.RWMutex
+	webauthnCredentialUpdateCache          = make(map[string]updateCache)
+	webauthnCredentialUpsertCacheMut       sync.RWMutex
+	webauthnCredentialUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var webauthnCredentialAfterSelectMu sync.Mutex
+var webauthnCredentialAfterSelectHooks []WebauthnCredentialHook
+
+var webauthnCredentialBeforeInsertMu sync.Mutex
+var webauthnCredentialBeforeInsertHooks []WebauthnCredentialHook
+var webauthnCredentialAfterInsertMu sync.Mutex
+var webauthnCredentialAfterInsertHooks []WebauthnCredentialHook
+
+var webauthnCredentialBeforeUpdateMu sync.Mutex
+var webauthnCredentialBeforeUpdateHooks []WebauthnCredentialHook
+var webauthnCredentialAfterUpdateMu sync.Mutex
+var webauthnCredentialAfterUpdateHooks []WebauthnCredentialHook
+
+var webauthnCredentialBeforeDeleteMu sync.Mutex
+var webauthnCredentialBeforeDeleteHooks []WebauthnCredentialHook
+var webauthnCredentialAfterDeleteMu sync.Mutex
+var webauthnCredentialAfterDeleteHooks []WebauthnCredentialHook
+
+var webauthnCredentialBeforeUpsertMu sync.Mutex
+var webauthnCredentialBeforeUpsertHooks []WebauthnCredentialHook
+var webauthnCredentialAfterUpsertMu sync.Mutex
+var webauthnCredentialAfterUpsertHooks []WebauthnCredentialHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WebauthnCredential) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCredentialAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WebauthnCredential) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCredentialBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WebauthnCredential) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCredentialAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WebauthnCredential) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCredentialBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WebauthnCredential) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCredentialAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WebauthnCredential) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCredentialBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WebauthnCredential) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCredentialAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WebauthnCredential) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCredentialBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WebauthnCredential) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCredentialAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWebauthnCredentialHook registers your hook function for all future operations.
+func AddWebauthnCredentialHook(hookPoint boil.HookPoint, webauthnCredentialHook WebauthnCredentialHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		webauthnCredentialAfterSelectMu.Lock()
+		webauthnCredentialAfterSelectHooks = append(webauthnCredentialAfterSelectHooks, webauthnCredentialHook)
+		webauthnCredentialAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		webauthnCredentialBeforeInsertMu.Lock()
+		webauthnCredentialBeforeInsertHooks = append(webauthnCredentialBeforeInsertHooks, webauthnCredentialHook)
+		webauthnCredentialBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		webauthnCredentialAfterInsertMu.Lock()
+		webauthnCredentialAfterInsertHooks = append(webauthnCredentialAfterInsertHooks, webauthnCredentialHook)
+		webauthnCredentialAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		webauthnCredentialBeforeUpdateMu.Lock()
+		webauthnCredentialBeforeUpdateHooks = append(webauthnCredentialBeforeUpdateHooks, webauthnCredentialHook)
+		webauthnCredentialBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		webauthnCredentialAfterUpdateMu.Lock()
+		webauthnCredentialAfterUpdateHooks = append(webauthnCredentialAfterUpdateHooks, webauthnCredentialHook)
+		webauthnCredentialAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		webauthnCredentialBeforeDeleteMu.Lock()
+		webauthnCredentialBeforeDeleteHooks = append(webauthnCredentialBeforeDeleteHooks, webauthnCredentialHook)
+		webauthnCredentialBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		webauthnCredentialAfterDeleteMu.Lock()
+		webauthnCredentialAfterDeleteHooks = append(webauthnCredentialAfterDeleteHooks, webauthnCredentialHook)
+		webauthnCredentialAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		webauthnCredentialBeforeUpsertMu.Lock()
+		webauthnCredentialBeforeUpsertHooks = append(webauthnCredentialBeforeUpsertHooks, webauthnCredentialHook)
+		webauthnCredentialBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		webauthnCredentialAfterUpsertMu.Lock()
+		webauthnCredentialAfterUpsertHooks = append(webauthnCredentialAfterUpsertHooks, webauthnCredentialHook)
+		webauthnCredentialAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single webauthnCredential record from the query.
+func (q webauthnCredentialQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WebauthnCredential, error) {
+	o := &WebauthnCredential{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for webauthn_credentials")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all WebauthnCredential records from the query.
+func (q webauthnCredentialQuery) All(ctx context.Context, exec boil.ContextExecutor) (WebauthnCredentialSlice, error) {
+	var o []*WebauthnCredential
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WebauthnCredential slice")
+	}
+
+	if len(webauthnCredentialAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WebauthnCredential records in the query.
+func (q webauthnCredentialQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count webauthn_credentials rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q webauthnCredentialQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if webauthn_credentials exists")
+	}
+
+	return count > 0, nil
+}
+
+// WebauthnCredentials retrieves all the records using an executor.
+func WebauthnCredentials(mods ...qm.QueryMod) webauthnCredentialQuery {
+	mods = append(mods, qm.From("`webauthn_credentials`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`webauthn_credentials`.*"})
+	}
+
+	return webauthnCredentialQuery{q}
+}
+
+// FindWebauthnCredential retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWebauthnCredential(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*WebauthnCredential, error) {
+	webauthnCredentialObj := &WebauthnCredential{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `webauthn_credentials` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, webauthnCredentialObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from webauthn_credentials")
+	}
+
+	if err = webauthnCredentialObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return webauthnCredentialObj, err
+	}
+
+	return webauthnCredentialObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WebauthnCredential) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no webauthn_credentials provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webauthnCredentialColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	webauthnCredentialInsertCacheMut.RLock()
+	cache, cached := webauthnCredentialInsertCache[key]
+	webauthnCredentialInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			webauthnCredentialAllColumns,
+			webauthnCredentialColumnsWithDefault,
+			webauthnCredentialColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(webauthnCredentialType, webauthnCredentialMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(webauthnCredentialType, webauthnCredentialMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `webauthn_credentials` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `webauthn_credentials` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `webauthn_credentials` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, webauthnCredentialPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into webauthn_credentials")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == webauthnCredentialMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for webauthn_credentials")
+	}
+
+CacheNoHooks:
+	if !cached {
+		webauthnCredentialInsertCacheMut.Lock()
+		webauthnCredentialInsertCache[key] = cache
+		webauthnCredentialInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the WebauthnCredential.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WebauthnCredential) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	webauthnCredentialUpdateCacheMut.RLock()
+	cache, cached := webauthnCredentialUpdateCache[key]
+	webauthnCredentialUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			webauthnCredentialAllColumns,
+			webauthnCredentialPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update webauthn_credentials, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `webauthn_credentials` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, webauthnCredentialPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(webauthnCredentialType, webauthnCredentialMapping, append(wl, webauthnCredentialPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update webauthn_credentials row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for webauthn_credentials")
+	}
+
+	if !cached {
+		webauthnCredentialUpdateCacheMut.Lock()
+		webauthnCredentialUpdateCache[key] = cache
+		webauthnCredentialUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q webauthnCredentialQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for webauthn_credentials")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for webauthn_credentials")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WebauthnCredentialSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webauthnCredentialPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `webauthn_credentials` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, webauthnCredentialPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in webauthnCredential slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all webauthnCredential")
+	}
+	return rowsAff, nil
+}
+
+var mySQLWebauthnCredentialUniqueColumns = []string{
+	"id",
+	"credential_id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WebauthnCredential) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no webauthn_credentials provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webauthnCredentialColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLWebauthnCredentialUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	webauthnCredentialUpsertCacheMut.RLock()
+	cache, cached := webauthnCredentialUpsertCache[key]
+	webauthnCredentialUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			webauthnCredentialAllColumns,
+			webauthnCredentialColumnsWithDefault,
+			webauthnCredentialColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			webauthnCredentialAllColumns,
+			webauthnCredentialPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert webauthn_credentials, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(webauthnCredentialAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`webauthn_credentials`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `webauthn_credentials` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(webauthnCredentialType, webauthnCredentialMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(webauthnCredentialType, webauthnCredentialMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for webauthn_credentials")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == webauthnCredentialMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(webauthnCredentialType, webauthnCredentialMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for webauthn_credentials")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for webauthn_credentials")
+	}
+
+CacheNoHooks:
+	if !cached {
+		webauthnCredentialUpsertCacheMut.Lock()
+		webauthnCredentialUpsertCache[key] = cache
+		webauthnCredentialUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single WebauthnCredential record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WebauthnCredential) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WebauthnCredential provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), webauthnCredentialPrimaryKeyMapping)
+	sql := "DELETE FROM `webauthn_credentials` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from webauthn_credentials")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for webauthn_credentials")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q webauthnCredentialQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no webauthnCredentialQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from webauthn_credentials")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for webauthn_credentials")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WebauthnCredentialSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(webauthnCredentialBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webauthnCredentialPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `webauthn_credentials` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, webauthnCredentialPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from webauthnCredential slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for webauthn_credentials")
+	}
+
+	if len(webauthnCredentialAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WebauthnCredential) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWebauthnCredential(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebauthnCredentialSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WebauthnCredentialSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webauthnCredentialPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `webauthn_credentials`.* FROM `webauthn_credentials` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, webauthnCredentialPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WebauthnCredentialSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WebauthnCredentialExists checks if the WebauthnCredential row exists.
+func WebauthnCredentialExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `webauthn_credentials` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if webauthn_credentials exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the WebauthnCredential row exists.
+func (o *WebauthnCredential) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return WebauthnCredentialExists(ctx, exec, o.ID)
+}
+
+// /////////////////////////////// BEGIN EXTENSIONS /////////////////////////////////
+// Expose table columns
+var (
+	WebauthnCredentialAllColumns            = webauthnCredentialAllColumns
+	WebauthnCredentialColumnsWithoutDefault = webauthnCredentialColumnsWithoutDefault
+	WebauthnCredentialColumnsWithDefault    = webauthnCredentialColumnsWithDefault
+	WebauthnCredentialPrimaryKeyColumns     = webauthnCredentialPrimaryKeyColumns
+	WebauthnCredentialGeneratedColumns      = webauthnCredentialGeneratedColumns
+)
+
+// GetID get ID from model object
+func (o *WebauthnCredential) GetID() int64 {
+	return o.ID
+}
+
+// GetIDs extract IDs from model objects
+func (s WebauthnCredentialSlice) GetIDs() []int64 {
+	result := make([]int64, len(s))
+	for i := range s {
+		result[i] = s[i].ID
+	}
+	return result
+}
+
+// GetIntfIDs extract IDs from model objects as interface slice
+func (s WebauthnCredentialSlice) GetIntfIDs() []interface{} {
+	result := make([]interface{}, len(s))
+	for i := range s {
+		result[i] = s[i].ID
+	}
+	return result
+}
+
+// ToIDMap convert a slice of model objects to a map with ID as key
+func (s WebauthnCredentialSlice) ToIDMap() map[int64]*WebauthnCredential {
+	result := make(map[int64]*WebauthnCredential, len(s))
+	for _, o := range s {
+		result[o.ID] = o
+	}
+	return result
+}
+
+// ToUniqueItems construct a slice of unique items from the given slice
+func (s WebauthnCredentialSlice) ToUniqueItems() WebauthnCredentialSlice {
+	result := make(WebauthnCredentialSlice, 0, len(s))
+	mapChk := make(map[int64]struct{}, len(s))
+	for i := len(s) - 1; i >= 0; i-- {
+		o := s[i]
+		if _, ok := mapChk[o.ID]; !ok {
+			mapChk[o.ID] = struct{}{}
+			result = append(result, o)
+		}
+	}
+	return result
+}
+
+// FindItemByID find item by ID in the slice
+func (s WebauthnCredentialSlice) FindItemByID(id int64) *WebauthnCredential {
+	for _, o := range s {
+		if o.ID == id {
+			return o
+		}
+	}
+	return nil
+}
+
+// FindMissingItemIDs find all item IDs that are not in the list
+// NOTE: the input ID slice should contain unique values
+func (s WebauthnCredentialSlice) FindMissingItemIDs(expectedIDs []int64) []int64 {
+	if len(s) == 0 {
+		return expectedIDs
+	}
+	result := []int64{}
+	mapChk := s.ToIDMap()
+	for _, id := range expectedIDs {
+		if _, ok := mapChk[id]; !ok {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// InsertAll inserts all rows with the specified column values, using an executor.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o WebauthnCredentialSlice) InsertAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	// Calculate the widest columns from all rows need to insert
+	wlCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		wl, _ := columns.InsertColumnSet(
+			webauthnCredentialAllColumns,
+			webauthnCredentialColumnsWithDefault,
+			webauthnCredentialColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(webauthnCredentialColumnsWithDefault, row),
+		)
+		for _, col := range wl {
+			wlCols[col] = struct{}{}
+		}
+	}
+	wl := make([]string, 0, len(wlCols))
+	for _, col := range webauthnCredentialAllColumns {
+		if _, ok := wlCols[col]; ok {
+			wl = append(wl, col)
+		}
+	}
+
+	var sql string
+	vals := []interface{}{}
+	for i, row := range o {
+		if !boil.TimestampsAreSkipped(ctx) {
+			currTime := time.Now().In(boil.GetLocation())
+			if row.CreatedAt.IsZero() {
+				row.CreatedAt = currTime
+			}
+			if row.UpdatedAt.IsZero() {
+				row.UpdatedAt = currTime
+			}
+		}
+
+		if err := row.doBeforeInsertHooks(ctx, exec); err != nil {
+			return 0, err
+		}
+
+		if i == 0 {
+			sql = "INSERT INTO `webauthn_credentials` " + "(`" + strings.Join(wl, "`,`") + "`)" + " VALUES "
+		}
+		sql += strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), len(vals)+1, len(wl))
+		if i != len(o)-1 {
+			sql += ","
+		}
+		valMapping, err := queries.BindMapping(webauthnCredentialType, webauthnCredentialMapping, wl)
+		if err != nil {
+			return 0, err
+		}
+
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valMapping)...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, vals...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to insert all from webauthnCredential slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by insertall for webauthn_credentials")
+	}
+
+	if len(webauthnCredentialAfterInsertHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterInsertHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// InsertIgnoreAll inserts all rows with ignoring the existing ones having the same primary key values.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o WebauthnCredentialSlice) InsertIgnoreAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	return o.UpsertAll(ctx, exec, boil.None(), columns)
+}
+
+// UpsertAll inserts or updates all rows
+// Currently it doesn't support "NoContext" and "NoRowsAffected"
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o WebauthnCredentialSlice) UpsertAll(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	// Calculate the widest columns from all rows need to upsert
+	insertCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		nzUniques := queries.NonZeroDefaultSet(mySQLWebauthnCredentialUniqueColumns, row)
+		if len(nzUniques) == 0 {
+			return 0, errors.New("cannot upsert with a table that cannot conflict on a unique column")
+		}
+		insert, _ := insertColumns.InsertColumnSet(
+			webauthnCredentialAllColumns,
+			webauthnCredentialColumnsWithDefault,
+			webauthnCredentialColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(webauthnCredentialColumnsWithDefault, row),
+		)
+		for _, col := range insert {
+			insertCols[col] = struct{}{}
+		}
+	}
+	insert := make([]string, 0, len(insertCols))
+	for _, col := range webauthnCredentialAllColumns {
+		if _, ok := insertCols[col]; ok {
+			insert = append(insert, col)
+		}
+	}
+
+	update := updateColumns.UpdateColumnSet(
+		webauthnCredentialAllColumns,
+		webauthnCredentialPrimaryKeyColumns,
+	)
+	if !updateColumns.IsNone() && len(update) == 0 {
+		return 0, errors.New("models: unable to upsert webauthn_credentials, could not build update column list")
+	}
+
+	buf := strmangle.GetBuffer()
+	defer strmangle.PutBuffer(buf)
+
+	if len(update) == 0 {
+		fmt.Fprintf(
+			buf,
+			"INSERT IGNORE INTO `webauthn_credentials`(%s) VALUES %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
+			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
+		)
+	} else {
+		fmt.Fprintf(
+			buf,
+			"INSERT INTO `webauthn_credentials`(%s) VALUES %s ON DUPLICATE KEY UPDATE ",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
+			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
+		)
+
+		for i, v := range update {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			quoted := strmangle.IdentQuote(dialect.LQ, dialect.RQ, v)
+			buf.WriteString(quoted)
+			buf.WriteString(" = VALUES(")
+			buf.WriteString(quoted)
+			buf.WriteByte(')')
+		}
+	}
+
+	query := buf.String()
+	valueMapping, err := queries.BindMapping(webauthnCredentialType, webauthnCredentialMapping, insert)
+	if err != nil {
+		return 0, err
+	}
+
+	var vals []interface{}
+	for _, row := range o {
+		if !boil.TimestampsAreSkipped(ctx) {
+			currTime := time.Now().In(boil.GetLocation())
+			if row.CreatedAt.IsZero() {
+				row.CreatedAt = currTime
+			}
+
+			row.UpdatedAt = currTime
+		}
+
+		if err := row.doBeforeUpsertHooks(ctx, exec); err != nil {
+			return 0, err
+		}
+
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valueMapping)...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, query, vals...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to upsert for webauthn_credentials")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by upsert for webauthn_credentials")
+	}
+
+	if len(webauthnCredentialAfterUpsertHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterUpsertHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllByPage delete all WebauthnCredential records from the slice.
+// This function deletes data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s WebauthnCredentialSlice) DeleteAllByPage(ctx context.Context, exec boil.ContextExecutor, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// MySQL max placeholders = 65535
+	chunkSize := DefaultPageSize
+	if len(limits) > 0 && limits[0] > 0 && limits[0] <= MaxPageSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.DeleteAll(ctx, exec)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].DeleteAll(ctx, exec)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// UpdateAllByPage update all WebauthnCredential records from the slice.
+// This function updates data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s WebauthnCredentialSlice) UpdateAllByPage(ctx context.Context, exec boil.ContextExecutor, cols M, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// MySQL max placeholders = 65535
+	// NOTE (eric): len(cols) should not be too big
+	chunkSize := DefaultPageSize
+	if len(limits) > 0 && limits[0] > 0 && limits[0] <= MaxPageSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.UpdateAll(ctx, exec, cols)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].UpdateAll(ctx, exec, cols)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// InsertAllByPage insert all WebauthnCredential records from the slice.
+// This function inserts data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s WebauthnCredentialSlice) InsertAllByPage(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// MySQL max placeholders = 65535
+	chunkSize := MaxPageSize / reflect.ValueOf(&WebauthnCredentialColumns).Elem().NumField()
+	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.InsertAll(ctx, exec, columns)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].InsertAll(ctx, exec, columns)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// InsertIgnoreAllByPage insert all WebauthnCredential records from the slice.
+// This function inserts data by pages to avoid exceeding Postgres limitation (max parameters: 65535)
+func (s WebauthnCredentialSlice) InsertIgnoreAllByPage(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// max number of parameters = 65535
+	chunkSize := MaxPageSize / reflect.ValueOf(&WebauthnCredentialColumns).Elem().NumField()
+	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.InsertIgnoreAll(ctx, exec, columns)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].InsertIgnoreAll(ctx, exec, columns)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// UpsertAllByPage upsert all WebauthnCredential records from the slice.
+// This function upserts data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s WebauthnCredentialSlice) UpsertAllByPage(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// MySQL max placeholders = 65535
+	chunkSize := MaxPageSize / reflect.ValueOf(&WebauthnCredentialColumns).Elem().NumField()
+	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.UpsertAll(ctx, exec, updateColumns, insertColumns)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].UpsertAll(ctx, exec, updateColumns, insertColumns)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+///////////////////////////////// END EXTENSIONS /////////////////////////////////
@@ -10,11 +10,13 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"mime/multipart"
 	"net/http"
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
@@ -27,6 +29,203 @@ const (
 	CookieAuthScopes = "cookieAuth.Scopes"
 )
 
+// Defines values for ImportFormat.
+const (
+	ImportFormatCsv     ImportFormat = "csv"
+	ImportFormatJson    ImportFormat = "json"
+	ImportFormatTodoist ImportFormat = "todoist"
+	ImportFormatTrello  ImportFormat = "trello"
+)
+
+// Defines values for ImportStatus.
+const (
+	ImportStatusCompleted  ImportStatus = "completed"
+	ImportStatusFailed     ImportStatus = "failed"
+	ImportStatusProcessing ImportStatus = "processing"
+	ImportStatusQueued     ImportStatus = "queued"
+)
+
+// Defines values for UnauthorizedReason.
+const (
+	AudienceInvalid     UnauthorizedReason = "audience_invalid"
+	IssuerInvalid       UnauthorizedReason = "issuer_invalid"
+	RefreshTokenExpired UnauthorizedReason = "refresh_token_expired"
+	RefreshTokenInvalid UnauthorizedReason = "refresh_token_invalid"
+	RefreshTokenReused  UnauthorizedReason = "refresh_token_reused"
+	RefreshTokenRotated UnauthorizedReason = "refresh_token_rotated"
+	SessionRevoked      UnauthorizedReason = "session_revoked"
+	SignatureInvalid    UnauthorizedReason = "signature_invalid"
+	SubjectInvalid      UnauthorizedReason = "subject_invalid"
+	TokenExpired        UnauthorizedReason = "token_expired"
+	TokenIssuedInFuture UnauthorizedReason = "token_issued_in_future"
+	TokenMalformed      UnauthorizedReason = "token_malformed"
+	TokenMissing        UnauthorizedReason = "token_missing"
+	TokenNotYetValid    UnauthorizedReason = "token_not_yet_valid"
+	TokenRevoked        UnauthorizedReason = "token_revoked"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+)
+
+// Defines values for GetExportsParamsFormat.
+const (
+	GetExportsParamsFormatCsv      GetExportsParamsFormat = "csv"
+	GetExportsParamsFormatJson     GetExportsParamsFormat = "json"
+	GetExportsParamsFormatMarkdown GetExportsParamsFormat = "markdown"
+)
+
+// Activity defines model for Activity.
+type Activity struct {
+	ActorId   int       `json:"actorId"`
+	CreatedAt time.Time `json:"createdAt"`
+	EventType string    `json:"eventType"`
+	Id        int       `json:"id"`
+	TodoId    int       `json:"todoId"`
+	TodoTitle string    `json:"todoTitle"`
+}
+
+// AppPassword defines model for AppPassword.
+type AppPassword struct {
+	CreatedAt  time.Time  `json:"createdAt"`
+	Id         int        `json:"id"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	Name       string     `json:"name"`
+}
+
+// Attachment defines model for Attachment.
+type Attachment struct {
+	ContentType string    `json:"contentType"`
+	CreatedAt   time.Time `json:"createdAt"`
+	FileName    string    `json:"fileName"`
+	Id          int       `json:"id"`
+	Size        int64     `json:"size"`
+	TodoId      int       `json:"todoId"`
+}
+
+// ChangeEmailValidationError defines model for ChangeEmailValidationError.
+type ChangeEmailValidationError struct {
+	Email    *[]string `json:"email,omitempty"`
+	Password *[]string `json:"password,omitempty"`
+}
+
+// Comment defines model for Comment.
+type Comment struct {
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"createdAt"`
+	Id        int       `json:"id"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	UserId    int       `json:"userId"`
+}
+
+// ForgotPasswordValidationError defines model for ForgotPasswordValidationError.
+type ForgotPasswordValidationError struct {
+	Email *[]string `json:"email,omitempty"`
+}
+
+// Import defines model for Import.
+type Import struct {
+	CreatedAt     time.Time        `json:"createdAt"`
+	ErrorMessage  *string          `json:"errorMessage,omitempty"`
+	Errors        []ImportRowError `json:"errors"`
+	Format        ImportFormat     `json:"format"`
+	Id            int              `json:"id"`
+	ImportedRows  int              `json:"importedRows"`
+	ProcessedRows int              `json:"processedRows"`
+	SkippedRows   int              `json:"skippedRows"`
+	Status        ImportStatus     `json:"status"`
+	TotalRows     int              `json:"totalRows"`
+	UpdatedAt     time.Time        `json:"updatedAt"`
+}
+
+// ImportFormat defines model for Import.Format.
+type ImportFormat string
+
+// ImportStatus defines model for Import.Status.
+type ImportStatus string
+
+// ImportRowError defines model for ImportRowError.
+type ImportRowError struct {
+	Messages []string `json:"messages"`
+	Row      int      `json:"row"`
+}
+
+// Passkey defines model for Passkey.
+type Passkey struct {
+	// BackupEligible whether the credential can be synced to other devices
+	BackupEligible bool `json:"backupEligible"`
+
+	// BackupState whether the credential is currently synced
+	BackupState bool       `json:"backupState"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	Id          int        `json:"id"`
+	LastUsedAt  *time.Time `json:"lastUsedAt,omitempty"`
+	Name        string     `json:"name"`
+	Transports  []string   `json:"transports"`
+}
+
+// PasskeyValidationError defines model for PasskeyValidationError.
+type PasskeyValidationError struct {
+	CeremonyToken *[]string `json:"ceremonyToken,omitempty"`
+	Code          *[]string `json:"code,omitempty"`
+	Credential    *[]string `json:"credential,omitempty"`
+	Name          *[]string `json:"name,omitempty"`
+	Password      *[]string `json:"password,omitempty"`
+}
+
+// ResendEmailVerificationValidationError defines model for ResendEmailVerificationValidationError.
+type ResendEmailVerificationValidationError struct {
+	Email *[]string `json:"email,omitempty"`
+}
+
+// ResetPasswordValidationError defines model for ResetPasswordValidationError.
+type ResetPasswordValidationError struct {
+	Password *[]string `json:"password,omitempty"`
+	Token    *[]string `json:"token,omitempty"`
+}
+
+// SavedFilter defines model for SavedFilter.
+type SavedFilter struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Id        int       `json:"id"`
+	Name      string    `json:"name"`
+	Query     string    `json:"query"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Session defines model for Session.
+type Session struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Current whether the session is the one making the request
+	Current    bool      `json:"current"`
+	Id         int       `json:"id"`
+	IpAddress  string    `json:"ipAddress"`
+	LastSeenAt time.Time `json:"lastSeenAt"`
+	UserAgent  string    `json:"userAgent"`
+}
+
+// ShareLink defines model for ShareLink.
+type ShareLink struct {
+	CreatedAt   time.Time  `json:"createdAt"`
+	ExpiresAt   *time.Time `json:"expiresAt,omitempty"`
+	HasPassword bool       `json:"hasPassword"`
+	Id          int        `json:"id"`
+	TodoId      int        `json:"todoId"`
+}
+
+// SignInResult defines model for SignInResult.
+type SignInResult struct {
+	// TwoFactorRequired true when the sign-in must be completed at /auth/signIn/twoFactor
+	TwoFactorRequired *bool `json:"twoFactorRequired,omitempty"`
+
+	// TwoFactorToken short-lived token for the second step of the sign-in
+	TwoFactorToken *string `json:"twoFactorToken,omitempty"`
+}
+
 // SignUpValidationError defines model for SignUpValidationError.
 type SignUpValidationError struct {
 	BackIdentification  *[]string `json:"backIdentification,omitempty"`
@@ -38,12 +237,93 @@ type SignUpValidationError struct {
 	Password            *[]string `json:"password,omitempty"`
 }
 
+// StoreAppPasswordValidationError defines model for StoreAppPasswordValidationError.
+type StoreAppPasswordValidationError struct {
+	Name *[]string `json:"name,omitempty"`
+}
+
+// StoreAttachmentValidationError defines model for StoreAttachmentValidationError.
+type StoreAttachmentValidationError struct {
+	File *[]string `json:"file,omitempty"`
+}
+
+// StoreCommentValidationError defines model for StoreCommentValidationError.
+type StoreCommentValidationError struct {
+	Body *[]string `json:"body,omitempty"`
+}
+
+// StoreImportValidationError defines model for StoreImportValidationError.
+type StoreImportValidationError struct {
+	File   *[]string `json:"file,omitempty"`
+	Format *[]string `json:"format,omitempty"`
+}
+
+// StoreSavedFilterValidationError defines model for StoreSavedFilterValidationError.
+type StoreSavedFilterValidationError struct {
+	Name  *[]string `json:"name,omitempty"`
+	Query *[]string `json:"query,omitempty"`
+}
+
+// StoreShareLinkValidationError defines model for StoreShareLinkValidationError.
+type StoreShareLinkValidationError struct {
+	ExpiresAt *[]string `json:"expiresAt,omitempty"`
+	Password  *[]string `json:"password,omitempty"`
+}
+
+// StoreTimeEntryValidationError defines model for StoreTimeEntryValidationError.
+type StoreTimeEntryValidationError struct {
+	Note      *[]string `json:"note,omitempty"`
+	StartedAt *[]string `json:"startedAt,omitempty"`
+	StoppedAt *[]string `json:"stoppedAt,omitempty"`
+	TodoId    *[]string `json:"todoId,omitempty"`
+}
+
+// StoreTodoTemplateValidationError defines model for StoreTodoTemplateValidationError.
+type StoreTodoTemplateValidationError struct {
+	Items *[]string `json:"items,omitempty"`
+	Name  *[]string `json:"name,omitempty"`
+}
+
 // StoreTodoValidationError defines model for StoreTodoValidationError.
 type StoreTodoValidationError struct {
 	Content *[]string `json:"content,omitempty"`
 	Title   *[]string `json:"title,omitempty"`
 }
 
+// StoreWebhookValidationError defines model for StoreWebhookValidationError.
+type StoreWebhookValidationError struct {
+	EventTypes *[]string `json:"eventTypes,omitempty"`
+	Secret     *[]string `json:"secret,omitempty"`
+	Url        *[]string `json:"url,omitempty"`
+}
+
+// TimeEntry defines model for TimeEntry.
+type TimeEntry struct {
+	// DurationSeconds elapsed seconds so far while the timer is running
+	DurationSeconds int64     `json:"durationSeconds"`
+	Id              int       `json:"id"`
+	Note            string    `json:"note"`
+	StartedAt       time.Time `json:"startedAt"`
+
+	// StoppedAt absent while the timer is running
+	StoppedAt *time.Time `json:"stoppedAt,omitempty"`
+	TodoId    int        `json:"todoId"`
+}
+
+// TimeReportDay defines model for TimeReportDay.
+type TimeReportDay struct {
+	Date         openapi_types.Date `json:"date"`
+	Todos        []TimeReportTodo   `json:"todos"`
+	TotalSeconds int64              `json:"totalSeconds"`
+}
+
+// TimeReportTodo defines model for TimeReportTodo.
+type TimeReportTodo struct {
+	Title        string `json:"title"`
+	TodoId       int    `json:"todoId"`
+	TotalSeconds int64  `json:"totalSeconds"`
+}
+
 // Todo defines model for Todo.
 type Todo struct {
 	Content string `json:"content"`
@@ -51,22 +331,273 @@ type Todo struct {
 	Title   string `json:"title"`
 }
 
+// TodoTemplate defines model for TodoTemplate.
+type TodoTemplate struct {
+	CreatedAt time.Time          `json:"createdAt"`
+	Id        int                `json:"id"`
+	Items     []TodoTemplateItem `json:"items"`
+	Name      string             `json:"name"`
+	UpdatedAt time.Time          `json:"updatedAt"`
+}
+
+// TodoTemplateItem title and content may contain placeholders such as {{name}} or {{date+7}}
+type TodoTemplateItem struct {
+	Content string `json:"content"`
+	Title   string `json:"title"`
+}
+
+// TwoFactorValidationError defines model for TwoFactorValidationError.
+type TwoFactorValidationError struct {
+	Code     *[]string `json:"code,omitempty"`
+	Password *[]string `json:"password,omitempty"`
+}
+
+// UnauthorizedReason machine-readable reason why the authentication failed
+type UnauthorizedReason string
+
+// UnlockAccountValidationError defines model for UnlockAccountValidationError.
+type UnlockAccountValidationError struct {
+	Token *[]string `json:"token,omitempty"`
+}
+
+// UpdateMeValidationError defines model for UpdateMeValidationError.
+type UpdateMeValidationError struct {
+	Birthday  *[]string `json:"birthday,omitempty"`
+	FirstName *[]string `json:"firstName,omitempty"`
+	LastName  *[]string `json:"lastName,omitempty"`
+}
+
+// User defines model for User.
+type User struct {
+	Birthday      *openapi_types.Date `json:"birthday,omitempty"`
+	CreatedAt     time.Time           `json:"createdAt"`
+	Email         string              `json:"email"`
+	EmailVerified bool                `json:"emailVerified"`
+	FirstName     string              `json:"firstName"`
+
+	// HasBackIdentification whether the back side of the identification has been submitted
+	HasBackIdentification bool `json:"hasBackIdentification"`
+
+	// HasFrontIdentification whether the front side of the identification has been submitted
+	HasFrontIdentification bool   `json:"hasFrontIdentification"`
+	Id                     int    `json:"id"`
+	LastName               string `json:"lastName"`
+
+	// PendingEmail new email address waiting for confirmation
+	PendingEmail     *string `json:"pendingEmail,omitempty"`
+	TwoFactorEnabled bool    `json:"twoFactorEnabled"`
+}
+
+// VerifyEmailValidationError defines model for VerifyEmailValidationError.
+type VerifyEmailValidationError struct {
+	Token *[]string `json:"token,omitempty"`
+}
+
+// Webhook defines model for Webhook.
+type Webhook struct {
+	CreatedAt  time.Time `json:"createdAt"`
+	EventTypes []string  `json:"eventTypes"`
+	Id         int       `json:"id"`
+	Url        string    `json:"url"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts        int                   `json:"attempts"`
+	CreatedAt       time.Time             `json:"createdAt"`
+	ErrorMessage    *string               `json:"errorMessage,omitempty"`
+	EventId         int                   `json:"eventId"`
+	EventType       string                `json:"eventType"`
+	Id              int                   `json:"id"`
+	LastAttemptedAt *time.Time            `json:"lastAttemptedAt,omitempty"`
+	NextAttemptAt   time.Time             `json:"nextAttemptAt"`
+	ResponseStatus  *int                  `json:"responseStatus,omitempty"`
+	Status          WebhookDeliveryStatus `json:"status"`
+	WebhookId       int                   `json:"webhookId"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDelivery.Status.
+type WebhookDeliveryStatus string
+
+// BadRequestErrorResponse defines model for BadRequestErrorResponse.
+type BadRequestErrorResponse struct {
+	Code    int64  `json:"code"`
+	Message string `json:"message"`
+}
+
+// ChangeEmailResponse defines model for ChangeEmailResponse.
+type ChangeEmailResponse struct {
+	Code   int64                      `json:"code"`
+	Errors ChangeEmailValidationError `json:"errors"`
+	User   *User                      `json:"user,omitempty"`
+}
+
+// CreateAppPasswordResponse defines model for CreateAppPasswordResponse.
+type CreateAppPasswordResponse struct {
+	AppPassword *AppPassword                    `json:"appPassword,omitempty"`
+	Code        int64                           `json:"code"`
+	Errors      StoreAppPasswordValidationError `json:"errors"`
+	Password    *string                         `json:"password,omitempty"`
+}
+
+// CreateShareLinkResponse defines model for CreateShareLinkResponse.
+type CreateShareLinkResponse struct {
+	Code      int64                         `json:"code"`
+	Errors    StoreShareLinkValidationError `json:"errors"`
+	ShareLink *ShareLink                    `json:"shareLink,omitempty"`
+	Token     *string                       `json:"token,omitempty"`
+}
+
 // CsrfResponse defines model for CsrfResponse.
 type CsrfResponse struct {
 	CsrfToken string `json:"csrf_token"`
 }
 
+// DeleteAppPasswordResponse defines model for DeleteAppPasswordResponse.
+type DeleteAppPasswordResponse struct {
+	Code   int64 `json:"code"`
+	Result bool  `json:"result"`
+}
+
+// DeleteAttachmentResponse defines model for DeleteAttachmentResponse.
+type DeleteAttachmentResponse struct {
+	Code   int64 `json:"code"`
+	Result bool  `json:"result"`
+}
+
+// DeleteCommentResponse defines model for DeleteCommentResponse.
+type DeleteCommentResponse struct {
+	Code   int64 `json:"code"`
+	Result bool  `json:"result"`
+}
+
+// DeletePasskeyResponse defines model for DeletePasskeyResponse.
+type DeletePasskeyResponse struct {
+	Code   int64 `json:"code"`
+	Result bool  `json:"result"`
+}
+
+// DeleteSavedFilterResponse defines model for DeleteSavedFilterResponse.
+type DeleteSavedFilterResponse struct {
+	Code   int64 `json:"code"`
+	Result bool  `json:"result"`
+}
+
+// DeleteTimeEntryResponse defines model for DeleteTimeEntryResponse.
+type DeleteTimeEntryResponse struct {
+	Code   int64 `json:"code"`
+	Result bool  `json:"result"`
+}
+
 // DeleteTodoResponse defines model for DeleteTodoResponse.
 type DeleteTodoResponse struct {
 	Code   int64 `json:"code"`
 	Result bool  `json:"result"`
 }
 
+// DeleteTodoTemplateResponse defines model for DeleteTodoTemplateResponse.
+type DeleteTodoTemplateResponse struct {
+	Code   int64 `json:"code"`
+	Result bool  `json:"result"`
+}
+
+// DeleteWebhookResponse defines model for DeleteWebhookResponse.
+type DeleteWebhookResponse struct {
+	Code   int64 `json:"code"`
+	Result bool  `json:"result"`
+}
+
+// ExportTodosResponse defines model for ExportTodosResponse.
+type ExportTodosResponse = openapi_types.File
+
+// FetchActivitiesResponse defines model for FetchActivitiesResponse.
+type FetchActivitiesResponse struct {
+	Activities []Activity `json:"activities"`
+	NextCursor *string    `json:"nextCursor,omitempty"`
+}
+
+// FetchAppPasswordsResponse defines model for FetchAppPasswordsResponse.
+type FetchAppPasswordsResponse struct {
+	AppPasswords []AppPassword `json:"appPasswords"`
+}
+
+// FetchAttachmentsResponse defines model for FetchAttachmentsResponse.
+type FetchAttachmentsResponse struct {
+	Attachments []Attachment `json:"attachments"`
+}
+
+// FetchMeResponse defines model for FetchMeResponse.
+type FetchMeResponse struct {
+	User User `json:"user"`
+}
+
+// FetchPasskeysResponse defines model for FetchPasskeysResponse.
+type FetchPasskeysResponse struct {
+	Passkeys []Passkey `json:"passkeys"`
+}
+
+// FetchSavedFiltersResponse defines model for FetchSavedFiltersResponse.
+type FetchSavedFiltersResponse struct {
+	SavedFilters []SavedFilter `json:"savedFilters"`
+}
+
+// FetchSessionsResponse defines model for FetchSessionsResponse.
+type FetchSessionsResponse struct {
+	Sessions []Session `json:"sessions"`
+}
+
+// FetchShareLinksResponse defines model for FetchShareLinksResponse.
+type FetchShareLinksResponse struct {
+	ShareLinks []ShareLink `json:"shareLinks"`
+}
+
+// FetchTimeEntriesResponse defines model for FetchTimeEntriesResponse.
+type FetchTimeEntriesResponse struct {
+	TimeEntries  []TimeEntry `json:"timeEntries"`
+	TotalSeconds int64       `json:"totalSeconds"`
+}
+
+// FetchTimeReportResponse defines model for FetchTimeReportResponse.
+type FetchTimeReportResponse struct {
+	Days         []TimeReportDay    `json:"days"`
+	From         openapi_types.Date `json:"from"`
+	To           openapi_types.Date `json:"to"`
+	TotalSeconds int64              `json:"totalSeconds"`
+}
+
+// FetchTodoTemplatesResponse defines model for FetchTodoTemplatesResponse.
+type FetchTodoTemplatesResponse struct {
+	TodoTemplates []TodoTemplate `json:"todoTemplates"`
+}
+
 // FetchTodosResponse defines model for FetchTodosResponse.
 type FetchTodosResponse struct {
 	Todos []Todo `json:"todos"`
 }
 
+// FetchWebhookDeliveriesResponse defines model for FetchWebhookDeliveriesResponse.
+type FetchWebhookDeliveriesResponse struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+	NextCursor *string           `json:"nextCursor,omitempty"`
+}
+
+// FetchWebhooksResponse defines model for FetchWebhooksResponse.
+type FetchWebhooksResponse struct {
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+// ForgotPasswordResponse defines model for ForgotPasswordResponse.
+type ForgotPasswordResponse struct {
+	Code   int64                         `json:"code"`
+	Errors ForgotPasswordValidationError `json:"errors"`
+}
+
+// InstantiateTodoTemplateResponse defines model for InstantiateTodoTemplateResponse.
+type InstantiateTodoTemplateResponse struct {
+	Code  int64  `json:"code"`
+	Todos []Todo `json:"todos"`
+}
+
 // InternalServerErrorResponse defines model for InternalServerErrorResponse.
 type InternalServerErrorResponse struct {
 	Code    int64  `json:"code"`
@@ -79,9 +610,71 @@ type NotFoundErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// PasskeyOptionsResponse defines model for PasskeyOptionsResponse.
+type PasskeyOptionsResponse struct {
+	// CeremonyToken token to be sent back when finishing the ceremony
+	CeremonyToken string `json:"ceremonyToken"`
+
+	// Options PublicKeyCredentialCreationOptions or PublicKeyCredentialRequestOptions in JSON form
+	Options map[string]interface{} `json:"options"`
+}
+
+// PasskeyResponse defines model for PasskeyResponse.
+type PasskeyResponse struct {
+	Code    int64                  `json:"code"`
+	Errors  PasskeyValidationError `json:"errors"`
+	Passkey *Passkey               `json:"passkey,omitempty"`
+}
+
+// RedeliverWebhookResponse defines model for RedeliverWebhookResponse.
+type RedeliverWebhookResponse struct {
+	Delivery WebhookDelivery `json:"delivery"`
+}
+
+// RefreshOkResponse defines model for RefreshOkResponse.
+type RefreshOkResponse = map[string]interface{}
+
+// ResendEmailVerificationResponse defines model for ResendEmailVerificationResponse.
+type ResendEmailVerificationResponse struct {
+	Code   int64                                  `json:"code"`
+	Errors ResendEmailVerificationValidationError `json:"errors"`
+}
+
+// ResetPasswordResponse defines model for ResetPasswordResponse.
+type ResetPasswordResponse struct {
+	Code   int64                        `json:"code"`
+	Errors ResetPasswordValidationError `json:"errors"`
+}
+
+// RevokeSessionResponse defines model for RevokeSessionResponse.
+type RevokeSessionResponse struct {
+	Code   int64 `json:"code"`
+	Result bool  `json:"result"`
+}
+
+// RevokeShareLinkResponse defines model for RevokeShareLinkResponse.
+type RevokeShareLinkResponse struct {
+	Code   int64 `json:"code"`
+	Result bool  `json:"result"`
+}
+
+// ShowAttachmentResponse defines model for ShowAttachmentResponse.
+type ShowAttachmentResponse struct {
+	Attachment Attachment `json:"attachment"`
+	ExpiresAt  time.Time  `json:"expiresAt"`
+	Url        string     `json:"url"`
+}
+
+// ShowImportResponse defines model for ShowImportResponse.
+type ShowImportResponse struct {
+	Import Import `json:"import"`
+}
+
 // ShowTodoResponse defines model for ShowTodoResponse.
 type ShowTodoResponse struct {
-	Todo Todo `json:"todo"`
+	Comments           *[]Comment `json:"comments,omitempty"`
+	CommentsNextCursor *string    `json:"commentsNextCursor,omitempty"`
+	Todo               Todo       `json:"todo"`
 }
 
 // SignInBadRequestResponse defines model for SignInBadRequestResponse.
@@ -90,7 +683,10 @@ type SignInBadRequestResponse struct {
 }
 
 // SignInOkResponse defines model for SignInOkResponse.
-type SignInOkResponse = map[string]interface{}
+type SignInOkResponse = SignInResult
+
+// SignOutOkResponse defines model for SignOutOkResponse.
+type SignOutOkResponse = map[string]interface{}
 
 // SignUpResponse defines model for SignUpResponse.
 type SignUpResponse struct {
@@ -98,1007 +694,8507 @@ type SignUpResponse struct {
 	Errors SignUpValidationError `json:"errors"`
 }
 
+// StoreAttachmentResponse defines model for StoreAttachmentResponse.
+type StoreAttachmentResponse struct {
+	Attachment *Attachment                    `json:"attachment,omitempty"`
+	Code       int64                          `json:"code"`
+	Errors     StoreAttachmentValidationError `json:"errors"`
+}
+
+// StoreCommentResponse defines model for StoreCommentResponse.
+type StoreCommentResponse struct {
+	Code    int64                       `json:"code"`
+	Comment *Comment                    `json:"comment,omitempty"`
+	Errors  StoreCommentValidationError `json:"errors"`
+}
+
+// StoreImportResponse defines model for StoreImportResponse.
+type StoreImportResponse struct {
+	Code   int64                      `json:"code"`
+	Errors StoreImportValidationError `json:"errors"`
+	Import *Import                    `json:"import,omitempty"`
+}
+
+// StoreSavedFilterResponse defines model for StoreSavedFilterResponse.
+type StoreSavedFilterResponse struct {
+	Code        int64                           `json:"code"`
+	Errors      StoreSavedFilterValidationError `json:"errors"`
+	SavedFilter *SavedFilter                    `json:"savedFilter,omitempty"`
+}
+
+// StoreTimeEntryResponse defines model for StoreTimeEntryResponse.
+type StoreTimeEntryResponse struct {
+	Code      int64                         `json:"code"`
+	Errors    StoreTimeEntryValidationError `json:"errors"`
+	TimeEntry *TimeEntry                    `json:"timeEntry,omitempty"`
+}
+
 // StoreTodoResponse defines model for StoreTodoResponse.
 type StoreTodoResponse struct {
 	Code   int64                    `json:"code"`
 	Errors StoreTodoValidationError `json:"errors"`
 }
 
-// UnauthorizedErrorResponse defines model for UnauthorizedErrorResponse.
-type UnauthorizedErrorResponse struct {
-	Code    int64  `json:"code"`
-	Message string `json:"message"`
+// StoreTodoTemplateResponse defines model for StoreTodoTemplateResponse.
+type StoreTodoTemplateResponse struct {
+	Code         int64                            `json:"code"`
+	Errors       StoreTodoTemplateValidationError `json:"errors"`
+	TodoTemplate *TodoTemplate                    `json:"todoTemplate,omitempty"`
 }
 
-// SignInInput defines model for SignInInput.
-type SignInInput struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+// StoreWebhookResponse defines model for StoreWebhookResponse.
+type StoreWebhookResponse struct {
+	Code    int64                       `json:"code"`
+	Errors  StoreWebhookValidationError `json:"errors"`
+	Webhook *Webhook                    `json:"webhook,omitempty"`
 }
 
-// StoreTodoInput defines model for StoreTodoInput.
-type StoreTodoInput struct {
-	Content string `json:"content"`
-	Title   string `json:"title"`
+// TimeEntryResponse defines model for TimeEntryResponse.
+type TimeEntryResponse struct {
+	Code      int64     `json:"code"`
+	TimeEntry TimeEntry `json:"timeEntry"`
 }
 
-// PostAuthSignInJSONBody defines parameters for PostAuthSignIn.
-type PostAuthSignInJSONBody struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
-}
+// TooManyRequestsErrorResponse defines model for TooManyRequestsErrorResponse.
+type TooManyRequestsErrorResponse struct {
+	Code    int64  `json:"code"`
+	Message string `json:"message"`
 
-// PostAuthSignUpMultipartBody defines parameters for PostAuthSignUp.
-type PostAuthSignUpMultipartBody struct {
-	BackIdentification  *openapi_types.File `json:"backIdentification,omitempty"`
-	Birthday            *openapi_types.Date `json:"birthday,omitempty"`
-	Email               string              `json:"email"`
-	FirstName           string              `json:"firstName"`
-	FrontIdentification *openapi_types.File `json:"frontIdentification,omitempty"`
-	LastName            string              `json:"lastName"`
-	Password            string              `json:"password"`
+	// RetryAfter seconds to wait before trying again
+	RetryAfter *int64 `json:"retryAfter,omitempty"`
 }
 
-// PostAuthValidateSignUpMultipartBody defines parameters for PostAuthValidateSignUp.
-type PostAuthValidateSignUpMultipartBody struct {
-	BackIdentification  *openapi_types.File `json:"backIdentification,omitempty"`
-	Birthday            *openapi_types.Date `json:"birthday,omitempty"`
-	Email               string              `json:"email"`
-	FirstName           string              `json:"firstName"`
-	FrontIdentification *openapi_types.File `json:"frontIdentification,omitempty"`
-	LastName            string              `json:"lastName"`
-	Password            string              `json:"password"`
+// TwoFactorRecoveryCodesResponse defines model for TwoFactorRecoveryCodesResponse.
+type TwoFactorRecoveryCodesResponse struct {
+	Code          int64                    `json:"code"`
+	Errors        TwoFactorValidationError `json:"errors"`
+	RecoveryCodes *[]string                `json:"recoveryCodes,omitempty"`
 }
 
-// PostTodosJSONBody defines parameters for PostTodos.
-type PostTodosJSONBody struct {
-	Content string `json:"content"`
-	Title   string `json:"title"`
+// TwoFactorResponse defines model for TwoFactorResponse.
+type TwoFactorResponse struct {
+	Code   int64                    `json:"code"`
+	Errors TwoFactorValidationError `json:"errors"`
 }
 
-// PatchTodoJSONBody defines parameters for PatchTodo.
-type PatchTodoJSONBody struct {
-	Content string `json:"content"`
-	Title   string `json:"title"`
+// TwoFactorSetupResponse defines model for TwoFactorSetupResponse.
+type TwoFactorSetupResponse struct {
+	// OtpauthUri otpauth URI to be rendered as a QR code
+	OtpauthUri string `json:"otpauthUri"`
+
+	// Secret base32 encoded TOTP secret for manual entry
+	Secret string `json:"secret"`
 }
 
-// PostAuthSignInJSONRequestBody defines body for PostAuthSignIn for application/json ContentType.
-type PostAuthSignInJSONRequestBody PostAuthSignInJSONBody
+// UnauthorizedErrorResponse defines model for UnauthorizedErrorResponse.
+type UnauthorizedErrorResponse struct {
+	Code    int64  `json:"code"`
+	Message string `json:"message"`
 
-// PostAuthSignUpMultipartRequestBody defines body for PostAuthSignUp for multipart/form-data ContentType.
-type PostAuthSignUpMultipartRequestBody PostAuthSignUpMultipartBody
+	// Reason machine-readable reason why the authentication failed
+	Reason *UnauthorizedReason `json:"reason,omitempty"`
+}
 
-// PostAuthValidateSignUpMultipartRequestBody defines body for PostAuthValidateSignUp for multipart/form-data ContentType.
-type PostAuthValidateSignUpMultipartRequestBody PostAuthValidateSignUpMultipartBody
+// UnlockAccountResponse defines model for UnlockAccountResponse.
+type UnlockAccountResponse struct {
+	Code   int64                        `json:"code"`
+	Errors UnlockAccountValidationError `json:"errors"`
+}
 
-// PostTodosJSONRequestBody defines body for PostTodos for application/json ContentType.
-type PostTodosJSONRequestBody PostTodosJSONBody
+// UpdateMeResponse defines model for UpdateMeResponse.
+type UpdateMeResponse struct {
+	Code   int64                   `json:"code"`
+	Errors UpdateMeValidationError `json:"errors"`
+	User   *User                   `json:"user,omitempty"`
+}
 
-// PatchTodoJSONRequestBody defines body for PatchTodo for application/json ContentType.
-type PatchTodoJSONRequestBody PatchTodoJSONBody
+// VerifyEmailResponse defines model for VerifyEmailResponse.
+type VerifyEmailResponse struct {
+	Code   int64                      `json:"code"`
+	Errors VerifyEmailValidationError `json:"errors"`
+}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Get Csrf
-	// (GET /auth/csrf)
-	GetAuthCsrf(ctx echo.Context) error
-	// Sign In
-	// (POST /auth/signIn)
-	PostAuthSignIn(ctx echo.Context) error
-	// SignUp
-	// (POST /auth/signUp)
-	PostAuthSignUp(ctx echo.Context) error
-	// Validate SignUp
-	// (POST /auth/validateSignUp)
-	PostAuthValidateSignUp(ctx echo.Context) error
-	// Fetch Todos
-	// (GET /todos)
-	GetTodos(ctx echo.Context) error
-	// Create Todo
-	// (POST /todos)
-	PostTodos(ctx echo.Context) error
-	// Delete Todo
-	// (DELETE /todos/{id})
-	DeleteTodo(ctx echo.Context, id string) error
-	// Show Todo
-	// (GET /todos/{id})
-	GetTodo(ctx echo.Context, id string) error
-	// Update Todo
-	// (PATCH /todos/{id})
-	PatchTodo(ctx echo.Context, id string) error
+// ChangeEmailInput defines model for ChangeEmailInput.
+type ChangeEmailInput struct {
+	Email string `json:"email"`
+
+	// Password current password
+	Password string `json:"password"`
 }
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+// DisableTwoFactorInput defines model for DisableTwoFactorInput.
+type DisableTwoFactorInput struct {
+	// Code TOTP code or recovery code
+	Code     string `json:"code"`
+	Password string `json:"password"`
 }
 
-// GetAuthCsrf converts echo context to params.
-func (w *ServerInterfaceWrapper) GetAuthCsrf(ctx echo.Context) error {
-	var err error
+// ForgotPasswordInput defines model for ForgotPasswordInput.
+type ForgotPasswordInput struct {
+	Email string `json:"email"`
+}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetAuthCsrf(ctx)
-	return err
+// InstantiateTodoTemplateInput defines model for InstantiateTodoTemplateInput.
+type InstantiateTodoTemplateInput struct {
+	Variables *map[string]string `json:"variables,omitempty"`
 }
 
-// PostAuthSignIn converts echo context to params.
-func (w *ServerInterfaceWrapper) PostAuthSignIn(ctx echo.Context) error {
-	var err error
+// PasskeyRegisterBeginInput defines model for PasskeyRegisterBeginInput.
+type PasskeyRegisterBeginInput struct {
+	// Code TOTP code or recovery code. Required when two-factor authentication is enabled.
+	Code     *string `json:"code,omitempty"`
+	Password string  `json:"password"`
+}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostAuthSignIn(ctx)
-	return err
+// PasskeyRegisterInput defines model for PasskeyRegisterInput.
+type PasskeyRegisterInput struct {
+	CeremonyToken string `json:"ceremonyToken"`
+
+	// Credential PublicKeyCredential returned by navigator.credentials.create() in JSON form
+	Credential map[string]interface{} `json:"credential"`
+
+	// Name display name of the passkey. Defaults to the registration date.
+	Name *string `json:"name,omitempty"`
 }
 
-// PostAuthSignUp converts echo context to params.
-func (w *ServerInterfaceWrapper) PostAuthSignUp(ctx echo.Context) error {
-	var err error
+// PasskeySignInInput defines model for PasskeySignInInput.
+type PasskeySignInInput struct {
+	CeremonyToken string `json:"ceremonyToken"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostAuthSignUp(ctx)
-	return err
+	// Credential PublicKeyCredential returned by navigator.credentials.get() in JSON form
+	Credential map[string]interface{} `json:"credential"`
 }
 
-// PostAuthValidateSignUp converts echo context to params.
-func (w *ServerInterfaceWrapper) PostAuthValidateSignUp(ctx echo.Context) error {
-	var err error
+// RegenerateRecoveryCodesInput defines model for RegenerateRecoveryCodesInput.
+type RegenerateRecoveryCodesInput struct {
+	// Code TOTP code or recovery code
+	Code     string `json:"code"`
+	Password string `json:"password"`
+}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostAuthValidateSignUp(ctx)
-	return err
+// ResendEmailVerificationInput defines model for ResendEmailVerificationInput.
+type ResendEmailVerificationInput struct {
+	Email string `json:"email"`
 }
 
-// GetTodos converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTodos(ctx echo.Context) error {
-	var err error
+// ResetPasswordInput defines model for ResetPasswordInput.
+type ResetPasswordInput struct {
+	Password string `json:"password"`
+	Token    string `json:"token"`
+}
 
-	ctx.Set(CookieAuthScopes, []string{})
+// SignInInput defines model for SignInInput.
+type SignInInput struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTodos(ctx)
-	return err
+// StoreAppPasswordInput defines model for StoreAppPasswordInput.
+type StoreAppPasswordInput struct {
+	Name string `json:"name"`
 }
 
-// PostTodos converts echo context to params.
-func (w *ServerInterfaceWrapper) PostTodos(ctx echo.Context) error {
-	var err error
+// StoreCommentInput defines model for StoreCommentInput.
+type StoreCommentInput struct {
+	Body string `json:"body"`
+}
 
-	ctx.Set(CookieAuthScopes, []string{})
+// StoreSavedFilterInput defines model for StoreSavedFilterInput.
+type StoreSavedFilterInput struct {
+	Name string `json:"name"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostTodos(ctx)
-	return err
+	// Query filter query in the same syntax as the `q` parameter of GET /todos
+	Query string `json:"query"`
 }
 
-// DeleteTodo converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteTodo(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "id" -------------
-	var id string
+// StoreShareLinkInput defines model for StoreShareLinkInput.
+type StoreShareLinkInput struct {
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	Password  *string    `json:"password,omitempty"`
+}
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
-	}
+// StoreTimeEntryInput defines model for StoreTimeEntryInput.
+type StoreTimeEntryInput struct {
+	Note      *string   `json:"note,omitempty"`
+	StartedAt time.Time `json:"startedAt"`
+	StoppedAt time.Time `json:"stoppedAt"`
+	TodoId    int       `json:"todoId"`
+}
 
-	ctx.Set(CookieAuthScopes, []string{})
+// StoreTodoInput defines model for StoreTodoInput.
+type StoreTodoInput struct {
+	Content string `json:"content"`
+	Title   string `json:"title"`
+}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteTodo(ctx, id)
-	return err
+// StoreTodoTemplateInput defines model for StoreTodoTemplateInput.
+type StoreTodoTemplateInput struct {
+	Items []TodoTemplateItem `json:"items"`
+	Name  string             `json:"name"`
 }
 
-// GetTodo converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTodo(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "id" -------------
-	var id string
+// StoreWebhookInput defines model for StoreWebhookInput.
+type StoreWebhookInput struct {
+	EventTypes []string `json:"eventTypes"`
+	Secret     string   `json:"secret"`
+	Url        string   `json:"url"`
+}
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
-	}
+// TwoFactorCodeInput defines model for TwoFactorCodeInput.
+type TwoFactorCodeInput struct {
+	Code string `json:"code"`
+}
 
-	ctx.Set(CookieAuthScopes, []string{})
+// TwoFactorSignInInput defines model for TwoFactorSignInInput.
+type TwoFactorSignInInput struct {
+	// Code TOTP code or recovery code
+	Code           string `json:"code"`
+	TwoFactorToken string `json:"twoFactorToken"`
+}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTodo(ctx, id)
-	return err
+// UnlockAccountInput defines model for UnlockAccountInput.
+type UnlockAccountInput struct {
+	Token string `json:"token"`
 }
 
-// PatchTodo converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchTodo(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "id" -------------
-	var id string
+// UpdateMeInput defines model for UpdateMeInput.
+type UpdateMeInput struct {
+	Birthday  *openapi_types.Date `json:"birthday,omitempty"`
+	FirstName *string             `json:"firstName,omitempty"`
+	LastName  *string             `json:"lastName,omitempty"`
+}
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
-	}
+// VerifyEmailInput defines model for VerifyEmailInput.
+type VerifyEmailInput struct {
+	Token string `json:"token"`
+}
 
-	ctx.Set(CookieAuthScopes, []string{})
+// GetActivitiesParams defines parameters for GetActivities.
+type GetActivitiesParams struct {
+	// Cursor cursor of the activities returned by previous response
+	Cursor    *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit     *int    `form:"limit,omitempty" json:"limit,omitempty"`
+	TodoId    *int    `form:"todoId,omitempty" json:"todoId,omitempty"`
+	ActorId   *int    `form:"actorId,omitempty" json:"actorId,omitempty"`
+	EventType *string `form:"eventType,omitempty" json:"eventType,omitempty"`
+}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchTodo(ctx, id)
-	return err
+// PostAppPasswordsJSONBody defines parameters for PostAppPasswords.
+type PostAppPasswordsJSONBody struct {
+	Name string `json:"name"`
 }
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+// PostAuthEmailResendJSONBody defines parameters for PostAuthEmailResend.
+type PostAuthEmailResendJSONBody struct {
+	Email string `json:"email"`
 }
 
-// RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
+// PostAuthEmailVerifyJSONBody defines parameters for PostAuthEmailVerify.
+type PostAuthEmailVerifyJSONBody struct {
+	Token string `json:"token"`
 }
 
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
-func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+// PostAuthPasskeysRegisterBeginJSONBody defines parameters for PostAuthPasskeysRegisterBegin.
+type PostAuthPasskeysRegisterBeginJSONBody struct {
+	// Code TOTP code or recovery code. Required when two-factor authentication is enabled.
+	Code     *string `json:"code,omitempty"`
+	Password string  `json:"password"`
+}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
-	}
+// PostAuthPasskeysRegisterFinishJSONBody defines parameters for PostAuthPasskeysRegisterFinish.
+type PostAuthPasskeysRegisterFinishJSONBody struct {
+	CeremonyToken string `json:"ceremonyToken"`
 
-	router.GET(baseURL+"/auth/csrf", wrapper.GetAuthCsrf)
-	router.POST(baseURL+"/auth/signIn", wrapper.PostAuthSignIn)
-	router.POST(baseURL+"/auth/signUp", wrapper.PostAuthSignUp)
-	router.POST(baseURL+"/auth/validateSignUp", wrapper.PostAuthValidateSignUp)
-	router.GET(baseURL+"/todos", wrapper.GetTodos)
-	router.POST(baseURL+"/todos", wrapper.PostTodos)
-	router.DELETE(baseURL+"/todos/:id", wrapper.DeleteTodo)
-	router.GET(baseURL+"/todos/:id", wrapper.GetTodo)
-	router.PATCH(baseURL+"/todos/:id", wrapper.PatchTodo)
+	// Credential PublicKeyCredential returned by navigator.credentials.create() in JSON form
+	Credential map[string]interface{} `json:"credential"`
 
+	// Name display name of the passkey. Defaults to the registration date.
+	Name *string `json:"name,omitempty"`
 }
 
-type CsrfResponseJSONResponse struct {
-	CsrfToken string `json:"csrf_token"`
-}
+// PostAuthPasskeysSignInFinishJSONBody defines parameters for PostAuthPasskeysSignInFinish.
+type PostAuthPasskeysSignInFinishJSONBody struct {
+	CeremonyToken string `json:"ceremonyToken"`
 
-type DeleteTodoResponseJSONResponse struct {
-	Code   int64 `json:"code"`
-	Result bool  `json:"result"`
+	// Credential PublicKeyCredential returned by navigator.credentials.get() in JSON form
+	Credential map[string]interface{} `json:"credential"`
 }
 
-type FetchTodosResponseJSONResponse struct {
-	Todos []Todo `json:"todos"`
+// PostAuthPasswordForgotJSONBody defines parameters for PostAuthPasswordForgot.
+type PostAuthPasswordForgotJSONBody struct {
+	Email string `json:"email"`
 }
 
-type InternalServerErrorResponseJSONResponse struct {
-	Code    int64  `json:"code"`
-	Message string `json:"message"`
+// PostAuthPasswordResetJSONBody defines parameters for PostAuthPasswordReset.
+type PostAuthPasswordResetJSONBody struct {
+	Password string `json:"password"`
+	Token    string `json:"token"`
 }
 
-type NotFoundErrorResponseJSONResponse struct {
-	Code    int64  `json:"code"`
-	Message string `json:"message"`
+// PostAuthRefreshParams defines parameters for PostAuthRefresh.
+type PostAuthRefreshParams struct {
+	// RefreshToken opaque refresh token issued on sign in
+	RefreshToken *string `form:"refresh_token,omitempty" json:"refresh_token,omitempty"`
 }
 
-type ShowTodoResponseJSONResponse struct {
-	Todo Todo `json:"todo"`
+// PostAuthSignInJSONBody defines parameters for PostAuthSignIn.
+type PostAuthSignInJSONBody struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
 }
 
-type SignInBadRequestResponseJSONResponse struct {
-	Errors []string `json:"errors"`
+// PostAuthSignInTwoFactorJSONBody defines parameters for PostAuthSignInTwoFactor.
+type PostAuthSignInTwoFactorJSONBody struct {
+	// Code TOTP code or recovery code
+	Code           string `json:"code"`
+	TwoFactorToken string `json:"twoFactorToken"`
 }
 
-type SignInOkResponseResponseHeaders struct {
-	SetCookie string
+// PostAuthSignOutParams defines parameters for PostAuthSignOut.
+type PostAuthSignOutParams struct {
+	// Token access token issued on sign in
+	Token *string `form:"token,omitempty" json:"token,omitempty"`
+
+	// RefreshToken opaque refresh token issued on sign in
+	RefreshToken *string `form:"refresh_token,omitempty" json:"refresh_token,omitempty"`
 }
-type SignInOkResponseJSONResponse struct {
-	Body map[string]interface{}
 
-	Headers SignInOkResponseResponseHeaders
+// PostAuthSignUpMultipartBody defines parameters for PostAuthSignUp.
+type PostAuthSignUpMultipartBody struct {
+	BackIdentification  *openapi_types.File `json:"backIdentification,omitempty"`
+	Birthday            *openapi_types.Date `json:"birthday,omitempty"`
+	Email               string              `json:"email"`
+	FirstName           string              `json:"firstName"`
+	FrontIdentification *openapi_types.File `json:"frontIdentification,omitempty"`
+	LastName            string              `json:"lastName"`
+	Password            string              `json:"password"`
 }
 
-type SignUpResponseJSONResponse struct {
-	Code   int64                 `json:"code"`
-	Errors SignUpValidationError `json:"errors"`
+// PostAuthTwoFactorConfirmJSONBody defines parameters for PostAuthTwoFactorConfirm.
+type PostAuthTwoFactorConfirmJSONBody struct {
+	Code string `json:"code"`
 }
 
-type StoreTodoResponseJSONResponse struct {
-	Code   int64                    `json:"code"`
-	Errors StoreTodoValidationError `json:"errors"`
+// PostAuthTwoFactorDisableJSONBody defines parameters for PostAuthTwoFactorDisable.
+type PostAuthTwoFactorDisableJSONBody struct {
+	// Code TOTP code or recovery code
+	Code     string `json:"code"`
+	Password string `json:"password"`
 }
 
-type UnauthorizedErrorResponseJSONResponse struct {
-	Code    int64  `json:"code"`
-	Message string `json:"message"`
+// PostAuthTwoFactorRecoveryCodesJSONBody defines parameters for PostAuthTwoFactorRecoveryCodes.
+type PostAuthTwoFactorRecoveryCodesJSONBody struct {
+	// Code TOTP code or recovery code
+	Code     string `json:"code"`
+	Password string `json:"password"`
 }
 
-type GetAuthCsrfRequestObject struct {
+// PostAuthUnlockJSONBody defines parameters for PostAuthUnlock.
+type PostAuthUnlockJSONBody struct {
+	Token string `json:"token"`
 }
 
-type GetAuthCsrfResponseObject interface {
-	VisitGetAuthCsrfResponse(w http.ResponseWriter) error
+// PostAuthValidateSignUpMultipartBody defines parameters for PostAuthValidateSignUp.
+type PostAuthValidateSignUpMultipartBody struct {
+	BackIdentification  *openapi_types.File `json:"backIdentification,omitempty"`
+	Birthday            *openapi_types.Date `json:"birthday,omitempty"`
+	Email               string              `json:"email"`
+	FirstName           string              `json:"firstName"`
+	FrontIdentification *openapi_types.File `json:"frontIdentification,omitempty"`
+	LastName            string              `json:"lastName"`
+	Password            string              `json:"password"`
 }
 
-type GetAuthCsrf200JSONResponse struct{ CsrfResponseJSONResponse }
+// GetExportsParams defines parameters for GetExports.
+type GetExportsParams struct {
+	// Format export file format
+	Format GetExportsParamsFormat `form:"format" json:"format"`
 
-func (response GetAuthCsrf200JSONResponse) VisitGetAuthCsrfResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Q filter query such as `title:meeting AND NOT created>30d`. Supported fields are `title` and `content` (with `:`) and `created` and `updated` (with `<`, `<=`, `>`, `>=`, `=` and a `2006-01-02` date or a `7d` duration in h/d/w). Terms are combined with AND, OR, NOT and parentheses; a bare word matches title or content. Other fields such as `tag` or `due` are rejected with 400.
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// FilterId id of the saved filter to apply
+	FilterId *int `form:"filterId,omitempty" json:"filterId,omitempty"`
 }
 
-type GetAuthCsrf500JSONResponse struct {
-	InternalServerErrorResponseJSONResponse
+// GetExportsParamsFormat defines parameters for GetExports.
+type GetExportsParamsFormat string
+
+// PostImportsMultipartBody defines parameters for PostImports.
+type PostImportsMultipartBody struct {
+	File   openapi_types.File `json:"file"`
+	Format string             `json:"format"`
 }
 
-func (response GetAuthCsrf500JSONResponse) VisitGetAuthCsrfResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// PostSavedFiltersJSONBody defines parameters for PostSavedFilters.
+type PostSavedFiltersJSONBody struct {
+	Name string `json:"name"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Query filter query in the same syntax as the `q` parameter of GET /todos
+	Query string `json:"query"`
 }
 
-type PostAuthSignInRequestObject struct {
-	Body *PostAuthSignInJSONRequestBody
+// PutSavedFilterJSONBody defines parameters for PutSavedFilter.
+type PutSavedFilterJSONBody struct {
+	Name string `json:"name"`
+
+	// Query filter query in the same syntax as the `q` parameter of GET /todos
+	Query string `json:"query"`
 }
 
-type PostAuthSignInResponseObject interface {
-	VisitPostAuthSignInResponse(w http.ResponseWriter) error
+// GetSharedTodoParams defines parameters for GetSharedTodo.
+type GetSharedTodoParams struct {
+	// XSharePassword password of the share link if it is protected
+	XSharePassword *string `json:"X-Share-Password,omitempty"`
 }
 
-type PostAuthSignIn200JSONResponse struct{ SignInOkResponseJSONResponse }
+// GetTimeEntriesParams defines parameters for GetTimeEntries.
+type GetTimeEntriesParams struct {
+	// TodoId only entries of the Todo
+	TodoId *int `form:"todoId,omitempty" json:"todoId,omitempty"`
 
-func (response PostAuthSignIn200JSONResponse) VisitPostAuthSignInResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
-	w.WriteHeader(200)
+	// From first date of the range (inclusive)
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
 
-	return json.NewEncoder(w).Encode(response.Body)
+	// To last date of the range (inclusive)
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
 }
 
-type PostAuthSignIn400JSONResponse struct {
-	SignInBadRequestResponseJSONResponse
+// PostTimeEntriesJSONBody defines parameters for PostTimeEntries.
+type PostTimeEntriesJSONBody struct {
+	Note      *string   `json:"note,omitempty"`
+	StartedAt time.Time `json:"startedAt"`
+	StoppedAt time.Time `json:"stoppedAt"`
+	TodoId    int       `json:"todoId"`
 }
 
-func (response PostAuthSignIn400JSONResponse) VisitPostAuthSignInResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// GetTimeReportsParams defines parameters for GetTimeReports.
+type GetTimeReportsParams struct {
+	// From first date of the range (inclusive)
+	From openapi_types.Date `form:"from" json:"from"`
 
-type PostAuthSignIn500JSONResponse struct {
-	InternalServerErrorResponseJSONResponse
+	// To last date of the range (inclusive)
+	To openapi_types.Date `form:"to" json:"to"`
 }
 
-func (response PostAuthSignIn500JSONResponse) VisitPostAuthSignInResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// GetTimeReportsCsvParams defines parameters for GetTimeReportsCsv.
+type GetTimeReportsCsvParams struct {
+	// From first date of the range (inclusive)
+	From openapi_types.Date `form:"from" json:"from"`
 
-	return json.NewEncoder(w).Encode(response)
+	// To last date of the range (inclusive)
+	To openapi_types.Date `form:"to" json:"to"`
 }
 
-type PostAuthSignUpRequestObject struct {
-	Body *multipart.Reader
+// PostTodoTemplatesJSONBody defines parameters for PostTodoTemplates.
+type PostTodoTemplatesJSONBody struct {
+	Items []TodoTemplateItem `json:"items"`
+	Name  string             `json:"name"`
 }
 
-type PostAuthSignUpResponseObject interface {
-	VisitPostAuthSignUpResponse(w http.ResponseWriter) error
+// PutTodoTemplateJSONBody defines parameters for PutTodoTemplate.
+type PutTodoTemplateJSONBody struct {
+	Items []TodoTemplateItem `json:"items"`
+	Name  string             `json:"name"`
 }
 
-type PostAuthSignUp200JSONResponse struct{ SignUpResponseJSONResponse }
+// PostTodoTemplateInstantiateJSONBody defines parameters for PostTodoTemplateInstantiate.
+type PostTodoTemplateInstantiateJSONBody struct {
+	Variables *map[string]string `json:"variables,omitempty"`
+}
 
-func (response PostAuthSignUp200JSONResponse) VisitPostAuthSignUpResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetTodosParams defines parameters for GetTodos.
+type GetTodosParams struct {
+	// Q filter query such as `title:meeting AND NOT created>30d`. Supported fields are `title` and `content` (with `:`) and `created` and `updated` (with `<`, `<=`, `>`, `>=`, `=` and a `2006-01-02` date or a `7d` duration in h/d/w). Terms are combined with AND, OR, NOT and parentheses; a bare word matches title or content. Other fields such as `tag` or `due` are rejected with 400.
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// FilterId id of the saved filter to apply
+	FilterId *int `form:"filterId,omitempty" json:"filterId,omitempty"`
 }
 
-type PostAuthSignUp400JSONResponse struct {
-	Code   int64                 `json:"code"`
-	Errors SignUpValidationError `json:"errors"`
+// PostTodosJSONBody defines parameters for PostTodos.
+type PostTodosJSONBody struct {
+	Content string `json:"content"`
+	Title   string `json:"title"`
 }
 
-func (response PostAuthSignUp400JSONResponse) VisitPostAuthSignUpResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
+// GetTodoParams defines parameters for GetTodo.
+type GetTodoParams struct {
+	// CommentsCursor cursor of the comments returned by previous response
+	CommentsCursor *string `form:"commentsCursor,omitempty" json:"commentsCursor,omitempty"`
+	CommentsLimit  *int    `form:"commentsLimit,omitempty" json:"commentsLimit,omitempty"`
 }
 
-type PostAuthSignUp500JSONResponse struct {
-	InternalServerErrorResponseJSONResponse
+// PatchTodoJSONBody defines parameters for PatchTodo.
+type PatchTodoJSONBody struct {
+	Content string `json:"content"`
+	Title   string `json:"title"`
 }
 
-func (response PostAuthSignUp500JSONResponse) VisitPostAuthSignUpResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// PostTodoAttachmentsMultipartBody defines parameters for PostTodoAttachments.
+type PostTodoAttachmentsMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// PostTodoCommentsJSONBody defines parameters for PostTodoComments.
+type PostTodoCommentsJSONBody struct {
+	Body string `json:"body"`
 }
 
-type PostAuthValidateSignUpRequestObject struct {
-	Body *multipart.Reader
+// PatchTodoCommentJSONBody defines parameters for PatchTodoComment.
+type PatchTodoCommentJSONBody struct {
+	Body string `json:"body"`
 }
 
-type PostAuthValidateSignUpResponseObject interface {
-	VisitPostAuthValidateSignUpResponse(w http.ResponseWriter) error
+// PostTodoShareLinksJSONBody defines parameters for PostTodoShareLinks.
+type PostTodoShareLinksJSONBody struct {
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	Password  *string    `json:"password,omitempty"`
 }
 
-type PostAuthValidateSignUp200JSONResponse struct{ SignUpResponseJSONResponse }
+// PatchUsersMeJSONBody defines parameters for PatchUsersMe.
+type PatchUsersMeJSONBody struct {
+	Birthday  *openapi_types.Date `json:"birthday,omitempty"`
+	FirstName *string             `json:"firstName,omitempty"`
+	LastName  *string             `json:"lastName,omitempty"`
+}
 
-func (response PostAuthValidateSignUp200JSONResponse) VisitPostAuthValidateSignUpResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostUsersMeEmailJSONBody defines parameters for PostUsersMeEmail.
+type PostUsersMeEmailJSONBody struct {
+	Email string `json:"email"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Password current password
+	Password string `json:"password"`
 }
 
-type PostAuthValidateSignUp400JSONResponse struct {
-	Code   int64                 `json:"code"`
-	Errors SignUpValidationError `json:"errors"`
+// PostWebhooksJSONBody defines parameters for PostWebhooks.
+type PostWebhooksJSONBody struct {
+	EventTypes []string `json:"eventTypes"`
+	Secret     string   `json:"secret"`
+	Url        string   `json:"url"`
 }
 
-func (response PostAuthValidateSignUp400JSONResponse) VisitPostAuthValidateSignUpResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
+// GetWebhookDeliveriesParams defines parameters for GetWebhookDeliveries.
+type GetWebhookDeliveriesParams struct {
+	// Cursor cursor of the deliveries returned by previous response
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-type PostAuthValidateSignUp500JSONResponse struct {
-	InternalServerErrorResponseJSONResponse
-}
+// PostAppPasswordsJSONRequestBody defines body for PostAppPasswords for application/json ContentType.
+type PostAppPasswordsJSONRequestBody PostAppPasswordsJSONBody
 
-func (response PostAuthValidateSignUp500JSONResponse) VisitPostAuthValidateSignUpResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// PostAuthEmailResendJSONRequestBody defines body for PostAuthEmailResend for application/json ContentType.
+type PostAuthEmailResendJSONRequestBody PostAuthEmailResendJSONBody
 
-	return json.NewEncoder(w).Encode(response)
-}
+// PostAuthEmailVerifyJSONRequestBody defines body for PostAuthEmailVerify for application/json ContentType.
+type PostAuthEmailVerifyJSONRequestBody PostAuthEmailVerifyJSONBody
 
-type GetTodosRequestObject struct {
-}
+// PostAuthPasskeysRegisterBeginJSONRequestBody defines body for PostAuthPasskeysRegisterBegin for application/json ContentType.
+type PostAuthPasskeysRegisterBeginJSONRequestBody PostAuthPasskeysRegisterBeginJSONBody
 
-type GetTodosResponseObject interface {
-	VisitGetTodosResponse(w http.ResponseWriter) error
-}
+// PostAuthPasskeysRegisterFinishJSONRequestBody defines body for PostAuthPasskeysRegisterFinish for application/json ContentType.
+type PostAuthPasskeysRegisterFinishJSONRequestBody PostAuthPasskeysRegisterFinishJSONBody
 
-type GetTodos200JSONResponse struct{ FetchTodosResponseJSONResponse }
+// PostAuthPasskeysSignInFinishJSONRequestBody defines body for PostAuthPasskeysSignInFinish for application/json ContentType.
+type PostAuthPasskeysSignInFinishJSONRequestBody PostAuthPasskeysSignInFinishJSONBody
 
-func (response GetTodos200JSONResponse) VisitGetTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostAuthPasswordForgotJSONRequestBody defines body for PostAuthPasswordForgot for application/json ContentType.
+type PostAuthPasswordForgotJSONRequestBody PostAuthPasswordForgotJSONBody
 
-	return json.NewEncoder(w).Encode(response)
-}
+// PostAuthPasswordResetJSONRequestBody defines body for PostAuthPasswordReset for application/json ContentType.
+type PostAuthPasswordResetJSONRequestBody PostAuthPasswordResetJSONBody
 
-type GetTodos401JSONResponse struct {
-	UnauthorizedErrorResponseJSONResponse
-}
+// PostAuthSignInJSONRequestBody defines body for PostAuthSignIn for application/json ContentType.
+type PostAuthSignInJSONRequestBody PostAuthSignInJSONBody
 
-func (response GetTodos401JSONResponse) VisitGetTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// PostAuthSignInTwoFactorJSONRequestBody defines body for PostAuthSignInTwoFactor for application/json ContentType.
+type PostAuthSignInTwoFactorJSONRequestBody PostAuthSignInTwoFactorJSONBody
 
-	return json.NewEncoder(w).Encode(response)
-}
+// PostAuthSignUpMultipartRequestBody defines body for PostAuthSignUp for multipart/form-data ContentType.
+type PostAuthSignUpMultipartRequestBody PostAuthSignUpMultipartBody
 
-type GetTodos500JSONResponse struct {
-	InternalServerErrorResponseJSONResponse
-}
+// PostAuthTwoFactorConfirmJSONRequestBody defines body for PostAuthTwoFactorConfirm for application/json ContentType.
+type PostAuthTwoFactorConfirmJSONRequestBody PostAuthTwoFactorConfirmJSONBody
 
-func (response GetTodos500JSONResponse) VisitGetTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// PostAuthTwoFactorDisableJSONRequestBody defines body for PostAuthTwoFactorDisable for application/json ContentType.
+type PostAuthTwoFactorDisableJSONRequestBody PostAuthTwoFactorDisableJSONBody
 
-	return json.NewEncoder(w).Encode(response)
-}
+// PostAuthTwoFactorRecoveryCodesJSONRequestBody defines body for PostAuthTwoFactorRecoveryCodes for application/json ContentType.
+type PostAuthTwoFactorRecoveryCodesJSONRequestBody PostAuthTwoFactorRecoveryCodesJSONBody
 
-type PostTodosRequestObject struct {
-	Body *PostTodosJSONRequestBody
-}
+// PostAuthUnlockJSONRequestBody defines body for PostAuthUnlock for application/json ContentType.
+type PostAuthUnlockJSONRequestBody PostAuthUnlockJSONBody
 
-type PostTodosResponseObject interface {
-	VisitPostTodosResponse(w http.ResponseWriter) error
-}
+// PostAuthValidateSignUpMultipartRequestBody defines body for PostAuthValidateSignUp for multipart/form-data ContentType.
+type PostAuthValidateSignUpMultipartRequestBody PostAuthValidateSignUpMultipartBody
 
-type PostTodos200JSONResponse struct{ StoreTodoResponseJSONResponse }
+// PostImportsMultipartRequestBody defines body for PostImports for multipart/form-data ContentType.
+type PostImportsMultipartRequestBody PostImportsMultipartBody
 
-func (response PostTodos200JSONResponse) VisitPostTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostSavedFiltersJSONRequestBody defines body for PostSavedFilters for application/json ContentType.
+type PostSavedFiltersJSONRequestBody PostSavedFiltersJSONBody
 
-	return json.NewEncoder(w).Encode(response)
-}
+// PutSavedFilterJSONRequestBody defines body for PutSavedFilter for application/json ContentType.
+type PutSavedFilterJSONRequestBody PutSavedFilterJSONBody
 
-type PostTodos400JSONResponse struct {
-	Code   int64                    `json:"code"`
-	Errors StoreTodoValidationError `json:"errors"`
-}
+// PostTimeEntriesJSONRequestBody defines body for PostTimeEntries for application/json ContentType.
+type PostTimeEntriesJSONRequestBody PostTimeEntriesJSONBody
 
-func (response PostTodos400JSONResponse) VisitPostTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// PostTodoTemplatesJSONRequestBody defines body for PostTodoTemplates for application/json ContentType.
+type PostTodoTemplatesJSONRequestBody PostTodoTemplatesJSONBody
 
-	return json.NewEncoder(w).Encode(response)
-}
+// PutTodoTemplateJSONRequestBody defines body for PutTodoTemplate for application/json ContentType.
+type PutTodoTemplateJSONRequestBody PutTodoTemplateJSONBody
 
-type PostTodos401JSONResponse struct {
-	UnauthorizedErrorResponseJSONResponse
-}
+// PostTodoTemplateInstantiateJSONRequestBody defines body for PostTodoTemplateInstantiate for application/json ContentType.
+type PostTodoTemplateInstantiateJSONRequestBody PostTodoTemplateInstantiateJSONBody
 
-func (response PostTodos401JSONResponse) VisitPostTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// PostTodosJSONRequestBody defines body for PostTodos for application/json ContentType.
+type PostTodosJSONRequestBody PostTodosJSONBody
 
-	return json.NewEncoder(w).Encode(response)
-}
+// PatchTodoJSONRequestBody defines body for PatchTodo for application/json ContentType.
+type PatchTodoJSONRequestBody PatchTodoJSONBody
 
-type PostTodos500JSONResponse struct {
-	InternalServerErrorResponseJSONResponse
-}
+// PostTodoAttachmentsMultipartRequestBody defines body for PostTodoAttachments for multipart/form-data ContentType.
+type PostTodoAttachmentsMultipartRequestBody PostTodoAttachmentsMultipartBody
 
-func (response PostTodos500JSONResponse) VisitPostTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// PostTodoCommentsJSONRequestBody defines body for PostTodoComments for application/json ContentType.
+type PostTodoCommentsJSONRequestBody PostTodoCommentsJSONBody
 
-	return json.NewEncoder(w).Encode(response)
-}
+// PatchTodoCommentJSONRequestBody defines body for PatchTodoComment for application/json ContentType.
+type PatchTodoCommentJSONRequestBody PatchTodoCommentJSONBody
 
-type DeleteTodoRequestObject struct {
-	Id string `json:"id"`
-}
+// PostTodoShareLinksJSONRequestBody defines body for PostTodoShareLinks for application/json ContentType.
+type PostTodoShareLinksJSONRequestBody PostTodoShareLinksJSONBody
 
-type DeleteTodoResponseObject interface {
-	VisitDeleteTodoResponse(w http.ResponseWriter) error
-}
+// PatchUsersMeJSONRequestBody defines body for PatchUsersMe for application/json ContentType.
+type PatchUsersMeJSONRequestBody PatchUsersMeJSONBody
 
-type DeleteTodo200JSONResponse struct{ DeleteTodoResponseJSONResponse }
+// PostUsersMeEmailJSONRequestBody defines body for PostUsersMeEmail for application/json ContentType.
+type PostUsersMeEmailJSONRequestBody PostUsersMeEmailJSONBody
 
-func (response DeleteTodo200JSONResponse) VisitDeleteTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostWebhooksJSONRequestBody defines body for PostWebhooks for application/json ContentType.
+type PostWebhooksJSONRequestBody PostWebhooksJSONBody
 
-	return json.NewEncoder(w).Encode(response)
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Fetch Activities
+	// (GET /activity)
+	GetActivities(ctx echo.Context, params GetActivitiesParams) error
+	// Fetch App Passwords
+	// (GET /appPasswords)
+	GetAppPasswords(ctx echo.Context) error
+	// Create App Password
+	// (POST /appPasswords)
+	PostAppPasswords(ctx echo.Context) error
+	// Revoke App Password
+	// (DELETE /appPasswords/{id})
+	DeleteAppPassword(ctx echo.Context, id string) error
+	// Get Csrf
+	// (GET /auth/csrf)
+	GetAuthCsrf(ctx echo.Context) error
+	// Resend Email Verification
+	// (POST /auth/email/resend)
+	PostAuthEmailResend(ctx echo.Context) error
+	// Verify Email
+	// (POST /auth/email/verify)
+	PostAuthEmailVerify(ctx echo.Context) error
+	// Fetch Passkeys
+	// (GET /auth/passkeys)
+	GetAuthPasskeys(ctx echo.Context) error
+	// Begin Passkey Registration
+	// (POST /auth/passkeys/register/begin)
+	PostAuthPasskeysRegisterBegin(ctx echo.Context) error
+	// Finish Passkey Registration
+	// (POST /auth/passkeys/register/finish)
+	PostAuthPasskeysRegisterFinish(ctx echo.Context) error
+	// Begin Passkey Sign In
+	// (POST /auth/passkeys/signIn/begin)
+	PostAuthPasskeysSignInBegin(ctx echo.Context) error
+	// Finish Passkey Sign In
+	// (POST /auth/passkeys/signIn/finish)
+	PostAuthPasskeysSignInFinish(ctx echo.Context) error
+	// Delete Passkey
+	// (DELETE /auth/passkeys/{id})
+	DeleteAuthPasskey(ctx echo.Context, id string) error
+	// Forgot Password
+	// (POST /auth/password/forgot)
+	PostAuthPasswordForgot(ctx echo.Context) error
+	// Reset Password
+	// (POST /auth/password/reset)
+	PostAuthPasswordReset(ctx echo.Context) error
+	// Refresh Access Token
+	// (POST /auth/refresh)
+	PostAuthRefresh(ctx echo.Context, params PostAuthRefreshParams) error
+	// Fetch Sessions
+	// (GET /auth/sessions)
+	GetAuthSessions(ctx echo.Context) error
+	// Revoke Other Sessions
+	// (POST /auth/sessions/revokeOthers)
+	PostAuthSessionsRevokeOthers(ctx echo.Context) error
+	// Revoke Session
+	// (DELETE /auth/sessions/{id})
+	DeleteAuthSession(ctx echo.Context, id string) error
+	// Sign In
+	// (POST /auth/signIn)
+	PostAuthSignIn(ctx echo.Context) error
+	// Sign In With Two-Factor Authentication
+	// (POST /auth/signIn/twoFactor)
+	PostAuthSignInTwoFactor(ctx echo.Context) error
+	// Sign Out
+	// (POST /auth/signOut)
+	PostAuthSignOut(ctx echo.Context, params PostAuthSignOutParams) error
+	// SignUp
+	// (POST /auth/signUp)
+	PostAuthSignUp(ctx echo.Context) error
+	// Confirm Two-Factor Authentication
+	// (POST /auth/twoFactor/confirm)
+	PostAuthTwoFactorConfirm(ctx echo.Context) error
+	// Disable Two-Factor Authentication
+	// (POST /auth/twoFactor/disable)
+	PostAuthTwoFactorDisable(ctx echo.Context) error
+	// Regenerate Recovery Codes
+	// (POST /auth/twoFactor/recoveryCodes)
+	PostAuthTwoFactorRecoveryCodes(ctx echo.Context) error
+	// Set Up Two-Factor Authentication
+	// (POST /auth/twoFactor/setup)
+	PostAuthTwoFactorSetup(ctx echo.Context) error
+	// Unlock Account
+	// (POST /auth/unlock)
+	PostAuthUnlock(ctx echo.Context) error
+	// Validate SignUp
+	// (POST /auth/validateSignUp)
+	PostAuthValidateSignUp(ctx echo.Context) error
+	// Export Todos
+	// (GET /exports)
+	GetExports(ctx echo.Context, params GetExportsParams) error
+	// Create Import
+	// (POST /imports)
+	PostImports(ctx echo.Context) error
+	// Show Import
+	// (GET /imports/{id})
+	GetImport(ctx echo.Context, id string) error
+	// Fetch Saved Filters
+	// (GET /savedFilters)
+	GetSavedFilters(ctx echo.Context) error
+	// Create Saved Filter
+	// (POST /savedFilters)
+	PostSavedFilters(ctx echo.Context) error
+	// Delete Saved Filter
+	// (DELETE /savedFilters/{id})
+	DeleteSavedFilter(ctx echo.Context, id string) error
+	// Update Saved Filter
+	// (PUT /savedFilters/{id})
+	PutSavedFilter(ctx echo.Context, id string) error
+	// Fetch Share Links
+	// (GET /shareLinks)
+	GetShareLinks(ctx echo.Context) error
+	// Revoke Share Link
+	// (DELETE /shareLinks/{id})
+	DeleteShareLink(ctx echo.Context, id string) error
+	// Show Shared Todo
+	// (GET /shared/{token})
+	GetSharedTodo(ctx echo.Context, token string, params GetSharedTodoParams) error
+	// Fetch Time Entries
+	// (GET /timeEntries)
+	GetTimeEntries(ctx echo.Context, params GetTimeEntriesParams) error
+	// Create Time Entry
+	// (POST /timeEntries)
+	PostTimeEntries(ctx echo.Context) error
+	// Delete Time Entry
+	// (DELETE /timeEntries/{id})
+	DeleteTimeEntry(ctx echo.Context, id string) error
+	// Fetch Time Report
+	// (GET /timeReports)
+	GetTimeReports(ctx echo.Context, params GetTimeReportsParams) error
+	// Export Time Report
+	// (GET /timeReports/csv)
+	GetTimeReportsCsv(ctx echo.Context, params GetTimeReportsCsvParams) error
+	// Fetch Todo Templates
+	// (GET /todoTemplates)
+	GetTodoTemplates(ctx echo.Context) error
+	// Create Todo Template
+	// (POST /todoTemplates)
+	PostTodoTemplates(ctx echo.Context) error
+	// Delete Todo Template
+	// (DELETE /todoTemplates/{id})
+	DeleteTodoTemplate(ctx echo.Context, id string) error
+	// Update Todo Template
+	// (PUT /todoTemplates/{id})
+	PutTodoTemplate(ctx echo.Context, id string) error
+	// Instantiate Todo Template
+	// (POST /todoTemplates/{id}/instantiate)
+	PostTodoTemplateInstantiate(ctx echo.Context, id string) error
+	// Fetch Todos
+	// (GET /todos)
+	GetTodos(ctx echo.Context, params GetTodosParams) error
+	// Create Todo
+	// (POST /todos)
+	PostTodos(ctx echo.Context) error
+	// Delete Todo
+	// (DELETE /todos/{id})
+	DeleteTodo(ctx echo.Context, id string) error
+	// Show Todo
+	// (GET /todos/{id})
+	GetTodo(ctx echo.Context, id string, params GetTodoParams) error
+	// Update Todo
+	// (PATCH /todos/{id})
+	PatchTodo(ctx echo.Context, id string) error
+	// Fetch Attachments
+	// (GET /todos/{id}/attachments)
+	GetTodoAttachments(ctx echo.Context, id string) error
+	// Upload Attachment
+	// (POST /todos/{id}/attachments)
+	PostTodoAttachments(ctx echo.Context, id string) error
+	// Delete Attachment
+	// (DELETE /todos/{id}/attachments/{attachmentId})
+	DeleteTodoAttachment(ctx echo.Context, id string, attachmentId string) error
+	// Show Attachment Download URL
+	// (GET /todos/{id}/attachments/{attachmentId})
+	GetTodoAttachment(ctx echo.Context, id string, attachmentId string) error
+	// Create Comment
+	// (POST /todos/{id}/comments)
+	PostTodoComments(ctx echo.Context, id string) error
+	// Delete Comment
+	// (DELETE /todos/{id}/comments/{commentId})
+	DeleteTodoComment(ctx echo.Context, id string, commentId string) error
+	// Update Comment
+	// (PATCH /todos/{id}/comments/{commentId})
+	PatchTodoComment(ctx echo.Context, id string, commentId string) error
+	// Create Share Link
+	// (POST /todos/{id}/shareLinks)
+	PostTodoShareLinks(ctx echo.Context, id string) error
+	// Start Timer
+	// (POST /todos/{id}/timer/start)
+	PostTodoTimerStart(ctx echo.Context, id string) error
+	// Stop Timer
+	// (POST /todos/{id}/timer/stop)
+	PostTodoTimerStop(ctx echo.Context, id string) error
+	// Fetch Me
+	// (GET /users/me)
+	GetUsersMe(ctx echo.Context) error
+	// Update Me
+	// (PATCH /users/me)
+	PatchUsersMe(ctx echo.Context) error
+	// Change Email
+	// (POST /users/me/email)
+	PostUsersMeEmail(ctx echo.Context) error
+	// Fetch Webhooks
+	// (GET /webhooks)
+	GetWebhooks(ctx echo.Context) error
+	// Create Webhook
+	// (POST /webhooks)
+	PostWebhooks(ctx echo.Context) error
+	// Delete Webhook
+	// (DELETE /webhooks/{id})
+	DeleteWebhook(ctx echo.Context, id string) error
+	// Fetch Webhook Deliveries
+	// (GET /webhooks/{id}/deliveries)
+	GetWebhookDeliveries(ctx echo.Context, id string, params GetWebhookDeliveriesParams) error
+	// Redeliver Webhook
+	// (POST /webhooks/{id}/deliveries/{deliveryId}/redeliver)
+	PostWebhookDeliveryRedeliver(ctx echo.Context, id string, deliveryId string) error
 }
 
-type DeleteTodo401JSONResponse struct {
-	UnauthorizedErrorResponseJSONResponse
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
 }
 
-func (response DeleteTodo401JSONResponse) VisitDeleteTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// GetActivities converts echo context to params.
+func (w *ServerInterfaceWrapper) GetActivities(ctx echo.Context) error {
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(CookieAuthScopes, []string{})
 
-type DeleteTodo404JSONResponse struct {
-	NotFoundErrorResponseJSONResponse
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetActivitiesParams
+	// ------------- Optional query parameter "cursor" -------------
 
-func (response DeleteTodo404JSONResponse) VisitDeleteTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type DeleteTodo500JSONResponse struct {
-	InternalServerErrorResponseJSONResponse
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
-func (response DeleteTodo500JSONResponse) VisitDeleteTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Optional query parameter "todoId" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "todoId", ctx.QueryParams(), &params.TodoId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
+	}
 
-type GetTodoRequestObject struct {
-	Id string `json:"id"`
-}
+	// ------------- Optional query parameter "actorId" -------------
 
-type GetTodoResponseObject interface {
-	VisitGetTodoResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "actorId", ctx.QueryParams(), &params.ActorId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter actorId: %s", err))
+	}
 
-type GetTodo200JSONResponse struct{ ShowTodoResponseJSONResponse }
+	// ------------- Optional query parameter "eventType" -------------
 
-func (response GetTodo200JSONResponse) VisitGetTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, false, "eventType", ctx.QueryParams(), &params.EventType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eventType: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetActivities(ctx, params)
+	return err
 }
 
-type GetTodo401JSONResponse struct {
-	UnauthorizedErrorResponseJSONResponse
-}
+// GetAppPasswords converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAppPasswords(ctx echo.Context) error {
+	var err error
 
-func (response GetTodo401JSONResponse) VisitGetTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAppPasswords(ctx)
+	return err
+}
+
+// PostAppPasswords converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAppPasswords(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAppPasswords(ctx)
+	return err
+}
+
+// DeleteAppPassword converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAppPassword(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAppPassword(ctx, id)
+	return err
+}
+
+// GetAuthCsrf converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAuthCsrf(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAuthCsrf(ctx)
+	return err
+}
+
+// PostAuthEmailResend converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthEmailResend(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthEmailResend(ctx)
+	return err
+}
+
+// PostAuthEmailVerify converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthEmailVerify(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthEmailVerify(ctx)
+	return err
+}
+
+// GetAuthPasskeys converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAuthPasskeys(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAuthPasskeys(ctx)
+	return err
+}
+
+// PostAuthPasskeysRegisterBegin converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthPasskeysRegisterBegin(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthPasskeysRegisterBegin(ctx)
+	return err
+}
+
+// PostAuthPasskeysRegisterFinish converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthPasskeysRegisterFinish(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthPasskeysRegisterFinish(ctx)
+	return err
+}
+
+// PostAuthPasskeysSignInBegin converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthPasskeysSignInBegin(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthPasskeysSignInBegin(ctx)
+	return err
+}
+
+// PostAuthPasskeysSignInFinish converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthPasskeysSignInFinish(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthPasskeysSignInFinish(ctx)
+	return err
+}
+
+// DeleteAuthPasskey converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAuthPasskey(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAuthPasskey(ctx, id)
+	return err
+}
+
+// PostAuthPasswordForgot converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthPasswordForgot(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthPasswordForgot(ctx)
+	return err
+}
+
+// PostAuthPasswordReset converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthPasswordReset(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthPasswordReset(ctx)
+	return err
+}
+
+// PostAuthRefresh converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthRefresh(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAuthRefreshParams
+
+	if cookie, err := ctx.Cookie("refresh_token"); err == nil {
+
+		var value string
+		err = runtime.BindStyledParameterWithLocation("simple", true, "refresh_token", runtime.ParamLocationCookie, cookie.Value, &value)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter refresh_token: %s", err))
+		}
+		params.RefreshToken = &value
+
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthRefresh(ctx, params)
+	return err
+}
+
+// GetAuthSessions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAuthSessions(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAuthSessions(ctx)
+	return err
+}
+
+// PostAuthSessionsRevokeOthers converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthSessionsRevokeOthers(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthSessionsRevokeOthers(ctx)
+	return err
+}
+
+// DeleteAuthSession converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAuthSession(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAuthSession(ctx, id)
+	return err
+}
+
+// PostAuthSignIn converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthSignIn(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthSignIn(ctx)
+	return err
+}
+
+// PostAuthSignInTwoFactor converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthSignInTwoFactor(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthSignInTwoFactor(ctx)
+	return err
+}
+
+// PostAuthSignOut converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthSignOut(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAuthSignOutParams
+
+	if cookie, err := ctx.Cookie("token"); err == nil {
+
+		var value string
+		err = runtime.BindStyledParameterWithLocation("simple", true, "token", runtime.ParamLocationCookie, cookie.Value, &value)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+		params.Token = &value
+
+	}
+
+	if cookie, err := ctx.Cookie("refresh_token"); err == nil {
+
+		var value string
+		err = runtime.BindStyledParameterWithLocation("simple", true, "refresh_token", runtime.ParamLocationCookie, cookie.Value, &value)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter refresh_token: %s", err))
+		}
+		params.RefreshToken = &value
+
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthSignOut(ctx, params)
+	return err
+}
+
+// PostAuthSignUp converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthSignUp(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthSignUp(ctx)
+	return err
+}
+
+// PostAuthTwoFactorConfirm converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthTwoFactorConfirm(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthTwoFactorConfirm(ctx)
+	return err
+}
+
+// PostAuthTwoFactorDisable converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthTwoFactorDisable(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthTwoFactorDisable(ctx)
+	return err
+}
+
+// PostAuthTwoFactorRecoveryCodes converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthTwoFactorRecoveryCodes(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthTwoFactorRecoveryCodes(ctx)
+	return err
+}
+
+// PostAuthTwoFactorSetup converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthTwoFactorSetup(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthTwoFactorSetup(ctx)
+	return err
+}
+
+// PostAuthUnlock converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthUnlock(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthUnlock(ctx)
+	return err
+}
+
+// PostAuthValidateSignUp converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthValidateSignUp(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthValidateSignUp(ctx)
+	return err
+}
+
+// GetExports converts echo context to params.
+func (w *ServerInterfaceWrapper) GetExports(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetExportsParams
+	// ------------- Required query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
+
+	// ------------- Optional query parameter "filterId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filterId", ctx.QueryParams(), &params.FilterId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filterId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetExports(ctx, params)
+	return err
+}
+
+// PostImports converts echo context to params.
+func (w *ServerInterfaceWrapper) PostImports(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostImports(ctx)
+	return err
+}
+
+// GetImport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetImport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetImport(ctx, id)
+	return err
+}
+
+// GetSavedFilters converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSavedFilters(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSavedFilters(ctx)
+	return err
+}
+
+// PostSavedFilters converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSavedFilters(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSavedFilters(ctx)
+	return err
+}
+
+// DeleteSavedFilter converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteSavedFilter(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteSavedFilter(ctx, id)
+	return err
+}
+
+// PutSavedFilter converts echo context to params.
+func (w *ServerInterfaceWrapper) PutSavedFilter(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutSavedFilter(ctx, id)
+	return err
+}
+
+// GetShareLinks converts echo context to params.
+func (w *ServerInterfaceWrapper) GetShareLinks(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetShareLinks(ctx)
+	return err
+}
+
+// DeleteShareLink converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteShareLink(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteShareLink(ctx, id)
+	return err
+}
+
+// GetSharedTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSharedTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationPath, ctx.Param("token"), &token)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSharedTodoParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "X-Share-Password" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Share-Password")]; found {
+		var XSharePassword string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Share-Password, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Share-Password", runtime.ParamLocationHeader, valueList[0], &XSharePassword)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Share-Password: %s", err))
+		}
+
+		params.XSharePassword = &XSharePassword
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSharedTodo(ctx, token, params)
+	return err
+}
+
+// GetTimeEntries converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTimeEntries(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTimeEntriesParams
+	// ------------- Optional query parameter "todoId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "todoId", ctx.QueryParams(), &params.TodoId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTimeEntries(ctx, params)
+	return err
+}
+
+// PostTimeEntries converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTimeEntries(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTimeEntries(ctx)
+	return err
+}
+
+// DeleteTimeEntry converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTimeEntry(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTimeEntry(ctx, id)
+	return err
+}
+
+// GetTimeReports converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTimeReports(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTimeReportsParams
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTimeReports(ctx, params)
+	return err
+}
+
+// GetTimeReportsCsv converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTimeReportsCsv(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTimeReportsCsvParams
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTimeReportsCsv(ctx, params)
+	return err
+}
+
+// GetTodoTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTodoTemplates(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTodoTemplates(ctx)
+	return err
+}
+
+// PostTodoTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTodoTemplates(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTodoTemplates(ctx)
+	return err
+}
+
+// DeleteTodoTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTodoTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTodoTemplate(ctx, id)
+	return err
+}
+
+// PutTodoTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) PutTodoTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutTodoTemplate(ctx, id)
+	return err
+}
+
+// PostTodoTemplateInstantiate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTodoTemplateInstantiate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTodoTemplateInstantiate(ctx, id)
+	return err
+}
+
+// GetTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTodos(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTodosParams
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
+
+	// ------------- Optional query parameter "filterId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filterId", ctx.QueryParams(), &params.FilterId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filterId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTodos(ctx, params)
+	return err
+}
+
+// PostTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTodos(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTodos(ctx)
+	return err
+}
+
+// DeleteTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTodo(ctx, id)
+	return err
+}
+
+// GetTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTodoParams
+	// ------------- Optional query parameter "commentsCursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "commentsCursor", ctx.QueryParams(), &params.CommentsCursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter commentsCursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "commentsLimit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "commentsLimit", ctx.QueryParams(), &params.CommentsLimit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter commentsLimit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTodo(ctx, id, params)
+	return err
+}
+
+// PatchTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTodo(ctx, id)
+	return err
+}
+
+// GetTodoAttachments converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTodoAttachments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTodoAttachments(ctx, id)
+	return err
+}
+
+// PostTodoAttachments converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTodoAttachments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTodoAttachments(ctx, id)
+	return err
+}
+
+// DeleteTodoAttachment converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTodoAttachment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "attachmentId", runtime.ParamLocationPath, ctx.Param("attachmentId"), &attachmentId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter attachmentId: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTodoAttachment(ctx, id, attachmentId)
+	return err
+}
+
+// GetTodoAttachment converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTodoAttachment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "attachmentId", runtime.ParamLocationPath, ctx.Param("attachmentId"), &attachmentId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter attachmentId: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTodoAttachment(ctx, id, attachmentId)
+	return err
+}
+
+// PostTodoComments converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTodoComments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTodoComments(ctx, id)
+	return err
+}
+
+// DeleteTodoComment converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTodoComment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "commentId" -------------
+	var commentId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "commentId", runtime.ParamLocationPath, ctx.Param("commentId"), &commentId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter commentId: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTodoComment(ctx, id, commentId)
+	return err
+}
+
+// PatchTodoComment converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTodoComment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "commentId" -------------
+	var commentId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "commentId", runtime.ParamLocationPath, ctx.Param("commentId"), &commentId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter commentId: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTodoComment(ctx, id, commentId)
+	return err
+}
+
+// PostTodoShareLinks converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTodoShareLinks(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTodoShareLinks(ctx, id)
+	return err
+}
+
+// PostTodoTimerStart converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTodoTimerStart(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTodoTimerStart(ctx, id)
+	return err
+}
+
+// PostTodoTimerStop converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTodoTimerStop(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTodoTimerStop(ctx, id)
+	return err
+}
+
+// GetUsersMe converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersMe(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersMe(ctx)
+	return err
+}
+
+// PatchUsersMe converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchUsersMe(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchUsersMe(ctx)
+	return err
+}
+
+// PostUsersMeEmail converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersMeEmail(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersMeEmail(ctx)
+	return err
+}
+
+// GetWebhooks converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWebhooks(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWebhooks(ctx)
+	return err
+}
+
+// PostWebhooks converts echo context to params.
+func (w *ServerInterfaceWrapper) PostWebhooks(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostWebhooks(ctx)
+	return err
+}
+
+// DeleteWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteWebhook(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteWebhook(ctx, id)
+	return err
+}
+
+// GetWebhookDeliveries converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWebhookDeliveries(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhookDeliveriesParams
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWebhookDeliveries(ctx, id, params)
+	return err
+}
+
+// PostWebhookDeliveryRedeliver converts echo context to params.
+func (w *ServerInterfaceWrapper) PostWebhookDeliveryRedeliver(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "deliveryId" -------------
+	var deliveryId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "deliveryId", runtime.ParamLocationPath, ctx.Param("deliveryId"), &deliveryId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter deliveryId: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostWebhookDeliveryRedeliver(ctx, id, deliveryId)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/activity", wrapper.GetActivities)
+	router.GET(baseURL+"/appPasswords", wrapper.GetAppPasswords)
+	router.POST(baseURL+"/appPasswords", wrapper.PostAppPasswords)
+	router.DELETE(baseURL+"/appPasswords/:id", wrapper.DeleteAppPassword)
+	router.GET(baseURL+"/auth/csrf", wrapper.GetAuthCsrf)
+	router.POST(baseURL+"/auth/email/resend", wrapper.PostAuthEmailResend)
+	router.POST(baseURL+"/auth/email/verify", wrapper.PostAuthEmailVerify)
+	router.GET(baseURL+"/auth/passkeys", wrapper.GetAuthPasskeys)
+	router.POST(baseURL+"/auth/passkeys/register/begin", wrapper.PostAuthPasskeysRegisterBegin)
+	router.POST(baseURL+"/auth/passkeys/register/finish", wrapper.PostAuthPasskeysRegisterFinish)
+	router.POST(baseURL+"/auth/passkeys/signIn/begin", wrapper.PostAuthPasskeysSignInBegin)
+	router.POST(baseURL+"/auth/passkeys/signIn/finish", wrapper.PostAuthPasskeysSignInFinish)
+	router.DELETE(baseURL+"/auth/passkeys/:id", wrapper.DeleteAuthPasskey)
+	router.POST(baseURL+"/auth/password/forgot", wrapper.PostAuthPasswordForgot)
+	router.POST(baseURL+"/auth/password/reset", wrapper.PostAuthPasswordReset)
+	router.POST(baseURL+"/auth/refresh", wrapper.PostAuthRefresh)
+	router.GET(baseURL+"/auth/sessions", wrapper.GetAuthSessions)
+	router.POST(baseURL+"/auth/sessions/revokeOthers", wrapper.PostAuthSessionsRevokeOthers)
+	router.DELETE(baseURL+"/auth/sessions/:id", wrapper.DeleteAuthSession)
+	router.POST(baseURL+"/auth/signIn", wrapper.PostAuthSignIn)
+	router.POST(baseURL+"/auth/signIn/twoFactor", wrapper.PostAuthSignInTwoFactor)
+	router.POST(baseURL+"/auth/signOut", wrapper.PostAuthSignOut)
+	router.POST(baseURL+"/auth/signUp", wrapper.PostAuthSignUp)
+	router.POST(baseURL+"/auth/twoFactor/confirm", wrapper.PostAuthTwoFactorConfirm)
+	router.POST(baseURL+"/auth/twoFactor/disable", wrapper.PostAuthTwoFactorDisable)
+	router.POST(baseURL+"/auth/twoFactor/recoveryCodes", wrapper.PostAuthTwoFactorRecoveryCodes)
+	router.POST(baseURL+"/auth/twoFactor/setup", wrapper.PostAuthTwoFactorSetup)
+	router.POST(baseURL+"/auth/unlock", wrapper.PostAuthUnlock)
+	router.POST(baseURL+"/auth/validateSignUp", wrapper.PostAuthValidateSignUp)
+	router.GET(baseURL+"/exports", wrapper.GetExports)
+	router.POST(baseURL+"/imports", wrapper.PostImports)
+	router.GET(baseURL+"/imports/:id", wrapper.GetImport)
+	router.GET(baseURL+"/savedFilters", wrapper.GetSavedFilters)
+	router.POST(baseURL+"/savedFilters", wrapper.PostSavedFilters)
+	router.DELETE(baseURL+"/savedFilters/:id", wrapper.DeleteSavedFilter)
+	router.PUT(baseURL+"/savedFilters/:id", wrapper.PutSavedFilter)
+	router.GET(baseURL+"/shareLinks", wrapper.GetShareLinks)
+	router.DELETE(baseURL+"/shareLinks/:id", wrapper.DeleteShareLink)
+	router.GET(baseURL+"/shared/:token", wrapper.GetSharedTodo)
+	router.GET(baseURL+"/timeEntries", wrapper.GetTimeEntries)
+	router.POST(baseURL+"/timeEntries", wrapper.PostTimeEntries)
+	router.DELETE(baseURL+"/timeEntries/:id", wrapper.DeleteTimeEntry)
+	router.GET(baseURL+"/timeReports", wrapper.GetTimeReports)
+	router.GET(baseURL+"/timeReports/csv", wrapper.GetTimeReportsCsv)
+	router.GET(baseURL+"/todoTemplates", wrapper.GetTodoTemplates)
+	router.POST(baseURL+"/todoTemplates", wrapper.PostTodoTemplates)
+	router.DELETE(baseURL+"/todoTemplates/:id", wrapper.DeleteTodoTemplate)
+	router.PUT(baseURL+"/todoTemplates/:id", wrapper.PutTodoTemplate)
+	router.POST(baseURL+"/todoTemplates/:id/instantiate", wrapper.PostTodoTemplateInstantiate)
+	router.GET(baseURL+"/todos", wrapper.GetTodos)
+	router.POST(baseURL+"/todos", wrapper.PostTodos)
+	router.DELETE(baseURL+"/todos/:id", wrapper.DeleteTodo)
+	router.GET(baseURL+"/todos/:id", wrapper.GetTodo)
+	router.PATCH(baseURL+"/todos/:id", wrapper.PatchTodo)
+	router.GET(baseURL+"/todos/:id/attachments", wrapper.GetTodoAttachments)
+	router.POST(baseURL+"/todos/:id/attachments", wrapper.PostTodoAttachments)
+	router.DELETE(baseURL+"/todos/:id/attachments/:attachmentId", wrapper.DeleteTodoAttachment)
+	router.GET(baseURL+"/todos/:id/attachments/:attachmentId", wrapper.GetTodoAttachment)
+	router.POST(baseURL+"/todos/:id/comments", wrapper.PostTodoComments)
+	router.DELETE(baseURL+"/todos/:id/comments/:commentId", wrapper.DeleteTodoComment)
+	router.PATCH(baseURL+"/todos/:id/comments/:commentId", wrapper.PatchTodoComment)
+	router.POST(baseURL+"/todos/:id/shareLinks", wrapper.PostTodoShareLinks)
+	router.POST(baseURL+"/todos/:id/timer/start", wrapper.PostTodoTimerStart)
+	router.POST(baseURL+"/todos/:id/timer/stop", wrapper.PostTodoTimerStop)
+	router.GET(baseURL+"/users/me", wrapper.GetUsersMe)
+	router.PATCH(baseURL+"/users/me", wrapper.PatchUsersMe)
+	router.POST(baseURL+"/users/me/email", wrapper.PostUsersMeEmail)
+	router.GET(baseURL+"/webhooks", wrapper.GetWebhooks)
+	router.POST(baseURL+"/webhooks", wrapper.PostWebhooks)
+	router.DELETE(baseURL+"/webhooks/:id", wrapper.DeleteWebhook)
+	router.GET(baseURL+"/webhooks/:id/deliveries", wrapper.GetWebhookDeliveries)
+	router.POST(baseURL+"/webhooks/:id/deliveries/:deliveryId/redeliver", wrapper.PostWebhookDeliveryRedeliver)
+
+}
+
+type BadRequestErrorResponseJSONResponse struct {
+	Code    int64  `json:"code"`
+	Message string `json:"message"`
+}
+
+type ChangeEmailResponseJSONResponse struct {
+	Code   int64                      `json:"code"`
+	Errors ChangeEmailValidationError `json:"errors"`
+	User   *User                      `json:"user,omitempty"`
+}
+
+type CreateAppPasswordResponseJSONResponse struct {
+	AppPassword *AppPassword                    `json:"appPassword,omitempty"`
+	Code        int64                           `json:"code"`
+	Errors      StoreAppPasswordValidationError `json:"errors"`
+	Password    *string                         `json:"password,omitempty"`
+}
+
+type CreateShareLinkResponseJSONResponse struct {
+	Code      int64                         `json:"code"`
+	Errors    StoreShareLinkValidationError `json:"errors"`
+	ShareLink *ShareLink                    `json:"shareLink,omitempty"`
+	Token     *string                       `json:"token,omitempty"`
+}
+
+type CsrfResponseJSONResponse struct {
+	CsrfToken string `json:"csrf_token"`
+}
+
+type DeleteAppPasswordResponseJSONResponse struct {
+	Code   int64 `json:"code"`
+	Result bool  `json:"result"`
+}
+
+type DeleteAttachmentResponseJSONResponse struct {
+	Code   int64 `json:"code"`
+	Result bool  `json:"result"`
+}
+
+type DeleteCommentResponseJSONResponse struct {
+	Code   int64 `json:"code"`
+	Result bool  `json:"result"`
+}
+
+type DeletePasskeyResponseJSONResponse struct {
+	Code   int64 `json:"code"`
+	Result bool  `json:"result"`
+}
+
+type DeleteSavedFilterResponseJSONResponse struct {
+	Code   int64 `json:"code"`
+	Result bool  `json:"result"`
+}
+
+type DeleteTimeEntryResponseJSONResponse struct {
+	Code   int64 `json:"code"`
+	Result bool  `json:"result"`
+}
+
+type DeleteTodoResponseJSONResponse struct {
+	Code   int64 `json:"code"`
+	Result bool  `json:"result"`
+}
+
+type DeleteTodoTemplateResponseJSONResponse struct {
+	Code   int64 `json:"code"`
+	Result bool  `json:"result"`
+}
+
+type DeleteWebhookResponseJSONResponse struct {
+	Code   int64 `json:"code"`
+	Result bool  `json:"result"`
+}
+
+type ExportTimeReportResponseResponseHeaders struct {
+	ContentDisposition string
+}
+type ExportTimeReportResponseTextcsvResponse struct {
+	Body io.Reader
+
+	Headers       ExportTimeReportResponseResponseHeaders
+	ContentLength int64
+}
+
+type ExportTodosResponseResponseHeaders struct {
+	ContentDisposition string
+}
+type ExportTodosResponseJSONResponse struct {
+	Body openapi_types.File
+
+	Headers ExportTodosResponseResponseHeaders
+}
+type ExportTodosResponseTextcsvResponse struct {
+	Body io.Reader
+
+	Headers       ExportTodosResponseResponseHeaders
+	ContentLength int64
+}
+type ExportTodosResponseTextmarkdownResponse struct {
+	Body io.Reader
+
+	Headers       ExportTodosResponseResponseHeaders
+	ContentLength int64
+}
+
+type FetchActivitiesResponseJSONResponse struct {
+	Activities []Activity `json:"activities"`
+	NextCursor *string    `json:"nextCursor,omitempty"`
+}
+
+type FetchAppPasswordsResponseJSONResponse struct {
+	AppPasswords []AppPassword `json:"appPasswords"`
+}
+
+type FetchAttachmentsResponseJSONResponse struct {
+	Attachments []Attachment `json:"attachments"`
+}
+
+type FetchMeResponseJSONResponse struct {
+	User User `json:"user"`
+}
+
+type FetchPasskeysResponseJSONResponse struct {
+	Passkeys []Passkey `json:"passkeys"`
+}
+
+type FetchSavedFiltersResponseJSONResponse struct {
+	SavedFilters []SavedFilter `json:"savedFilters"`
+}
+
+type FetchSessionsResponseJSONResponse struct {
+	Sessions []Session `json:"sessions"`
+}
+
+type FetchShareLinksResponseJSONResponse struct {
+	ShareLinks []ShareLink `json:"shareLinks"`
+}
+
+type FetchTimeEntriesResponseJSONResponse struct {
+	TimeEntries  []TimeEntry `json:"timeEntries"`
+	TotalSeconds int64       `json:"totalSeconds"`
+}
+
+type FetchTimeReportResponseJSONResponse struct {
+	Days         []TimeReportDay    `json:"days"`
+	From         openapi_types.Date `json:"from"`
+	To           openapi_types.Date `json:"to"`
+	TotalSeconds int64              `json:"totalSeconds"`
+}
+
+type FetchTodoTemplatesResponseJSONResponse struct {
+	TodoTemplates []TodoTemplate `json:"todoTemplates"`
+}
+
+type FetchTodosResponseJSONResponse struct {
+	Todos []Todo `json:"todos"`
+}
+
+type FetchWebhookDeliveriesResponseJSONResponse struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+	NextCursor *string           `json:"nextCursor,omitempty"`
+}
+
+type FetchWebhooksResponseJSONResponse struct {
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+type ForgotPasswordResponseJSONResponse struct {
+	Code   int64                         `json:"code"`
+	Errors ForgotPasswordValidationError `json:"errors"`
+}
+
+type InstantiateTodoTemplateResponseJSONResponse struct {
+	Code  int64  `json:"code"`
+	Todos []Todo `json:"todos"`
+}
+
+type InternalServerErrorResponseJSONResponse struct {
+	Code    int64  `json:"code"`
+	Message string `json:"message"`
+}
+
+type NotFoundErrorResponseJSONResponse struct {
+	Code    int64  `json:"code"`
+	Message string `json:"message"`
+}
+
+type PasskeyOptionsResponseJSONResponse struct {
+	// CeremonyToken token to be sent back when finishing the ceremony
+	CeremonyToken string `json:"ceremonyToken"`
+
+	// Options PublicKeyCredentialCreationOptions or PublicKeyCredentialRequestOptions in JSON form
+	Options map[string]interface{} `json:"options"`
+}
+
+type PasskeyResponseJSONResponse struct {
+	Code    int64                  `json:"code"`
+	Errors  PasskeyValidationError `json:"errors"`
+	Passkey *Passkey               `json:"passkey,omitempty"`
+}
+
+type RedeliverWebhookResponseJSONResponse struct {
+	Delivery WebhookDelivery `json:"delivery"`
+}
+
+type RefreshOkResponseResponseHeaders struct {
+	SetCookie string
+}
+type RefreshOkResponseJSONResponse struct {
+	Body map[string]interface{}
+
+	Headers RefreshOkResponseResponseHeaders
+}
+
+type ResendEmailVerificationResponseJSONResponse struct {
+	Code   int64                                  `json:"code"`
+	Errors ResendEmailVerificationValidationError `json:"errors"`
+}
+
+type ResetPasswordResponseJSONResponse struct {
+	Code   int64                        `json:"code"`
+	Errors ResetPasswordValidationError `json:"errors"`
+}
+
+type RevokeSessionResponseJSONResponse struct {
+	Code   int64 `json:"code"`
+	Result bool  `json:"result"`
+}
+
+type RevokeShareLinkResponseJSONResponse struct {
+	Code   int64 `json:"code"`
+	Result bool  `json:"result"`
+}
+
+type ShowAttachmentResponseJSONResponse struct {
+	Attachment Attachment `json:"attachment"`
+	ExpiresAt  time.Time  `json:"expiresAt"`
+	Url        string     `json:"url"`
+}
+
+type ShowImportResponseJSONResponse struct {
+	Import Import `json:"import"`
+}
+
+type ShowTodoResponseJSONResponse struct {
+	Comments           *[]Comment `json:"comments,omitempty"`
+	CommentsNextCursor *string    `json:"commentsNextCursor,omitempty"`
+	Todo               Todo       `json:"todo"`
+}
+
+type SignInBadRequestResponseJSONResponse struct {
+	Errors []string `json:"errors"`
+}
+
+type SignInOkResponseResponseHeaders struct {
+	SetCookie string
+}
+type SignInOkResponseJSONResponse struct {
+	Body SignInResult
+
+	Headers SignInOkResponseResponseHeaders
+}
+
+type SignOutOkResponseResponseHeaders struct {
+	SetCookie string
+}
+type SignOutOkResponseJSONResponse struct {
+	Body map[string]interface{}
+
+	Headers SignOutOkResponseResponseHeaders
+}
+
+type SignUpResponseJSONResponse struct {
+	Code   int64                 `json:"code"`
+	Errors SignUpValidationError `json:"errors"`
+}
+
+type StoreAttachmentResponseJSONResponse struct {
+	Attachment *Attachment                    `json:"attachment,omitempty"`
+	Code       int64                          `json:"code"`
+	Errors     StoreAttachmentValidationError `json:"errors"`
+}
+
+type StoreCommentResponseJSONResponse struct {
+	Code    int64                       `json:"code"`
+	Comment *Comment                    `json:"comment,omitempty"`
+	Errors  StoreCommentValidationError `json:"errors"`
+}
+
+type StoreImportResponseJSONResponse struct {
+	Code   int64                      `json:"code"`
+	Errors StoreImportValidationError `json:"errors"`
+	Import *Import                    `json:"import,omitempty"`
+}
+
+type StoreSavedFilterResponseJSONResponse struct {
+	Code        int64                           `json:"code"`
+	Errors      StoreSavedFilterValidationError `json:"errors"`
+	SavedFilter *SavedFilter                    `json:"savedFilter,omitempty"`
+}
+
+type StoreTimeEntryResponseJSONResponse struct {
+	Code      int64                         `json:"code"`
+	Errors    StoreTimeEntryValidationError `json:"errors"`
+	TimeEntry *TimeEntry                    `json:"timeEntry,omitempty"`
+}
+
+type StoreTodoResponseJSONResponse struct {
+	Code   int64                    `json:"code"`
+	Errors StoreTodoValidationError `json:"errors"`
+}
+
+type StoreTodoTemplateResponseJSONResponse struct {
+	Code         int64                            `json:"code"`
+	Errors       StoreTodoTemplateValidationError `json:"errors"`
+	TodoTemplate *TodoTemplate                    `json:"todoTemplate,omitempty"`
+}
+
+type StoreWebhookResponseJSONResponse struct {
+	Code    int64                       `json:"code"`
+	Errors  StoreWebhookValidationError `json:"errors"`
+	Webhook *Webhook                    `json:"webhook,omitempty"`
+}
+
+type TimeEntryResponseJSONResponse struct {
+	Code      int64     `json:"code"`
+	TimeEntry TimeEntry `json:"timeEntry"`
+}
+
+type TooManyRequestsErrorResponseJSONResponse struct {
+	Code    int64  `json:"code"`
+	Message string `json:"message"`
+
+	// RetryAfter seconds to wait before trying again
+	RetryAfter *int64 `json:"retryAfter,omitempty"`
+}
+
+type TwoFactorRecoveryCodesResponseJSONResponse struct {
+	Code          int64                    `json:"code"`
+	Errors        TwoFactorValidationError `json:"errors"`
+	RecoveryCodes *[]string                `json:"recoveryCodes,omitempty"`
+}
+
+type TwoFactorResponseJSONResponse struct {
+	Code   int64                    `json:"code"`
+	Errors TwoFactorValidationError `json:"errors"`
+}
+
+type TwoFactorSetupResponseJSONResponse struct {
+	// OtpauthUri otpauth URI to be rendered as a QR code
+	OtpauthUri string `json:"otpauthUri"`
+
+	// Secret base32 encoded TOTP secret for manual entry
+	Secret string `json:"secret"`
+}
+
+type UnauthorizedErrorResponseJSONResponse struct {
+	Code    int64  `json:"code"`
+	Message string `json:"message"`
+
+	// Reason machine-readable reason why the authentication failed
+	Reason *UnauthorizedReason `json:"reason,omitempty"`
+}
+
+type UnlockAccountResponseJSONResponse struct {
+	Code   int64                        `json:"code"`
+	Errors UnlockAccountValidationError `json:"errors"`
+}
+
+type UpdateMeResponseJSONResponse struct {
+	Code   int64                   `json:"code"`
+	Errors UpdateMeValidationError `json:"errors"`
+	User   *User                   `json:"user,omitempty"`
+}
+
+type VerifyEmailResponseJSONResponse struct {
+	Code   int64                      `json:"code"`
+	Errors VerifyEmailValidationError `json:"errors"`
+}
+
+type GetActivitiesRequestObject struct {
+	Params GetActivitiesParams
+}
+
+type GetActivitiesResponseObject interface {
+	VisitGetActivitiesResponse(w http.ResponseWriter) error
+}
+
+type GetActivities200JSONResponse struct {
+	FetchActivitiesResponseJSONResponse
+}
+
+func (response GetActivities200JSONResponse) VisitGetActivitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetActivities400JSONResponse struct {
+	BadRequestErrorResponseJSONResponse
+}
+
+func (response GetActivities400JSONResponse) VisitGetActivitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetActivities401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response GetActivities401JSONResponse) VisitGetActivitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetActivities500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response GetActivities500JSONResponse) VisitGetActivitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAppPasswordsRequestObject struct {
+}
+
+type GetAppPasswordsResponseObject interface {
+	VisitGetAppPasswordsResponse(w http.ResponseWriter) error
+}
+
+type GetAppPasswords200JSONResponse struct {
+	FetchAppPasswordsResponseJSONResponse
+}
+
+func (response GetAppPasswords200JSONResponse) VisitGetAppPasswordsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAppPasswords401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response GetAppPasswords401JSONResponse) VisitGetAppPasswordsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAppPasswords500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response GetAppPasswords500JSONResponse) VisitGetAppPasswordsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAppPasswordsRequestObject struct {
+	Body *PostAppPasswordsJSONRequestBody
+}
+
+type PostAppPasswordsResponseObject interface {
+	VisitPostAppPasswordsResponse(w http.ResponseWriter) error
+}
+
+type PostAppPasswords200JSONResponse struct {
+	CreateAppPasswordResponseJSONResponse
+}
+
+func (response PostAppPasswords200JSONResponse) VisitPostAppPasswordsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAppPasswords400JSONResponse struct {
+	AppPassword *AppPassword                    `json:"appPassword,omitempty"`
+	Code        int64                           `json:"code"`
+	Errors      StoreAppPasswordValidationError `json:"errors"`
+	Password    *string                         `json:"password,omitempty"`
+}
+
+func (response PostAppPasswords400JSONResponse) VisitPostAppPasswordsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAppPasswords401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response PostAppPasswords401JSONResponse) VisitPostAppPasswordsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAppPasswords500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostAppPasswords500JSONResponse) VisitPostAppPasswordsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAppPasswordRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteAppPasswordResponseObject interface {
+	VisitDeleteAppPasswordResponse(w http.ResponseWriter) error
+}
+
+type DeleteAppPassword200JSONResponse struct {
+	DeleteAppPasswordResponseJSONResponse
+}
+
+func (response DeleteAppPassword200JSONResponse) VisitDeleteAppPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAppPassword401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response DeleteAppPassword401JSONResponse) VisitDeleteAppPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAppPassword404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response DeleteAppPassword404JSONResponse) VisitDeleteAppPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAppPassword500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response DeleteAppPassword500JSONResponse) VisitDeleteAppPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthCsrfRequestObject struct {
+}
+
+type GetAuthCsrfResponseObject interface {
+	VisitGetAuthCsrfResponse(w http.ResponseWriter) error
+}
+
+type GetAuthCsrf200JSONResponse struct{ CsrfResponseJSONResponse }
+
+func (response GetAuthCsrf200JSONResponse) VisitGetAuthCsrfResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthCsrf500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response GetAuthCsrf500JSONResponse) VisitGetAuthCsrfResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthEmailResendRequestObject struct {
+	Body *PostAuthEmailResendJSONRequestBody
+}
+
+type PostAuthEmailResendResponseObject interface {
+	VisitPostAuthEmailResendResponse(w http.ResponseWriter) error
+}
+
+type PostAuthEmailResend200JSONResponse struct {
+	ResendEmailVerificationResponseJSONResponse
+}
+
+func (response PostAuthEmailResend200JSONResponse) VisitPostAuthEmailResendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthEmailResend400JSONResponse struct {
+	Code   int64                                  `json:"code"`
+	Errors ResendEmailVerificationValidationError `json:"errors"`
+}
+
+func (response PostAuthEmailResend400JSONResponse) VisitPostAuthEmailResendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthEmailResend500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostAuthEmailResend500JSONResponse) VisitPostAuthEmailResendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthEmailVerifyRequestObject struct {
+	Body *PostAuthEmailVerifyJSONRequestBody
+}
+
+type PostAuthEmailVerifyResponseObject interface {
+	VisitPostAuthEmailVerifyResponse(w http.ResponseWriter) error
+}
+
+type PostAuthEmailVerify200JSONResponse struct {
+	VerifyEmailResponseJSONResponse
+}
+
+func (response PostAuthEmailVerify200JSONResponse) VisitPostAuthEmailVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthEmailVerify400JSONResponse struct {
+	Code   int64                      `json:"code"`
+	Errors VerifyEmailValidationError `json:"errors"`
+}
+
+func (response PostAuthEmailVerify400JSONResponse) VisitPostAuthEmailVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthEmailVerify500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostAuthEmailVerify500JSONResponse) VisitPostAuthEmailVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthPasskeysRequestObject struct {
+}
+
+type GetAuthPasskeysResponseObject interface {
+	VisitGetAuthPasskeysResponse(w http.ResponseWriter) error
+}
+
+type GetAuthPasskeys200JSONResponse struct {
+	FetchPasskeysResponseJSONResponse
+}
+
+func (response GetAuthPasskeys200JSONResponse) VisitGetAuthPasskeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthPasskeys401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response GetAuthPasskeys401JSONResponse) VisitGetAuthPasskeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthPasskeys500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response GetAuthPasskeys500JSONResponse) VisitGetAuthPasskeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthPasskeysRegisterBeginRequestObject struct {
+	Body *PostAuthPasskeysRegisterBeginJSONRequestBody
+}
+
+type PostAuthPasskeysRegisterBeginResponseObject interface {
+	VisitPostAuthPasskeysRegisterBeginResponse(w http.ResponseWriter) error
+}
+
+type PostAuthPasskeysRegisterBegin200JSONResponse struct {
+	PasskeyOptionsResponseJSONResponse
+}
+
+func (response PostAuthPasskeysRegisterBegin200JSONResponse) VisitPostAuthPasskeysRegisterBeginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthPasskeysRegisterBegin400JSONResponse struct{ PasskeyResponseJSONResponse }
+
+func (response PostAuthPasskeysRegisterBegin400JSONResponse) VisitPostAuthPasskeysRegisterBeginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthPasskeysRegisterBegin401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response PostAuthPasskeysRegisterBegin401JSONResponse) VisitPostAuthPasskeysRegisterBeginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthPasskeysRegisterBegin429JSONResponse struct {
+	TooManyRequestsErrorResponseJSONResponse
+}
+
+func (response PostAuthPasskeysRegisterBegin429JSONResponse) VisitPostAuthPasskeysRegisterBeginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthPasskeysRegisterBegin500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostAuthPasskeysRegisterBegin500JSONResponse) VisitPostAuthPasskeysRegisterBeginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthPasskeysRegisterFinishRequestObject struct {
+	Body *PostAuthPasskeysRegisterFinishJSONRequestBody
+}
+
+type PostAuthPasskeysRegisterFinishResponseObject interface {
+	VisitPostAuthPasskeysRegisterFinishResponse(w http.ResponseWriter) error
+}
+
+type PostAuthPasskeysRegisterFinish200JSONResponse struct{ PasskeyResponseJSONResponse }
+
+func (response PostAuthPasskeysRegisterFinish200JSONResponse) VisitPostAuthPasskeysRegisterFinishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthPasskeysRegisterFinish400JSONResponse struct {
+	Code    int64                  `json:"code"`
+	Errors  PasskeyValidationError `json:"errors"`
+	Passkey *Passkey               `json:"passkey,omitempty"`
+}
+
+func (response PostAuthPasskeysRegisterFinish400JSONResponse) VisitPostAuthPasskeysRegisterFinishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthPasskeysRegisterFinish401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response PostAuthPasskeysRegisterFinish401JSONResponse) VisitPostAuthPasskeysRegisterFinishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthPasskeysRegisterFinish500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostAuthPasskeysRegisterFinish500JSONResponse) VisitPostAuthPasskeysRegisterFinishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthPasskeysSignInBeginRequestObject struct {
+}
+
+type PostAuthPasskeysSignInBeginResponseObject interface {
+	VisitPostAuthPasskeysSignInBeginResponse(w http.ResponseWriter) error
+}
+
+type PostAuthPasskeysSignInBegin200JSONResponse struct {
+	PasskeyOptionsResponseJSONResponse
+}
+
+func (response PostAuthPasskeysSignInBegin200JSONResponse) VisitPostAuthPasskeysSignInBeginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthPasskeysSignInBegin500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostAuthPasskeysSignInBegin500JSONResponse) VisitPostAuthPasskeysSignInBeginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthPasskeysSignInFinishRequestObject struct {
+	Body *PostAuthPasskeysSignInFinishJSONRequestBody
+}
+
+type PostAuthPasskeysSignInFinishResponseObject interface {
+	VisitPostAuthPasskeysSignInFinishResponse(w http.ResponseWriter) error
+}
+
+type PostAuthPasskeysSignInFinish200JSONResponse struct{ SignInOkResponseJSONResponse }
+
+func (response PostAuthPasskeysSignInFinish200JSONResponse) VisitPostAuthPasskeysSignInFinishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostAuthPasskeysSignInFinish400JSONResponse struct {
+	SignInBadRequestResponseJSONResponse
+}
+
+func (response PostAuthPasskeysSignInFinish400JSONResponse) VisitPostAuthPasskeysSignInFinishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthPasskeysSignInFinish500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostAuthPasskeysSignInFinish500JSONResponse) VisitPostAuthPasskeysSignInFinishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAuthPasskeyRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteAuthPasskeyResponseObject interface {
+	VisitDeleteAuthPasskeyResponse(w http.ResponseWriter) error
+}
+
+type DeleteAuthPasskey200JSONResponse struct {
+	DeletePasskeyResponseJSONResponse
+}
+
+func (response DeleteAuthPasskey200JSONResponse) VisitDeleteAuthPasskeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAuthPasskey401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response DeleteAuthPasskey401JSONResponse) VisitDeleteAuthPasskeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAuthPasskey404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response DeleteAuthPasskey404JSONResponse) VisitDeleteAuthPasskeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAuthPasskey500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response DeleteAuthPasskey500JSONResponse) VisitDeleteAuthPasskeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthPasswordForgotRequestObject struct {
+	Body *PostAuthPasswordForgotJSONRequestBody
+}
+
+type PostAuthPasswordForgotResponseObject interface {
+	VisitPostAuthPasswordForgotResponse(w http.ResponseWriter) error
+}
+
+type PostAuthPasswordForgot200JSONResponse struct {
+	ForgotPasswordResponseJSONResponse
+}
+
+func (response PostAuthPasswordForgot200JSONResponse) VisitPostAuthPasswordForgotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthPasswordForgot400JSONResponse struct {
+	Code   int64                         `json:"code"`
+	Errors ForgotPasswordValidationError `json:"errors"`
+}
+
+func (response PostAuthPasswordForgot400JSONResponse) VisitPostAuthPasswordForgotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthPasswordForgot500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostAuthPasswordForgot500JSONResponse) VisitPostAuthPasswordForgotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthPasswordResetRequestObject struct {
+	Body *PostAuthPasswordResetJSONRequestBody
+}
+
+type PostAuthPasswordResetResponseObject interface {
+	VisitPostAuthPasswordResetResponse(w http.ResponseWriter) error
+}
+
+type PostAuthPasswordReset200JSONResponse struct {
+	ResetPasswordResponseJSONResponse
+}
+
+func (response PostAuthPasswordReset200JSONResponse) VisitPostAuthPasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthPasswordReset400JSONResponse struct {
+	Code   int64                        `json:"code"`
+	Errors ResetPasswordValidationError `json:"errors"`
+}
+
+func (response PostAuthPasswordReset400JSONResponse) VisitPostAuthPasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthPasswordReset500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostAuthPasswordReset500JSONResponse) VisitPostAuthPasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthRefreshRequestObject struct {
+	Params PostAuthRefreshParams
+}
+
+type PostAuthRefreshResponseObject interface {
+	VisitPostAuthRefreshResponse(w http.ResponseWriter) error
+}
+
+type PostAuthRefresh200JSONResponse struct{ RefreshOkResponseJSONResponse }
+
+func (response PostAuthRefresh200JSONResponse) VisitPostAuthRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostAuthRefresh401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response PostAuthRefresh401JSONResponse) VisitPostAuthRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthRefresh500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostAuthRefresh500JSONResponse) VisitPostAuthRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthSessionsRequestObject struct {
+}
+
+type GetAuthSessionsResponseObject interface {
+	VisitGetAuthSessionsResponse(w http.ResponseWriter) error
+}
+
+type GetAuthSessions200JSONResponse struct {
+	FetchSessionsResponseJSONResponse
+}
+
+func (response GetAuthSessions200JSONResponse) VisitGetAuthSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthSessions401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response GetAuthSessions401JSONResponse) VisitGetAuthSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthSessions500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response GetAuthSessions500JSONResponse) VisitGetAuthSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthSessionsRevokeOthersRequestObject struct {
+}
+
+type PostAuthSessionsRevokeOthersResponseObject interface {
+	VisitPostAuthSessionsRevokeOthersResponse(w http.ResponseWriter) error
+}
+
+type PostAuthSessionsRevokeOthers200JSONResponse struct {
+	RevokeSessionResponseJSONResponse
+}
+
+func (response PostAuthSessionsRevokeOthers200JSONResponse) VisitPostAuthSessionsRevokeOthersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthSessionsRevokeOthers401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response PostAuthSessionsRevokeOthers401JSONResponse) VisitPostAuthSessionsRevokeOthersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthSessionsRevokeOthers500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostAuthSessionsRevokeOthers500JSONResponse) VisitPostAuthSessionsRevokeOthersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAuthSessionRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteAuthSessionResponseObject interface {
+	VisitDeleteAuthSessionResponse(w http.ResponseWriter) error
+}
+
+type DeleteAuthSession200JSONResponse struct {
+	RevokeSessionResponseJSONResponse
+}
+
+func (response DeleteAuthSession200JSONResponse) VisitDeleteAuthSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAuthSession401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response DeleteAuthSession401JSONResponse) VisitDeleteAuthSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAuthSession404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response DeleteAuthSession404JSONResponse) VisitDeleteAuthSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAuthSession500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response DeleteAuthSession500JSONResponse) VisitDeleteAuthSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthSignInRequestObject struct {
+	Body *PostAuthSignInJSONRequestBody
+}
+
+type PostAuthSignInResponseObject interface {
+	VisitPostAuthSignInResponse(w http.ResponseWriter) error
+}
+
+type PostAuthSignIn200JSONResponse struct{ SignInOkResponseJSONResponse }
+
+func (response PostAuthSignIn200JSONResponse) VisitPostAuthSignInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostAuthSignIn400JSONResponse struct {
+	SignInBadRequestResponseJSONResponse
+}
+
+func (response PostAuthSignIn400JSONResponse) VisitPostAuthSignInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthSignIn429JSONResponse struct {
+	TooManyRequestsErrorResponseJSONResponse
+}
+
+func (response PostAuthSignIn429JSONResponse) VisitPostAuthSignInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthSignIn500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostAuthSignIn500JSONResponse) VisitPostAuthSignInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthSignInTwoFactorRequestObject struct {
+	Body *PostAuthSignInTwoFactorJSONRequestBody
+}
+
+type PostAuthSignInTwoFactorResponseObject interface {
+	VisitPostAuthSignInTwoFactorResponse(w http.ResponseWriter) error
+}
+
+type PostAuthSignInTwoFactor200JSONResponse struct{ SignInOkResponseJSONResponse }
+
+func (response PostAuthSignInTwoFactor200JSONResponse) VisitPostAuthSignInTwoFactorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostAuthSignInTwoFactor400JSONResponse struct {
+	SignInBadRequestResponseJSONResponse
+}
+
+func (response PostAuthSignInTwoFactor400JSONResponse) VisitPostAuthSignInTwoFactorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthSignInTwoFactor429JSONResponse struct {
+	TooManyRequestsErrorResponseJSONResponse
+}
+
+func (response PostAuthSignInTwoFactor429JSONResponse) VisitPostAuthSignInTwoFactorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthSignInTwoFactor500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostAuthSignInTwoFactor500JSONResponse) VisitPostAuthSignInTwoFactorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthSignOutRequestObject struct {
+	Params PostAuthSignOutParams
+}
+
+type PostAuthSignOutResponseObject interface {
+	VisitPostAuthSignOutResponse(w http.ResponseWriter) error
+}
+
+type PostAuthSignOut200JSONResponse struct{ SignOutOkResponseJSONResponse }
+
+func (response PostAuthSignOut200JSONResponse) VisitPostAuthSignOutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostAuthSignOut500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostAuthSignOut500JSONResponse) VisitPostAuthSignOutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthSignUpRequestObject struct {
+	Body *multipart.Reader
+}
+
+type PostAuthSignUpResponseObject interface {
+	VisitPostAuthSignUpResponse(w http.ResponseWriter) error
+}
+
+type PostAuthSignUp200JSONResponse struct{ SignUpResponseJSONResponse }
+
+func (response PostAuthSignUp200JSONResponse) VisitPostAuthSignUpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthSignUp400JSONResponse struct {
+	Code   int64                 `json:"code"`
+	Errors SignUpValidationError `json:"errors"`
+}
+
+func (response PostAuthSignUp400JSONResponse) VisitPostAuthSignUpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthSignUp500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostAuthSignUp500JSONResponse) VisitPostAuthSignUpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthTwoFactorConfirmRequestObject struct {
+	Body *PostAuthTwoFactorConfirmJSONRequestBody
+}
+
+type PostAuthTwoFactorConfirmResponseObject interface {
+	VisitPostAuthTwoFactorConfirmResponse(w http.ResponseWriter) error
+}
+
+type PostAuthTwoFactorConfirm200JSONResponse struct {
+	TwoFactorRecoveryCodesResponseJSONResponse
+}
+
+func (response PostAuthTwoFactorConfirm200JSONResponse) VisitPostAuthTwoFactorConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthTwoFactorConfirm400JSONResponse struct {
+	Code          int64                    `json:"code"`
+	Errors        TwoFactorValidationError `json:"errors"`
+	RecoveryCodes *[]string                `json:"recoveryCodes,omitempty"`
+}
+
+func (response PostAuthTwoFactorConfirm400JSONResponse) VisitPostAuthTwoFactorConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthTwoFactorConfirm401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response PostAuthTwoFactorConfirm401JSONResponse) VisitPostAuthTwoFactorConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthTwoFactorConfirm500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostAuthTwoFactorConfirm500JSONResponse) VisitPostAuthTwoFactorConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthTwoFactorDisableRequestObject struct {
+	Body *PostAuthTwoFactorDisableJSONRequestBody
+}
+
+type PostAuthTwoFactorDisableResponseObject interface {
+	VisitPostAuthTwoFactorDisableResponse(w http.ResponseWriter) error
+}
+
+type PostAuthTwoFactorDisable200JSONResponse struct{ TwoFactorResponseJSONResponse }
+
+func (response PostAuthTwoFactorDisable200JSONResponse) VisitPostAuthTwoFactorDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthTwoFactorDisable400JSONResponse struct {
+	Code   int64                    `json:"code"`
+	Errors TwoFactorValidationError `json:"errors"`
+}
+
+func (response PostAuthTwoFactorDisable400JSONResponse) VisitPostAuthTwoFactorDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthTwoFactorDisable401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response PostAuthTwoFactorDisable401JSONResponse) VisitPostAuthTwoFactorDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthTwoFactorDisable429JSONResponse struct {
+	TooManyRequestsErrorResponseJSONResponse
+}
+
+func (response PostAuthTwoFactorDisable429JSONResponse) VisitPostAuthTwoFactorDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthTwoFactorDisable500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostAuthTwoFactorDisable500JSONResponse) VisitPostAuthTwoFactorDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthTwoFactorRecoveryCodesRequestObject struct {
+	Body *PostAuthTwoFactorRecoveryCodesJSONRequestBody
+}
+
+type PostAuthTwoFactorRecoveryCodesResponseObject interface {
+	VisitPostAuthTwoFactorRecoveryCodesResponse(w http.ResponseWriter) error
+}
+
+type PostAuthTwoFactorRecoveryCodes200JSONResponse struct {
+	TwoFactorRecoveryCodesResponseJSONResponse
+}
+
+func (response PostAuthTwoFactorRecoveryCodes200JSONResponse) VisitPostAuthTwoFactorRecoveryCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthTwoFactorRecoveryCodes400JSONResponse struct {
+	Code          int64                    `json:"code"`
+	Errors        TwoFactorValidationError `json:"errors"`
+	RecoveryCodes *[]string                `json:"recoveryCodes,omitempty"`
+}
+
+func (response PostAuthTwoFactorRecoveryCodes400JSONResponse) VisitPostAuthTwoFactorRecoveryCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthTwoFactorRecoveryCodes401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response PostAuthTwoFactorRecoveryCodes401JSONResponse) VisitPostAuthTwoFactorRecoveryCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthTwoFactorRecoveryCodes429JSONResponse struct {
+	TooManyRequestsErrorResponseJSONResponse
+}
+
+func (response PostAuthTwoFactorRecoveryCodes429JSONResponse) VisitPostAuthTwoFactorRecoveryCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthTwoFactorRecoveryCodes500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostAuthTwoFactorRecoveryCodes500JSONResponse) VisitPostAuthTwoFactorRecoveryCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthTwoFactorSetupRequestObject struct {
+}
+
+type PostAuthTwoFactorSetupResponseObject interface {
+	VisitPostAuthTwoFactorSetupResponse(w http.ResponseWriter) error
+}
+
+type PostAuthTwoFactorSetup200JSONResponse struct {
+	TwoFactorSetupResponseJSONResponse
+}
+
+func (response PostAuthTwoFactorSetup200JSONResponse) VisitPostAuthTwoFactorSetupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthTwoFactorSetup400JSONResponse struct {
+	BadRequestErrorResponseJSONResponse
+}
+
+func (response PostAuthTwoFactorSetup400JSONResponse) VisitPostAuthTwoFactorSetupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthTwoFactorSetup401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response PostAuthTwoFactorSetup401JSONResponse) VisitPostAuthTwoFactorSetupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthTwoFactorSetup500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostAuthTwoFactorSetup500JSONResponse) VisitPostAuthTwoFactorSetupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthUnlockRequestObject struct {
+	Body *PostAuthUnlockJSONRequestBody
+}
+
+type PostAuthUnlockResponseObject interface {
+	VisitPostAuthUnlockResponse(w http.ResponseWriter) error
+}
+
+type PostAuthUnlock200JSONResponse struct {
+	UnlockAccountResponseJSONResponse
+}
+
+func (response PostAuthUnlock200JSONResponse) VisitPostAuthUnlockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthUnlock400JSONResponse struct {
+	Code   int64                        `json:"code"`
+	Errors UnlockAccountValidationError `json:"errors"`
+}
+
+func (response PostAuthUnlock400JSONResponse) VisitPostAuthUnlockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthUnlock500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostAuthUnlock500JSONResponse) VisitPostAuthUnlockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthValidateSignUpRequestObject struct {
+	Body *multipart.Reader
+}
+
+type PostAuthValidateSignUpResponseObject interface {
+	VisitPostAuthValidateSignUpResponse(w http.ResponseWriter) error
+}
+
+type PostAuthValidateSignUp200JSONResponse struct{ SignUpResponseJSONResponse }
+
+func (response PostAuthValidateSignUp200JSONResponse) VisitPostAuthValidateSignUpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthValidateSignUp400JSONResponse struct {
+	Code   int64                 `json:"code"`
+	Errors SignUpValidationError `json:"errors"`
+}
+
+func (response PostAuthValidateSignUp400JSONResponse) VisitPostAuthValidateSignUpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthValidateSignUp500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostAuthValidateSignUp500JSONResponse) VisitPostAuthValidateSignUpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExportsRequestObject struct {
+	Params GetExportsParams
+}
+
+type GetExportsResponseObject interface {
+	VisitGetExportsResponse(w http.ResponseWriter) error
+}
+
+type GetExports200JSONResponse struct {
+	ExportTodosResponseJSONResponse
+}
+
+func (response GetExports200JSONResponse) VisitGetExportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetExports200TextcsvResponse struct {
+	ExportTodosResponseTextcsvResponse
+}
+
+func (response GetExports200TextcsvResponse) VisitGetExportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetExports200TextmarkdownResponse struct {
+	ExportTodosResponseTextmarkdownResponse
+}
+
+func (response GetExports200TextmarkdownResponse) VisitGetExportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/markdown")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetExports400JSONResponse struct {
+	BadRequestErrorResponseJSONResponse
+}
+
+func (response GetExports400JSONResponse) VisitGetExportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExports401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response GetExports401JSONResponse) VisitGetExportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExports404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response GetExports404JSONResponse) VisitGetExportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExports500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response GetExports500JSONResponse) VisitGetExportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostImportsRequestObject struct {
+	Body *multipart.Reader
+}
+
+type PostImportsResponseObject interface {
+	VisitPostImportsResponse(w http.ResponseWriter) error
+}
+
+type PostImports200JSONResponse struct {
+	StoreImportResponseJSONResponse
+}
+
+func (response PostImports200JSONResponse) VisitPostImportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostImports400JSONResponse struct {
+	Code   int64                      `json:"code"`
+	Errors StoreImportValidationError `json:"errors"`
+	Import *Import                    `json:"import,omitempty"`
+}
+
+func (response PostImports400JSONResponse) VisitPostImportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostImports401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response PostImports401JSONResponse) VisitPostImportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostImports500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostImports500JSONResponse) VisitPostImportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetImportRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetImportResponseObject interface {
+	VisitGetImportResponse(w http.ResponseWriter) error
+}
+
+type GetImport200JSONResponse struct{ ShowImportResponseJSONResponse }
+
+func (response GetImport200JSONResponse) VisitGetImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetImport401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response GetImport401JSONResponse) VisitGetImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetImport404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response GetImport404JSONResponse) VisitGetImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetImport500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response GetImport500JSONResponse) VisitGetImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSavedFiltersRequestObject struct {
+}
+
+type GetSavedFiltersResponseObject interface {
+	VisitGetSavedFiltersResponse(w http.ResponseWriter) error
+}
+
+type GetSavedFilters200JSONResponse struct {
+	FetchSavedFiltersResponseJSONResponse
+}
+
+func (response GetSavedFilters200JSONResponse) VisitGetSavedFiltersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSavedFilters401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response GetSavedFilters401JSONResponse) VisitGetSavedFiltersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSavedFilters500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response GetSavedFilters500JSONResponse) VisitGetSavedFiltersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSavedFiltersRequestObject struct {
+	Body *PostSavedFiltersJSONRequestBody
+}
+
+type PostSavedFiltersResponseObject interface {
+	VisitPostSavedFiltersResponse(w http.ResponseWriter) error
+}
+
+type PostSavedFilters200JSONResponse struct {
+	StoreSavedFilterResponseJSONResponse
+}
+
+func (response PostSavedFilters200JSONResponse) VisitPostSavedFiltersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSavedFilters400JSONResponse struct {
+	Code        int64                           `json:"code"`
+	Errors      StoreSavedFilterValidationError `json:"errors"`
+	SavedFilter *SavedFilter                    `json:"savedFilter,omitempty"`
+}
+
+func (response PostSavedFilters400JSONResponse) VisitPostSavedFiltersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSavedFilters401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response PostSavedFilters401JSONResponse) VisitPostSavedFiltersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSavedFilters500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostSavedFilters500JSONResponse) VisitPostSavedFiltersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSavedFilterRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteSavedFilterResponseObject interface {
+	VisitDeleteSavedFilterResponse(w http.ResponseWriter) error
+}
+
+type DeleteSavedFilter200JSONResponse struct {
+	DeleteSavedFilterResponseJSONResponse
+}
+
+func (response DeleteSavedFilter200JSONResponse) VisitDeleteSavedFilterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSavedFilter401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response DeleteSavedFilter401JSONResponse) VisitDeleteSavedFilterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSavedFilter404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response DeleteSavedFilter404JSONResponse) VisitDeleteSavedFilterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSavedFilter500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response DeleteSavedFilter500JSONResponse) VisitDeleteSavedFilterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutSavedFilterRequestObject struct {
+	Id   string `json:"id"`
+	Body *PutSavedFilterJSONRequestBody
+}
+
+type PutSavedFilterResponseObject interface {
+	VisitPutSavedFilterResponse(w http.ResponseWriter) error
+}
+
+type PutSavedFilter200JSONResponse struct {
+	StoreSavedFilterResponseJSONResponse
+}
+
+func (response PutSavedFilter200JSONResponse) VisitPutSavedFilterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutSavedFilter400JSONResponse struct {
+	Code        int64                           `json:"code"`
+	Errors      StoreSavedFilterValidationError `json:"errors"`
+	SavedFilter *SavedFilter                    `json:"savedFilter,omitempty"`
+}
+
+func (response PutSavedFilter400JSONResponse) VisitPutSavedFilterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutSavedFilter401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response PutSavedFilter401JSONResponse) VisitPutSavedFilterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutSavedFilter404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response PutSavedFilter404JSONResponse) VisitPutSavedFilterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutSavedFilter500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PutSavedFilter500JSONResponse) VisitPutSavedFilterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetShareLinksRequestObject struct {
+}
+
+type GetShareLinksResponseObject interface {
+	VisitGetShareLinksResponse(w http.ResponseWriter) error
+}
+
+type GetShareLinks200JSONResponse struct {
+	FetchShareLinksResponseJSONResponse
+}
+
+func (response GetShareLinks200JSONResponse) VisitGetShareLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetShareLinks401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response GetShareLinks401JSONResponse) VisitGetShareLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetShareLinks500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response GetShareLinks500JSONResponse) VisitGetShareLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteShareLinkRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteShareLinkResponseObject interface {
+	VisitDeleteShareLinkResponse(w http.ResponseWriter) error
+}
+
+type DeleteShareLink200JSONResponse struct {
+	RevokeShareLinkResponseJSONResponse
+}
+
+func (response DeleteShareLink200JSONResponse) VisitDeleteShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteShareLink401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response DeleteShareLink401JSONResponse) VisitDeleteShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteShareLink404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response DeleteShareLink404JSONResponse) VisitDeleteShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteShareLink500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response DeleteShareLink500JSONResponse) VisitDeleteShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSharedTodoRequestObject struct {
+	Token  string `json:"token"`
+	Params GetSharedTodoParams
+}
+
+type GetSharedTodoResponseObject interface {
+	VisitGetSharedTodoResponse(w http.ResponseWriter) error
+}
+
+type GetSharedTodo200JSONResponse struct{ ShowTodoResponseJSONResponse }
+
+func (response GetSharedTodo200JSONResponse) VisitGetSharedTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSharedTodo401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response GetSharedTodo401JSONResponse) VisitGetSharedTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSharedTodo404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response GetSharedTodo404JSONResponse) VisitGetSharedTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSharedTodo429JSONResponse struct {
+	TooManyRequestsErrorResponseJSONResponse
+}
+
+func (response GetSharedTodo429JSONResponse) VisitGetSharedTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSharedTodo500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response GetSharedTodo500JSONResponse) VisitGetSharedTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTimeEntriesRequestObject struct {
+	Params GetTimeEntriesParams
+}
+
+type GetTimeEntriesResponseObject interface {
+	VisitGetTimeEntriesResponse(w http.ResponseWriter) error
+}
+
+type GetTimeEntries200JSONResponse struct {
+	FetchTimeEntriesResponseJSONResponse
+}
+
+func (response GetTimeEntries200JSONResponse) VisitGetTimeEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTimeEntries400JSONResponse struct {
+	BadRequestErrorResponseJSONResponse
+}
+
+func (response GetTimeEntries400JSONResponse) VisitGetTimeEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTimeEntries401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response GetTimeEntries401JSONResponse) VisitGetTimeEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTimeEntries500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response GetTimeEntries500JSONResponse) VisitGetTimeEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTimeEntriesRequestObject struct {
+	Body *PostTimeEntriesJSONRequestBody
+}
+
+type PostTimeEntriesResponseObject interface {
+	VisitPostTimeEntriesResponse(w http.ResponseWriter) error
+}
+
+type PostTimeEntries200JSONResponse struct {
+	StoreTimeEntryResponseJSONResponse
+}
+
+func (response PostTimeEntries200JSONResponse) VisitPostTimeEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTimeEntries400JSONResponse struct {
+	Code      int64                         `json:"code"`
+	Errors    StoreTimeEntryValidationError `json:"errors"`
+	TimeEntry *TimeEntry                    `json:"timeEntry,omitempty"`
+}
+
+func (response PostTimeEntries400JSONResponse) VisitPostTimeEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTimeEntries401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response PostTimeEntries401JSONResponse) VisitPostTimeEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTimeEntries500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostTimeEntries500JSONResponse) VisitPostTimeEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTimeEntryRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteTimeEntryResponseObject interface {
+	VisitDeleteTimeEntryResponse(w http.ResponseWriter) error
+}
+
+type DeleteTimeEntry200JSONResponse struct {
+	DeleteTimeEntryResponseJSONResponse
+}
+
+func (response DeleteTimeEntry200JSONResponse) VisitDeleteTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTimeEntry401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response DeleteTimeEntry401JSONResponse) VisitDeleteTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTimeEntry404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response DeleteTimeEntry404JSONResponse) VisitDeleteTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTimeEntry500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response DeleteTimeEntry500JSONResponse) VisitDeleteTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTimeReportsRequestObject struct {
+	Params GetTimeReportsParams
+}
+
+type GetTimeReportsResponseObject interface {
+	VisitGetTimeReportsResponse(w http.ResponseWriter) error
+}
+
+type GetTimeReports200JSONResponse struct {
+	FetchTimeReportResponseJSONResponse
+}
+
+func (response GetTimeReports200JSONResponse) VisitGetTimeReportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTimeReports400JSONResponse struct {
+	BadRequestErrorResponseJSONResponse
+}
+
+func (response GetTimeReports400JSONResponse) VisitGetTimeReportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTimeReports401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response GetTimeReports401JSONResponse) VisitGetTimeReportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTimeReports500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response GetTimeReports500JSONResponse) VisitGetTimeReportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTimeReportsCsvRequestObject struct {
+	Params GetTimeReportsCsvParams
+}
+
+type GetTimeReportsCsvResponseObject interface {
+	VisitGetTimeReportsCsvResponse(w http.ResponseWriter) error
+}
+
+type GetTimeReportsCsv200TextcsvResponse struct {
+	ExportTimeReportResponseTextcsvResponse
+}
+
+func (response GetTimeReportsCsv200TextcsvResponse) VisitGetTimeReportsCsvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetTimeReportsCsv400JSONResponse struct {
+	BadRequestErrorResponseJSONResponse
+}
+
+func (response GetTimeReportsCsv400JSONResponse) VisitGetTimeReportsCsvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTimeReportsCsv401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response GetTimeReportsCsv401JSONResponse) VisitGetTimeReportsCsvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTimeReportsCsv500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response GetTimeReportsCsv500JSONResponse) VisitGetTimeReportsCsvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodoTemplatesRequestObject struct {
+}
+
+type GetTodoTemplatesResponseObject interface {
+	VisitGetTodoTemplatesResponse(w http.ResponseWriter) error
+}
+
+type GetTodoTemplates200JSONResponse struct {
+	FetchTodoTemplatesResponseJSONResponse
+}
+
+func (response GetTodoTemplates200JSONResponse) VisitGetTodoTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodoTemplates401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response GetTodoTemplates401JSONResponse) VisitGetTodoTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodoTemplates500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response GetTodoTemplates500JSONResponse) VisitGetTodoTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoTemplatesRequestObject struct {
+	Body *PostTodoTemplatesJSONRequestBody
+}
+
+type PostTodoTemplatesResponseObject interface {
+	VisitPostTodoTemplatesResponse(w http.ResponseWriter) error
+}
+
+type PostTodoTemplates200JSONResponse struct {
+	StoreTodoTemplateResponseJSONResponse
+}
+
+func (response PostTodoTemplates200JSONResponse) VisitPostTodoTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoTemplates400JSONResponse struct {
+	Code         int64                            `json:"code"`
+	Errors       StoreTodoTemplateValidationError `json:"errors"`
+	TodoTemplate *TodoTemplate                    `json:"todoTemplate,omitempty"`
+}
+
+func (response PostTodoTemplates400JSONResponse) VisitPostTodoTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoTemplates401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response PostTodoTemplates401JSONResponse) VisitPostTodoTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoTemplates500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostTodoTemplates500JSONResponse) VisitPostTodoTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTodoTemplateRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteTodoTemplateResponseObject interface {
+	VisitDeleteTodoTemplateResponse(w http.ResponseWriter) error
+}
+
+type DeleteTodoTemplate200JSONResponse struct {
+	DeleteTodoTemplateResponseJSONResponse
+}
+
+func (response DeleteTodoTemplate200JSONResponse) VisitDeleteTodoTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTodoTemplate401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response DeleteTodoTemplate401JSONResponse) VisitDeleteTodoTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTodoTemplate404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response DeleteTodoTemplate404JSONResponse) VisitDeleteTodoTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTodoTemplate500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response DeleteTodoTemplate500JSONResponse) VisitDeleteTodoTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTodoTemplateRequestObject struct {
+	Id   string `json:"id"`
+	Body *PutTodoTemplateJSONRequestBody
+}
+
+type PutTodoTemplateResponseObject interface {
+	VisitPutTodoTemplateResponse(w http.ResponseWriter) error
+}
+
+type PutTodoTemplate200JSONResponse struct {
+	StoreTodoTemplateResponseJSONResponse
+}
+
+func (response PutTodoTemplate200JSONResponse) VisitPutTodoTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTodoTemplate400JSONResponse struct {
+	Code         int64                            `json:"code"`
+	Errors       StoreTodoTemplateValidationError `json:"errors"`
+	TodoTemplate *TodoTemplate                    `json:"todoTemplate,omitempty"`
+}
+
+func (response PutTodoTemplate400JSONResponse) VisitPutTodoTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTodoTemplate401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response PutTodoTemplate401JSONResponse) VisitPutTodoTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTodoTemplate404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response PutTodoTemplate404JSONResponse) VisitPutTodoTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTodoTemplate500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PutTodoTemplate500JSONResponse) VisitPutTodoTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoTemplateInstantiateRequestObject struct {
+	Id   string `json:"id"`
+	Body *PostTodoTemplateInstantiateJSONRequestBody
+}
+
+type PostTodoTemplateInstantiateResponseObject interface {
+	VisitPostTodoTemplateInstantiateResponse(w http.ResponseWriter) error
+}
+
+type PostTodoTemplateInstantiate200JSONResponse struct {
+	InstantiateTodoTemplateResponseJSONResponse
+}
+
+func (response PostTodoTemplateInstantiate200JSONResponse) VisitPostTodoTemplateInstantiateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoTemplateInstantiate400JSONResponse struct {
+	BadRequestErrorResponseJSONResponse
+}
+
+func (response PostTodoTemplateInstantiate400JSONResponse) VisitPostTodoTemplateInstantiateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoTemplateInstantiate401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response PostTodoTemplateInstantiate401JSONResponse) VisitPostTodoTemplateInstantiateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoTemplateInstantiate404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response PostTodoTemplateInstantiate404JSONResponse) VisitPostTodoTemplateInstantiateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoTemplateInstantiate500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostTodoTemplateInstantiate500JSONResponse) VisitPostTodoTemplateInstantiateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodosRequestObject struct {
+	Params GetTodosParams
+}
+
+type GetTodosResponseObject interface {
+	VisitGetTodosResponse(w http.ResponseWriter) error
+}
+
+type GetTodos200JSONResponse struct{ FetchTodosResponseJSONResponse }
+
+func (response GetTodos200JSONResponse) VisitGetTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodos400JSONResponse struct {
+	BadRequestErrorResponseJSONResponse
+}
+
+func (response GetTodos400JSONResponse) VisitGetTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodos401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response GetTodos401JSONResponse) VisitGetTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodos404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response GetTodos404JSONResponse) VisitGetTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodos500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response GetTodos500JSONResponse) VisitGetTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodosRequestObject struct {
+	Body *PostTodosJSONRequestBody
+}
+
+type PostTodosResponseObject interface {
+	VisitPostTodosResponse(w http.ResponseWriter) error
+}
+
+type PostTodos200JSONResponse struct{ StoreTodoResponseJSONResponse }
+
+func (response PostTodos200JSONResponse) VisitPostTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodos400JSONResponse struct {
+	Code   int64                    `json:"code"`
+	Errors StoreTodoValidationError `json:"errors"`
+}
+
+func (response PostTodos400JSONResponse) VisitPostTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodos401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response PostTodos401JSONResponse) VisitPostTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodos500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostTodos500JSONResponse) VisitPostTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTodoRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteTodoResponseObject interface {
+	VisitDeleteTodoResponse(w http.ResponseWriter) error
+}
+
+type DeleteTodo200JSONResponse struct{ DeleteTodoResponseJSONResponse }
+
+func (response DeleteTodo200JSONResponse) VisitDeleteTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTodo401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response DeleteTodo401JSONResponse) VisitDeleteTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTodo404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response DeleteTodo404JSONResponse) VisitDeleteTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTodo500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response DeleteTodo500JSONResponse) VisitDeleteTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodoRequestObject struct {
+	Id     string `json:"id"`
+	Params GetTodoParams
+}
+
+type GetTodoResponseObject interface {
+	VisitGetTodoResponse(w http.ResponseWriter) error
+}
+
+type GetTodo200JSONResponse struct{ ShowTodoResponseJSONResponse }
+
+func (response GetTodo200JSONResponse) VisitGetTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodo400JSONResponse struct {
+	BadRequestErrorResponseJSONResponse
+}
+
+func (response GetTodo400JSONResponse) VisitGetTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodo401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response GetTodo401JSONResponse) VisitGetTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodo404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response GetTodo404JSONResponse) VisitGetTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodo500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response GetTodo500JSONResponse) VisitGetTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTodoRequestObject struct {
+	Id   string `json:"id"`
+	Body *PatchTodoJSONRequestBody
+}
+
+type PatchTodoResponseObject interface {
+	VisitPatchTodoResponse(w http.ResponseWriter) error
+}
+
+type PatchTodo200JSONResponse struct{ StoreTodoResponseJSONResponse }
+
+func (response PatchTodo200JSONResponse) VisitPatchTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTodo400JSONResponse struct {
+	Code   int64                    `json:"code"`
+	Errors StoreTodoValidationError `json:"errors"`
+}
+
+func (response PatchTodo400JSONResponse) VisitPatchTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTodo401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response PatchTodo401JSONResponse) VisitPatchTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTodo404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response PatchTodo404JSONResponse) VisitPatchTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTodo500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PatchTodo500JSONResponse) VisitPatchTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodoAttachmentsRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetTodoAttachmentsResponseObject interface {
+	VisitGetTodoAttachmentsResponse(w http.ResponseWriter) error
+}
+
+type GetTodoAttachments200JSONResponse struct {
+	FetchAttachmentsResponseJSONResponse
+}
+
+func (response GetTodoAttachments200JSONResponse) VisitGetTodoAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodoAttachments401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response GetTodoAttachments401JSONResponse) VisitGetTodoAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodoAttachments404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response GetTodoAttachments404JSONResponse) VisitGetTodoAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodoAttachments500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response GetTodoAttachments500JSONResponse) VisitGetTodoAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoAttachmentsRequestObject struct {
+	Id   string `json:"id"`
+	Body *multipart.Reader
+}
+
+type PostTodoAttachmentsResponseObject interface {
+	VisitPostTodoAttachmentsResponse(w http.ResponseWriter) error
+}
+
+type PostTodoAttachments200JSONResponse struct {
+	StoreAttachmentResponseJSONResponse
+}
+
+func (response PostTodoAttachments200JSONResponse) VisitPostTodoAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoAttachments400JSONResponse struct {
+	Attachment *Attachment                    `json:"attachment,omitempty"`
+	Code       int64                          `json:"code"`
+	Errors     StoreAttachmentValidationError `json:"errors"`
+}
+
+func (response PostTodoAttachments400JSONResponse) VisitPostTodoAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoAttachments401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response PostTodoAttachments401JSONResponse) VisitPostTodoAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoAttachments404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response PostTodoAttachments404JSONResponse) VisitPostTodoAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoAttachments500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostTodoAttachments500JSONResponse) VisitPostTodoAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTodoAttachmentRequestObject struct {
+	Id           string `json:"id"`
+	AttachmentId string `json:"attachmentId"`
+}
+
+type DeleteTodoAttachmentResponseObject interface {
+	VisitDeleteTodoAttachmentResponse(w http.ResponseWriter) error
+}
+
+type DeleteTodoAttachment200JSONResponse struct {
+	DeleteAttachmentResponseJSONResponse
+}
+
+func (response DeleteTodoAttachment200JSONResponse) VisitDeleteTodoAttachmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTodoAttachment401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response DeleteTodoAttachment401JSONResponse) VisitDeleteTodoAttachmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTodoAttachment404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response DeleteTodoAttachment404JSONResponse) VisitDeleteTodoAttachmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTodoAttachment500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response DeleteTodoAttachment500JSONResponse) VisitDeleteTodoAttachmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodoAttachmentRequestObject struct {
+	Id           string `json:"id"`
+	AttachmentId string `json:"attachmentId"`
+}
+
+type GetTodoAttachmentResponseObject interface {
+	VisitGetTodoAttachmentResponse(w http.ResponseWriter) error
+}
+
+type GetTodoAttachment200JSONResponse struct {
+	ShowAttachmentResponseJSONResponse
+}
+
+func (response GetTodoAttachment200JSONResponse) VisitGetTodoAttachmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodoAttachment401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response GetTodoAttachment401JSONResponse) VisitGetTodoAttachmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodoAttachment404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response GetTodoAttachment404JSONResponse) VisitGetTodoAttachmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodoAttachment500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response GetTodoAttachment500JSONResponse) VisitGetTodoAttachmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoCommentsRequestObject struct {
+	Id   string `json:"id"`
+	Body *PostTodoCommentsJSONRequestBody
+}
+
+type PostTodoCommentsResponseObject interface {
+	VisitPostTodoCommentsResponse(w http.ResponseWriter) error
+}
+
+type PostTodoComments200JSONResponse struct {
+	StoreCommentResponseJSONResponse
+}
+
+func (response PostTodoComments200JSONResponse) VisitPostTodoCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoComments400JSONResponse struct {
+	Code    int64                       `json:"code"`
+	Comment *Comment                    `json:"comment,omitempty"`
+	Errors  StoreCommentValidationError `json:"errors"`
+}
+
+func (response PostTodoComments400JSONResponse) VisitPostTodoCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoComments401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response PostTodoComments401JSONResponse) VisitPostTodoCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoComments404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response PostTodoComments404JSONResponse) VisitPostTodoCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoComments500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostTodoComments500JSONResponse) VisitPostTodoCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTodoCommentRequestObject struct {
+	Id        string `json:"id"`
+	CommentId string `json:"commentId"`
+}
+
+type DeleteTodoCommentResponseObject interface {
+	VisitDeleteTodoCommentResponse(w http.ResponseWriter) error
+}
+
+type DeleteTodoComment200JSONResponse struct {
+	DeleteCommentResponseJSONResponse
+}
+
+func (response DeleteTodoComment200JSONResponse) VisitDeleteTodoCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTodoComment401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response DeleteTodoComment401JSONResponse) VisitDeleteTodoCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTodoComment404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response DeleteTodoComment404JSONResponse) VisitDeleteTodoCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTodoComment500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response DeleteTodoComment500JSONResponse) VisitDeleteTodoCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTodoCommentRequestObject struct {
+	Id        string `json:"id"`
+	CommentId string `json:"commentId"`
+	Body      *PatchTodoCommentJSONRequestBody
+}
+
+type PatchTodoCommentResponseObject interface {
+	VisitPatchTodoCommentResponse(w http.ResponseWriter) error
+}
+
+type PatchTodoComment200JSONResponse struct {
+	StoreCommentResponseJSONResponse
+}
+
+func (response PatchTodoComment200JSONResponse) VisitPatchTodoCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTodoComment400JSONResponse struct {
+	Code    int64                       `json:"code"`
+	Comment *Comment                    `json:"comment,omitempty"`
+	Errors  StoreCommentValidationError `json:"errors"`
+}
+
+func (response PatchTodoComment400JSONResponse) VisitPatchTodoCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTodoComment401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response PatchTodoComment401JSONResponse) VisitPatchTodoCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTodoComment404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response PatchTodoComment404JSONResponse) VisitPatchTodoCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTodoComment500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PatchTodoComment500JSONResponse) VisitPatchTodoCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoShareLinksRequestObject struct {
+	Id   string `json:"id"`
+	Body *PostTodoShareLinksJSONRequestBody
+}
+
+type PostTodoShareLinksResponseObject interface {
+	VisitPostTodoShareLinksResponse(w http.ResponseWriter) error
+}
+
+type PostTodoShareLinks200JSONResponse struct {
+	CreateShareLinkResponseJSONResponse
+}
+
+func (response PostTodoShareLinks200JSONResponse) VisitPostTodoShareLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoShareLinks400JSONResponse struct {
+	Code      int64                         `json:"code"`
+	Errors    StoreShareLinkValidationError `json:"errors"`
+	ShareLink *ShareLink                    `json:"shareLink,omitempty"`
+	Token     *string                       `json:"token,omitempty"`
+}
+
+func (response PostTodoShareLinks400JSONResponse) VisitPostTodoShareLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoShareLinks401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response PostTodoShareLinks401JSONResponse) VisitPostTodoShareLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoShareLinks404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response PostTodoShareLinks404JSONResponse) VisitPostTodoShareLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoShareLinks500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostTodoShareLinks500JSONResponse) VisitPostTodoShareLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoTimerStartRequestObject struct {
+	Id string `json:"id"`
+}
+
+type PostTodoTimerStartResponseObject interface {
+	VisitPostTodoTimerStartResponse(w http.ResponseWriter) error
+}
+
+type PostTodoTimerStart200JSONResponse struct{ TimeEntryResponseJSONResponse }
+
+func (response PostTodoTimerStart200JSONResponse) VisitPostTodoTimerStartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoTimerStart400JSONResponse struct {
+	BadRequestErrorResponseJSONResponse
+}
+
+func (response PostTodoTimerStart400JSONResponse) VisitPostTodoTimerStartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoTimerStart401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response PostTodoTimerStart401JSONResponse) VisitPostTodoTimerStartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoTimerStart404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response PostTodoTimerStart404JSONResponse) VisitPostTodoTimerStartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoTimerStart500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostTodoTimerStart500JSONResponse) VisitPostTodoTimerStartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoTimerStopRequestObject struct {
+	Id string `json:"id"`
+}
+
+type PostTodoTimerStopResponseObject interface {
+	VisitPostTodoTimerStopResponse(w http.ResponseWriter) error
+}
+
+type PostTodoTimerStop200JSONResponse struct{ TimeEntryResponseJSONResponse }
+
+func (response PostTodoTimerStop200JSONResponse) VisitPostTodoTimerStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoTimerStop401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response PostTodoTimerStop401JSONResponse) VisitPostTodoTimerStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoTimerStop404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response PostTodoTimerStop404JSONResponse) VisitPostTodoTimerStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTodoTimerStop500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostTodoTimerStop500JSONResponse) VisitPostTodoTimerStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersMeRequestObject struct {
+}
+
+type GetUsersMeResponseObject interface {
+	VisitGetUsersMeResponse(w http.ResponseWriter) error
+}
+
+type GetUsersMe200JSONResponse struct{ FetchMeResponseJSONResponse }
+
+func (response GetUsersMe200JSONResponse) VisitGetUsersMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersMe401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response GetUsersMe401JSONResponse) VisitGetUsersMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersMe500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response GetUsersMe500JSONResponse) VisitGetUsersMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersMeRequestObject struct {
+	Body *PatchUsersMeJSONRequestBody
+}
+
+type PatchUsersMeResponseObject interface {
+	VisitPatchUsersMeResponse(w http.ResponseWriter) error
+}
+
+type PatchUsersMe200JSONResponse struct{ UpdateMeResponseJSONResponse }
+
+func (response PatchUsersMe200JSONResponse) VisitPatchUsersMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersMe400JSONResponse struct {
+	Code   int64                   `json:"code"`
+	Errors UpdateMeValidationError `json:"errors"`
+	User   *User                   `json:"user,omitempty"`
+}
+
+func (response PatchUsersMe400JSONResponse) VisitPatchUsersMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersMe401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response PatchUsersMe401JSONResponse) VisitPatchUsersMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersMe500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PatchUsersMe500JSONResponse) VisitPatchUsersMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersMeEmailRequestObject struct {
+	Body *PostUsersMeEmailJSONRequestBody
+}
+
+type PostUsersMeEmailResponseObject interface {
+	VisitPostUsersMeEmailResponse(w http.ResponseWriter) error
+}
+
+type PostUsersMeEmail200JSONResponse struct {
+	ChangeEmailResponseJSONResponse
+}
+
+func (response PostUsersMeEmail200JSONResponse) VisitPostUsersMeEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersMeEmail400JSONResponse struct {
+	Code   int64                      `json:"code"`
+	Errors ChangeEmailValidationError `json:"errors"`
+	User   *User                      `json:"user,omitempty"`
+}
+
+func (response PostUsersMeEmail400JSONResponse) VisitPostUsersMeEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersMeEmail401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response PostUsersMeEmail401JSONResponse) VisitPostUsersMeEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersMeEmail429JSONResponse struct {
+	TooManyRequestsErrorResponseJSONResponse
+}
+
+func (response PostUsersMeEmail429JSONResponse) VisitPostUsersMeEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersMeEmail500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostUsersMeEmail500JSONResponse) VisitPostUsersMeEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksRequestObject struct {
+}
+
+type GetWebhooksResponseObject interface {
+	VisitGetWebhooksResponse(w http.ResponseWriter) error
+}
+
+type GetWebhooks200JSONResponse struct {
+	FetchWebhooksResponseJSONResponse
+}
+
+func (response GetWebhooks200JSONResponse) VisitGetWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooks401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response GetWebhooks401JSONResponse) VisitGetWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooks500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response GetWebhooks500JSONResponse) VisitGetWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksRequestObject struct {
+	Body *PostWebhooksJSONRequestBody
+}
+
+type PostWebhooksResponseObject interface {
+	VisitPostWebhooksResponse(w http.ResponseWriter) error
+}
+
+type PostWebhooks200JSONResponse struct {
+	StoreWebhookResponseJSONResponse
+}
+
+func (response PostWebhooks200JSONResponse) VisitPostWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooks400JSONResponse struct {
+	Code    int64                       `json:"code"`
+	Errors  StoreWebhookValidationError `json:"errors"`
+	Webhook *Webhook                    `json:"webhook,omitempty"`
+}
+
+func (response PostWebhooks400JSONResponse) VisitPostWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooks401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response PostWebhooks401JSONResponse) VisitPostWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooks500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostWebhooks500JSONResponse) VisitPostWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhookRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteWebhookResponseObject interface {
+	VisitDeleteWebhookResponse(w http.ResponseWriter) error
+}
+
+type DeleteWebhook200JSONResponse struct {
+	DeleteWebhookResponseJSONResponse
+}
+
+func (response DeleteWebhook200JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response DeleteWebhook401JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response DeleteWebhook404JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response DeleteWebhook500JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookDeliveriesRequestObject struct {
+	Id     string `json:"id"`
+	Params GetWebhookDeliveriesParams
+}
+
+type GetWebhookDeliveriesResponseObject interface {
+	VisitGetWebhookDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type GetWebhookDeliveries200JSONResponse struct {
+	FetchWebhookDeliveriesResponseJSONResponse
+}
+
+func (response GetWebhookDeliveries200JSONResponse) VisitGetWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookDeliveries400JSONResponse struct {
+	BadRequestErrorResponseJSONResponse
+}
+
+func (response GetWebhookDeliveries400JSONResponse) VisitGetWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookDeliveries401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response GetWebhookDeliveries401JSONResponse) VisitGetWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookDeliveries404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response GetWebhookDeliveries404JSONResponse) VisitGetWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookDeliveries500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response GetWebhookDeliveries500JSONResponse) VisitGetWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhookDeliveryRedeliverRequestObject struct {
+	Id         string `json:"id"`
+	DeliveryId string `json:"deliveryId"`
+}
+
+type PostWebhookDeliveryRedeliverResponseObject interface {
+	VisitPostWebhookDeliveryRedeliverResponse(w http.ResponseWriter) error
+}
+
+type PostWebhookDeliveryRedeliver200JSONResponse struct {
+	RedeliverWebhookResponseJSONResponse
+}
+
+func (response PostWebhookDeliveryRedeliver200JSONResponse) VisitPostWebhookDeliveryRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhookDeliveryRedeliver401JSONResponse struct {
+	UnauthorizedErrorResponseJSONResponse
+}
+
+func (response PostWebhookDeliveryRedeliver401JSONResponse) VisitPostWebhookDeliveryRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhookDeliveryRedeliver404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response PostWebhookDeliveryRedeliver404JSONResponse) VisitPostWebhookDeliveryRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhookDeliveryRedeliver500JSONResponse struct {
+	InternalServerErrorResponseJSONResponse
+}
+
+func (response PostWebhookDeliveryRedeliver500JSONResponse) VisitPostWebhookDeliveryRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetTodo404JSONResponse struct {
-	NotFoundErrorResponseJSONResponse
-}
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Fetch Activities
+	// (GET /activity)
+	GetActivities(ctx context.Context, request GetActivitiesRequestObject) (GetActivitiesResponseObject, error)
+	// Fetch App Passwords
+	// (GET /appPasswords)
+	GetAppPasswords(ctx context.Context, request GetAppPasswordsRequestObject) (GetAppPasswordsResponseObject, error)
+	// Create App Password
+	// (POST /appPasswords)
+	PostAppPasswords(ctx context.Context, request PostAppPasswordsRequestObject) (PostAppPasswordsResponseObject, error)
+	// Revoke App Password
+	// (DELETE /appPasswords/{id})
+	DeleteAppPassword(ctx context.Context, request DeleteAppPasswordRequestObject) (DeleteAppPasswordResponseObject, error)
+	// Get Csrf
+	// (GET /auth/csrf)
+	GetAuthCsrf(ctx context.Context, request GetAuthCsrfRequestObject) (GetAuthCsrfResponseObject, error)
+	// Resend Email Verification
+	// (POST /auth/email/resend)
+	PostAuthEmailResend(ctx context.Context, request PostAuthEmailResendRequestObject) (PostAuthEmailResendResponseObject, error)
+	// Verify Email
+	// (POST /auth/email/verify)
+	PostAuthEmailVerify(ctx context.Context, request PostAuthEmailVerifyRequestObject) (PostAuthEmailVerifyResponseObject, error)
+	// Fetch Passkeys
+	// (GET /auth/passkeys)
+	GetAuthPasskeys(ctx context.Context, request GetAuthPasskeysRequestObject) (GetAuthPasskeysResponseObject, error)
+	// Begin Passkey Registration
+	// (POST /auth/passkeys/register/begin)
+	PostAuthPasskeysRegisterBegin(ctx context.Context, request PostAuthPasskeysRegisterBeginRequestObject) (PostAuthPasskeysRegisterBeginResponseObject, error)
+	// Finish Passkey Registration
+	// (POST /auth/passkeys/register/finish)
+	PostAuthPasskeysRegisterFinish(ctx context.Context, request PostAuthPasskeysRegisterFinishRequestObject) (PostAuthPasskeysRegisterFinishResponseObject, error)
+	// Begin Passkey Sign In
+	// (POST /auth/passkeys/signIn/begin)
+	PostAuthPasskeysSignInBegin(ctx context.Context, request PostAuthPasskeysSignInBeginRequestObject) (PostAuthPasskeysSignInBeginResponseObject, error)
+	// Finish Passkey Sign In
+	// (POST /auth/passkeys/signIn/finish)
+	PostAuthPasskeysSignInFinish(ctx context.Context, request PostAuthPasskeysSignInFinishRequestObject) (PostAuthPasskeysSignInFinishResponseObject, error)
+	// Delete Passkey
+	// (DELETE /auth/passkeys/{id})
+	DeleteAuthPasskey(ctx context.Context, request DeleteAuthPasskeyRequestObject) (DeleteAuthPasskeyResponseObject, error)
+	// Forgot Password
+	// (POST /auth/password/forgot)
+	PostAuthPasswordForgot(ctx context.Context, request PostAuthPasswordForgotRequestObject) (PostAuthPasswordForgotResponseObject, error)
+	// Reset Password
+	// (POST /auth/password/reset)
+	PostAuthPasswordReset(ctx context.Context, request PostAuthPasswordResetRequestObject) (PostAuthPasswordResetResponseObject, error)
+	// Refresh Access Token
+	// (POST /auth/refresh)
+	PostAuthRefresh(ctx context.Context, request PostAuthRefreshRequestObject) (PostAuthRefreshResponseObject, error)
+	// Fetch Sessions
+	// (GET /auth/sessions)
+	GetAuthSessions(ctx context.Context, request GetAuthSessionsRequestObject) (GetAuthSessionsResponseObject, error)
+	// Revoke Other Sessions
+	// (POST /auth/sessions/revokeOthers)
+	PostAuthSessionsRevokeOthers(ctx context.Context, request PostAuthSessionsRevokeOthersRequestObject) (PostAuthSessionsRevokeOthersResponseObject, error)
+	// Revoke Session
+	// (DELETE /auth/sessions/{id})
+	DeleteAuthSession(ctx context.Context, request DeleteAuthSessionRequestObject) (DeleteAuthSessionResponseObject, error)
+	// Sign In
+	// (POST /auth/signIn)
+	PostAuthSignIn(ctx context.Context, request PostAuthSignInRequestObject) (PostAuthSignInResponseObject, error)
+	// Sign In With Two-Factor Authentication
+	// (POST /auth/signIn/twoFactor)
+	PostAuthSignInTwoFactor(ctx context.Context, request PostAuthSignInTwoFactorRequestObject) (PostAuthSignInTwoFactorResponseObject, error)
+	// Sign Out
+	// (POST /auth/signOut)
+	PostAuthSignOut(ctx context.Context, request PostAuthSignOutRequestObject) (PostAuthSignOutResponseObject, error)
+	// SignUp
+	// (POST /auth/signUp)
+	PostAuthSignUp(ctx context.Context, request PostAuthSignUpRequestObject) (PostAuthSignUpResponseObject, error)
+	// Confirm Two-Factor Authentication
+	// (POST /auth/twoFactor/confirm)
+	PostAuthTwoFactorConfirm(ctx context.Context, request PostAuthTwoFactorConfirmRequestObject) (PostAuthTwoFactorConfirmResponseObject, error)
+	// Disable Two-Factor Authentication
+	// (POST /auth/twoFactor/disable)
+	PostAuthTwoFactorDisable(ctx context.Context, request PostAuthTwoFactorDisableRequestObject) (PostAuthTwoFactorDisableResponseObject, error)
+	// Regenerate Recovery Codes
+	// (POST /auth/twoFactor/recoveryCodes)
+	PostAuthTwoFactorRecoveryCodes(ctx context.Context, request PostAuthTwoFactorRecoveryCodesRequestObject) (PostAuthTwoFactorRecoveryCodesResponseObject, error)
+	// Set Up Two-Factor Authentication
+	// (POST /auth/twoFactor/setup)
+	PostAuthTwoFactorSetup(ctx context.Context, request PostAuthTwoFactorSetupRequestObject) (PostAuthTwoFactorSetupResponseObject, error)
+	// Unlock Account
+	// (POST /auth/unlock)
+	PostAuthUnlock(ctx context.Context, request PostAuthUnlockRequestObject) (PostAuthUnlockResponseObject, error)
+	// Validate SignUp
+	// (POST /auth/validateSignUp)
+	PostAuthValidateSignUp(ctx context.Context, request PostAuthValidateSignUpRequestObject) (PostAuthValidateSignUpResponseObject, error)
+	// Export Todos
+	// (GET /exports)
+	GetExports(ctx context.Context, request GetExportsRequestObject) (GetExportsResponseObject, error)
+	// Create Import
+	// (POST /imports)
+	PostImports(ctx context.Context, request PostImportsRequestObject) (PostImportsResponseObject, error)
+	// Show Import
+	// (GET /imports/{id})
+	GetImport(ctx context.Context, request GetImportRequestObject) (GetImportResponseObject, error)
+	// Fetch Saved Filters
+	// (GET /savedFilters)
+	GetSavedFilters(ctx context.Context, request GetSavedFiltersRequestObject) (GetSavedFiltersResponseObject, error)
+	// Create Saved Filter
+	// (POST /savedFilters)
+	PostSavedFilters(ctx context.Context, request PostSavedFiltersRequestObject) (PostSavedFiltersResponseObject, error)
+	// Delete Saved Filter
+	// (DELETE /savedFilters/{id})
+	DeleteSavedFilter(ctx context.Context, request DeleteSavedFilterRequestObject) (DeleteSavedFilterResponseObject, error)
+	// Update Saved Filter
+	// (PUT /savedFilters/{id})
+	PutSavedFilter(ctx context.Context, request PutSavedFilterRequestObject) (PutSavedFilterResponseObject, error)
+	// Fetch Share Links
+	// (GET /shareLinks)
+	GetShareLinks(ctx context.Context, request GetShareLinksRequestObject) (GetShareLinksResponseObject, error)
+	// Revoke Share Link
+	// (DELETE /shareLinks/{id})
+	DeleteShareLink(ctx context.Context, request DeleteShareLinkRequestObject) (DeleteShareLinkResponseObject, error)
+	// Show Shared Todo
+	// (GET /shared/{token})
+	GetSharedTodo(ctx context.Context, request GetSharedTodoRequestObject) (GetSharedTodoResponseObject, error)
+	// Fetch Time Entries
+	// (GET /timeEntries)
+	GetTimeEntries(ctx context.Context, request GetTimeEntriesRequestObject) (GetTimeEntriesResponseObject, error)
+	// Create Time Entry
+	// (POST /timeEntries)
+	PostTimeEntries(ctx context.Context, request PostTimeEntriesRequestObject) (PostTimeEntriesResponseObject, error)
+	// Delete Time Entry
+	// (DELETE /timeEntries/{id})
+	DeleteTimeEntry(ctx context.Context, request DeleteTimeEntryRequestObject) (DeleteTimeEntryResponseObject, error)
+	// Fetch Time Report
+	// (GET /timeReports)
+	GetTimeReports(ctx context.Context, request GetTimeReportsRequestObject) (GetTimeReportsResponseObject, error)
+	// Export Time Report
+	// (GET /timeReports/csv)
+	GetTimeReportsCsv(ctx context.Context, request GetTimeReportsCsvRequestObject) (GetTimeReportsCsvResponseObject, error)
+	// Fetch Todo Templates
+	// (GET /todoTemplates)
+	GetTodoTemplates(ctx context.Context, request GetTodoTemplatesRequestObject) (GetTodoTemplatesResponseObject, error)
+	// Create Todo Template
+	// (POST /todoTemplates)
+	PostTodoTemplates(ctx context.Context, request PostTodoTemplatesRequestObject) (PostTodoTemplatesResponseObject, error)
+	// Delete Todo Template
+	// (DELETE /todoTemplates/{id})
+	DeleteTodoTemplate(ctx context.Context, request DeleteTodoTemplateRequestObject) (DeleteTodoTemplateResponseObject, error)
+	// Update Todo Template
+	// (PUT /todoTemplates/{id})
+	PutTodoTemplate(ctx context.Context, request PutTodoTemplateRequestObject) (PutTodoTemplateResponseObject, error)
+	// Instantiate Todo Template
+	// (POST /todoTemplates/{id}/instantiate)
+	PostTodoTemplateInstantiate(ctx context.Context, request PostTodoTemplateInstantiateRequestObject) (PostTodoTemplateInstantiateResponseObject, error)
+	// Fetch Todos
+	// (GET /todos)
+	GetTodos(ctx context.Context, request GetTodosRequestObject) (GetTodosResponseObject, error)
+	// Create Todo
+	// (POST /todos)
+	PostTodos(ctx context.Context, request PostTodosRequestObject) (PostTodosResponseObject, error)
+	// Delete Todo
+	// (DELETE /todos/{id})
+	DeleteTodo(ctx context.Context, request DeleteTodoRequestObject) (DeleteTodoResponseObject, error)
+	// Show Todo
+	// (GET /todos/{id})
+	GetTodo(ctx context.Context, request GetTodoRequestObject) (GetTodoResponseObject, error)
+	// Update Todo
+	// (PATCH /todos/{id})
+	PatchTodo(ctx context.Context, request PatchTodoRequestObject) (PatchTodoResponseObject, error)
+	// Fetch Attachments
+	// (GET /todos/{id}/attachments)
+	GetTodoAttachments(ctx context.Context, request GetTodoAttachmentsRequestObject) (GetTodoAttachmentsResponseObject, error)
+	// Upload Attachment
+	// (POST /todos/{id}/attachments)
+	PostTodoAttachments(ctx context.Context, request PostTodoAttachmentsRequestObject) (PostTodoAttachmentsResponseObject, error)
+	// Delete Attachment
+	// (DELETE /todos/{id}/attachments/{attachmentId})
+	DeleteTodoAttachment(ctx context.Context, request DeleteTodoAttachmentRequestObject) (DeleteTodoAttachmentResponseObject, error)
+	// Show Attachment Download URL
+	// (GET /todos/{id}/attachments/{attachmentId})
+	GetTodoAttachment(ctx context.Context, request GetTodoAttachmentRequestObject) (GetTodoAttachmentResponseObject, error)
+	// Create Comment
+	// (POST /todos/{id}/comments)
+	PostTodoComments(ctx context.Context, request PostTodoCommentsRequestObject) (PostTodoCommentsResponseObject, error)
+	// Delete Comment
+	// (DELETE /todos/{id}/comments/{commentId})
+	DeleteTodoComment(ctx context.Context, request DeleteTodoCommentRequestObject) (DeleteTodoCommentResponseObject, error)
+	// Update Comment
+	// (PATCH /todos/{id}/comments/{commentId})
+	PatchTodoComment(ctx context.Context, request PatchTodoCommentRequestObject) (PatchTodoCommentResponseObject, error)
+	// Create Share Link
+	// (POST /todos/{id}/shareLinks)
+	PostTodoShareLinks(ctx context.Context, request PostTodoShareLinksRequestObject) (PostTodoShareLinksResponseObject, error)
+	// Start Timer
+	// (POST /todos/{id}/timer/start)
+	PostTodoTimerStart(ctx context.Context, request PostTodoTimerStartRequestObject) (PostTodoTimerStartResponseObject, error)
+	// Stop Timer
+	// (POST /todos/{id}/timer/stop)
+	PostTodoTimerStop(ctx context.Context, request PostTodoTimerStopRequestObject) (PostTodoTimerStopResponseObject, error)
+	// Fetch Me
+	// (GET /users/me)
+	GetUsersMe(ctx context.Context, request GetUsersMeRequestObject) (GetUsersMeResponseObject, error)
+	// Update Me
+	// (PATCH /users/me)
+	PatchUsersMe(ctx context.Context, request PatchUsersMeRequestObject) (PatchUsersMeResponseObject, error)
+	// Change Email
+	// (POST /users/me/email)
+	PostUsersMeEmail(ctx context.Context, request PostUsersMeEmailRequestObject) (PostUsersMeEmailResponseObject, error)
+	// Fetch Webhooks
+	// (GET /webhooks)
+	GetWebhooks(ctx context.Context, request GetWebhooksRequestObject) (GetWebhooksResponseObject, error)
+	// Create Webhook
+	// (POST /webhooks)
+	PostWebhooks(ctx context.Context, request PostWebhooksRequestObject) (PostWebhooksResponseObject, error)
+	// Delete Webhook
+	// (DELETE /webhooks/{id})
+	DeleteWebhook(ctx context.Context, request DeleteWebhookRequestObject) (DeleteWebhookResponseObject, error)
+	// Fetch Webhook Deliveries
+	// (GET /webhooks/{id}/deliveries)
+	GetWebhookDeliveries(ctx context.Context, request GetWebhookDeliveriesRequestObject) (GetWebhookDeliveriesResponseObject, error)
+	// Redeliver Webhook
+	// (POST /webhooks/{id}/deliveries/{deliveryId}/redeliver)
+	PostWebhookDeliveryRedeliver(ctx context.Context, request PostWebhookDeliveryRedeliverRequestObject) (PostWebhookDeliveryRedeliverResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetActivities operation middleware
+func (sh *strictHandler) GetActivities(ctx echo.Context, params GetActivitiesParams) error {
+	var request GetActivitiesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetActivities(ctx.Request().Context(), request.(GetActivitiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetActivities")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetActivitiesResponseObject); ok {
+		return validResponse.VisitGetActivitiesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAppPasswords operation middleware
+func (sh *strictHandler) GetAppPasswords(ctx echo.Context) error {
+	var request GetAppPasswordsRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAppPasswords(ctx.Request().Context(), request.(GetAppPasswordsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAppPasswords")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetAppPasswordsResponseObject); ok {
+		return validResponse.VisitGetAppPasswordsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAppPasswords operation middleware
+func (sh *strictHandler) PostAppPasswords(ctx echo.Context) error {
+	var request PostAppPasswordsRequestObject
+
+	var body PostAppPasswordsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAppPasswords(ctx.Request().Context(), request.(PostAppPasswordsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAppPasswords")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAppPasswordsResponseObject); ok {
+		return validResponse.VisitPostAppPasswordsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteAppPassword operation middleware
+func (sh *strictHandler) DeleteAppPassword(ctx echo.Context, id string) error {
+	var request DeleteAppPasswordRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAppPassword(ctx.Request().Context(), request.(DeleteAppPasswordRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAppPassword")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteAppPasswordResponseObject); ok {
+		return validResponse.VisitDeleteAppPasswordResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAuthCsrf operation middleware
+func (sh *strictHandler) GetAuthCsrf(ctx echo.Context) error {
+	var request GetAuthCsrfRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuthCsrf(ctx.Request().Context(), request.(GetAuthCsrfRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuthCsrf")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetAuthCsrfResponseObject); ok {
+		return validResponse.VisitGetAuthCsrfResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthEmailResend operation middleware
+func (sh *strictHandler) PostAuthEmailResend(ctx echo.Context) error {
+	var request PostAuthEmailResendRequestObject
+
+	var body PostAuthEmailResendJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthEmailResend(ctx.Request().Context(), request.(PostAuthEmailResendRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthEmailResend")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthEmailResendResponseObject); ok {
+		return validResponse.VisitPostAuthEmailResendResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthEmailVerify operation middleware
+func (sh *strictHandler) PostAuthEmailVerify(ctx echo.Context) error {
+	var request PostAuthEmailVerifyRequestObject
+
+	var body PostAuthEmailVerifyJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthEmailVerify(ctx.Request().Context(), request.(PostAuthEmailVerifyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthEmailVerify")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthEmailVerifyResponseObject); ok {
+		return validResponse.VisitPostAuthEmailVerifyResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAuthPasskeys operation middleware
+func (sh *strictHandler) GetAuthPasskeys(ctx echo.Context) error {
+	var request GetAuthPasskeysRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuthPasskeys(ctx.Request().Context(), request.(GetAuthPasskeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuthPasskeys")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetAuthPasskeysResponseObject); ok {
+		return validResponse.VisitGetAuthPasskeysResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthPasskeysRegisterBegin operation middleware
+func (sh *strictHandler) PostAuthPasskeysRegisterBegin(ctx echo.Context) error {
+	var request PostAuthPasskeysRegisterBeginRequestObject
+
+	var body PostAuthPasskeysRegisterBeginJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthPasskeysRegisterBegin(ctx.Request().Context(), request.(PostAuthPasskeysRegisterBeginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthPasskeysRegisterBegin")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthPasskeysRegisterBeginResponseObject); ok {
+		return validResponse.VisitPostAuthPasskeysRegisterBeginResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthPasskeysRegisterFinish operation middleware
+func (sh *strictHandler) PostAuthPasskeysRegisterFinish(ctx echo.Context) error {
+	var request PostAuthPasskeysRegisterFinishRequestObject
+
+	var body PostAuthPasskeysRegisterFinishJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthPasskeysRegisterFinish(ctx.Request().Context(), request.(PostAuthPasskeysRegisterFinishRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthPasskeysRegisterFinish")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthPasskeysRegisterFinishResponseObject); ok {
+		return validResponse.VisitPostAuthPasskeysRegisterFinishResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthPasskeysSignInBegin operation middleware
+func (sh *strictHandler) PostAuthPasskeysSignInBegin(ctx echo.Context) error {
+	var request PostAuthPasskeysSignInBeginRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthPasskeysSignInBegin(ctx.Request().Context(), request.(PostAuthPasskeysSignInBeginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthPasskeysSignInBegin")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthPasskeysSignInBeginResponseObject); ok {
+		return validResponse.VisitPostAuthPasskeysSignInBeginResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthPasskeysSignInFinish operation middleware
+func (sh *strictHandler) PostAuthPasskeysSignInFinish(ctx echo.Context) error {
+	var request PostAuthPasskeysSignInFinishRequestObject
+
+	var body PostAuthPasskeysSignInFinishJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthPasskeysSignInFinish(ctx.Request().Context(), request.(PostAuthPasskeysSignInFinishRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthPasskeysSignInFinish")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthPasskeysSignInFinishResponseObject); ok {
+		return validResponse.VisitPostAuthPasskeysSignInFinishResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteAuthPasskey operation middleware
+func (sh *strictHandler) DeleteAuthPasskey(ctx echo.Context, id string) error {
+	var request DeleteAuthPasskeyRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAuthPasskey(ctx.Request().Context(), request.(DeleteAuthPasskeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAuthPasskey")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteAuthPasskeyResponseObject); ok {
+		return validResponse.VisitDeleteAuthPasskeyResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthPasswordForgot operation middleware
+func (sh *strictHandler) PostAuthPasswordForgot(ctx echo.Context) error {
+	var request PostAuthPasswordForgotRequestObject
+
+	var body PostAuthPasswordForgotJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthPasswordForgot(ctx.Request().Context(), request.(PostAuthPasswordForgotRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthPasswordForgot")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthPasswordForgotResponseObject); ok {
+		return validResponse.VisitPostAuthPasswordForgotResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthPasswordReset operation middleware
+func (sh *strictHandler) PostAuthPasswordReset(ctx echo.Context) error {
+	var request PostAuthPasswordResetRequestObject
+
+	var body PostAuthPasswordResetJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthPasswordReset(ctx.Request().Context(), request.(PostAuthPasswordResetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthPasswordReset")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthPasswordResetResponseObject); ok {
+		return validResponse.VisitPostAuthPasswordResetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthRefresh operation middleware
+func (sh *strictHandler) PostAuthRefresh(ctx echo.Context, params PostAuthRefreshParams) error {
+	var request PostAuthRefreshRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthRefresh(ctx.Request().Context(), request.(PostAuthRefreshRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthRefresh")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthRefreshResponseObject); ok {
+		return validResponse.VisitPostAuthRefreshResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAuthSessions operation middleware
+func (sh *strictHandler) GetAuthSessions(ctx echo.Context) error {
+	var request GetAuthSessionsRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuthSessions(ctx.Request().Context(), request.(GetAuthSessionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuthSessions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetAuthSessionsResponseObject); ok {
+		return validResponse.VisitGetAuthSessionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthSessionsRevokeOthers operation middleware
+func (sh *strictHandler) PostAuthSessionsRevokeOthers(ctx echo.Context) error {
+	var request PostAuthSessionsRevokeOthersRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthSessionsRevokeOthers(ctx.Request().Context(), request.(PostAuthSessionsRevokeOthersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthSessionsRevokeOthers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthSessionsRevokeOthersResponseObject); ok {
+		return validResponse.VisitPostAuthSessionsRevokeOthersResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteAuthSession operation middleware
+func (sh *strictHandler) DeleteAuthSession(ctx echo.Context, id string) error {
+	var request DeleteAuthSessionRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAuthSession(ctx.Request().Context(), request.(DeleteAuthSessionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAuthSession")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteAuthSessionResponseObject); ok {
+		return validResponse.VisitDeleteAuthSessionResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthSignIn operation middleware
+func (sh *strictHandler) PostAuthSignIn(ctx echo.Context) error {
+	var request PostAuthSignInRequestObject
+
+	var body PostAuthSignInJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthSignIn(ctx.Request().Context(), request.(PostAuthSignInRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthSignIn")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthSignInResponseObject); ok {
+		return validResponse.VisitPostAuthSignInResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthSignInTwoFactor operation middleware
+func (sh *strictHandler) PostAuthSignInTwoFactor(ctx echo.Context) error {
+	var request PostAuthSignInTwoFactorRequestObject
+
+	var body PostAuthSignInTwoFactorJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthSignInTwoFactor(ctx.Request().Context(), request.(PostAuthSignInTwoFactorRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthSignInTwoFactor")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthSignInTwoFactorResponseObject); ok {
+		return validResponse.VisitPostAuthSignInTwoFactorResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthSignOut operation middleware
+func (sh *strictHandler) PostAuthSignOut(ctx echo.Context, params PostAuthSignOutParams) error {
+	var request PostAuthSignOutRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthSignOut(ctx.Request().Context(), request.(PostAuthSignOutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthSignOut")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthSignOutResponseObject); ok {
+		return validResponse.VisitPostAuthSignOutResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthSignUp operation middleware
+func (sh *strictHandler) PostAuthSignUp(ctx echo.Context) error {
+	var request PostAuthSignUpRequestObject
+
+	if reader, err := ctx.Request().MultipartReader(); err != nil {
+		return err
+	} else {
+		request.Body = reader
+	}
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthSignUp(ctx.Request().Context(), request.(PostAuthSignUpRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthSignUp")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthSignUpResponseObject); ok {
+		return validResponse.VisitPostAuthSignUpResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthTwoFactorConfirm operation middleware
+func (sh *strictHandler) PostAuthTwoFactorConfirm(ctx echo.Context) error {
+	var request PostAuthTwoFactorConfirmRequestObject
+
+	var body PostAuthTwoFactorConfirmJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthTwoFactorConfirm(ctx.Request().Context(), request.(PostAuthTwoFactorConfirmRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthTwoFactorConfirm")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthTwoFactorConfirmResponseObject); ok {
+		return validResponse.VisitPostAuthTwoFactorConfirmResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthTwoFactorDisable operation middleware
+func (sh *strictHandler) PostAuthTwoFactorDisable(ctx echo.Context) error {
+	var request PostAuthTwoFactorDisableRequestObject
+
+	var body PostAuthTwoFactorDisableJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthTwoFactorDisable(ctx.Request().Context(), request.(PostAuthTwoFactorDisableRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthTwoFactorDisable")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthTwoFactorDisableResponseObject); ok {
+		return validResponse.VisitPostAuthTwoFactorDisableResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthTwoFactorRecoveryCodes operation middleware
+func (sh *strictHandler) PostAuthTwoFactorRecoveryCodes(ctx echo.Context) error {
+	var request PostAuthTwoFactorRecoveryCodesRequestObject
+
+	var body PostAuthTwoFactorRecoveryCodesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthTwoFactorRecoveryCodes(ctx.Request().Context(), request.(PostAuthTwoFactorRecoveryCodesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthTwoFactorRecoveryCodes")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthTwoFactorRecoveryCodesResponseObject); ok {
+		return validResponse.VisitPostAuthTwoFactorRecoveryCodesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthTwoFactorSetup operation middleware
+func (sh *strictHandler) PostAuthTwoFactorSetup(ctx echo.Context) error {
+	var request PostAuthTwoFactorSetupRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthTwoFactorSetup(ctx.Request().Context(), request.(PostAuthTwoFactorSetupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthTwoFactorSetup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthTwoFactorSetupResponseObject); ok {
+		return validResponse.VisitPostAuthTwoFactorSetupResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthUnlock operation middleware
+func (sh *strictHandler) PostAuthUnlock(ctx echo.Context) error {
+	var request PostAuthUnlockRequestObject
+
+	var body PostAuthUnlockJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthUnlock(ctx.Request().Context(), request.(PostAuthUnlockRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthUnlock")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthUnlockResponseObject); ok {
+		return validResponse.VisitPostAuthUnlockResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthValidateSignUp operation middleware
+func (sh *strictHandler) PostAuthValidateSignUp(ctx echo.Context) error {
+	var request PostAuthValidateSignUpRequestObject
+
+	if reader, err := ctx.Request().MultipartReader(); err != nil {
+		return err
+	} else {
+		request.Body = reader
+	}
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthValidateSignUp(ctx.Request().Context(), request.(PostAuthValidateSignUpRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthValidateSignUp")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthValidateSignUpResponseObject); ok {
+		return validResponse.VisitPostAuthValidateSignUpResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetExports operation middleware
+func (sh *strictHandler) GetExports(ctx echo.Context, params GetExportsParams) error {
+	var request GetExportsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetExports(ctx.Request().Context(), request.(GetExportsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetExports")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetExportsResponseObject); ok {
+		return validResponse.VisitGetExportsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostImports operation middleware
+func (sh *strictHandler) PostImports(ctx echo.Context) error {
+	var request PostImportsRequestObject
+
+	if reader, err := ctx.Request().MultipartReader(); err != nil {
+		return err
+	} else {
+		request.Body = reader
+	}
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostImports(ctx.Request().Context(), request.(PostImportsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostImports")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostImportsResponseObject); ok {
+		return validResponse.VisitPostImportsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetImport operation middleware
+func (sh *strictHandler) GetImport(ctx echo.Context, id string) error {
+	var request GetImportRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetImport(ctx.Request().Context(), request.(GetImportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetImport")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetImportResponseObject); ok {
+		return validResponse.VisitGetImportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetSavedFilters operation middleware
+func (sh *strictHandler) GetSavedFilters(ctx echo.Context) error {
+	var request GetSavedFiltersRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSavedFilters(ctx.Request().Context(), request.(GetSavedFiltersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSavedFilters")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetSavedFiltersResponseObject); ok {
+		return validResponse.VisitGetSavedFiltersResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostSavedFilters operation middleware
+func (sh *strictHandler) PostSavedFilters(ctx echo.Context) error {
+	var request PostSavedFiltersRequestObject
+
+	var body PostSavedFiltersJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostSavedFilters(ctx.Request().Context(), request.(PostSavedFiltersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostSavedFilters")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostSavedFiltersResponseObject); ok {
+		return validResponse.VisitPostSavedFiltersResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteSavedFilter operation middleware
+func (sh *strictHandler) DeleteSavedFilter(ctx echo.Context, id string) error {
+	var request DeleteSavedFilterRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteSavedFilter(ctx.Request().Context(), request.(DeleteSavedFilterRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteSavedFilter")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteSavedFilterResponseObject); ok {
+		return validResponse.VisitDeleteSavedFilterResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutSavedFilter operation middleware
+func (sh *strictHandler) PutSavedFilter(ctx echo.Context, id string) error {
+	var request PutSavedFilterRequestObject
+
+	request.Id = id
+
+	var body PutSavedFilterJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutSavedFilter(ctx.Request().Context(), request.(PutSavedFilterRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutSavedFilter")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutSavedFilterResponseObject); ok {
+		return validResponse.VisitPutSavedFilterResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetShareLinks operation middleware
+func (sh *strictHandler) GetShareLinks(ctx echo.Context) error {
+	var request GetShareLinksRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetShareLinks(ctx.Request().Context(), request.(GetShareLinksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetShareLinks")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetShareLinksResponseObject); ok {
+		return validResponse.VisitGetShareLinksResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteShareLink operation middleware
+func (sh *strictHandler) DeleteShareLink(ctx echo.Context, id string) error {
+	var request DeleteShareLinkRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteShareLink(ctx.Request().Context(), request.(DeleteShareLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteShareLink")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteShareLinkResponseObject); ok {
+		return validResponse.VisitDeleteShareLinkResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetSharedTodo operation middleware
+func (sh *strictHandler) GetSharedTodo(ctx echo.Context, token string, params GetSharedTodoParams) error {
+	var request GetSharedTodoRequestObject
+
+	request.Token = token
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSharedTodo(ctx.Request().Context(), request.(GetSharedTodoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSharedTodo")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetSharedTodoResponseObject); ok {
+		return validResponse.VisitGetSharedTodoResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTimeEntries operation middleware
+func (sh *strictHandler) GetTimeEntries(ctx echo.Context, params GetTimeEntriesParams) error {
+	var request GetTimeEntriesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTimeEntries(ctx.Request().Context(), request.(GetTimeEntriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTimeEntries")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTimeEntriesResponseObject); ok {
+		return validResponse.VisitGetTimeEntriesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTimeEntries operation middleware
+func (sh *strictHandler) PostTimeEntries(ctx echo.Context) error {
+	var request PostTimeEntriesRequestObject
+
+	var body PostTimeEntriesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTimeEntries(ctx.Request().Context(), request.(PostTimeEntriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTimeEntries")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTimeEntriesResponseObject); ok {
+		return validResponse.VisitPostTimeEntriesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteTimeEntry operation middleware
+func (sh *strictHandler) DeleteTimeEntry(ctx echo.Context, id string) error {
+	var request DeleteTimeEntryRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTimeEntry(ctx.Request().Context(), request.(DeleteTimeEntryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTimeEntry")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteTimeEntryResponseObject); ok {
+		return validResponse.VisitDeleteTimeEntryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTimeReports operation middleware
+func (sh *strictHandler) GetTimeReports(ctx echo.Context, params GetTimeReportsParams) error {
+	var request GetTimeReportsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTimeReports(ctx.Request().Context(), request.(GetTimeReportsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTimeReports")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTimeReportsResponseObject); ok {
+		return validResponse.VisitGetTimeReportsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTimeReportsCsv operation middleware
+func (sh *strictHandler) GetTimeReportsCsv(ctx echo.Context, params GetTimeReportsCsvParams) error {
+	var request GetTimeReportsCsvRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTimeReportsCsv(ctx.Request().Context(), request.(GetTimeReportsCsvRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTimeReportsCsv")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTimeReportsCsvResponseObject); ok {
+		return validResponse.VisitGetTimeReportsCsvResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTodoTemplates operation middleware
+func (sh *strictHandler) GetTodoTemplates(ctx echo.Context) error {
+	var request GetTodoTemplatesRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTodoTemplates(ctx.Request().Context(), request.(GetTodoTemplatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTodoTemplates")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTodoTemplatesResponseObject); ok {
+		return validResponse.VisitGetTodoTemplatesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTodoTemplates operation middleware
+func (sh *strictHandler) PostTodoTemplates(ctx echo.Context) error {
+	var request PostTodoTemplatesRequestObject
+
+	var body PostTodoTemplatesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTodoTemplates(ctx.Request().Context(), request.(PostTodoTemplatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTodoTemplates")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTodoTemplatesResponseObject); ok {
+		return validResponse.VisitPostTodoTemplatesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteTodoTemplate operation middleware
+func (sh *strictHandler) DeleteTodoTemplate(ctx echo.Context, id string) error {
+	var request DeleteTodoTemplateRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTodoTemplate(ctx.Request().Context(), request.(DeleteTodoTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTodoTemplate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteTodoTemplateResponseObject); ok {
+		return validResponse.VisitDeleteTodoTemplateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutTodoTemplate operation middleware
+func (sh *strictHandler) PutTodoTemplate(ctx echo.Context, id string) error {
+	var request PutTodoTemplateRequestObject
+
+	request.Id = id
+
+	var body PutTodoTemplateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutTodoTemplate(ctx.Request().Context(), request.(PutTodoTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutTodoTemplate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutTodoTemplateResponseObject); ok {
+		return validResponse.VisitPutTodoTemplateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTodoTemplateInstantiate operation middleware
+func (sh *strictHandler) PostTodoTemplateInstantiate(ctx echo.Context, id string) error {
+	var request PostTodoTemplateInstantiateRequestObject
+
+	request.Id = id
+
+	var body PostTodoTemplateInstantiateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTodoTemplateInstantiate(ctx.Request().Context(), request.(PostTodoTemplateInstantiateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTodoTemplateInstantiate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTodoTemplateInstantiateResponseObject); ok {
+		return validResponse.VisitPostTodoTemplateInstantiateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTodos operation middleware
+func (sh *strictHandler) GetTodos(ctx echo.Context, params GetTodosParams) error {
+	var request GetTodosRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTodos(ctx.Request().Context(), request.(GetTodosRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTodos")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTodosResponseObject); ok {
+		return validResponse.VisitGetTodosResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTodos operation middleware
+func (sh *strictHandler) PostTodos(ctx echo.Context) error {
+	var request PostTodosRequestObject
+
+	var body PostTodosJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTodos(ctx.Request().Context(), request.(PostTodosRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTodos")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTodosResponseObject); ok {
+		return validResponse.VisitPostTodosResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteTodo operation middleware
+func (sh *strictHandler) DeleteTodo(ctx echo.Context, id string) error {
+	var request DeleteTodoRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTodo(ctx.Request().Context(), request.(DeleteTodoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTodo")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteTodoResponseObject); ok {
+		return validResponse.VisitDeleteTodoResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTodo operation middleware
+func (sh *strictHandler) GetTodo(ctx echo.Context, id string, params GetTodoParams) error {
+	var request GetTodoRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTodo(ctx.Request().Context(), request.(GetTodoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTodo")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTodoResponseObject); ok {
+		return validResponse.VisitGetTodoResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PatchTodo operation middleware
+func (sh *strictHandler) PatchTodo(ctx echo.Context, id string) error {
+	var request PatchTodoRequestObject
+
+	request.Id = id
+
+	var body PatchTodoJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchTodo(ctx.Request().Context(), request.(PatchTodoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchTodo")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchTodoResponseObject); ok {
+		return validResponse.VisitPatchTodoResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTodoAttachments operation middleware
+func (sh *strictHandler) GetTodoAttachments(ctx echo.Context, id string) error {
+	var request GetTodoAttachmentsRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTodoAttachments(ctx.Request().Context(), request.(GetTodoAttachmentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTodoAttachments")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTodoAttachmentsResponseObject); ok {
+		return validResponse.VisitGetTodoAttachmentsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTodoAttachments operation middleware
+func (sh *strictHandler) PostTodoAttachments(ctx echo.Context, id string) error {
+	var request PostTodoAttachmentsRequestObject
+
+	request.Id = id
+
+	if reader, err := ctx.Request().MultipartReader(); err != nil {
+		return err
+	} else {
+		request.Body = reader
+	}
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTodoAttachments(ctx.Request().Context(), request.(PostTodoAttachmentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTodoAttachments")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTodoAttachmentsResponseObject); ok {
+		return validResponse.VisitPostTodoAttachmentsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteTodoAttachment operation middleware
+func (sh *strictHandler) DeleteTodoAttachment(ctx echo.Context, id string, attachmentId string) error {
+	var request DeleteTodoAttachmentRequestObject
+
+	request.Id = id
+	request.AttachmentId = attachmentId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTodoAttachment(ctx.Request().Context(), request.(DeleteTodoAttachmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTodoAttachment")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteTodoAttachmentResponseObject); ok {
+		return validResponse.VisitDeleteTodoAttachmentResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTodoAttachment operation middleware
+func (sh *strictHandler) GetTodoAttachment(ctx echo.Context, id string, attachmentId string) error {
+	var request GetTodoAttachmentRequestObject
+
+	request.Id = id
+	request.AttachmentId = attachmentId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTodoAttachment(ctx.Request().Context(), request.(GetTodoAttachmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTodoAttachment")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTodoAttachmentResponseObject); ok {
+		return validResponse.VisitGetTodoAttachmentResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTodoComments operation middleware
+func (sh *strictHandler) PostTodoComments(ctx echo.Context, id string) error {
+	var request PostTodoCommentsRequestObject
 
-func (response GetTodo404JSONResponse) VisitGetTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PostTodoCommentsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type GetTodo500JSONResponse struct {
-	InternalServerErrorResponseJSONResponse
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTodoComments(ctx.Request().Context(), request.(PostTodoCommentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTodoComments")
+	}
 
-func (response GetTodo500JSONResponse) VisitGetTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTodoCommentsResponseObject); ok {
+		return validResponse.VisitPostTodoCommentsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PatchTodoRequestObject struct {
-	Id   string `json:"id"`
-	Body *PatchTodoJSONRequestBody
-}
+// DeleteTodoComment operation middleware
+func (sh *strictHandler) DeleteTodoComment(ctx echo.Context, id string, commentId string) error {
+	var request DeleteTodoCommentRequestObject
 
-type PatchTodoResponseObject interface {
-	VisitPatchTodoResponse(w http.ResponseWriter) error
-}
+	request.Id = id
+	request.CommentId = commentId
 
-type PatchTodo200JSONResponse struct{ StoreTodoResponseJSONResponse }
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTodoComment(ctx.Request().Context(), request.(DeleteTodoCommentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTodoComment")
+	}
 
-func (response PatchTodo200JSONResponse) VisitPatchTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteTodoCommentResponseObject); ok {
+		return validResponse.VisitDeleteTodoCommentResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PatchTodo400JSONResponse struct {
-	Code   int64                    `json:"code"`
-	Errors StoreTodoValidationError `json:"errors"`
-}
+// PatchTodoComment operation middleware
+func (sh *strictHandler) PatchTodoComment(ctx echo.Context, id string, commentId string) error {
+	var request PatchTodoCommentRequestObject
 
-func (response PatchTodo400JSONResponse) VisitPatchTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Id = id
+	request.CommentId = commentId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PatchTodoCommentJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type PatchTodo401JSONResponse struct {
-	UnauthorizedErrorResponseJSONResponse
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchTodoComment(ctx.Request().Context(), request.(PatchTodoCommentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchTodoComment")
+	}
 
-func (response PatchTodo401JSONResponse) VisitPatchTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchTodoCommentResponseObject); ok {
+		return validResponse.VisitPatchTodoCommentResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PatchTodo404JSONResponse struct {
-	NotFoundErrorResponseJSONResponse
-}
+// PostTodoShareLinks operation middleware
+func (sh *strictHandler) PostTodoShareLinks(ctx echo.Context, id string) error {
+	var request PostTodoShareLinksRequestObject
 
-func (response PatchTodo404JSONResponse) VisitPatchTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PostTodoShareLinksJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type PatchTodo500JSONResponse struct {
-	InternalServerErrorResponseJSONResponse
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTodoShareLinks(ctx.Request().Context(), request.(PostTodoShareLinksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTodoShareLinks")
+	}
 
-func (response PatchTodo500JSONResponse) VisitPatchTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTodoShareLinksResponseObject); ok {
+		return validResponse.VisitPostTodoShareLinksResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Get Csrf
-	// (GET /auth/csrf)
-	GetAuthCsrf(ctx context.Context, request GetAuthCsrfRequestObject) (GetAuthCsrfResponseObject, error)
-	// Sign In
-	// (POST /auth/signIn)
-	PostAuthSignIn(ctx context.Context, request PostAuthSignInRequestObject) (PostAuthSignInResponseObject, error)
-	// SignUp
-	// (POST /auth/signUp)
-	PostAuthSignUp(ctx context.Context, request PostAuthSignUpRequestObject) (PostAuthSignUpResponseObject, error)
-	// Validate SignUp
-	// (POST /auth/validateSignUp)
-	PostAuthValidateSignUp(ctx context.Context, request PostAuthValidateSignUpRequestObject) (PostAuthValidateSignUpResponseObject, error)
-	// Fetch Todos
-	// (GET /todos)
-	GetTodos(ctx context.Context, request GetTodosRequestObject) (GetTodosResponseObject, error)
-	// Create Todo
-	// (POST /todos)
-	PostTodos(ctx context.Context, request PostTodosRequestObject) (PostTodosResponseObject, error)
-	// Delete Todo
-	// (DELETE /todos/{id})
-	DeleteTodo(ctx context.Context, request DeleteTodoRequestObject) (DeleteTodoResponseObject, error)
-	// Show Todo
-	// (GET /todos/{id})
-	GetTodo(ctx context.Context, request GetTodoRequestObject) (GetTodoResponseObject, error)
-	// Update Todo
-	// (PATCH /todos/{id})
-	PatchTodo(ctx context.Context, request PatchTodoRequestObject) (PatchTodoResponseObject, error)
-}
+// PostTodoTimerStart operation middleware
+func (sh *strictHandler) PostTodoTimerStart(ctx echo.Context, id string) error {
+	var request PostTodoTimerStartRequestObject
 
-type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
-type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+	request.Id = id
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTodoTimerStart(ctx.Request().Context(), request.(PostTodoTimerStartRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTodoTimerStart")
+	}
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTodoTimerStartResponseObject); ok {
+		return validResponse.VisitPostTodoTimerStartResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// GetAuthCsrf operation middleware
-func (sh *strictHandler) GetAuthCsrf(ctx echo.Context) error {
-	var request GetAuthCsrfRequestObject
+// PostTodoTimerStop operation middleware
+func (sh *strictHandler) PostTodoTimerStop(ctx echo.Context, id string) error {
+	var request PostTodoTimerStopRequestObject
+
+	request.Id = id
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAuthCsrf(ctx.Request().Context(), request.(GetAuthCsrfRequestObject))
+		return sh.ssi.PostTodoTimerStop(ctx.Request().Context(), request.(PostTodoTimerStopRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAuthCsrf")
+		handler = middleware(handler, "PostTodoTimerStop")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetAuthCsrfResponseObject); ok {
-		return validResponse.VisitGetAuthCsrfResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostTodoTimerStopResponseObject); ok {
+		return validResponse.VisitPostTodoTimerStopResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostAuthSignIn operation middleware
-func (sh *strictHandler) PostAuthSignIn(ctx echo.Context) error {
-	var request PostAuthSignInRequestObject
-
-	var body PostAuthSignInJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+// GetUsersMe operation middleware
+func (sh *strictHandler) GetUsersMe(ctx echo.Context) error {
+	var request GetUsersMeRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostAuthSignIn(ctx.Request().Context(), request.(PostAuthSignInRequestObject))
+		return sh.ssi.GetUsersMe(ctx.Request().Context(), request.(GetUsersMeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostAuthSignIn")
+		handler = middleware(handler, "GetUsersMe")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostAuthSignInResponseObject); ok {
-		return validResponse.VisitPostAuthSignInResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetUsersMeResponseObject); ok {
+		return validResponse.VisitGetUsersMeResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostAuthSignUp operation middleware
-func (sh *strictHandler) PostAuthSignUp(ctx echo.Context) error {
-	var request PostAuthSignUpRequestObject
+// PatchUsersMe operation middleware
+func (sh *strictHandler) PatchUsersMe(ctx echo.Context) error {
+	var request PatchUsersMeRequestObject
 
-	if reader, err := ctx.Request().MultipartReader(); err != nil {
+	var body PatchUsersMeJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
 		return err
-	} else {
-		request.Body = reader
 	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostAuthSignUp(ctx.Request().Context(), request.(PostAuthSignUpRequestObject))
+		return sh.ssi.PatchUsersMe(ctx.Request().Context(), request.(PatchUsersMeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostAuthSignUp")
+		handler = middleware(handler, "PatchUsersMe")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostAuthSignUpResponseObject); ok {
-		return validResponse.VisitPostAuthSignUpResponse(ctx.Response())
+	} else if validResponse, ok := response.(PatchUsersMeResponseObject); ok {
+		return validResponse.VisitPatchUsersMeResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostAuthValidateSignUp operation middleware
-func (sh *strictHandler) PostAuthValidateSignUp(ctx echo.Context) error {
-	var request PostAuthValidateSignUpRequestObject
+// PostUsersMeEmail operation middleware
+func (sh *strictHandler) PostUsersMeEmail(ctx echo.Context) error {
+	var request PostUsersMeEmailRequestObject
 
-	if reader, err := ctx.Request().MultipartReader(); err != nil {
+	var body PostUsersMeEmailJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
 		return err
-	} else {
-		request.Body = reader
 	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostAuthValidateSignUp(ctx.Request().Context(), request.(PostAuthValidateSignUpRequestObject))
+		return sh.ssi.PostUsersMeEmail(ctx.Request().Context(), request.(PostUsersMeEmailRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostAuthValidateSignUp")
+		handler = middleware(handler, "PostUsersMeEmail")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostAuthValidateSignUpResponseObject); ok {
-		return validResponse.VisitPostAuthValidateSignUpResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostUsersMeEmailResponseObject); ok {
+		return validResponse.VisitPostUsersMeEmailResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetTodos operation middleware
-func (sh *strictHandler) GetTodos(ctx echo.Context) error {
-	var request GetTodosRequestObject
+// GetWebhooks operation middleware
+func (sh *strictHandler) GetWebhooks(ctx echo.Context) error {
+	var request GetWebhooksRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTodos(ctx.Request().Context(), request.(GetTodosRequestObject))
+		return sh.ssi.GetWebhooks(ctx.Request().Context(), request.(GetWebhooksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTodos")
+		handler = middleware(handler, "GetWebhooks")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetTodosResponseObject); ok {
-		return validResponse.VisitGetTodosResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetWebhooksResponseObject); ok {
+		return validResponse.VisitGetWebhooksResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostTodos operation middleware
-func (sh *strictHandler) PostTodos(ctx echo.Context) error {
-	var request PostTodosRequestObject
+// PostWebhooks operation middleware
+func (sh *strictHandler) PostWebhooks(ctx echo.Context) error {
+	var request PostWebhooksRequestObject
 
-	var body PostTodosJSONRequestBody
+	var body PostWebhooksJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostTodos(ctx.Request().Context(), request.(PostTodosRequestObject))
+		return sh.ssi.PostWebhooks(ctx.Request().Context(), request.(PostWebhooksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostTodos")
+		handler = middleware(handler, "PostWebhooks")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostTodosResponseObject); ok {
-		return validResponse.VisitPostTodosResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostWebhooksResponseObject); ok {
+		return validResponse.VisitPostWebhooksResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteTodo operation middleware
-func (sh *strictHandler) DeleteTodo(ctx echo.Context, id string) error {
-	var request DeleteTodoRequestObject
+// DeleteWebhook operation middleware
+func (sh *strictHandler) DeleteWebhook(ctx echo.Context, id string) error {
+	var request DeleteWebhookRequestObject
 
 	request.Id = id
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteTodo(ctx.Request().Context(), request.(DeleteTodoRequestObject))
+		return sh.ssi.DeleteWebhook(ctx.Request().Context(), request.(DeleteWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteTodo")
+		handler = middleware(handler, "DeleteWebhook")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteTodoResponseObject); ok {
-		return validResponse.VisitDeleteTodoResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteWebhookResponseObject); ok {
+		return validResponse.VisitDeleteWebhookResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetTodo operation middleware
-func (sh *strictHandler) GetTodo(ctx echo.Context, id string) error {
-	var request GetTodoRequestObject
+// GetWebhookDeliveries operation middleware
+func (sh *strictHandler) GetWebhookDeliveries(ctx echo.Context, id string, params GetWebhookDeliveriesParams) error {
+	var request GetWebhookDeliveriesRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTodo(ctx.Request().Context(), request.(GetTodoRequestObject))
+		return sh.ssi.GetWebhookDeliveries(ctx.Request().Context(), request.(GetWebhookDeliveriesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTodo")
+		handler = middleware(handler, "GetWebhookDeliveries")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetTodoResponseObject); ok {
-		return validResponse.VisitGetTodoResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetWebhookDeliveriesResponseObject); ok {
+		return validResponse.VisitGetWebhookDeliveriesResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchTodo operation middleware
-func (sh *strictHandler) PatchTodo(ctx echo.Context, id string) error {
-	var request PatchTodoRequestObject
+// PostWebhookDeliveryRedeliver operation middleware
+func (sh *strictHandler) PostWebhookDeliveryRedeliver(ctx echo.Context, id string, deliveryId string) error {
+	var request PostWebhookDeliveryRedeliverRequestObject
 
 	request.Id = id
-
-	var body PatchTodoJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.DeliveryId = deliveryId
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchTodo(ctx.Request().Context(), request.(PatchTodoRequestObject))
+		return sh.ssi.PostWebhookDeliveryRedeliver(ctx.Request().Context(), request.(PostWebhookDeliveryRedeliverRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchTodo")
+		handler = middleware(handler, "PostWebhookDeliveryRedeliver")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchTodoResponseObject); ok {
-		return validResponse.VisitPatchTodoResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostWebhookDeliveryRedeliverResponseObject); ok {
+		return validResponse.VisitPostWebhookDeliveryRedeliverResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
@@ -1108,28 +9204,156 @@ func (sh *strictHandler) PatchTodo(ctx echo.Context, id string) error {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xYUY/bNgz+K4a2R7dOt9tQ+K3t1iIY0BZN05dDMOhsJlHPkVyJbpEd/N8HSo4tn5XE",
-	"Se8Oa9c3xxFpft9HUSJvWKY2pZIg0bD0hmn4VIHB5yoXYF/MxEpO5VSWFdLPTEkEaR95WRYi4yiUTD4a",
-	"Jemdydaw4fRUalWCxsYLbLgo6AG3JbCUGdRCrlgds5Ib80XpPPBnHdtwhIacpZeND89iEe8s1NVHyJDV",
-	"ZJKDybQoKSyWNuFHkQNQx/bFvAzh2VQFipJrTJZKbx7lHPkhSFc8u57mIFEsGxboLZlyZCm7EpLrLYuH",
-	"iK+ExnXOt73lOUcILd5P3FJog6/5BsL/aiXxrPAKfsDteLW68DyX8fkizssomlY7EVFpeK9y9bV56ZkN",
-	"sKLAAo4Ddcvi1tUYQBT6Do11Z0oljQvphdHLd82Lr0Fm9PJvVNcgj0Pw1o6JniKMdjETU39AAWj1uIvA",
-	"VQ69VBUSf7/oMlVIhBVo5nirCl+8K6UK4HIIkHy268dgJPcvAbM1oTJ3AAvJDz0IhI19+FnDkqXsp6Sr",
-	"wImzNgl91eagi5NrzbfDzLMux6CxSCILJXrnCTeVCFryYgb6M+g/tVb6YRXcgDF8NWKXNQru1o8BvQMX",
-	"OXSRhdeD/1rhS1XJ/DsD/lphZHEFIM/W6ssdbVRKv3F5HMjbcXV/rb7YrO1DsEf6c56/c3eVO4ACxFN/",
-	"dw6Pg0NbsXFwwo2kiz8A7s31WaDGfrt1HrM18Bwc9BngoxdKXQsIem3zs25vUQ+7ZTqRDmWci+wDL0Ru",
-	"I7BbYN+uOkG23rXjPwl8F9z9YJ9LXuFaafEPfG/10oc2KJl13ITfdkPD7BrZG4ytLf0WYbxV2yuMN+k1",
-	"ESeYhbuL8Q78JmO8ld99nFCom4v8HvEGCeLt86NCe4k/HkbbWJyDYV9oARjvmwN6fMsj/MbO230jWyGR",
-	"szjYDzXB26P8jYtvuC9jZiCrtMDtjDbcLlo6kJ5VuKZft04zMEYoGdl/YybonVvPYiZtdjHX1XSEluIv",
-	"2LoiIORSDZ0ilwZ5dh19qkBvo1LzDEUG0bO3U8NiZqrNhhrnlLEOl7uIx+wzaOO8PHk8Id5UCZKXgqXs",
-	"18f0ilIY1xZYQjUnoc6Lfq3ACkI6WU2nOUvZK0CCRu0Wu9Uk/jKZ7DsP2nVJr5OsY/bbGKNDLYGvEUsv",
-	"Fz4drwCjJlLkK0PpQAjZgowcWGNvHzYjlQngfauMBexuKSz2hlHb/YF786rEH1bV51A2uH7VMbsYbxi4",
-	"lN437/ThyLJ1iPZ5OY72eXku7buZ2tm0e9fJU0jvm9071ZagfUx/djUZZgPG+zVmty4iaaKKXIYl+dB3",
-	"+EOafdLsiIoOadSOYJpqu39GMnMX1nhYkN83pf50IgPTJEvmk+Om++/e98Br/8y9XNQ9oj2WPJKbSRRd",
-	"0YIJ/0IDiWPP/z3cUsp35J6a5f158HmJPmjuRud62PLbEtaTKCBsu32SG5HXTuACEIZSu1nwQam7cfFZ",
-	"Gykwbb4Tvi8mF8c9hEeGD66Wx3JwGwYrXDdOO1zfzlJlMFj832nS0hsujFzzDaCdtV3euGaFmoGuVbHd",
-	"U9dOoa4gPjCGW9hmIgv0RfMyP1pteXMY/ai232CmeQKHarV1RZ9wqVbpgqVsjVimSVKojBdrZTB9Onk6",
-	"YeS3sb+dRcRVBDIvlZDYZam9T9XxoG2296bhchdUvaj/DQAA//9b7FpvaSAAAA==",
+	"H4sIAAAAAAAC/+x9a3PbuLLgX0Fpt2qTWsXymTN7Hzl1P3icZMp7J5OsH3O26mwqhkVIwgkFcADQim7K",
+	"/30LLxIkARJ8yLEdf7NFoIF+oNFoNLq/zZZ0m1GCiOCz199mDP2ZIy5+oQlG6ofTDSRr9HYLcXpGslzI",
+	"35aUCETUnzDLUryEAlOy+CenRP7Glxu0hfKvjNEMMWFAIQlE/iH2GZq9nnHBMFnP7uazDHK+oyyRHxPE",
+	"lwxnEuLs9WyZM4aIAEWLeb333VxNGjOUzF7/wwzigPxU9KA3/0RLMbuTXaqjaCSBwhJoNO/mszeYw5sU",
+	"Xe7oO7gUlI3Ff0kT1MTw8sPlRyA/AcoAQ0t6i9he/dDEtUqpdkI4FFPAYuhgMAaXO/pK41xS4x1layo+",
+	"GqiHkgUvN2OmrqcH7PzKeZ8RLiARGAp0SRN6ibZZCgUai8AtZFjSSv0DkwTLXjD9WGnUYF8djQjEnPkD",
+	"iQCwGJQoSqS/oP05WmMuEPsFrTG5b2E9AueGb2C3QQSIHX210iIEc7FBRJiRAeYAEUm65GgiCY+RD0Mj",
+	"YIkEFJWCNBxNPsTQlpL9Jf2CiFcUlgwlkiowDUuQYDlqIJLfpHj5n2h/WgAADImcEZSAmz0g8BavoaDs",
+	"qByBy7+hQC9eAkzA/7748DtYUbadNeg2nxG49fA9wTxLoQS+RYCugNggpZK/oP0ReINWME8FB4KqD0zR",
+	"kGluJ1Cgo06tXSVXhTiDmFtn6wVekzPy1Ji6RqKTo4chtKQnOHOWzzlaI4IYFOjcaIVTmiD+9LfMEnFg",
+	"MQcKdZc2HJFEGRd/IIZXBvkHt4XqeRoryJ1pFZXJbIAWxsxnIrDGatgJI8e99gKFhcdUmEZLxJm5B7Bi",
+	"9fRBFZ+rzIfPNk8FziATC6k1XiVQwDaUbuDyy5nSE1Yk5K+yKxSz17MbTCDb+9beDWZik8B9pbncEnyN",
+	"w4RbYcbF72Znan5llIhB00thC9h4bpXTc0DOhzPxKgPgLLdMFJShkyybatURP741lFSrmPmeZJlvJalJ",
+	"CwGXmy0iYgIRXOEURXG1wZs0EpFitjU0Tuk2hEMfut/QZN9Nd9Uq6shKt565nm0zyu6V3POiSecq0e3m",
+	"8SzR2NRQvIC3KHmH0wmMdBJa+n/miO2bdsdKjQrUV2l2SWOXS4uY74mAXwHk6qfrP69BBhncItmarsCv",
+	"by/BQtCE8k5xJVp16AlE6QtJDqDpUafUBjL0GyZfRu9oXzPMED8RDTX+SuDtAKOrGys5dSDnXsPpEm/R",
+	"WyLYfjTvqfDzngvIBEr6IMsFzbJ+XaQ0nLnUwUSgNWIeA0c1dOflDhgjIu8hyWEKJOmAol2dpnKI0YZ6",
+	"0a2JKxZpxJajm80LUDGoKYdIdauc0smDBdpW//jvDK1mr2f/bVG6TRe6N19URhZo6zh8IGNw7x6vo7SA",
+	"HjWaDk3HkCLI39HNhtLxSuAWEXG5z2qUCbm4Cow5WjLkF4ycRZxfZKMCytydRgxhDPIlSQpvrjykTXU8",
+	"bccg+izpuF3l7DyTnsiPMcGpWtgpXUYe1Krt54Oo0nA7XJGULr+cLJc0H2+h9Tpyxsxczw6Y6TmzzuTe",
+	"8H60+PU6XbWfoVpOQjH7tcYIvHdkVjkQ9pNcHE3OGD236n2PAsYzSrge9BeYnOvLsLeMUXZuvk2w7ApW",
+	"YSL+5efZvGECzGdbxDlcx+qWsn0M8r/ABBjMgEINFLjdzd37vvtFGcmpdO6xzvT+gClO1DQUFmpD4Yh1",
+	"QbjiHivLkNHMofeVYYWAytPuHNgnICMsoXWh5wwsZzM5A+ruCA8X4h0n/cleUrg43TxEMa2evzw04vZb",
+	"J6SiYbxTdCBZOVtNQUvOVp9jJ1q2jVpznK0Ac9baG5SiqddaD1lgiOepa9reUJoiSEIMMe1jGWKwK/xS",
+	"TxA548V6gpgV98lPBDONFShvWuur0PHLPUF2Fn6np4gbTegTRcu6RJ4gesaz8aQwe/s1o0zItXaO5F8B",
+	"5AT6KhZLfltFqvtmqDGmHk97RvWIpWKbzzYIJkjbeqd68FdvMM8ox/aKscRYB3WUk2kMXWJHE8oHcS3m",
+	"6msoaUzPLWRfErojk1FWIntImr5DYrk5WQp8i6U4T3HWKoBFO37N+Huvwxd9Fac545R1m8PO0FEhjxJ3",
+	"UCJf2ZQ1YUrLmE97DO1BnOqBtEqfOgHcAXqQwLmD9lGhsKAnIUIJLZ4GRZ9uEjjge1Cg7NXE//0U299g",
+	"14rqGI/Je9REwBidU3DPBC3Gs86M3cm3AnA8qhatJsKOLT0F0twBF424M4dO5CsDxBPAvbv2UQFxjimZ",
+	"hAIGVDz2ukM35hZwD6xNFw/C1tczCcoFsHikK76mVrRL4D0QLy71PbjbA9Y0m7goocVf35o+3m1cUAHT",
+	"C7SkJOEVkyhkKzcut8sJ1aDFE7C4wfdu9p1mcx8CJnDfj3J65DfQS70Vo9uoSypBI5uN44aajxqtBmuu",
+	"Ee/JkvrRoeCIcwKdRKpdeIPCEjoXdnWIHnRwAxC4nxRTkaAf6lEo90XVg6E5j79BKb5FE6mxpAAWjXJ1",
+	"GqOPJM4M4ilkgy5KWgTJNQWVdgZUXxp1SkYBuDfqNYQrT+ce4n1VdYaN+6rR90v113kudQIP9O6XTAfQ",
+	"K4ZK8epFE0MgRuSGxG4Re2KRBxY5oLHzRB/8TsU7mpPkiSH+OxVA4eVB2ZwCP6i2U6jDxiu16lzUdat8",
+	"qneDAEdEAPm4Q7/ZXGGC+QaTtQpjtnB8BhjVsx39yE1d5WNKDPYy8szTykSr2EajHrzZmfd57WYH9rDt",
+	"ISpzM7VAZIZ0ZsT6PEbrfd914TkyRsV0NxiJtXb6Gkd+a2cf+YrMNC/snSqWK4b45sMw9CIGV+ADrvUL",
+	"JF6dUvoFIy/Yigc98DrxIQp2YKrTmyvhl5BVFjuPIR8qvQ5o1NWeUVZJc0u/IOPpelJXlAaz7xN8dmDc",
+	"LjZ0N2m4UXmZ0e+GZMCDo6i3BM58dA93qKjXVhu6cy5bwBu6IymFCbg6/62yAGRD/XxtAjJiBaiLhHq4",
+	"Bs6mczR2GkwDmcmCRLb9rs5MhJjPj2Fh/d7mz9AHsLgDnccxFE835QGrUE291SjDyCegXqn2Y9/f1FDq",
+	"od3Na/Jy/h7kBlo3rXcQCvC5VlzhaY23eySgD7k4lIFmwE800avsQUY5q5lNb1h4XpB/x83oQCH0xRgH",
+	"pN93Ca81armHYu9BNtPrgDSbbNc+jNzo6XnO9qOMhBH0+m5xv31eYpRz9FDOiV7oFRQxHQ2/U3RxDwoW",
+	"M/TQz95t73vcsE9Iu3uPXu5DNprQA+qq73df0pMGdpI+6XE+97vKno6O3yWWugcJzfw81DNXlNE3npPQ",
+	"7Dupq+n0TAkpGmVK30OyN0ch/qDupySKgu1PVmYDq86e69AWeeOzg1iAG7SiDAHB9vKiB64hJrN57wia",
+	"AZdgl5QCSUP7+Jh7LsOKRAeVLIIPcV0WM/UsSuZOfsRpvf/qdHIl1LIRBqj8mAg7LXU85LhAIp/ioE1F",
+	"JnPeXjHcXI7mG7g6PzOXsAyRBDGUyPRREPyf82DKjTKTShXkDeTorz8BRGTHBKg8HrqtvCIFW53+Byl9",
+	"15V/yowxd3Hom6RDUrFC4CsiQVGG/wslD05zQjNQa2C7g8C57jGBQnShenRhJbfJQ1yplQlOv1pr2VMq",
+	"pDEJVB4kVczcvm96iloGlodIKGd60wtPJcNLKTp39j2Zml3xbsv3CIwyf362+Uzn0u6V7q3IWuVVQjgw",
+	"UDhJnDk2xSVWw8lsXmBUQHVBuBN08ftUJG8raAU+aJp7UoefVLOU1MSnP9FCZEkhF1e8H6y4vGuKUqpp",
+	"iAputtMWSlRczd5MeUFhGEAomVAzmNkpREWO/6tPcGRUskJcka9iWvMK0mboEIXLy9UwfVuyAIXTMcen",
+	"rHMT2PQw3S0KLbPz4VK6y6MSxs6nXEp5lvQFJTexeGEwrecaG3fu7uAO/21e2zDz22OVx/Pf4WT7UJ65",
+	"nRVO+NHaT21271tMV89lcLfn/5zuSs9b/ZVOkcgXkXyr9l1+O5vPlGWg1zXmiiUMpal7Nd4taPp2AiXn",
+	"dMf9LTJGl4jztib8C86y1gYCipy7GPyZoxwlswK8nKa6ospSJNSHFcQpSrzIqKdB4dF6Lx7fAjH9irm7",
+	"o9aJUiNjlSCFQEQsMhPjEV5jNWFpyLM55vRMBcroLkJvyFbzcoTmtM/pzhyXwgh8LCNdm6ns8+xtitf4",
+	"JvVkwdxtkNggpiOgy2IcS0hUwPSeLFEiD+5UtUrQLV4iJ61zEZA1NyNdCCjih8EcmIpX6d4M5oX9MK2p",
+	"+UwwSLhk0hi3l2uFOQDndd5VSRywJ4oY7i5R6dxIGnH18YJvz049elQKycT3s5y5N2MnQD0PnSOjdyfd",
+	"wCPHDMw23sgYQkQnnd9AzPpYJhfVS+6DHc66c+o3vkyyj1YS50dsgZW8+WHlYPMATEEyo9nbNwOTUEDu",
+	"BPJfShDYwi/2TY4pz+jdE4KmV3aSJAxxHkz8e4EQ6XsEOFn7072HDgG6vTubkiBVdjkzcvll6NLCKjel",
+	"5mhmDQhB3kD+sZn6NIJDA8/X7niB3a+gSRvd3IjLBulEeVdk51EXX8FyZIr+SQHGa/IKE7DNubxsBIWt",
+	"DaAAC+nxXnA14qIA7RXnZoLx6qh8Q5l4JR/gJEC/aZOXHXoJyRtPwAXKbK06MymvKilo5dIhQKdmtGNk",
+	"raT4TcFN6h3fa4CXo5IQvEc3f7WleABuqvF7M1P8zPNxuSO/cbCIzJBJdYwVnF4wdjRYx2fw7IJDhSYX",
+	"iNAMureGziwwTmha/rjJ0fRyvSZDMfFPLYRISyzjaNl0rLShyLRML4hRKE92eyGi+9MdrZMMYRWMmAyW",
+	"IopHqFKjqE83p05Rn2OKNU+GEi9IiyDxWmIGI6r0TH5MbiDUMr82nDpxcS5nezDI3gOOQScWjUAs4gHq",
+	"BcX3MY//hqIfQMlDgUs3BLGKb5LrisdOWqqqwYpSmHGUABuQxylYQQZ2G5wiZaTKcwWTBz+WE6Id1RGX",
+	"c8GD+KEKnFWxgjcqiUQcFtOURKuegkp05g0eGCo4ByKnCFr4RFTNZ9bktPHoRqQp65VUphjVn15mfNoz",
+	"O08XjJugxqWRngl4A+MIdWkeWdbT8PkDFLpCG8ahWYiGMMENjXR7HkTV880WTL34tVW9Cx72ewRt+Eri",
+	"2bl3z9eNqj+Yv+/eSuRN6yfUs43wE1Yr68URXGHWdJNIiACSBBh2gi3cq78hJiBL4RJtaJogxgHPlxsZ",
+	"Dvrtm5zu3Z3Mi/Ptm5zd//zXu7vZvM7OwxRf9FJA4tZGhlD0bjCe7N7M+ODUPGh4Aj4b/NzC5QYT9Ioh",
+	"mMCbFAEdSwp2m73aCCUERITNHGLumefF1bRyWn3eYnspbf6HqRRt1VJ6raDIGfqMya2cdNFKH4TK/wkV",
+	"n/dIfK62wpznKPmMyedVLsFIuZc/MQcezBOMyNIdgueKDo1BmUp9oVpoZ6zzC9P5aD6bgYue1d/LaVd/",
+	"Zyjnvp+pkAvSFUYPYzzKqjUyNVxeboBQtY7kE6xAdGhrbb+D+/SGuORcIgSQ8uHPERtZyHCIMz9YWR6V",
+	"V4Mo4LVvL5y4gfwXr8c3fMWjEq5xnCDrncaVzmADObhBiACe32yxEIEYgA3k7/y+2PDQynk7wdhtwQRB",
+	"UmWIJJis31pmVKdJ0A4obgCoL4jUcyV58SX9+ktKVliyGvtXfHFb8JZIXZxE5MjR4Tftpfur4uEZJsiG",
+	"kGQELmrkumjZVltipSdVZy3jeGb19/Lx4fj7toHugmBkY0w+IJyUeYDK8QMsMti2cKmeXs6XggJtM8Gn",
+	"iyvvDBKUaIUOWsOi0uUyOdGI9JuszDtsOvbpZgv+XRTBfTGBf0bZKHNmuUQo6Qr2My9p4z0QZYeSzNVA",
+	"+iKkr+B7nQgdomYlKShz2l+WMyz2F/KIZU1r+gWjk1xsmmrW3qOrr/MZlr/p9vZwZFKEloPBDP+nygYp",
+	"hYKsaBOogIQLuaup6wOQMbgUeInAycczrjiw3UK5HmazEkftdpjPbhHTERazvxwd69yiiMAMz17P/nok",
+	"f5KGv9goxBbQebGx9r2Fa9TEudDPWRRY7RySHJ79ikTZSA3B4BbpAhn/qANdquRSdscsq/QAhkTOCErA",
+	"jUQa3WKa87JApaGuDUkxxNXAZm2FjebfvD1TvMXC19ERU3/P0lnWu2v5bqR/38paCGL7qVZ4+afj45Ab",
+	"oWi3CNV9upvPfo7pH6rurPr/pbt/+Enj3Xz2v2Jm0Jbp2V3XSh7dFf2PT3ef3DVVF/nZfCbgmtcLSkmY",
+	"i3oFp7Y1VCmq1LKMXJDDWemrVPV4meHSzuVHpb6VNIkp9zBAFziuQAEvpBV8CtM3J3+8DHHjI+VNdigh",
+	"/8VctvupYJtg1KzvXNRz78/YcCXs2FXaAeFxiYaHq2HRqC/WxTec3JlUx8gXUK7zklZlJiAmjarJg5Zt",
+	"uPbyJLz5+fjnbgj+dPH3zlkP7VsXfdXWUDunNHHKjdO4zmILIX5S0iIj65acrRy93lTWudjIKtqDOF4p",
+	"EH4AIldo+isSwMy0IKQk/qe7All1OJdDIaIfnHqVqcnlLC23WzeTs+p9ZN4GJzralsMtAju4B9ZnQhkg",
+	"VKhvqr2610RrzAViKJkrb74Ez2Vw45ZyoQJ2ddMMMbDFJBfoyK+pc7Gxj7MlBgOUdSC8fYTO7soGHqu5",
+	"I+AcVHyCGbw75UkJyT4sT6faEwUgqbursNgUwaZFUKousbC34nYiX9wjmejDHFANkKV6MyrvfHYoTTsE",
+	"RntphgiM498ZISS+xAKxghHoe1BhcJMBtPDfrQ3ZYhjbZo4ikAx2OI8JUKke5n4FbOs+DreWGwUxH62l",
+	"7NCiiy0LS+/FDVpjEl6hFwIyAaAsyyCHJoZRmhFFWZMjNbZim6kJIp/1EXiL11BQdlS+wOJH2knz4mWh",
+	"71U3nV1d9gpNVBdUKTWDHVvrhiNgYur1LOz15hxkaS41hErks6TSV88ABDarlP5Jx9vv6KuVzrpTu23E",
+	"HCDtoj4C75TTC1gnFND5VATdQZZUQvbFhlFhL6k1yxM9+Z9/+ndAyRIBLBTor9qjdgRO5P4oXT2Ya1Un",
+	"qHGSqFH+B68rSftMwBCruqGG9V4p8brtL0oEBmjAom6LA2eEKgxU74nVhvUqMtOYzj/9ezeE1oR2964M",
+	"FBtAWS2mXK+9FINeb2HNYDYCJaBCIC70YnHdeLWbe8r0mhcqZ17lsW68sL7T0xovreMF9WFI6D3vNFoL",
+	"D5Uu81qp76ZTU8iF6l8pZZ5grrS5ChxxZKrHprRG4sXLbiE0ZQgKfTmdhjuozVbVCBIJcNaHXX1UAeeI",
+	"xSoCORFMjsri3eaYriy+yglzLiN8W3doebQ06z1m79OcHK1MNJgRqqRR+yFWlwQrYhxamGoaoIc0dbnd",
+	"tBdMHucMbLqKOQkY51nJ3BEuuMPYEI/I/WZ4UFKyxtWDetys1b5YqVxBLRuEPDbAwsqX9jUSIJVvc0v3",
+	"wBhvVLsCkWPqdEZDVEc1EdII3REohRurQcLdD6s/quVrOxSHEgfF3jZpkMaCjHkqBEIdtKB0IKpQCCMg",
+	"5qT4Vp380FfMVUiUzRJgtI3ae5QwqHhM7ZcUG4QZgFlWDMHV74X/AjIEtF6LkB2Vb2Koj3IKyfFX2+vj",
+	"j7x/ualWyGsRGxP32uLFVqGwxu2g2hr/ouSoCuo14gSXS3nONmJzCtMUiA02B29ozQ0VjWxclj8f/8Uc",
+	"+OWrGCzkob46hg7PVa2xBLJCu+IVE10BLDhQobra6DGx6zcqtt2keLAAEZ+7Q197432vrfi6yTC+oEz8",
+	"zczRyYRR+lYI2qV7TYoE6O0iLNWmfGZX4AfN4J95neJmCEqsMRgKo6kgN30IRLPC6IM8m9UWhKbkiZZS",
+	"W5Q3tCwM/7s8sirMoZAWHmmDGW/shR1jsDfWQnj83liHFl0sWei95oM0THjb7ZtsBZDavuxylu7DTNsx",
+	"Vj9QgoKLtaSvM+KwFeOrSvoIuWWoqkjRi2mRUQQGZEcAQcmah8aNRxg8UFLyPk8v2lnSYqbqHU7vssaT",
+	"b0xIadHIA0um4lfVrp4zxMssPPL0ortQJh8ebMufzz4WFwLGCIV7lICM0bX8Ed+idG+NAAIE2maUQYbT",
+	"PZCvbVByVKT01gDK01LUSQlcll6Z5QamKSJrAwmmnAIVaCnng5jaOsJ2hPZoDIqzepBemAd5dVBZLt2+",
+	"m0Z2qZarfJ2aCkDnCgwK69LjLd67wuy01/jWUi4diO5slDC33+gV93RLVa+leUkn5dtOU0kol2a2jmgv",
+	"j3hdsnrpJN3qLbRF52fpHSW94O9SfJyaKScV6eoQ7g+56DS4XNuqPChaA0z+vUwRNPln1e7Ej8Clvrgt",
+	"zo/qgfINAubZ5t88CQpJ8YCs9JpXjkvt8ihR6TiDVSYUffbqPnPNH9Vhr1mt+F5EVfOnTRivMlcWw5y+",
+	"yobuklfZSD1zlVWUxLBuB6f1VdZC6WIzW5iHkGEFoF8mdm1dUG9Da0QQ0/ZbYaChJUNCWlhyK+PVTYrP",
+	"ZaYXeeRmCPAN3RFASbpXsSHhlV5sGyZ2btTWI8uZjRCIjgpzsQISA+aRhaebuMYhu1Ipngnm0ORV94vn",
+	"G8zb5TMQFdXHgjpEpFO3cBvMhgi36VqAmka+h4v0Dx6NZGV03FJoVIEMGWwq9QuQnvqqrtUCKZ36lFjz",
+	"rHhlqFvIsgSEgpSSNWLSVJOO+ke7giq6dNhdk93QKqB+jO3iES60kl+1cqVRC4wjkWfhhfWrBa0vxpyy",
+	"mEeuAygYOKuF2BhKhXlUCwnKMtnDGGVRQq7KY85GyWK1TukP+ORV3p5fZYP0c64S9oSl5je8Eo4/SDam",
+	"uXPN6HnVUQY1m6hnsIPceiqDAqEzBw3RcpWcQyN0m7/AaKw4BXsf9KRULQrawudbnb4EXTTOqFV+23b6",
+	"fJ9nQX79UQX4fJgNvraxFG071aKvRZUg78Xu269FVkYOXnDBENyixL58PpICgKR9UnhGVyrltHKJ/vr2",
+	"EixseonGne9bM3KH20lPUIJFoKjR5UtyUHwM3794y6ltIfuS0B3xJCNp+qY0dia9ho2wuFapNF5vEVIx",
+	"QSe/vwG/f7gEJqPI/8uPj/+K/nqcXB+BizzTdcPACqM00VcdursOtrg2ef+uwQsdmPH6+qX5oKGZZiZF",
+	"YtFMDbK8ntu//qP4E5V/qR//49rYn9c/HR//y6vjv7w6/ukaKDlRduj1vybXwOZvlVdOm0Wy2L08ApeI",
+	"bfWEl3R7g4kJRJHozsGH87nCWV9KMUTEBnHE/wYguJFdlN27hWK5kcawxBfoZFIS2SNzgWpoUpIVrq9l",
+	"s+skl+RhCDD0T7QUduSfj4+PAsLwZz+XI06KWAVVjsawWVBpWKT7kMSpVl05OQa5GfXiUKvuYdkXj+lG",
+	"19VdjvazKk8rQF3Jr+VcaNIFmJp3L4pKgADyPVluGCU05+m+NRnE2dbqumF5IHT/MTtWCaX/thXq+yjT",
+	"PmhEHHHAW484FAEa3k3xYkN3ViA+mrtypfps8Xj1JZyqpZjDAEZu6O4QvHhM69ohv5ePh4rU4GVJja5I",
+	"OLeqWVvSngsX5PDANwfKEwh+c2nnMLhC/s6kPS6UNtXc4MAQ/ewAGaukHVDDNHUQwKNU1y4bw7JQX52x",
+	"z4VihEQ3dag64onQwXjzCJ8JxXH2ELp8Pstyj+LQ2ZPjFEcu6gLxrDaegGh6RKBD6dhqVDwmEyYCqnoV",
+	"UB3arIIS6nCboIDxBCyCkmouO0oq1ZkRHV9dAO7Q/hbymABrC+M5xLqgeoibB7Pg5SDJ4pu6POg436lZ",
+	"JroezQuGYKIibErPZxFxvWOUrJ23fZXAa/WudPq466DWSOR8u3yqxU20dXophqip4pW5Ls4YFcrXZp1f",
+	"GwQTxEr6/99XasBXzqu6qWPtNnQn0XlIC+YRhLbWhHfMGrNBlD2XmTDV2Uy29ZZtsSgDpnKxFY9lVaGo",
+	"lg3y0hmgQ9ZVWBwyIxh5N2QZkaG5fivAuDA+dD0CU1nkXmCyTHO5nF+GXMiMbiuDdVTdaI6dwoFDC9pr",
+	"4OHZoR1mPaeHdgXeWZnuiul0bbyHJIcpcErotfg3qktl2DnFwtiPPaUUgIadUQLdH6Vjo+ReUAxqqjTW",
+	"qdEtF7phQc0RDo0DceQRujMi+Hkoq1YU1R/D2+3Jes3QWgqeYFCakKo+qAydSaA2NZWh277l2kE6ttzR",
+	"22EY/3veHodPZNx2qQn9vFs6hUE7taRuxhcyuKMjmsWWxwVM9ZHBBqcXf8QJ/ym/fZb/A8m/iXp4XgBO",
+	"8ELsCnDKnnYeutxCom1+yMsK0OEazQXzBJRShXwuVyrk6jTjK3BaTfgGGwYZ8Q6U0Xa8A2ugKR+E8Dit",
+	"eZeXLSLRWKrRRn2MsBib3IE/xrQ/GIceo3Ufyd/7v66M0yK5aEjFsw55UneW4zTQAhMuIBHYlqk/hBR3",
+	"bYZc35IM2hfPnPkPkG6n+0QyHoD4HM07VM4dgvYW9hiLuMsQjnB7PL8NeH4bcPi3AcWZ6vlpwAQnufoB",
+	"Lvbg1rEvjTunTWFbDbepnsh5zMPYYj/odezqPG2NPGU9n6783Jq3ROd03VZERMFUq0kv6XYr0RxWS9p0",
+	"Ph1cU9pC+K27tvSEsTTPW0bPVydBST3IgUnaRO0H/9AuBI2J8LwLPe4TfecetoBCwOVG6Y6uEOSyZYfm",
+	"dFqOqCReAvnR7cwqPS1DXc4dTIV47dirLKUwcabVZc3WBWJQLfMCxli9UkIapl1C/X8sHVMTgaBghvXN",
+	"4lv5z1mUOd0tcaVVXJna4LLoz6xu0r5FB4UNbod1b+iOKOm5Ov8teicZ/Nr5mYXtHLjPHWXuBeJqgQHR",
+	"a6VusYeg73IDcaoH79oKT+0ch+6DBsDYTdCAGbYDejv/SAuqynNnCRUi2Nj47JfFN/NX3JbXIVblflfO",
+	"Zehm98NztUpyP1fvTSsWUjLtsb9LTdmTvytOz2rqcXsC+qip6ovke99Fu1/U2o209sh50Pt5C2KElOp5",
+	"Bx7nju3/A26p3Y97awIr8BaxBReQifuVWFspXE0AUFI8DQQvTC59BFhOiKoqo9rYOkQvO6NSZHMFf1iC",
+	"2cHPpJ699fbEpJir+NAZN10TRJrdtxzSTMleVdhcgYwTN5pNKW0/lrTQLEJY5Nrniy3qcLTH1by8ksDe",
+	"o+EO9vfo8cfSv3ejtxR5jYneZgLL9adfi99gJjbyAVtcpVFlHLt075vwWY3/fkxYoAXRP82zt+Pj4rnh",
+	"n5fp7vpaqHTenSXcTbZ3HY2musioLFODuEywodoSKvAS2e+2Ipdpo0s6FAUKlxv5RipxiycUieV1SW9I",
+	"9oDmgguoi855isnrcDhMbF5rWctBV7XLKCaiVhvCTsgC+htY3XMBCLMs3irKD1gbp4poqvsYa7yE0t8S",
+	"D/X9AQs6aFoAy03fYtuhmw2lnYmr/m6atTj6bZPhW5mF8Pg3NIcWlugFoTsDC03nNnOvQushZ2YDYKxf",
+	"x4AZ5tfxdn6UcYYGEz+33VUWG3DYIQK6VTnqUFfxQTjwCF3Frfw7VL6GikwsEpTiWxSRK8mKxhvdYQ9+",
+	"o+turfymBN8rHrKc1rCIyMGRkOmBIiDdjaYkyrOHZZL9DlTE7AGspcU38/f+LLlbMGT+O4hnx3//U44/",
+	"jZvo3OLQw0ywqqLoOzB/pen9w+8aDR4ENn4FU46lRSxn6ez1bCNE9nqxSOkSphvKxet/O/6349ndpwJE",
+	"neOSaACRRB0YS8GSP8+a75SUJ9PTXP3uaV8mffT1cjz2za5FrHyzn/3k6eVEp/iwKr/6+sr0uVhg5O1a",
+	"fPT0tGzx9LOffONlmZPR0zNkltm8l77uphaBp6P54umDvob6oK+hPpK3QNgHjwHmlw8imwDcJ21eMXBT",
+	"HnvGx1uTUEp6QTzDOy7Uu093/38AiBBFOeU+AQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,119 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	ActivityTodoCreated       = "todo.created"
+	ActivityTodoUpdated       = "todo.updated"
+	ActivityTodoDeleted       = "todo.deleted"
+	ActivityCommentCreated    = "comment.created"
+	ActivityCommentUpdated    = "comment.updated"
+	ActivityCommentDeleted    = "comment.deleted"
+	ActivityShareLinkCreated  = "share_link.created"
+	ActivityShareLinkRevoked  = "share_link.revoked"
+	ActivityAttachmentCreated = "attachment.created"
+	ActivityAttachmentDeleted = "attachment.deleted"
+)
+
+var activityEventTypes = []string{
+	ActivityTodoCreated,
+	ActivityTodoUpdated,
+	ActivityTodoDeleted,
+	ActivityCommentCreated,
+	ActivityCommentUpdated,
+	ActivityCommentDeleted,
+	ActivityShareLinkCreated,
+	ActivityShareLinkRevoked,
+	ActivityAttachmentCreated,
+	ActivityAttachmentDeleted,
+}
+
+const (
+	defaultActivitiesLimit = 20
+	maxActivitiesLimit     = 100
+)
+
+type ActivityService interface {
+	FetchActivitiesList(ctx context.Context, params apis.GetActivitiesParams, userID int64) (statusCode int64, activitiesList *models.ActivitySlice, nextCursor *string, err error)
+}
+
+type activityService struct {
+	db *sql.DB
+}
+
+func NewActivityService(db *sql.DB) ActivityService {
+	return &activityService{db}
+}
+
+func (as *activityService) FetchActivitiesList(ctx context.Context, params apis.GetActivitiesParams, userID int64) (statusCode int64, activitiesList *models.ActivitySlice, nextCursor *string, err error) {
+	pageSize := defaultActivitiesLimit
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > maxActivitiesLimit {
+			return http.StatusBadRequest, &models.ActivitySlice{}, nil, errors.New("limit must be between 1 and " + strconv.Itoa(maxActivitiesLimit))
+		}
+		pageSize = *params.Limit
+	}
+
+	// NOTE: 自身のTodoに関するアクティビティのみ取得
+	queryMods := []qm.QueryMod{qm.Where("user_id = ?", userID)}
+	if params.Cursor != nil {
+		cursorID, err := strconv.ParseInt(*params.Cursor, 10, 64)
+		if err != nil {
+			return http.StatusBadRequest, &models.ActivitySlice{}, nil, errors.New("invalid cursor")
+		}
+		queryMods = append(queryMods, qm.Where("id < ?", cursorID))
+	}
+	if params.TodoId != nil {
+		queryMods = append(queryMods, qm.Where("todo_id = ?", *params.TodoId))
+	}
+	if params.ActorId != nil {
+		queryMods = append(queryMods, qm.Where("actor_id = ?", *params.ActorId))
+	}
+	if params.EventType != nil {
+		if !slices.Contains(activityEventTypes, *params.EventType) {
+			return http.StatusBadRequest, &models.ActivitySlice{}, nil, errors.New("invalid eventType")
+		}
+		queryMods = append(queryMods, qm.Where("event_type = ?", *params.EventType))
+	}
+	// NOTE: 新しい順に並べ、次ページの有無を判定するため1件多く取得
+	queryMods = append(queryMods, qm.OrderBy("id DESC"), qm.Limit(pageSize+1))
+
+	activities, err := models.Activities(queryMods...).All(ctx, as.db)
+	if err != nil {
+		return http.StatusInternalServerError, &models.ActivitySlice{}, nil, err
+	}
+
+	if len(activities) > pageSize {
+		activities = activities[:pageSize]
+		next := strconv.FormatInt(activities[pageSize-1].ID, 10)
+		nextCursor = &next
+	}
+	return http.StatusOK, &activities, nextCursor, nil
+}
+
+// NOTE: 各サービスの更新処理と同一トランザクション内でアクティビティを記録する
+func recordActivity(ctx context.Context, exec boil.ContextExecutor, todo *models.Todo, actorID int64, eventType string) error {
+	activity := &models.Activity{
+		UserID:    todo.UserID,
+		ActorID:   actorID,
+		TodoID:    todo.ID,
+		TodoTitle: todo.Title,
+		EventType: eventType,
+	}
+	if err := activity.Insert(ctx, exec, boil.Infer()); err != nil {
+		return err
+	}
+	return enqueueWebhookDeliveries(ctx, exec, activity)
+}
@@ -0,0 +1,130 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/test/factories"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type TestActivityServiceSuite struct {
+	WithDBSuite
+}
+
+var testActivityService ActivityService
+
+func (s *TestActivityServiceSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	testActivityService = NewActivityService(DBCon)
+}
+
+func (s *TestActivityServiceSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *TestActivityServiceSuite) createTodo(title string) *models.Todo {
+	todoService := NewTodoService(DBCon)
+	todoService.CreateTodo(ctx, apis.PostTodosJSONRequestBody{Title: title, Content: "test content"}, int64(user.ID))
+
+	todo, err := models.Todos(qm.Where("title = ?", title)).One(ctx, DBCon)
+	if err != nil {
+		s.T().Fatalf("failed to fetch test todo %v", err)
+	}
+	return todo
+}
+
+func (s *TestActivityServiceSuite) TestRecordActivities() {
+	todo := s.createTodo("test title 1")
+	todoService := NewTodoService(DBCon)
+	todoService.UpdateTodo(ctx, todo.ID, apis.PatchTodoJSONRequestBody{Title: "updated title", Content: "updated content"}, int64(user.ID))
+	NewCommentService(DBCon).CreateComment(ctx, todo.ID, apis.PostTodoCommentsJSONRequestBody{Body: "test comment"}, int64(user.ID))
+	todoService.DeleteTodo(ctx, todo.ID, int64(user.ID))
+
+	// NOTE: Todoへの各操作がアクティビティとして記録されていることを確認
+	activities, _ := models.Activities(qm.Where("todo_id = ?", todo.ID), qm.OrderBy("id ASC")).All(ctx, DBCon)
+	var eventTypes []string
+	for _, activity := range activities {
+		eventTypes = append(eventTypes, activity.EventType)
+		assert.Equal(s.T(), int64(user.ID), activity.ActorID)
+	}
+	assert.Equal(s.T(), []string{ActivityTodoCreated, ActivityTodoUpdated, ActivityCommentCreated, ActivityTodoDeleted}, eventTypes)
+	assert.Equal(s.T(), "updated title", activities[3].TodoTitle)
+}
+
+func (s *TestActivityServiceSuite) TestFetchActivitiesList() {
+	s.createTodo("test title 1")
+	s.createTodo("test title 2")
+	s.createTodo("test title 3")
+	limit := 2
+
+	statusCode, activitiesList, nextCursor, err := testActivityService.FetchActivitiesList(ctx, apis.GetActivitiesParams{Limit: &limit}, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), *activitiesList, 2)
+	// NOTE: 新しい順に取得されることを確認
+	assert.Equal(s.T(), "test title 3", (*activitiesList)[0].TodoTitle)
+	assert.NotNil(s.T(), nextCursor)
+
+	statusCode, activitiesList, nextCursor, err = testActivityService.FetchActivitiesList(ctx, apis.GetActivitiesParams{Limit: &limit, Cursor: nextCursor}, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), *activitiesList, 1)
+	assert.Equal(s.T(), "test title 1", (*activitiesList)[0].TodoTitle)
+	assert.Nil(s.T(), nextCursor)
+}
+
+func (s *TestActivityServiceSuite) TestFetchActivitiesList_WithFilters() {
+	todo := s.createTodo("test title 1")
+	s.createTodo("test title 2")
+	NewTodoService(DBCon).UpdateTodo(ctx, todo.ID, apis.PatchTodoJSONRequestBody{Title: "updated title", Content: "updated content"}, int64(user.ID))
+
+	todoID := int(todo.ID)
+	statusCode, activitiesList, _, err := testActivityService.FetchActivitiesList(ctx, apis.GetActivitiesParams{TodoId: &todoID}, int64(user.ID))
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), *activitiesList, 2)
+
+	eventType := ActivityTodoUpdated
+	statusCode, activitiesList, _, err = testActivityService.FetchActivitiesList(ctx, apis.GetActivitiesParams{EventType: &eventType}, int64(user.ID))
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), *activitiesList, 1)
+
+	actorID := user.ID + 1
+	statusCode, activitiesList, _, err = testActivityService.FetchActivitiesList(ctx, apis.GetActivitiesParams{ActorId: &actorID}, int64(user.ID))
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), *activitiesList, 0)
+}
+
+func (s *TestActivityServiceSuite) TestFetchActivitiesList_InvalidParams() {
+	eventType := "unknown"
+	statusCode, _, _, err := testActivityService.FetchActivitiesList(ctx, apis.GetActivitiesParams{EventType: &eventType}, int64(user.ID))
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.NotNil(s.T(), err)
+
+	cursor := "invalid"
+	statusCode, _, _, err = testActivityService.FetchActivitiesList(ctx, apis.GetActivitiesParams{Cursor: &cursor}, int64(user.ID))
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.NotNil(s.T(), err)
+}
+
+func TestActivityService(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestActivityServiceSuite))
+}
@@ -0,0 +1,151 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/validator"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// NOTE: 利用日時の更新による書き込みを抑えるための間隔
+const appPasswordLastUsedInterval = time.Minute
+
+type AppPasswordService interface {
+	CreateAppPassword(ctx context.Context, requestParams apis.PostAppPasswordsJSONRequestBody, userID int64) (statusCode int64, appPassword *models.AppPassword, password string, err error)
+	FetchAppPasswordsList(ctx context.Context, userID int64) (statusCode int64, appPasswordsList *models.AppPasswordSlice, err error)
+	RevokeAppPassword(ctx context.Context, id int64, userID int64) (statusCode int64, err error)
+	Authenticate(ctx context.Context, email string, password string) (statusCode int64, userID int64, err error)
+}
+
+type appPasswordService struct {
+	db *sql.DB
+}
+
+func NewAppPasswordService(db *sql.DB) AppPasswordService {
+	return &appPasswordService{db}
+}
+
+func (as *appPasswordService) CreateAppPassword(ctx context.Context, requestParams apis.PostAppPasswordsJSONRequestBody, userID int64) (statusCode int64, appPassword *models.AppPassword, password string, err error) {
+	// NOTE: バリデーションチェック
+	validationErrors := validator.ValidateCreateAppPassword(requestParams)
+	if validationErrors != nil {
+		return http.StatusBadRequest, &models.AppPassword{}, "", validationErrors
+	}
+
+	password, err = as.generatePassword()
+	if err != nil {
+		return http.StatusInternalServerError, &models.AppPassword{}, "", err
+	}
+
+	appPassword = &models.AppPassword{}
+	appPassword.UserID = userID
+	appPassword.Name = requestParams.Name
+	// NOTE: パスワードは十分な長さの乱数のため、ハッシュ化した値で照合する
+	appPassword.PasswordDigest = as.digestPassword(password)
+
+	tx, err := as.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, &models.AppPassword{}, "", err
+	}
+	defer tx.Rollback()
+
+	if err := appPassword.Insert(ctx, tx, boil.Infer()); err != nil {
+		return http.StatusInternalServerError, &models.AppPassword{}, "", err
+	}
+	if err := recordUserCredentialOutboxEvent(ctx, tx, appPassword.ID, userID, appPassword.Name, OutboxUserAppPasswordCreated); err != nil {
+		return http.StatusInternalServerError, &models.AppPassword{}, "", err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, &models.AppPassword{}, "", err
+	}
+	return http.StatusOK, appPassword, password, nil
+}
+
+func (as *appPasswordService) FetchAppPasswordsList(ctx context.Context, userID int64) (statusCode int64, appPasswordsList *models.AppPasswordSlice, err error) {
+	appPasswords, err := models.AppPasswords(qm.Where("user_id = ?", userID), qm.OrderBy("id ASC")).All(ctx, as.db)
+	if err != nil {
+		return http.StatusInternalServerError, &models.AppPasswordSlice{}, err
+	}
+	return http.StatusOK, &appPasswords, nil
+}
+
+func (as *appPasswordService) RevokeAppPassword(ctx context.Context, id int64, userID int64) (statusCode int64, err error) {
+	tx, err := as.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	defer tx.Rollback()
+
+	appPassword, err := models.AppPasswords(qm.Where("id = ? AND user_id = ?", id, userID), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		return http.StatusNotFound, err
+	}
+
+	if _, err := appPassword.Delete(ctx, tx); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	if err := recordUserCredentialOutboxEvent(ctx, tx, appPassword.ID, userID, appPassword.Name, OutboxUserAppPasswordRevoked); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusOK, nil
+}
+
+func (as *appPasswordService) Authenticate(ctx context.Context, email string, password string) (statusCode int64, userID int64, err error) {
+	user, err := models.Users(qm.Where("email = ?", email)).One(ctx, as.db)
+	if err != nil {
+		return http.StatusUnauthorized, 0, errors.New("invalid email or app password")
+	}
+
+	appPassword, err := models.AppPasswords(qm.Where("user_id = ? AND password_digest = ?", user.ID, as.digestPassword(password))).One(ctx, as.db)
+	if err != nil {
+		return http.StatusUnauthorized, 0, errors.New("invalid email or app password")
+	}
+
+	if !appPassword.LastUsedAt.Valid || time.Since(appPassword.LastUsedAt.Time) > appPasswordLastUsedInterval {
+		appPassword.LastUsedAt = null.TimeFrom(time.Now())
+		if _, err := appPassword.Update(ctx, as.db, boil.Infer()); err != nil {
+			return http.StatusInternalServerError, 0, err
+		}
+	}
+	return http.StatusOK, int64(user.ID), nil
+}
+
+// NOTE: 入力しやすいよう「xxxx-xxxx-...」の形式で生成する
+func (as *appPasswordService) generatePassword() (string, error) {
+	randomBytes := make([]byte, 15)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return "", err
+	}
+	encoded := strings.ToLower(base32.StdEncoding.EncodeToString(randomBytes))
+
+	var groups []string
+	for i := 0; i < len(encoded); i += 4 {
+		groups = append(groups, encoded[i:i+4])
+	}
+	return strings.Join(groups, "-"), nil
+}
+
+// NOTE: 区切り文字・大文字小文字の違いは無視して照合する
+func (as *appPasswordService) digestPassword(password string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(password))
+	digest := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(digest[:])
+}
@@ -0,0 +1,110 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/test/factories"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type TestAppPasswordServiceSuite struct {
+	WithDBSuite
+}
+
+var testAppPasswordService AppPasswordService
+
+func (s *TestAppPasswordServiceSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	testAppPasswordService = NewAppPasswordService(DBCon)
+}
+
+func (s *TestAppPasswordServiceSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *TestAppPasswordServiceSuite) TestCreateAppPassword() {
+	requestParams := apis.PostAppPasswordsJSONRequestBody{Name: "iPhone"}
+
+	statusCode, appPassword, password, err := testAppPasswordService.CreateAppPassword(ctx, requestParams, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "iPhone", appPassword.Name)
+	assert.Len(s.T(), strings.Split(password, "-"), 6)
+	// NOTE: パスワードそのものは保存されないこと
+	assert.NotContains(s.T(), appPassword.PasswordDigest, strings.ReplaceAll(password, "-", ""))
+}
+
+func (s *TestAppPasswordServiceSuite) TestCreateAppPassword_ValidationError() {
+	requestParams := apis.PostAppPasswordsJSONRequestBody{Name: ""}
+
+	statusCode, _, password, err := testAppPasswordService.CreateAppPassword(ctx, requestParams, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), "", password)
+	assert.Equal(s.T(), "name: 名前は必須入力です。.", err.Error())
+}
+
+func (s *TestAppPasswordServiceSuite) TestAuthenticate() {
+	_, _, password, _ := testAppPasswordService.CreateAppPassword(ctx, apis.PostAppPasswordsJSONRequestBody{Name: "iPhone"}, int64(user.ID))
+
+	// NOTE: 区切り文字や大文字小文字が異なっても認証できること
+	statusCode, userID, err := testAppPasswordService.Authenticate(ctx, "test@example.com", strings.ToUpper(strings.ReplaceAll(password, "-", "")))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), int64(user.ID), userID)
+
+	_, appPasswords, _ := testAppPasswordService.FetchAppPasswordsList(ctx, int64(user.ID))
+	assert.True(s.T(), (*appPasswords)[0].LastUsedAt.Valid)
+}
+
+func (s *TestAppPasswordServiceSuite) TestAuthenticate_Unauthorized() {
+	_, _, password, _ := testAppPasswordService.CreateAppPassword(ctx, apis.PostAppPasswordsJSONRequestBody{Name: "iPhone"}, int64(user.ID))
+
+	statusCode, _, _ := testAppPasswordService.Authenticate(ctx, "test@example.com", "wrong-password")
+	assert.Equal(s.T(), int64(http.StatusUnauthorized), statusCode)
+
+	// NOTE: ログイン用のパスワードでは認証できないこと
+	statusCode, _, _ = testAppPasswordService.Authenticate(ctx, "test@example.com", "password")
+	assert.Equal(s.T(), int64(http.StatusUnauthorized), statusCode)
+
+	statusCode, _, _ = testAppPasswordService.Authenticate(ctx, "other@example.com", password)
+	assert.Equal(s.T(), int64(http.StatusUnauthorized), statusCode)
+}
+
+func (s *TestAppPasswordServiceSuite) TestRevokeAppPassword() {
+	_, appPassword, password, _ := testAppPasswordService.CreateAppPassword(ctx, apis.PostAppPasswordsJSONRequestBody{Name: "iPhone"}, int64(user.ID))
+
+	statusCode, err := testAppPasswordService.RevokeAppPassword(ctx, appPassword.ID, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	// NOTE: 失効したパスワードでは認証できないこと
+	statusCode, _, _ = testAppPasswordService.Authenticate(ctx, "test@example.com", password)
+	assert.Equal(s.T(), int64(http.StatusUnauthorized), statusCode)
+}
+
+func (s *TestAppPasswordServiceSuite) TestRevokeAppPassword_NotFound() {
+	statusCode, _ := testAppPasswordService.RevokeAppPassword(ctx, 0, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusNotFound), statusCode)
+}
+
+func TestAppPasswordService(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestAppPasswordServiceSuite))
+}
@@ -0,0 +1,227 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/validator"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"cloud.google.com/go/storage"
+)
+
+// NOTE: ダウンロード用の署名付きURLの有効期間
+const attachmentURLExpiration = 15 * time.Minute
+
+type AttachmentService interface {
+	CreateAttachment(ctx context.Context, todoID int64, requestParams apis.PostTodoAttachmentsMultipartRequestBody, userID int64) (statusCode int64, attachment *models.Attachment, err error)
+	FetchAttachmentsList(ctx context.Context, todoID int64, userID int64) (statusCode int64, attachmentsList *models.AttachmentSlice, err error)
+	ShowAttachmentURL(ctx context.Context, todoID int64, id int64, userID int64) (statusCode int64, attachment *models.Attachment, url string, expiresAt time.Time, err error)
+	DeleteAttachment(ctx context.Context, todoID int64, id int64, userID int64) (statusCode int64, err error)
+}
+
+type attachmentService struct {
+	db *sql.DB
+}
+
+func NewAttachmentService(db *sql.DB) AttachmentService {
+	return &attachmentService{db}
+}
+
+func (as *attachmentService) CreateAttachment(ctx context.Context, todoID int64, requestParams apis.PostTodoAttachmentsMultipartRequestBody, userID int64) (statusCode int64, attachment *models.Attachment, err error) {
+	todo, err := models.Todos(qm.Where("id = ? AND user_id = ?", todoID, userID)).One(ctx, as.db)
+	if err != nil {
+		return http.StatusNotFound, &models.Attachment{}, err
+	}
+
+	// NOTE: バリデーションチェック
+	validationErrors := validator.ValidateCreateAttachment(&requestParams)
+	if validationErrors != nil {
+		return http.StatusBadRequest, &models.Attachment{}, validationErrors
+	}
+
+	fileBytes, err := requestParams.File.Bytes()
+	if err != nil {
+		return http.StatusInternalServerError, &models.Attachment{}, err
+	}
+
+	// NOTE: 同名ファイルの上書きを防ぐためランダムなディレクトリを挟む
+	randomBytes := make([]byte, 16)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return http.StatusInternalServerError, &models.Attachment{}, err
+	}
+	fileName := path.Base(requestParams.File.Filename())
+	objectPath := "todos/" + strconv.FormatInt(todoID, 10) + "/attachments/" + hex.EncodeToString(randomBytes) + "/" + fileName
+
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		return http.StatusInternalServerError, &models.Attachment{}, err
+	}
+	defer client.Close()
+
+	reader, err := requestParams.File.Reader()
+	if err != nil {
+		return http.StatusInternalServerError, &models.Attachment{}, err
+	}
+	defer reader.Close()
+
+	bucket := client.Bucket(os.Getenv("STORAGE_BUCKET_NAME"))
+	if err := uploadObject(ctx, bucket, objectPath, reader); err != nil {
+		return http.StatusInternalServerError, &models.Attachment{}, err
+	}
+
+	attachment = &models.Attachment{}
+	attachment.UserID = userID
+	attachment.TodoID = todoID
+	attachment.FileName = fileName
+	attachment.ContentType = mimetype.Detect(fileBytes).String()
+	attachment.Size = requestParams.File.FileSize()
+	attachment.ObjectPath = objectPath
+	if err := as.insertAttachment(ctx, todo, attachment, userID); err != nil {
+		// NOTE: 登録に失敗した場合はアップロード済みのファイルを削除
+		deleteObjects(ctx, bucket, []string{objectPath})
+		return http.StatusInternalServerError, &models.Attachment{}, err
+	}
+	return http.StatusOK, attachment, nil
+}
+
+func (as *attachmentService) FetchAttachmentsList(ctx context.Context, todoID int64, userID int64) (statusCode int64, attachmentsList *models.AttachmentSlice, err error) {
+	exists, err := models.Todos(qm.Where("id = ? AND user_id = ?", todoID, userID)).Exists(ctx, as.db)
+	if err != nil {
+		return http.StatusInternalServerError, &models.AttachmentSlice{}, err
+	}
+	if !exists {
+		return http.StatusNotFound, &models.AttachmentSlice{}, errors.New("todo not found")
+	}
+
+	attachments, err := models.Attachments(qm.Where("todo_id = ?", todoID), qm.OrderBy("id ASC")).All(ctx, as.db)
+	if err != nil {
+		return http.StatusInternalServerError, &models.AttachmentSlice{}, err
+	}
+	return http.StatusOK, &attachments, nil
+}
+
+func (as *attachmentService) ShowAttachmentURL(ctx context.Context, todoID int64, id int64, userID int64) (statusCode int64, attachment *models.Attachment, url string, expiresAt time.Time, err error) {
+	attachment, err = models.Attachments(qm.Where("id = ? AND todo_id = ? AND user_id = ?", id, todoID, userID)).One(ctx, as.db)
+	if err != nil {
+		return http.StatusNotFound, &models.Attachment{}, "", time.Time{}, err
+	}
+
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		return http.StatusInternalServerError, &models.Attachment{}, "", time.Time{}, err
+	}
+	defer client.Close()
+
+	expiresAt = time.Now().Add(attachmentURLExpiration)
+	opts := &storage.SignedURLOptions{
+		Scheme:  storage.SigningSchemeV4,
+		Method:  http.MethodGet,
+		Expires: expiresAt,
+	}
+	// NOTE: エミュレータ利用時は認証情報が無いため、署名を検証しないエミュレータ向けのダミー署名を用いる
+	if os.Getenv("STORAGE_EMULATOR_HOST") != "" {
+		opts.GoogleAccessID = "emulator"
+		opts.SignBytes = func(b []byte) ([]byte, error) {
+			digest := sha256.Sum256(b)
+			return digest[:], nil
+		}
+		opts.Insecure = true
+		opts.Hostname = os.Getenv("STORAGE_SIGNED_URL_HOST")
+	}
+
+	url, err = client.Bucket(os.Getenv("STORAGE_BUCKET_NAME")).SignedURL(attachment.ObjectPath, opts)
+	if err != nil {
+		return http.StatusInternalServerError, &models.Attachment{}, "", time.Time{}, err
+	}
+	return http.StatusOK, attachment, url, expiresAt, nil
+}
+
+func (as *attachmentService) DeleteAttachment(ctx context.Context, todoID int64, id int64, userID int64) (statusCode int64, err error) {
+	attachment, err := models.Attachments(qm.Where("id = ? AND todo_id = ? AND user_id = ?", id, todoID, userID)).One(ctx, as.db)
+	if err != nil {
+		return http.StatusNotFound, err
+	}
+
+	todo, err := models.Todos(qm.Where("id = ?", attachment.TodoID)).One(ctx, as.db)
+	if err != nil {
+		return http.StatusNotFound, err
+	}
+
+	tx, err := as.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	defer tx.Rollback()
+
+	if _, err := attachment.Delete(ctx, tx); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	if err := recordActivity(ctx, tx, todo, userID, ActivityAttachmentDeleted); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	deleteAttachmentObjects(ctx, models.AttachmentSlice{attachment})
+	return http.StatusOK, nil
+}
+
+func (as *attachmentService) insertAttachment(ctx context.Context, todo *models.Todo, attachment *models.Attachment, userID int64) error {
+	tx, err := as.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := attachment.Insert(ctx, tx, boil.Infer()); err != nil {
+		return err
+	}
+	if err := recordActivity(ctx, tx, todo, userID, ActivityAttachmentCreated); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// NOTE: 添付ファイルの実体をCloud Storageから削除する
+// 	   : DBの削除は確定済みのため、失敗しても削除自体は成功として扱い、残ったファイルをログに残す
+func deleteAttachmentObjects(ctx context.Context, attachments models.AttachmentSlice) {
+	if len(attachments) == 0 {
+		return
+	}
+
+	var objectPaths []string
+	for _, attachment := range attachments {
+		objectPaths = append(objectPaths, attachment.ObjectPath)
+	}
+
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		log.Printf("orphaned attachment objects %v: %v", objectPaths, err)
+		return
+	}
+	defer client.Close()
+
+	bucket := client.Bucket(os.Getenv("STORAGE_BUCKET_NAME"))
+	for _, objectPath := range objectPaths {
+		if err := deleteObjects(ctx, bucket, []string{objectPath}); err != nil {
+			log.Printf("orphaned attachment object %s: %v", objectPath, err)
+		}
+	}
+}
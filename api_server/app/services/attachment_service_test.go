@@ -0,0 +1,202 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/test/factories"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"cloud.google.com/go/storage"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+type TestAttachmentServiceSuite struct {
+	WithDBSuite
+}
+
+var (
+	testAttachmentService AttachmentService
+	attachedTodo          *models.Todo
+	pngBytes              = []byte{0x89, 0x50, 0x4E, 0x47, 0x0D, 0x0A, 0x1A, 0x0A}
+)
+
+func (s *TestAttachmentServiceSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	// NOTE: 添付対象のTodoの作成
+	attachedTodo = &models.Todo{Title: "test title 1", Content: null.String{String: "test content 1", Valid: true}, UserID: int64(user.ID)}
+	if err := attachedTodo.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	testAttachmentService = NewAttachmentService(DBCon)
+}
+
+func (s *TestAttachmentServiceSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *TestAttachmentServiceSuite) requestParams(content []byte, filename string) apis.PostTodoAttachmentsMultipartRequestBody {
+	var file openapi_types.File
+	file.InitFromBytes(content, filename)
+	return apis.PostTodoAttachmentsMultipartRequestBody{File: file}
+}
+
+func (s *TestAttachmentServiceSuite) objectExists(path string) bool {
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		s.T().Fatalf("failed to create storage client %v", err)
+	}
+	defer client.Close()
+
+	_, err = client.Bucket(os.Getenv("STORAGE_BUCKET_NAME")).Object(path).Attrs(ctx)
+	return !errors.Is(err, storage.ErrObjectNotExist)
+}
+
+func (s *TestAttachmentServiceSuite) TestCreateAttachment_StatusOk() {
+	statusCode, attachment, err := testAttachmentService.CreateAttachment(ctx, attachedTodo.ID, s.requestParams(pngBytes, "image.png"), int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "image.png", attachment.FileName)
+	assert.Equal(s.T(), "image/png", attachment.ContentType)
+	assert.Equal(s.T(), int64(len(pngBytes)), attachment.Size)
+
+	// NOTE: Cloud Storageにアップロードされていることを確認
+	assert.True(s.T(), s.objectExists(attachment.ObjectPath))
+}
+
+func (s *TestAttachmentServiceSuite) TestCreateAttachment_ValidationError() {
+	// NOTE: 拡張子を偽装してもMIMEタイプ(zip)で判定される
+	statusCode, _, err := testAttachmentService.CreateAttachment(ctx, attachedTodo.ID, s.requestParams([]byte{0x50, 0x4B, 0x03, 0x04}, "archive.png"), int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), "file: 添付ファイルの形式は画像(webp, png, jpeg, gif), pdf, テキスト, csvのいずれかでお願いします。.", err.Error())
+
+	statusCode, _, err = testAttachmentService.CreateAttachment(ctx, attachedTodo.ID, s.requestParams(make([]byte, 10*1024*1024+1), "large.txt"), int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), "file: 添付ファイルのサイズは10MB以下でお願いします。.", err.Error())
+
+	// NOTE: ファイル名が空の場合は保存先のパスが「.」にならないよう弾く
+	statusCode, _, err = testAttachmentService.CreateAttachment(ctx, attachedTodo.ID, s.requestParams(pngBytes, ""), int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), "file: 添付ファイルのファイル名を指定してください。.", err.Error())
+}
+
+func (s *TestAttachmentServiceSuite) TestCreateAttachment_NotFound() {
+	statusCode, _, err := testAttachmentService.CreateAttachment(ctx, attachedTodo.ID, s.requestParams(pngBytes, "image.png"), int64(user.ID+1))
+
+	assert.Equal(s.T(), int64(http.StatusNotFound), statusCode)
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestAttachmentServiceSuite) TestFetchAttachmentsList() {
+	testAttachmentService.CreateAttachment(ctx, attachedTodo.ID, s.requestParams(pngBytes, "image.png"), int64(user.ID))
+	testAttachmentService.CreateAttachment(ctx, attachedTodo.ID, s.requestParams([]byte("memo"), "memo.txt"), int64(user.ID))
+
+	statusCode, attachmentsList, err := testAttachmentService.FetchAttachmentsList(ctx, attachedTodo.ID, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), *attachmentsList, 2)
+	assert.Equal(s.T(), "memo.txt", (*attachmentsList)[1].FileName)
+}
+
+func (s *TestAttachmentServiceSuite) TestShowAttachmentURL_StatusOk() {
+	_, attachment, _ := testAttachmentService.CreateAttachment(ctx, attachedTodo.ID, s.requestParams([]byte("memo"), "memo.txt"), int64(user.ID))
+
+	statusCode, _, url, _, err := testAttachmentService.ShowAttachmentURL(ctx, attachedTodo.ID, attachment.ID, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+
+	// NOTE: 署名付きURLからファイルを取得できることを確認
+	res, err := http.Get(url)
+	if err != nil {
+		s.T().Fatalf("failed to download attachment %v", err)
+	}
+	defer res.Body.Close()
+	body, _ := io.ReadAll(res.Body)
+	assert.Equal(s.T(), http.StatusOK, res.StatusCode)
+	assert.Equal(s.T(), "memo", string(body))
+}
+
+func (s *TestAttachmentServiceSuite) TestShowAttachmentURL_NotFound() {
+	_, attachment, _ := testAttachmentService.CreateAttachment(ctx, attachedTodo.ID, s.requestParams(pngBytes, "image.png"), int64(user.ID))
+
+	statusCode, _, _, _, err := testAttachmentService.ShowAttachmentURL(ctx, attachedTodo.ID, attachment.ID, int64(user.ID+1))
+
+	assert.Equal(s.T(), int64(http.StatusNotFound), statusCode)
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestAttachmentServiceSuite) TestDeleteAttachment_StatusOk() {
+	_, attachment, _ := testAttachmentService.CreateAttachment(ctx, attachedTodo.ID, s.requestParams(pngBytes, "image.png"), int64(user.ID))
+
+	statusCode, err := testAttachmentService.DeleteAttachment(ctx, attachedTodo.ID, attachment.ID, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+
+	// NOTE: レコードとCloud Storageのファイルが削除されていることを確認
+	isExistAttachment, _ := models.Attachments(qm.Where("id = ?", attachment.ID)).Exists(ctx, DBCon)
+	assert.False(s.T(), isExistAttachment)
+	assert.False(s.T(), s.objectExists(attachment.ObjectPath))
+}
+
+func (s *TestAttachmentServiceSuite) TestDeleteAttachment_StorageError() {
+	_, attachment, _ := testAttachmentService.CreateAttachment(ctx, attachedTodo.ID, s.requestParams(pngBytes, "image.png"), int64(user.ID))
+
+	// NOTE: Cloud Storageに接続できない場合も、DBの削除が確定していれば成功を返す
+	s.T().Setenv("STORAGE_EMULATOR_HOST", "127.0.0.1:1")
+	statusCode, err := testAttachmentService.DeleteAttachment(ctx, attachedTodo.ID, attachment.ID, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	isExistAttachment, _ := models.Attachments(qm.Where("id = ?", attachment.ID)).Exists(ctx, DBCon)
+	assert.False(s.T(), isExistAttachment)
+}
+
+func (s *TestAttachmentServiceSuite) TestDeleteAttachment_NotFound() {
+	statusCode, err := testAttachmentService.DeleteAttachment(ctx, attachedTodo.ID, 1, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusNotFound), statusCode)
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestAttachmentServiceSuite) TestDeleteTodo_CleanupAttachments() {
+	_, attachment, _ := testAttachmentService.CreateAttachment(ctx, attachedTodo.ID, s.requestParams(pngBytes, "image.png"), int64(user.ID))
+
+	statusCode, err := NewTodoService(DBCon).DeleteTodo(ctx, attachedTodo.ID, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+
+	// NOTE: Todo削除時に添付ファイルも削除されていることを確認
+	isExistAttachment, _ := models.Attachments(qm.Where("id = ?", attachment.ID)).Exists(ctx, DBCon)
+	assert.False(s.T(), isExistAttachment)
+	assert.False(s.T(), s.objectExists(attachment.ObjectPath))
+}
+
+func TestAttachmentService(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestAttachmentServiceSuite))
+}
@@ -6,14 +6,16 @@ import (
 	"app/validator"
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"io"
+	"log"
 	"net/http"
 	"os"
 	"strconv"
 	"time"
 
-	"github.com/golang-jwt/jwt"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -25,17 +27,25 @@ import (
 type AuthService interface {
 	ValidateSignUp(ctx context.Context, request *apis.PostAuthValidateSignUpMultipartRequestBody) error
 	SignUp(ctx context.Context, requestParams apis.PostAuthSignUpMultipartRequestBody) error
-	SignIn(ctx context.Context, requestParams apis.PostAuthSignInJSONBody) (statusCode int64, tokenString string, error error)
-	// GetAuthUser(ctx echo.Context) (*models.User, error)
-	// Getuser(ctx context.Context, id int) *models.User
+	SignIn(ctx context.Context, requestParams apis.PostAuthSignInJSONBody, userAgent string, ipAddress string) (statusCode int64, tokenPair *AuthTokenPair, twoFactorToken string, error error)
+	SignInTwoFactor(ctx context.Context, requestParams apis.PostAuthSignInTwoFactorJSONRequestBody, userAgent string, ipAddress string) (statusCode int64, tokenPair *AuthTokenPair, error error)
+	Refresh(ctx context.Context, refreshToken string) (statusCode int64, tokenPair *AuthTokenPair, error error)
+	SignOut(ctx context.Context, accessToken string, refreshToken string) (statusCode int64, error error)
+	ForgotPassword(ctx context.Context, requestParams apis.PostAuthPasswordForgotJSONRequestBody) (statusCode int64, error error)
+	ResetPassword(ctx context.Context, requestParams apis.PostAuthPasswordResetJSONRequestBody) (statusCode int64, error error)
+	VerifyEmail(ctx context.Context, requestParams apis.PostAuthEmailVerifyJSONRequestBody) (statusCode int64, error error)
+	ResendEmailVerification(ctx context.Context, requestParams apis.PostAuthEmailResendJSONRequestBody) (statusCode int64, error error)
+	UnlockAccount(ctx context.Context, requestParams apis.PostAuthUnlockJSONRequestBody) (statusCode int64, error error)
 }
 
 type authService struct {
 	db *sql.DB
+	tokenDenylist TokenDenylistStore
+	mailer Mailer
 }
 
-func NewAuthService(db *sql.DB) AuthService {
-	return &authService{db}
+func NewAuthService(db *sql.DB, tokenDenylist TokenDenylistStore, mailer Mailer) AuthService {
+	return &authService{db, tokenDenylist, mailer}
 }
 
 func (as *authService) ValidateSignUp(ctx context.Context, request *apis.PostAuthValidateSignUpMultipartRequestBody) error {
@@ -59,10 +69,28 @@ func (as *authService) SignUp(ctx context.Context, requestParams apis.PostAuthSi
 		return err
 	}
 	user.Password = hashedPassword
-	createErr := user.Insert(ctx, as.db, boil.Infer())
+
+	tx, err := as.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	createErr := user.Insert(ctx, tx, boil.Infer())
 	if createErr != nil {
 		return createErr
 	}
+	if err := recordUserOutboxEvent(ctx, tx, &user, OutboxUserSignedUp); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	// NOTE: 送信に失敗しても登録は完了しているため、再送できるようログにのみ残す
+	if err := sendEmailVerificationMail(ctx, as.db, as.mailer, &user, user.Email, time.Now()); err != nil {
+		log.Printf("failed to send verification mail to user %d: %v", user.ID, err)
+	}
 
 	client, err := storage.NewClient(ctx)
 	if err != nil {
@@ -101,61 +129,533 @@ func (as *authService) SignUp(ctx context.Context, requestParams apis.PostAuthSi
 	return updateIdenfiticationErr
 }
 
-func (as *authService) SignIn(ctx context.Context, requestParams apis.PostAuthSignInJSONBody) (statusCode int64, tokenString string, error error) {
+func (as *authService) SignIn(ctx context.Context, requestParams apis.PostAuthSignInJSONBody, userAgent string, ipAddress string) (statusCode int64, tokenPair *AuthTokenPair, twoFactorToken string, error error) {
+	// NOTE: ロック中または待ち時間中は、パスワードを照合せずに拒否する
+	now := time.Now()
+	email := normalizeSignInEmail(requestParams.Email)
+	ipAddress = normalizeSignInIPAddress(ipAddress)
+	retryAfter, err := checkSignInThrottle(ctx, as.db, email, ipAddress, now)
+	if err != nil {
+		return http.StatusInternalServerError, &AuthTokenPair{}, "", err
+	}
+	if retryAfter > 0 {
+		return http.StatusTooManyRequests, &AuthTokenPair{}, "", &SignInThrottledError{RetryAfter: retryAfter}
+	}
+
 	// NOTE: emailからの取得
+	user, err := models.Users(qm.Where("email = ?", email)).One(ctx, as.db)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return http.StatusInternalServerError, &AuthTokenPair{}, "", err
+	}
+
+	// NOTE: パスワードの照合、ユーザが存在しない場合も同じ処理を行い同じエラーを返す
+	hashedPassword := dummyPasswordHash()
+	if user != nil {
+		hashedPassword = user.Password
+	}
+	if err := as.compareHashPassword(hashedPassword, requestParams.Password); err != nil || user == nil {
+		if err := recordSignInFailure(ctx, as.db, as.mailer, email, ipAddress, user, now); err != nil {
+			return http.StatusInternalServerError, &AuthTokenPair{}, "", err
+		}
+		return http.StatusBadRequest, &AuthTokenPair{}, "", fmt.Errorf("メールアドレスまたはパスワードに該当する%sが存在しません。", "ユーザ")
+	}
+
+	// NOTE: パスワードの照合後に判定し、未確認であることを第三者に知られないようにする
+	if CurrentEmailVerificationPolicy() == EmailVerificationPolicyBlock && !user.EmailVerifiedAt.Valid {
+		return http.StatusBadRequest, &AuthTokenPair{}, "", errors.New("メールアドレスの確認が完了していません。確認メールに記載のURLを開いてください。")
+	}
+
+	// NOTE: 2段階認証を有効にしている場合は、セッションを作成せず2段階目の認証用のトークンを返す
+	// 	   : 失敗回数は2段階目の認証が成功するまで残し、コードの総当たりも同じ制限の対象にする
+	if user.TotpEnabledAt.Valid {
+		retryAfter, err := checkTwoFactorChallengeLimit(ctx, as.db, int64(user.ID), now)
+		if err != nil {
+			return http.StatusInternalServerError, &AuthTokenPair{}, "", err
+		}
+		if retryAfter > 0 {
+			return http.StatusTooManyRequests, &AuthTokenPair{}, "", &SignInThrottledError{RetryAfter: retryAfter}
+		}
+		twoFactorToken, err = issueTwoFactorChallenge(ctx, as.db, int64(user.ID), now)
+		if err != nil {
+			return http.StatusInternalServerError, &AuthTokenPair{}, "", err
+		}
+		return http.StatusOK, &AuthTokenPair{}, twoFactorToken, nil
+	}
+	if err := clearSignInFailures(ctx, as.db, email); err != nil {
+		return http.StatusInternalServerError, &AuthTokenPair{}, "", err
+	}
+
+	tx, err := as.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, &AuthTokenPair{}, "", err
+	}
+	defer tx.Rollback()
+
+	// NOTE: サインインごとにセッションを作成し、リフレッシュトークンを紐づける
+	session, err := createSession(ctx, tx, int64(user.ID), userAgent, ipAddress, now)
+	if err != nil {
+		return http.StatusInternalServerError, &AuthTokenPair{}, "", err
+	}
+	tokenPair, err = issueAuthTokenPair(ctx, tx, session, now)
+	if err != nil {
+		return http.StatusInternalServerError, &AuthTokenPair{}, "", err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, &AuthTokenPair{}, "", err
+	}
+	return http.StatusOK, tokenPair, "", nil
+}
+
+func (as *authService) SignInTwoFactor(ctx context.Context, requestParams apis.PostAuthSignInTwoFactorJSONRequestBody, userAgent string, ipAddress string) (statusCode int64, tokenPair *AuthTokenPair, error error) {
+	// NOTE: バリデーションチェック
+	if err := validator.ValidateSignInTwoFactor(&requestParams); err != nil {
+		return http.StatusBadRequest, &AuthTokenPair{}, err
+	}
+
+	tx, err := as.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, &AuthTokenPair{}, err
+	}
+	defer tx.Rollback()
+
+	// NOTE: 同じトークンで同時に試行されないよう、行をロックしてから確認する
+	now := time.Now()
+	challenge, err := models.TwoFactorChallenges(qm.Where("token_hash = ?", hashToken(requestParams.TwoFactorToken)), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return http.StatusBadRequest, &AuthTokenPair{}, errTwoFactorChallengeFailed
+		}
+		return http.StatusInternalServerError, &AuthTokenPair{}, err
+	}
+	if challenge.UsedAt.Valid || challenge.ExpiresAt.Before(now) || challenge.Attempts >= twoFactorChallengeMaxAttempts {
+		return http.StatusBadRequest, &AuthTokenPair{}, errTwoFactorChallengeFailed
+	}
+
+	// NOTE: トークンの発行後に2段階認証が無効にされた場合は、改めてサインインさせる
+	user, err := models.FindUser(ctx, tx, int(challenge.UserID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return http.StatusBadRequest, &AuthTokenPair{}, errTwoFactorChallengeFailed
+		}
+		return http.StatusInternalServerError, &AuthTokenPair{}, err
+	}
+	if !user.TotpEnabledAt.Valid {
+		return http.StatusBadRequest, &AuthTokenPair{}, errTwoFactorChallengeFailed
+	}
+
+	// NOTE: 発行済みのトークンでも、ロック中または待ち時間中は照合せずに拒否する
+	email := normalizeSignInEmail(user.Email)
+	ipAddress = normalizeSignInIPAddress(ipAddress)
+	retryAfter, err := checkSignInThrottle(ctx, tx, email, ipAddress, now)
+	if err != nil {
+		return http.StatusInternalServerError, &AuthTokenPair{}, err
+	}
+	if retryAfter > 0 {
+		return http.StatusTooManyRequests, &AuthTokenPair{}, &SignInThrottledError{RetryAfter: retryAfter}
+	}
+
+	ok, err := verifySecondFactor(ctx, tx, user, requestParams.Code, now)
+	if err != nil {
+		return http.StatusInternalServerError, &AuthTokenPair{}, err
+	}
+	if !ok {
+		// NOTE: 失敗した回数を記録し、上限に達したトークンは利用できなくする
+		// 	   : パスワードの失敗と合わせて数え、トークンを発行し直しても制限を回避できないようにする
+		challenge.Attempts++
+		if _, err := challenge.Update(ctx, tx, boil.Whitelist("attempts", "updated_at")); err != nil {
+			return http.StatusInternalServerError, &AuthTokenPair{}, err
+		}
+		if err := recordSignInFailure(ctx, tx, as.mailer, email, ipAddress, user, now); err != nil {
+			return http.StatusInternalServerError, &AuthTokenPair{}, err
+		}
+		if err := tx.Commit(); err != nil {
+			return http.StatusInternalServerError, &AuthTokenPair{}, err
+		}
+		return http.StatusBadRequest, &AuthTokenPair{}, errInvalidTwoFactorCode
+	}
+
+	challenge.UsedAt = null.TimeFrom(now)
+	if _, err := challenge.Update(ctx, tx, boil.Whitelist("used_at", "updated_at")); err != nil {
+		return http.StatusInternalServerError, &AuthTokenPair{}, err
+	}
+	if err := clearSignInFailures(ctx, tx, email); err != nil {
+		return http.StatusInternalServerError, &AuthTokenPair{}, err
+	}
+	session, err := createSession(ctx, tx, int64(user.ID), userAgent, ipAddress, now)
+	if err != nil {
+		return http.StatusInternalServerError, &AuthTokenPair{}, err
+	}
+	tokenPair, err = issueAuthTokenPair(ctx, tx, session, now)
+	if err != nil {
+		return http.StatusInternalServerError, &AuthTokenPair{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, &AuthTokenPair{}, err
+	}
+	return http.StatusOK, tokenPair, nil
+}
+
+func (as *authService) Refresh(ctx context.Context, refreshToken string) (statusCode int64, tokenPair *AuthTokenPair, error error) {
+	if refreshToken == "" {
+		return http.StatusUnauthorized, &AuthTokenPair{}, &AuthTokenError{Reason: apis.RefreshTokenInvalid, Message: "リフレッシュトークンがありません。"}
+	}
+
+	tx, err := as.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, &AuthTokenPair{}, err
+	}
+	defer tx.Rollback()
+
+	// NOTE: 同じトークンで同時にリフレッシュされた場合に二重発行しないよう、行をロックしてから確認する
+	now := time.Now()
+	currentToken, err := models.RefreshTokens(qm.Where("token_hash = ?", hashToken(refreshToken)), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return http.StatusUnauthorized, &AuthTokenPair{}, &AuthTokenError{Reason: apis.RefreshTokenInvalid, Message: "リフレッシュトークンが不正です。"}
+		}
+		return http.StatusInternalServerError, &AuthTokenPair{}, err
+	}
+
+	session, err := models.Sessions(qm.Where("family_id = ?", currentToken.FamilyID)).One(ctx, tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return http.StatusUnauthorized, &AuthTokenPair{}, &AuthTokenError{Reason: apis.RefreshTokenInvalid, Message: "リフレッシュトークンが不正です。"}
+		}
+		return http.StatusInternalServerError, &AuthTokenPair{}, err
+	}
+
+	// NOTE: 使用済みになった直後の再利用は同時リフレッシュによる競合とみなし、失効させずに拒否する
+	//     : クライアントは先に発行されたトークンのCookieで再試行できる
+	if currentToken.UsedAt.Valid && !now.After(currentToken.UsedAt.Time.Add(RefreshTokenReuseGracePeriod)) && !session.RevokedAt.Valid {
+		return http.StatusUnauthorized, &AuthTokenPair{}, &AuthTokenError{Reason: apis.RefreshTokenRotated, Message: "リフレッシュトークンは既に更新されています。"}
+	}
+	// NOTE: 猶予期間を過ぎて使用済みのトークンが再度使われた場合は漏洩とみなし、セッションごと同じファミリーのトークンを全て失効させる
+	if currentToken.UsedAt.Valid {
+		if err := revokeSessions(ctx, tx, models.SessionSlice{session}, now); err != nil {
+			return http.StatusInternalServerError, &AuthTokenPair{}, err
+		}
+		if err := tx.Commit(); err != nil {
+			return http.StatusInternalServerError, &AuthTokenPair{}, err
+		}
+		return http.StatusUnauthorized, &AuthTokenPair{}, &AuthTokenError{Reason: apis.RefreshTokenReused, Message: "リフレッシュトークンが再利用されました。再度サインインしてください。"}
+	}
+	if currentToken.RevokedAt.Valid || session.RevokedAt.Valid {
+		return http.StatusUnauthorized, &AuthTokenPair{}, &AuthTokenError{Reason: apis.RefreshTokenInvalid, Message: "リフレッシュトークンは失効しています。"}
+	}
+	if currentToken.ExpiresAt.Before(now) {
+		return http.StatusUnauthorized, &AuthTokenPair{}, &AuthTokenError{Reason: apis.RefreshTokenExpired, Message: "リフレッシュトークンの有効期限が切れています。"}
+	}
+
+	currentToken.UsedAt = null.TimeFrom(now)
+	if _, err := currentToken.Update(ctx, tx, boil.Whitelist("used_at", "updated_at")); err != nil {
+		return http.StatusInternalServerError, &AuthTokenPair{}, err
+	}
+	session.LastSeenAt = now.Truncate(time.Second)
+	if _, err := session.Update(ctx, tx, boil.Whitelist("last_seen_at", "updated_at")); err != nil {
+		return http.StatusInternalServerError, &AuthTokenPair{}, err
+	}
+	tokenPair, err = issueAuthTokenPair(ctx, tx, session, now)
+	if err != nil {
+		return http.StatusInternalServerError, &AuthTokenPair{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, &AuthTokenPair{}, err
+	}
+	return http.StatusOK, tokenPair, nil
+}
+
+func (as *authService) SignOut(ctx context.Context, accessToken string, refreshToken string) (statusCode int64, error error) {
+	now := time.Now()
+	sessions := models.SessionSlice{}
+
+	// NOTE: 有効期限の許容幅を過ぎるまでは検証を通過しうるため、その間は失効リストに残す
+	if authToken, err := VerifyAuthToken(accessToken, now); err == nil {
+		if err := as.tokenDenylist.Revoke(ctx, authToken.ID, authToken.ExpiresAt.Add(AuthTokenLeeway)); err != nil {
+			return http.StatusInternalServerError, err
+		}
+		tokenSessions, err := models.Sessions(qm.Where("id = ? AND user_id = ?", authToken.SessionID, authToken.UserID)).All(ctx, as.db)
+		if err != nil {
+			return http.StatusInternalServerError, err
+		}
+		sessions = append(sessions, tokenSessions...)
+	}
+
+	// NOTE: アクセストークンの有効期限が切れていてもサインアウトできるよう、リフレッシュトークンからもセッションを特定する
+	if refreshToken != "" {
+		refreshSessions, err := models.Sessions(
+			qm.InnerJoin("refresh_tokens ON refresh_tokens.family_id = sessions.family_id"),
+			qm.Where("refresh_tokens.token_hash = ?", hashToken(refreshToken)),
+		).All(ctx, as.db)
+		if err != nil {
+			return http.StatusInternalServerError, err
+		}
+		sessions = append(sessions, refreshSessions...)
+	}
+
+	// NOTE: セッションを失効させ、紐づくリフレッシュトークンも利用できなくする
+	if err := revokeSessions(ctx, as.db, sessions, now); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusOK, nil
+}
+
+func (as *authService) ForgotPassword(ctx context.Context, requestParams apis.PostAuthPasswordForgotJSONRequestBody) (statusCode int64, error error) {
+	// NOTE: サインインと同じく、大文字・小文字や前後の空白の違いを無視して検索する
+	requestParams.Email = normalizeSignInEmail(requestParams.Email)
+
+	// NOTE: バリデーションチェック
+	if err := validator.ValidateForgotPassword(&requestParams); err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	// NOTE: 登録済みのメールアドレスかどうかを推測されないよう、該当するユーザがいない場合も成功として扱う
 	user, err := models.Users(qm.Where("email = ?", requestParams.Email)).One(ctx, as.db)
 	if err != nil {
-		return http.StatusBadRequest, "", fmt.Errorf("メールアドレスまたはパスワードに該当する%sが存在しません。", "ユーザ")
-	}
-
-	// NOTE: パスワードの照合
-	if err := as.compareHashPassword(user.Password, requestParams.Password); err != nil {
-		return http.StatusBadRequest, "", fmt.Errorf("メールアドレスまたはパスワードに該当する%sが存在しません。", "ユーザ")
-	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"user_id": user.ID,
-		"exp":     time.Now().Add(time.Hour * 24).Unix(),
-	})
-	tokenString, err = token.SignedString([]byte(os.Getenv("JWT_TOKEN_KEY")))
-	if err != nil {
-		return http.StatusInternalServerError, "", err
-	}
-	return http.StatusOK, tokenString, nil
-}
-
-// // func (as *authService) GetAuthUser(ctx echo.Context) (*models.User, error) {
-// // 	// NOTE: Cookieからtokenを取得
-// // 	tokenString, err := ctx.Cookie("token")
-// // 	if err != nil {
-// // 		return &models.User{}, err
-// // 	}
-// // 	// NOTE: tokenに該当するユーザを取得する
-// // 	token, err := jwt.Parse(tokenString.Value, func(token *jwt.Token) (interface{}, error) {
-// // 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-// // 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-// // 		}
-
-// // 		return []byte("abcdefghijklmn"), nil
-// // 	})
-// // 	if err != nil {
-// // 		return &models.User{}, fmt.Errorf("failt jwt parse")
-// // 	}
-
-// // 	var userID int
-// // 	if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-// // 		userID = int(claims["user_id"].(float64))
-// // 	}
-// // 	if userID == 0 {
-// // 		return &models.User{}, fmt.Errorf("invalid token")
-// // 	}
-// // 	user, err := models.FindUser(context.Background(), as.db, userID)
-// // 	return user, err
-// // }
-
-// // func (as *authService) Getuser(ctx context.Context, id int) *models.User {
-// // 	user, _ := models.FindUser(ctx, as.db, id)
-// // 	return user
-// // }
+		if errors.Is(err, sql.ErrNoRows) {
+			return http.StatusOK, nil
+		}
+		return http.StatusInternalServerError, err
+	}
+
+	token, err := issuePasswordResetToken(ctx, as.db, int64(user.ID), time.Now())
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	// NOTE: 送信に失敗した場合もレスポンスを変えず、ログにのみ残す
+	if err := as.mailer.Send(ctx, newPasswordResetMailMessage(user.Email, token)); err != nil {
+		log.Printf("failed to send password reset mail to user %d: %v", user.ID, err)
+	}
+	return http.StatusOK, nil
+}
+
+func (as *authService) ResetPassword(ctx context.Context, requestParams apis.PostAuthPasswordResetJSONRequestBody) (statusCode int64, error error) {
+	// NOTE: バリデーションチェック
+	if err := validator.ValidateResetPassword(&requestParams); err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	tx, err := as.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	defer tx.Rollback()
+
+	// NOTE: 同じトークンで同時に再設定されないよう、行をロックしてから確認する
+	now := time.Now()
+	invalidTokenErr := validation.Errors{"token": errors.New("パスワード再設定用のURLが無効か、有効期限が切れています。")}
+	resetToken, err := models.PasswordResetTokens(qm.Where("token_hash = ?", hashToken(requestParams.Token)), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return http.StatusBadRequest, invalidTokenErr
+		}
+		return http.StatusInternalServerError, err
+	}
+	if resetToken.UsedAt.Valid || resetToken.ExpiresAt.Before(now) {
+		return http.StatusBadRequest, invalidTokenErr
+	}
+
+	user, err := models.FindUser(ctx, tx, int(resetToken.UserID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return http.StatusBadRequest, invalidTokenErr
+		}
+		return http.StatusInternalServerError, err
+	}
+	hashedPassword, err := as.encryptPassword(requestParams.Password)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	user.Password = hashedPassword
+	if _, err := user.Update(ctx, tx, boil.Whitelist("password", "updated_at")); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	// NOTE: 発行済みの他のトークンも含めて使用済みにする
+	if _, err := models.PasswordResetTokens(qm.Where("user_id = ? AND used_at IS NULL", user.ID)).UpdateAll(ctx, tx, models.M{"used_at": null.TimeFrom(now)}); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	// NOTE: パスワードを知る第三者がサインインしている可能性があるため、全てのセッションを失効させる
+	sessions, err := models.Sessions(qm.Where("user_id = ? AND revoked_at IS NULL", user.ID)).All(ctx, tx)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	if err := revokeSessions(ctx, tx, sessions, now); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	// NOTE: パスワードとは別に発行された認証手段も、第三者が追加した可能性があるため削除する
+	if _, err := models.AppPasswords(qm.Where("user_id = ?", user.ID)).DeleteAll(ctx, tx); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	if _, err := models.WebauthnCredentials(qm.Where("user_id = ?", user.ID)).DeleteAll(ctx, tx); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	// NOTE: パスワードを再設定した本人がすぐにサインインできるよう、ロックを解除する
+	if err := unlockAccount(ctx, tx, normalizeSignInEmail(user.Email), now); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	if err := recordUserOutboxEvent(ctx, tx, user, OutboxUserPasswordReset); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusOK, nil
+}
+
+// NOTE: ロックの通知メールに記載したトークンで、期限前にロックを解除する
+func (as *authService) UnlockAccount(ctx context.Context, requestParams apis.PostAuthUnlockJSONRequestBody) (statusCode int64, error error) {
+	// NOTE: バリデーションチェック
+	if err := validator.ValidateUnlockAccount(&requestParams); err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	tx, err := as.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	invalidTokenErr := validation.Errors{"token": errors.New("ロック解除用のURLが無効か、有効期限が切れています。")}
+	lockout, err := models.SignInLockouts(qm.Where("unlock_token_hash = ?", hashToken(requestParams.Token)), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return http.StatusBadRequest, invalidTokenErr
+		}
+		return http.StatusInternalServerError, err
+	}
+	if lockout.UnlockedAt.Valid || !lockout.LockedUntil.After(now) {
+		return http.StatusBadRequest, invalidTokenErr
+	}
+
+	if err := unlockAccount(ctx, tx, lockout.Email.String, now); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	log.Printf("sign-in unlocked: lockout %d, user %d", lockout.ID, lockout.UserID.Int64)
+
+	// NOTE: IPアドレス単位のロック等、ユーザを特定できない場合はイベントを記録しない
+	if lockout.UserID.Valid {
+		user, err := models.FindUser(ctx, tx, int(lockout.UserID.Int64))
+		if err != nil {
+			return http.StatusInternalServerError, err
+		}
+		if err := recordUserOutboxEvent(ctx, tx, user, OutboxUserUnlocked); err != nil {
+			return http.StatusInternalServerError, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusOK, nil
+}
+
+func (as *authService) VerifyEmail(ctx context.Context, requestParams apis.PostAuthEmailVerifyJSONRequestBody) (statusCode int64, error error) {
+	// NOTE: バリデーションチェック
+	if err := validator.ValidateVerifyEmail(&requestParams); err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	now := time.Now()
+	claims, err := verifyEmailVerificationToken(requestParams.Token, now)
+	if err != nil {
+		return http.StatusBadRequest, validation.Errors{"token": err}
+	}
+	user, err := models.FindUser(ctx, as.db, claims.UserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return http.StatusBadRequest, validation.Errors{"token": errInvalidEmailVerificationToken}
+		}
+		return http.StatusInternalServerError, err
+	}
+
+	isEmailChanged := false
+	switch {
+	// NOTE: 変更後のメールアドレスが確認された場合は、確認済みとしてメールアドレスを置き換える
+	case user.PendingEmail.Valid && user.PendingEmail.String == claims.Email:
+		isExist, err := models.Users(qm.Where("email = ? AND id <> ?", claims.Email, user.ID)).Exists(ctx, as.db)
+		if err != nil {
+			return http.StatusInternalServerError, err
+		}
+		if isExist {
+			return http.StatusBadRequest, validation.Errors{"token": errors.New("このメールアドレスは既に利用されています。")}
+		}
+		user.Email = claims.Email
+		user.PendingEmail = null.String{}
+		user.EmailVerifiedAt = null.TimeFrom(now)
+		isEmailChanged = true
+	case user.Email == claims.Email:
+		// NOTE: 確認済みの場合は何もしない
+		if user.EmailVerifiedAt.Valid {
+			return http.StatusOK, nil
+		}
+		user.EmailVerifiedAt = null.TimeFrom(now)
+	default:
+		return http.StatusBadRequest, validation.Errors{"token": errInvalidEmailVerificationToken}
+	}
+
+	tx, err := as.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	defer tx.Rollback()
+
+	if _, err := user.Update(ctx, tx, boil.Whitelist("email", "pending_email", "email_verified_at", "updated_at")); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	eventType := OutboxUserEmailVerified
+	// NOTE: 変更前のメールアドレスに送られたパスワード再設定用のURLは利用できなくする
+	if isEmailChanged {
+		if _, err := models.PasswordResetTokens(qm.Where("user_id = ? AND used_at IS NULL", user.ID)).UpdateAll(ctx, tx, models.M{"used_at": null.TimeFrom(now)}); err != nil {
+			return http.StatusInternalServerError, err
+		}
+		eventType = OutboxUserEmailChanged
+	}
+	if err := recordUserOutboxEvent(ctx, tx, user, eventType); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusOK, nil
+}
+
+func (as *authService) ResendEmailVerification(ctx context.Context, requestParams apis.PostAuthEmailResendJSONRequestBody) (statusCode int64, error error) {
+	// NOTE: バリデーションチェック
+	if err := validator.ValidateResendEmailVerification(&requestParams); err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	// NOTE: 登録済みのメールアドレスかどうかを推測されないよう、送信対象がない場合や送信間隔を空けていない場合も成功として扱う
+	user, err := models.Users(
+		qm.Where("email = ? AND email_verified_at IS NULL", requestParams.Email),
+		qm.Or("pending_email = ?", requestParams.Email),
+	).One(ctx, as.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return http.StatusOK, nil
+		}
+		return http.StatusInternalServerError, err
+	}
+	now := time.Now()
+	if !canResendEmailVerification(user, now) {
+		return http.StatusOK, nil
+	}
+
+	if err := sendEmailVerificationMail(ctx, as.db, as.mailer, user, requestParams.Email, now); err != nil {
+		log.Printf("failed to send verification mail to user %d: %v", user.ID, err)
+	}
+	return http.StatusOK, nil
+}
 
 // NOTE: パスワードの文字列をハッシュ化する
 func (as *authService) encryptPassword(password string) (string, error) {
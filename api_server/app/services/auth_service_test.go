@@ -5,8 +5,11 @@ import (
 	apis "app/openapi"
 	"app/test/factories"
 	"bytes"
+	"errors"
 	"net/http"
+	"regexp"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -24,12 +27,18 @@ type TestAuthServiceSuite struct {
 	WithDBSuite
 }
 
-var testAuthService AuthService
+var (
+	testAuthService AuthService
+	testTokenDenylist TokenDenylistStore
+	testMailer *MemoryMailer
+)
 
 func (s *TestAuthServiceSuite) SetupTest() {
 	s.SetDBCon()
 
-	testAuthService = NewAuthService(DBCon)
+	testTokenDenylist = NewMemoryTokenDenylistStore()
+	testMailer = NewMemoryMailer()
+	testAuthService = NewAuthService(DBCon, testTokenDenylist, testMailer)
 }
 
 func (s *TestAuthServiceSuite) TearDownTest() {
@@ -213,11 +222,25 @@ func (s *TestAuthServiceSuite) TestSignIn_StatusOK() {
 
 	requestParams := apis.PostAuthSignInJSONBody{Email: "test@example.com", Password: "password"}
 
-	statusCode, tokenString, err := testAuthService.SignIn(ctx, requestParams)
+	statusCode, tokenPair, _, err := testAuthService.SignIn(ctx, requestParams, "Mozilla/5.0", "192.0.2.1")
 
 	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
-	assert.NotNil(s.T(), tokenString)
+	assert.NotEmpty(s.T(), tokenPair.AccessToken)
+	assert.NotEmpty(s.T(), tokenPair.RefreshToken)
 	assert.Nil(s.T(), err)
+
+	// NOTE: 端末の情報とともにセッションが作成されることを確認
+	session, _ := models.Sessions(qm.Where("user_id = ?", user.ID)).One(ctx, DBCon)
+	assert.Equal(s.T(), "Mozilla/5.0", session.UserAgent)
+	assert.Equal(s.T(), "192.0.2.1", session.IPAddress)
+	authToken, _ := VerifyAuthToken(tokenPair.AccessToken, time.Now())
+	assert.Equal(s.T(), session.ID, authToken.SessionID)
+
+	// NOTE: リフレッシュトークンはハッシュ値で保存されることを確認
+	refreshToken, _ := models.RefreshTokens(qm.Where("user_id = ?", user.ID)).One(ctx, DBCon)
+	assert.Equal(s.T(), hashToken(tokenPair.RefreshToken), refreshToken.TokenHash)
+	assert.NotEqual(s.T(), tokenPair.RefreshToken, refreshToken.TokenHash)
+	assert.Equal(s.T(), session.FamilyID, refreshToken.FamilyID)
 }
 
 func (s *TestAuthServiceSuite) TestSignIn_BadRequest() {
@@ -229,13 +252,728 @@ func (s *TestAuthServiceSuite) TestSignIn_BadRequest() {
 
 	requestParams := apis.PostAuthSignInJSONBody{Email: "test_@example.com", Password: "password"}
 
-	statusCode, tokenString, err := testAuthService.SignIn(ctx, requestParams)
+	statusCode, tokenPair, _, err := testAuthService.SignIn(ctx, requestParams, "Mozilla/5.0", "192.0.2.1")
 
 	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
-	assert.Equal(s.T(), "", tokenString)
+	assert.Equal(s.T(), "", tokenPair.AccessToken)
 	assert.Equal(s.T(), "メールアドレスまたはパスワードに該当するユーザが存在しません。", err.Error())
 }
 
+func (s *TestAuthServiceSuite) signInForRefresh() *AuthTokenPair {
+	// NOTE: テスト用ユーザの作成
+	user := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	_, tokenPair, _, err := testAuthService.SignIn(ctx, apis.PostAuthSignInJSONBody{Email: "test@example.com", Password: "password"}, "Mozilla/5.0", "192.0.2.1")
+	if err != nil {
+		s.T().Fatalf("failed to sign in %v", err)
+	}
+	return tokenPair
+}
+
+func (s *TestAuthServiceSuite) assertRefreshTokenError(err error, reason apis.UnauthorizedReason) {
+	var authTokenErr *AuthTokenError
+	if assert.True(s.T(), errors.As(err, &authTokenErr)) {
+		assert.Equal(s.T(), reason, authTokenErr.Reason)
+	}
+}
+
+func (s *TestAuthServiceSuite) TestRefresh_StatusOK() {
+	signInTokenPair := s.signInForRefresh()
+
+	statusCode, tokenPair, err := testAuthService.Refresh(ctx, signInTokenPair.RefreshToken)
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.NotEmpty(s.T(), tokenPair.AccessToken)
+	assert.NotEqual(s.T(), signInTokenPair.RefreshToken, tokenPair.RefreshToken)
+
+	// NOTE: 利用したトークンは使用済みになり、同じファミリーに新しいトークンが発行されることを確認
+	usedToken, _ := models.RefreshTokens(qm.Where("token_hash = ?", hashToken(signInTokenPair.RefreshToken))).One(ctx, DBCon)
+	assert.True(s.T(), usedToken.UsedAt.Valid)
+	rotatedToken, _ := models.RefreshTokens(qm.Where("token_hash = ?", hashToken(tokenPair.RefreshToken))).One(ctx, DBCon)
+	assert.Equal(s.T(), usedToken.FamilyID, rotatedToken.FamilyID)
+	assert.False(s.T(), rotatedToken.UsedAt.Valid)
+}
+
+func (s *TestAuthServiceSuite) TestRefresh_Reused() {
+	signInTokenPair := s.signInForRefresh()
+	_, rotatedTokenPair, _ := testAuthService.Refresh(ctx, signInTokenPair.RefreshToken)
+	if _, err := models.RefreshTokens(qm.Where("used_at IS NOT NULL")).UpdateAll(ctx, DBCon, models.M{"used_at": time.Now().Add(-RefreshTokenReuseGracePeriod - time.Second)}); err != nil {
+		s.T().Fatalf("failed to update refresh token %v", err)
+	}
+
+	// NOTE: 猶予期間を過ぎてから使用済みのトークンを再度利用する
+	statusCode, _, err := testAuthService.Refresh(ctx, signInTokenPair.RefreshToken)
+
+	assert.Equal(s.T(), int64(http.StatusUnauthorized), statusCode)
+	s.assertRefreshTokenError(err, apis.RefreshTokenReused)
+
+	// NOTE: 同じファミリーのトークンとセッションが全て失効し、ローテーション後のトークンも利用できないことを確認
+	count, _ := models.RefreshTokens(qm.Where("revoked_at IS NULL")).Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(0), count)
+	count, _ = models.Sessions(qm.Where("revoked_at IS NULL")).Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(0), count)
+	statusCode, _, err = testAuthService.Refresh(ctx, rotatedTokenPair.RefreshToken)
+	assert.Equal(s.T(), int64(http.StatusUnauthorized), statusCode)
+	s.assertRefreshTokenError(err, apis.RefreshTokenInvalid)
+}
+
+func (s *TestAuthServiceSuite) TestRefresh_Concurrent() {
+	signInTokenPair := s.signInForRefresh()
+
+	// NOTE: 401を受けたクライアントが同じリフレッシュトークンで同時にリフレッシュした場合
+	//     : 行ロックにより順に処理されるため、テストでは続けて呼び出して再現する
+	statusCode, rotatedTokenPair, err := testAuthService.Refresh(ctx, signInTokenPair.RefreshToken)
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+
+	// NOTE: 後から処理された方は漏洩とみなさずに拒否されること
+	statusCode, _, err = testAuthService.Refresh(ctx, signInTokenPair.RefreshToken)
+	assert.Equal(s.T(), int64(http.StatusUnauthorized), statusCode)
+	s.assertRefreshTokenError(err, apis.RefreshTokenRotated)
+
+	// NOTE: セッションは失効せず、ローテーション後のトークンで引き続きリフレッシュできること
+	count, _ := models.Sessions(qm.Where("revoked_at IS NULL")).Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(1), count)
+	statusCode, _, err = testAuthService.Refresh(ctx, rotatedTokenPair.RefreshToken)
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+}
+
+func (s *TestAuthServiceSuite) TestRefresh_Expired() {
+	signInTokenPair := s.signInForRefresh()
+	if _, err := models.RefreshTokens().UpdateAll(ctx, DBCon, models.M{"expires_at": time.Now().Add(-time.Minute)}); err != nil {
+		s.T().Fatalf("failed to update refresh token %v", err)
+	}
+
+	statusCode, _, err := testAuthService.Refresh(ctx, signInTokenPair.RefreshToken)
+
+	assert.Equal(s.T(), int64(http.StatusUnauthorized), statusCode)
+	s.assertRefreshTokenError(err, apis.RefreshTokenExpired)
+}
+
+func (s *TestAuthServiceSuite) TestRefresh_Invalid() {
+	s.signInForRefresh()
+
+	for _, refreshToken := range []string{"", "unknown"} {
+		statusCode, _, err := testAuthService.Refresh(ctx, refreshToken)
+
+		assert.Equal(s.T(), int64(http.StatusUnauthorized), statusCode)
+		s.assertRefreshTokenError(err, apis.RefreshTokenInvalid)
+	}
+}
+
+func (s *TestAuthServiceSuite) TestSignOut_StatusOK() {
+	signInTokenPair := s.signInForRefresh()
+	authToken, _ := VerifyAuthToken(signInTokenPair.AccessToken, time.Now())
+
+	statusCode, err := testAuthService.SignOut(ctx, signInTokenPair.AccessToken, "")
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+
+	// NOTE: 有効期限の許容幅を過ぎるまで失効リストに残ることを確認
+	isRevoked, _ := testTokenDenylist.IsRevoked(ctx, authToken.ID, authToken.ExpiresAt.Add(AuthTokenLeeway))
+	assert.True(s.T(), isRevoked)
+	isRevoked, _ = testTokenDenylist.IsRevoked(ctx, authToken.ID, authToken.ExpiresAt.Add(AuthTokenLeeway+time.Second))
+	assert.False(s.T(), isRevoked)
+
+	// NOTE: セッションとリフレッシュトークンも失効していることを確認
+	session, _ := models.FindSession(ctx, DBCon, authToken.SessionID)
+	assert.True(s.T(), session.RevokedAt.Valid)
+	statusCode, _, err = testAuthService.Refresh(ctx, signInTokenPair.RefreshToken)
+	assert.Equal(s.T(), int64(http.StatusUnauthorized), statusCode)
+	s.assertRefreshTokenError(err, apis.RefreshTokenInvalid)
+}
+
+func (s *TestAuthServiceSuite) TestSignOut_ExpiredAccessToken() {
+	signInTokenPair := s.signInForRefresh()
+	authToken, _ := VerifyAuthToken(signInTokenPair.AccessToken, time.Now())
+
+	// NOTE: アクセストークンが検証できない場合も、リフレッシュトークンのセッションを失効させる
+	statusCode, err := testAuthService.SignOut(ctx, "expired", signInTokenPair.RefreshToken)
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	session, _ := models.FindSession(ctx, DBCon, authToken.SessionID)
+	assert.True(s.T(), session.RevokedAt.Valid)
+	statusCode, _, err = testAuthService.Refresh(ctx, signInTokenPair.RefreshToken)
+	assert.Equal(s.T(), int64(http.StatusUnauthorized), statusCode)
+	s.assertRefreshTokenError(err, apis.RefreshTokenInvalid)
+
+	// NOTE: どちらのトークンもない場合は何もせず成功とする
+	statusCode, err = testAuthService.SignOut(ctx, "", "")
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+}
+
+func (s *TestAuthServiceSuite) TestForgotPassword_StatusOK() {
+	// NOTE: テスト用ユーザの作成
+	user := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	statusCode, err := testAuthService.ForgotPassword(ctx, apis.PostAuthPasswordForgotJSONRequestBody{Email: "test@example.com"})
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+
+	// NOTE: メールで送信したトークンのハッシュ値が保存されることを確認
+	messages := testMailer.Messages()
+	if assert.Len(s.T(), messages, 1) {
+		assert.Equal(s.T(), "test@example.com", messages[0].To)
+		matches := regexp.MustCompile(`token=([A-Za-z0-9_-]+)`).FindStringSubmatch(messages[0].Body)
+		if assert.Len(s.T(), matches, 2) {
+			resetToken, _ := models.PasswordResetTokens(qm.Where("user_id = ?", user.ID)).One(ctx, DBCon)
+			assert.Equal(s.T(), hashToken(matches[1]), resetToken.TokenHash)
+		}
+	}
+}
+
+func (s *TestAuthServiceSuite) TestForgotPassword_NormalizedEmail() {
+	// NOTE: テスト用ユーザの作成
+	user := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	statusCode, err := testAuthService.ForgotPassword(ctx, apis.PostAuthPasswordForgotJSONRequestBody{Email: " Test@Example.com "})
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	if messages := testMailer.Messages(); assert.Len(s.T(), messages, 1) {
+		assert.Equal(s.T(), "test@example.com", messages[0].To)
+	}
+}
+
+func (s *TestAuthServiceSuite) TestForgotPassword_UnknownEmail() {
+	statusCode, err := testAuthService.ForgotPassword(ctx, apis.PostAuthPasswordForgotJSONRequestBody{Email: "unknown@example.com"})
+
+	// NOTE: 登録されていないメールアドレスでも同じ結果を返し、メールは送信しない
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), testMailer.Messages(), 0)
+}
+
+func (s *TestAuthServiceSuite) TestResetPassword_StatusOK() {
+	tokenPair := s.signInForRefresh()
+	user, _ := models.Users(qm.Where("email = ?", "test@example.com")).One(ctx, DBCon)
+	resetToken, err := issuePasswordResetToken(ctx, DBCon, int64(user.ID), time.Now())
+	if err != nil {
+		s.T().Fatalf("failed to issue password reset token %v", err)
+	}
+
+	// NOTE: 再設定前に発行されたアプリパスワードとパスキー
+	appPasswordService := NewAppPasswordService(DBCon)
+	_, _, appPassword, _ := appPasswordService.CreateAppPassword(ctx, apis.PostAppPasswordsJSONRequestBody{Name: "iPhone"}, int64(user.ID))
+	passkey := &models.WebauthnCredential{UserID: int64(user.ID), CredentialID: "credential-id", PublicKey: []byte("public-key"), Aaguid: []byte{}, Name: "passkey"}
+	if err := passkey.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test passkey %v", err)
+	}
+
+	statusCode, err := testAuthService.ResetPassword(ctx, apis.PostAuthPasswordResetJSONRequestBody{Token: resetToken, Password: "newPassword"})
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+
+	// NOTE: アプリパスワードで認証できず、パスキーも削除されていることを確認
+	statusCode, _, _ = appPasswordService.Authenticate(ctx, "test@example.com", appPassword)
+	assert.Equal(s.T(), int64(http.StatusUnauthorized), statusCode)
+	isExistPasskey, _ := models.WebauthnCredentials(qm.Where("user_id = ?", user.ID)).Exists(ctx, DBCon)
+	assert.False(s.T(), isExistPasskey)
+
+	// NOTE: 新しいパスワードでのみサインインできることを確認
+	statusCode, _, _, _ = testAuthService.SignIn(ctx, apis.PostAuthSignInJSONBody{Email: "test@example.com", Password: "password"}, "Mozilla/5.0", "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	statusCode, _, _, _ = testAuthService.SignIn(ctx, apis.PostAuthSignInJSONBody{Email: "test@example.com", Password: "newPassword"}, "Mozilla/5.0", "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+
+	// NOTE: 再設定前のセッションが失効していることを確認
+	authToken, _ := VerifyAuthToken(tokenPair.AccessToken, time.Now())
+	session, _ := models.FindSession(ctx, DBCon, authToken.SessionID)
+	assert.True(s.T(), session.RevokedAt.Valid)
+	statusCode, _, err = testAuthService.Refresh(ctx, tokenPair.RefreshToken)
+	assert.Equal(s.T(), int64(http.StatusUnauthorized), statusCode)
+	s.assertRefreshTokenError(err, apis.RefreshTokenInvalid)
+
+	// NOTE: 同じトークンは再利用できないことを確認
+	statusCode, err = testAuthService.ResetPassword(ctx, apis.PostAuthPasswordResetJSONRequestBody{Token: resetToken, Password: "otherPassword"})
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), "パスワード再設定用のURLが無効か、有効期限が切れています。", err.(validation.Errors)["token"].Error())
+}
+
+func (s *TestAuthServiceSuite) TestResetPassword_Expired() {
+	s.signInForRefresh()
+	user, _ := models.Users(qm.Where("email = ?", "test@example.com")).One(ctx, DBCon)
+	resetToken, err := issuePasswordResetToken(ctx, DBCon, int64(user.ID), time.Now().Add(-PasswordResetTokenLifetime-time.Minute))
+	if err != nil {
+		s.T().Fatalf("failed to issue password reset token %v", err)
+	}
+
+	statusCode, err := testAuthService.ResetPassword(ctx, apis.PostAuthPasswordResetJSONRequestBody{Token: resetToken, Password: "newPassword"})
+
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Contains(s.T(), err.(validation.Errors), "token")
+
+	// NOTE: セッションは失効しないことを確認
+	count, _ := models.Sessions(qm.Where("user_id = ? AND revoked_at IS NULL", user.ID)).Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(1), count)
+}
+
+func (s *TestAuthServiceSuite) TestResetPassword_ValidationErrors() {
+	statusCode, err := testAuthService.ResetPassword(ctx, apis.PostAuthPasswordResetJSONRequestBody{Token: "", Password: "short"})
+
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	if validationErrors, ok := err.(validation.Errors); assert.True(s.T(), ok) {
+		assert.Equal(s.T(), "トークンは必須入力です。", validationErrors["token"].Error())
+		assert.Equal(s.T(), "パスワードは8 ~ 24文字での入力をお願いします。", validationErrors["password"].Error())
+	}
+}
+
+func (s *TestAuthServiceSuite) createUnverifiedUser() *models.User {
+	// NOTE: テスト用ユーザの作成
+	user := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com", "EmailVerifiedAt": null.Time{}}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	return user
+}
+
+func (s *TestAuthServiceSuite) TestSignIn_EmailUnverified() {
+	s.createUnverifiedUser()
+	requestParams := apis.PostAuthSignInJSONBody{Email: "test@example.com", Password: "password"}
+
+	// NOTE: blockの場合のみサインインできないことを確認
+	s.T().Setenv("EMAIL_VERIFICATION_POLICY", string(EmailVerificationPolicyBlock))
+	statusCode, _, _, err := testAuthService.SignIn(ctx, requestParams, "Mozilla/5.0", "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), "メールアドレスの確認が完了していません。確認メールに記載のURLを開いてください。", err.Error())
+
+	s.T().Setenv("EMAIL_VERIFICATION_POLICY", string(EmailVerificationPolicyRestrict))
+	statusCode, _, _, err = testAuthService.SignIn(ctx, requestParams, "Mozilla/5.0", "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+}
+
+func (s *TestAuthServiceSuite) TestVerifyEmail_StatusOK() {
+	user := s.createUnverifiedUser()
+	token, _ := signEmailVerificationToken(user.ID, "test@example.com", time.Now())
+
+	statusCode, err := testAuthService.VerifyEmail(ctx, apis.PostAuthEmailVerifyJSONRequestBody{Token: token})
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	user, _ = models.FindUser(ctx, DBCon, user.ID)
+	assert.True(s.T(), user.EmailVerifiedAt.Valid)
+}
+
+func (s *TestAuthServiceSuite) TestVerifyEmail_PendingEmail() {
+	user := s.createUnverifiedUser()
+	user.EmailVerifiedAt = null.TimeFrom(time.Now())
+	user.PendingEmail = null.StringFrom("new@example.com")
+	if _, err := user.Update(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to update test user %v", err)
+	}
+	oldEmailToken, _ := signEmailVerificationToken(user.ID, "test@example.com", time.Now())
+	newEmailToken, _ := signEmailVerificationToken(user.ID, "new@example.com", time.Now())
+	// NOTE: 変更前のメールアドレスに送られたパスワード再設定用のトークン
+	resetToken, err := issuePasswordResetToken(ctx, DBCon, int64(user.ID), time.Now())
+	if err != nil {
+		s.T().Fatalf("failed to issue password reset token %v", err)
+	}
+
+	statusCode, err := testAuthService.VerifyEmail(ctx, apis.PostAuthEmailVerifyJSONRequestBody{Token: newEmailToken})
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	user, _ = models.FindUser(ctx, DBCon, user.ID)
+	assert.Equal(s.T(), "new@example.com", user.Email)
+	assert.False(s.T(), user.PendingEmail.Valid)
+
+	// NOTE: 変更前に発行されたパスワード再設定用のトークンは利用できないことを確認
+	statusCode, _ = testAuthService.ResetPassword(ctx, apis.PostAuthPasswordResetJSONRequestBody{Token: resetToken, Password: "newPassword"})
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+
+	// NOTE: 変更前のメールアドレス宛のトークンは利用できないことを確認
+	statusCode, err = testAuthService.VerifyEmail(ctx, apis.PostAuthEmailVerifyJSONRequestBody{Token: oldEmailToken})
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), "メールアドレス確認用のURLが無効か、有効期限が切れています。", err.(validation.Errors)["token"].Error())
+}
+
+func (s *TestAuthServiceSuite) TestResendEmailVerification() {
+	user := s.createUnverifiedUser()
+
+	statusCode, err := testAuthService.ResendEmailVerification(ctx, apis.PostAuthEmailResendJSONRequestBody{Email: "test@example.com"})
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), testMailer.Messages(), 1)
+
+	// NOTE: 送信間隔を空けずに再送した場合や、該当するユーザがいない場合は送信しないことを確認
+	statusCode, _ = testAuthService.ResendEmailVerification(ctx, apis.PostAuthEmailResendJSONRequestBody{Email: "test@example.com"})
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	statusCode, _ = testAuthService.ResendEmailVerification(ctx, apis.PostAuthEmailResendJSONRequestBody{Email: "unknown@example.com"})
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Len(s.T(), testMailer.Messages(), 1)
+
+	user.EmailVerificationSentAt = null.TimeFrom(time.Now().Add(-EmailVerificationResendInterval - time.Second))
+	if _, err := user.Update(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to update test user %v", err)
+	}
+	testAuthService.ResendEmailVerification(ctx, apis.PostAuthEmailResendJSONRequestBody{Email: "test@example.com"})
+	assert.Len(s.T(), testMailer.Messages(), 2)
+}
+
+func (s *TestAuthServiceSuite) createTwoFactorUser() (*models.User, string, []string) {
+	// NOTE: テスト用ユーザの作成
+	user := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	secret, recoveryCodes := enableTwoFactor(s.T(), int64(user.ID))
+	return user, secret, recoveryCodes
+}
+
+func (s *TestAuthServiceSuite) TestSignIn_TwoFactorRequired() {
+	s.createTwoFactorUser()
+
+	statusCode, tokenPair, twoFactorToken, err := testAuthService.SignIn(ctx, apis.PostAuthSignInJSONBody{Email: "test@example.com", Password: "password"}, "Mozilla/5.0", "192.0.2.1")
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.NotEmpty(s.T(), twoFactorToken)
+	assert.Empty(s.T(), tokenPair.AccessToken)
+
+	// NOTE: 2段階目の認証が完了するまではセッションを作成しない
+	count, _ := models.Sessions().Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(0), count)
+}
+
+func (s *TestAuthServiceSuite) TestSignInTwoFactor_StatusOK() {
+	_, secret, recoveryCodes := s.createTwoFactorUser()
+	signInParams := apis.PostAuthSignInJSONBody{Email: "test@example.com", Password: "password"}
+
+	_, _, twoFactorToken, _ := testAuthService.SignIn(ctx, signInParams, "Mozilla/5.0", "192.0.2.1")
+	code := currentTOTPCode(secret, time.Now())
+	statusCode, tokenPair, err := testAuthService.SignInTwoFactor(ctx, apis.PostAuthSignInTwoFactorJSONRequestBody{TwoFactorToken: twoFactorToken, Code: code}, "Mozilla/5.0", "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.NotEmpty(s.T(), tokenPair.AccessToken)
+	assert.NotEmpty(s.T(), tokenPair.RefreshToken)
+
+	// NOTE: 使用済みのトークンと、一度使用したTOTPのコードは再利用できないことを確認
+	statusCode, _, err = testAuthService.SignInTwoFactor(ctx, apis.PostAuthSignInTwoFactorJSONRequestBody{TwoFactorToken: twoFactorToken, Code: code}, "Mozilla/5.0", "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), errTwoFactorChallengeFailed, err)
+	_, _, twoFactorToken, _ = testAuthService.SignIn(ctx, signInParams, "Mozilla/5.0", "192.0.2.1")
+	statusCode, _, err = testAuthService.SignInTwoFactor(ctx, apis.PostAuthSignInTwoFactorJSONRequestBody{TwoFactorToken: twoFactorToken, Code: code}, "Mozilla/5.0", "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), errInvalidTwoFactorCode, err)
+
+	// NOTE: リカバリーコードは1回のみ利用できることを確認
+	statusCode, _, err = testAuthService.SignInTwoFactor(ctx, apis.PostAuthSignInTwoFactorJSONRequestBody{TwoFactorToken: twoFactorToken, Code: strings.ToUpper(recoveryCodes[0])}, "Mozilla/5.0", "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	_, _, twoFactorToken, _ = testAuthService.SignIn(ctx, signInParams, "Mozilla/5.0", "192.0.2.1")
+	statusCode, _, err = testAuthService.SignInTwoFactor(ctx, apis.PostAuthSignInTwoFactorJSONRequestBody{TwoFactorToken: twoFactorToken, Code: recoveryCodes[0]}, "Mozilla/5.0", "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), errInvalidTwoFactorCode, err)
+}
+
+func (s *TestAuthServiceSuite) TestSignInTwoFactor_TooManyAttempts() {
+	_, secret, _ := s.createTwoFactorUser()
+	_, _, twoFactorToken, _ := testAuthService.SignIn(ctx, apis.PostAuthSignInJSONBody{Email: "test@example.com", Password: "password"}, "Mozilla/5.0", "192.0.2.1")
+
+	for i := 0; i < twoFactorChallengeMaxAttempts; i++ {
+		statusCode, _, err := testAuthService.SignInTwoFactor(ctx, apis.PostAuthSignInTwoFactorJSONRequestBody{TwoFactorToken: twoFactorToken, Code: "000000"}, "Mozilla/5.0", "192.0.2.1")
+		assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+		assert.Equal(s.T(), errInvalidTwoFactorCode, err)
+		s.elapseSignInDelay()
+	}
+
+	// NOTE: 上限に達した後は正しいコードでも認証できないことを確認
+	statusCode, _, err := testAuthService.SignInTwoFactor(ctx, apis.PostAuthSignInTwoFactorJSONRequestBody{TwoFactorToken: twoFactorToken, Code: currentTOTPCode(secret, time.Now())}, "Mozilla/5.0", "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), errTwoFactorChallengeFailed, err)
+}
+
+func (s *TestAuthServiceSuite) TestSignInTwoFactor_FailuresAcrossChallenges() {
+	user, secret, _ := s.createTwoFactorUser()
+	signInParams := apis.PostAuthSignInJSONBody{Email: "test@example.com", Password: "password"}
+
+	// NOTE: トークンを発行し直しても、2段階目の失敗がパスワードの失敗と合わせて数えられることを確認
+	for failures := 0; failures < AccountLockoutThreshold; {
+		statusCode, _, twoFactorToken, err := testAuthService.SignIn(ctx, signInParams, "Mozilla/5.0", "192.0.2.1")
+		if !assert.Equal(s.T(), int64(http.StatusOK), statusCode, err) {
+			return
+		}
+		for i := 0; i < twoFactorChallengeMaxAttempts; i++ {
+			statusCode, _, _ = testAuthService.SignInTwoFactor(ctx, apis.PostAuthSignInTwoFactorJSONRequestBody{TwoFactorToken: twoFactorToken, Code: "000000"}, "Mozilla/5.0", "192.0.2.1")
+			assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+			failures++
+			s.elapseSignInDelay()
+		}
+	}
+
+	count, _ := models.SignInLockouts(qm.Where("scope = ? AND email = ?", "account", "test@example.com")).Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(1), count)
+	statusCode, _, _, _ := testAuthService.SignIn(ctx, signInParams, "Mozilla/5.0", "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusTooManyRequests), statusCode)
+
+	// NOTE: ロック前に発行されたトークンでも、ロック中は正しいコードで認証できないことを確認
+	twoFactorToken, err := issueTwoFactorChallenge(ctx, DBCon, int64(user.ID), time.Now())
+	if err != nil {
+		s.T().Fatalf("failed to issue two factor challenge %v", err)
+	}
+	statusCode, _, _ = testAuthService.SignInTwoFactor(ctx, apis.PostAuthSignInTwoFactorJSONRequestBody{TwoFactorToken: twoFactorToken, Code: currentTOTPCode(secret, time.Now())}, "Mozilla/5.0", "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusTooManyRequests), statusCode)
+}
+
+func (s *TestAuthServiceSuite) TestSignInTwoFactor_ClearsFailures() {
+	_, secret, _ := s.createTwoFactorUser()
+	s.createSignInFailures("test@example.com", "192.0.2.1", 2, time.Now().Add(-10*time.Minute))
+
+	// NOTE: パスワードが正しくても、2段階目の認証が成功するまでは失敗回数を残す
+	_, _, twoFactorToken, _ := testAuthService.SignIn(ctx, apis.PostAuthSignInJSONBody{Email: "test@example.com", Password: "password"}, "Mozilla/5.0", "192.0.2.1")
+	count, _ := models.SignInFailures(qm.Where("email = ?", "test@example.com")).Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(2), count)
+
+	statusCode, _, _ := testAuthService.SignInTwoFactor(ctx, apis.PostAuthSignInTwoFactorJSONRequestBody{TwoFactorToken: twoFactorToken, Code: currentTOTPCode(secret, time.Now())}, "Mozilla/5.0", "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	count, _ = models.SignInFailures(qm.Where("email = ?", "test@example.com")).Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(0), count)
+}
+
+func (s *TestAuthServiceSuite) TestSignIn_TwoFactorChallengeLimit() {
+	s.createTwoFactorUser()
+	signInParams := apis.PostAuthSignInJSONBody{Email: "test@example.com", Password: "password"}
+
+	for i := 0; i < twoFactorChallengeIssueLimit; i++ {
+		statusCode, _, twoFactorToken, _ := testAuthService.SignIn(ctx, signInParams, "Mozilla/5.0", "192.0.2.1")
+		assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+		assert.NotEmpty(s.T(), twoFactorToken)
+	}
+
+	// NOTE: 上限に達した後は、最も古いトークンが判定期間を過ぎるまで発行しない
+	statusCode, _, twoFactorToken, err := testAuthService.SignIn(ctx, signInParams, "Mozilla/5.0", "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusTooManyRequests), statusCode)
+	assert.Empty(s.T(), twoFactorToken)
+	var throttledErr *SignInThrottledError
+	if assert.ErrorAs(s.T(), err, &throttledErr) {
+		assert.InDelta(s.T(), SignInFailureWindow.Seconds(), throttledErr.RetryAfter.Seconds(), 5)
+	}
+}
+
+func (s *TestAuthServiceSuite) TestSignInTwoFactor_Expired() {
+	_, secret, _ := s.createTwoFactorUser()
+	_, _, twoFactorToken, _ := testAuthService.SignIn(ctx, apis.PostAuthSignInJSONBody{Email: "test@example.com", Password: "password"}, "Mozilla/5.0", "192.0.2.1")
+	challenge, _ := models.TwoFactorChallenges(qm.Where("token_hash = ?", hashToken(twoFactorToken))).One(ctx, DBCon)
+	challenge.ExpiresAt = time.Now().Add(-time.Second)
+	if _, err := challenge.Update(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to update challenge %v", err)
+	}
+
+	statusCode, _, err := testAuthService.SignInTwoFactor(ctx, apis.PostAuthSignInTwoFactorJSONRequestBody{TwoFactorToken: twoFactorToken, Code: currentTOTPCode(secret, time.Now())}, "Mozilla/5.0", "192.0.2.1")
+
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), errTwoFactorChallengeFailed, err)
+}
+
+// NOTE: 失敗の時刻を過去にずらし、待ち時間の経過を再現する
+func (s *TestAuthServiceSuite) elapseSignInDelay() {
+	if _, err := models.SignInFailures().UpdateAll(ctx, DBCon, models.M{"created_at": time.Now().Add(-time.Minute)}); err != nil {
+		s.T().Fatalf("failed to update sign in failures %v", err)
+	}
+}
+
+// NOTE: 過去の失敗を直接作成し、時間の経過を再現する
+func (s *TestAuthServiceSuite) createSignInFailures(email string, ipAddress string, count int, createdAt time.Time) {
+	for i := 0; i < count; i++ {
+		failure := &models.SignInFailure{Email: email, IPAddress: ipAddress, CreatedAt: createdAt}
+		if err := failure.Insert(ctx, DBCon, boil.Infer()); err != nil {
+			s.T().Fatalf("failed to create sign in failure %v", err)
+		}
+	}
+}
+
+func (s *TestAuthServiceSuite) TestSignIn_ProgressiveDelay() {
+	// NOTE: テスト用ユーザの作成
+	user := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	// NOTE: 大文字・小文字や空白が異なるメールアドレスも同じアカウントの失敗として数える
+	for _, email := range []string{"test@example.com", "TEST@example.com", " test@example.com"} {
+		statusCode, _, _, _ := testAuthService.SignIn(ctx, apis.PostAuthSignInJSONBody{Email: email, Password: "wrongPassword"}, "Mozilla/5.0", "192.0.2.1")
+		assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	}
+
+	// NOTE: 待ち時間中は正しいパスワードでもサインインできない
+	statusCode, _, _, err := testAuthService.SignIn(ctx, apis.PostAuthSignInJSONBody{Email: "test@example.com", Password: "password"}, "Mozilla/5.0", "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusTooManyRequests), statusCode)
+	var throttledErr *SignInThrottledError
+	if assert.ErrorAs(s.T(), err, &throttledErr) {
+		assert.InDelta(s.T(), float64(time.Second), float64(throttledErr.RetryAfter), float64(time.Second))
+	}
+
+	// NOTE: 待ち時間の経過後はサインインでき、失敗の記録が削除される
+	if _, err := models.SignInFailures().UpdateAll(ctx, DBCon, models.M{"created_at": time.Now().Add(-2 * time.Second)}); err != nil {
+		s.T().Fatalf("failed to update sign in failures %v", err)
+	}
+	statusCode, _, _, err = testAuthService.SignIn(ctx, apis.PostAuthSignInJSONBody{Email: "test@example.com", Password: "password"}, "Mozilla/5.0", "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	count, _ := models.SignInFailures(qm.Where("email = ?", "test@example.com")).Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(0), count)
+}
+
+func (s *TestAuthServiceSuite) TestSignIn_AccountLockout() {
+	// NOTE: テスト用ユーザの作成
+	user := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	s.createSignInFailures("test@example.com", "192.0.2.1", AccountLockoutThreshold-1, time.Now().Add(-10*time.Minute))
+
+	statusCode, _, _, err := testAuthService.SignIn(ctx, apis.PostAuthSignInJSONBody{Email: "test@example.com", Password: "wrongPassword"}, "Mozilla/5.0", "192.0.2.1")
+
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), "メールアドレスまたはパスワードに該当するユーザが存在しません。", err.Error())
+
+	// NOTE: ロックが記録され、ロック解除用のURLがメールで送信されることを確認
+	lockout, err := models.SignInLockouts(qm.Where("scope = ? AND email = ?", signInLockoutScopeAccount, "test@example.com")).One(ctx, DBCon)
+	if err != nil {
+		s.T().Fatalf("failed to find lockout %v", err)
+	}
+	assert.Equal(s.T(), int64(user.ID), lockout.UserID.Int64)
+	assert.Equal(s.T(), AccountLockoutThreshold, lockout.FailedAttempts)
+	messages := testMailer.Messages()
+	if !assert.Len(s.T(), messages, 1) {
+		s.T().FailNow()
+	}
+	assert.Equal(s.T(), "test@example.com", messages[0].To)
+	matches := regexp.MustCompile(`token=([A-Za-z0-9_-]+)`).FindStringSubmatch(messages[0].Body)
+	if !assert.Len(s.T(), matches, 2) {
+		s.T().FailNow()
+	}
+	assert.Equal(s.T(), hashToken(matches[1]), lockout.UnlockTokenHash.String)
+
+	// NOTE: ロック中は正しいパスワードでもサインインできない
+	statusCode, _, _, err = testAuthService.SignIn(ctx, apis.PostAuthSignInJSONBody{Email: "test@example.com", Password: "password"}, "Mozilla/5.0", "198.51.100.1")
+	assert.Equal(s.T(), int64(http.StatusTooManyRequests), statusCode)
+	var throttledErr *SignInThrottledError
+	if assert.ErrorAs(s.T(), err, &throttledErr) {
+		assert.InDelta(s.T(), float64(AccountLockoutDuration), float64(throttledErr.RetryAfter), float64(time.Minute))
+	}
+
+	// NOTE: メールのURLからロックを解除できることを確認
+	statusCode, err = testAuthService.UnlockAccount(ctx, apis.PostAuthUnlockJSONRequestBody{Token: matches[1]})
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	statusCode, _, _, _ = testAuthService.SignIn(ctx, apis.PostAuthSignInJSONBody{Email: "test@example.com", Password: "password"}, "Mozilla/5.0", "198.51.100.1")
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+
+	// NOTE: 同じトークンは再利用できないことを確認
+	statusCode, err = testAuthService.UnlockAccount(ctx, apis.PostAuthUnlockJSONRequestBody{Token: matches[1]})
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), "ロック解除用のURLが無効か、有効期限が切れています。", err.(validation.Errors)["token"].Error())
+}
+
+func (s *TestAuthServiceSuite) TestSignIn_AccountLockoutUnknownEmail() {
+	s.createSignInFailures("unknown@example.com", "192.0.2.1", AccountLockoutThreshold-1, time.Now().Add(-10*time.Minute))
+
+	statusCode, _, _, err := testAuthService.SignIn(ctx, apis.PostAuthSignInJSONBody{Email: "unknown@example.com", Password: "password"}, "Mozilla/5.0", "192.0.2.1")
+
+	// NOTE: 登録されていないメールアドレスでも同じ結果を返し、メールは送信しない
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), "メールアドレスまたはパスワードに該当するユーザが存在しません。", err.Error())
+	assert.Len(s.T(), testMailer.Messages(), 0)
+	lockout, _ := models.SignInLockouts(qm.Where("scope = ? AND email = ?", signInLockoutScopeAccount, "unknown@example.com")).One(ctx, DBCon)
+	if assert.NotNil(s.T(), lockout) {
+		assert.False(s.T(), lockout.UserID.Valid)
+		assert.False(s.T(), lockout.UnlockTokenHash.Valid)
+	}
+
+	statusCode, _, _, _ = testAuthService.SignIn(ctx, apis.PostAuthSignInJSONBody{Email: "unknown@example.com", Password: "password"}, "Mozilla/5.0", "198.51.100.1")
+	assert.Equal(s.T(), int64(http.StatusTooManyRequests), statusCode)
+}
+
+func (s *TestAuthServiceSuite) TestSignIn_IPLockout() {
+	// NOTE: テスト用ユーザの作成
+	user := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	// NOTE: 同じIPアドレスから複数のアカウントを試した場合
+	for i := 0; i < IPLockoutThreshold-1; i++ {
+		s.createSignInFailures("user"+strconv.Itoa(i)+"@example.com", "192.0.2.1", 1, time.Now().Add(-10*time.Minute))
+	}
+
+	statusCode, _, _, _ := testAuthService.SignIn(ctx, apis.PostAuthSignInJSONBody{Email: "other@example.com", Password: "password"}, "Mozilla/5.0", "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+
+	// NOTE: ロックされたIPアドレスからは他のアカウントでもサインインできない
+	statusCode, _, _, _ = testAuthService.SignIn(ctx, apis.PostAuthSignInJSONBody{Email: "test@example.com", Password: "password"}, "Mozilla/5.0", "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusTooManyRequests), statusCode)
+	statusCode, _, _, _ = testAuthService.SignIn(ctx, apis.PostAuthSignInJSONBody{Email: "test@example.com", Password: "password"}, "Mozilla/5.0", "198.51.100.1")
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+}
+
+func (s *TestAuthServiceSuite) TestSignIn_OversizedIPAddress() {
+	// NOTE: カラムの長さを超えるIPアドレスでも失敗が記録され、制限を回避できないことを確認
+	ipAddress := strings.Repeat("203.0.113.1, ", 10)
+	statusCode, _, _, _ := testAuthService.SignIn(ctx, apis.PostAuthSignInJSONBody{Email: "test@example.com", Password: "wrongPassword"}, "Mozilla/5.0", ipAddress)
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+
+	failure, err := models.SignInFailures(qm.Where("email = ?", "test@example.com")).One(ctx, DBCon)
+	if assert.NoError(s.T(), err) {
+		assert.Equal(s.T(), ipAddress[:45], failure.IPAddress)
+	}
+
+	// NOTE: IPアドレスとして解釈できる値は表記を揃えて記録する
+	statusCode, _, _, _ = testAuthService.SignIn(ctx, apis.PostAuthSignInJSONBody{Email: "other@example.com", Password: "wrongPassword"}, "Mozilla/5.0", " 2001:DB8:0:0:0:0:0:1 ")
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	failure, err = models.SignInFailures(qm.Where("email = ?", "other@example.com")).One(ctx, DBCon)
+	if assert.NoError(s.T(), err) {
+		assert.Equal(s.T(), "2001:db8::1", failure.IPAddress)
+	}
+}
+
+func (s *TestAuthServiceSuite) TestResetPassword_Unlock() {
+	// NOTE: テスト用ユーザの作成
+	user := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	s.createSignInFailures("test@example.com", "192.0.2.1", AccountLockoutThreshold, time.Now().Add(-10*time.Minute))
+	testAuthService.SignIn(ctx, apis.PostAuthSignInJSONBody{Email: "test@example.com", Password: "wrongPassword"}, "Mozilla/5.0", "198.51.100.1")
+	resetToken, err := issuePasswordResetToken(ctx, DBCon, int64(user.ID), time.Now())
+	if err != nil {
+		s.T().Fatalf("failed to issue password reset token %v", err)
+	}
+
+	statusCode, _ := testAuthService.ResetPassword(ctx, apis.PostAuthPasswordResetJSONRequestBody{Token: resetToken, Password: "newPassword"})
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+
+	// NOTE: パスワードの再設定によりロックが解除されることを確認
+	statusCode, _, _, _ = testAuthService.SignIn(ctx, apis.PostAuthSignInJSONBody{Email: "test@example.com", Password: "newPassword"}, "Mozilla/5.0", "198.51.100.1")
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+}
+
+func (s *TestAuthServiceSuite) TestUnlockAccount_ValidationErrors() {
+	statusCode, err := testAuthService.UnlockAccount(ctx, apis.PostAuthUnlockJSONRequestBody{Token: ""})
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), "トークンは必須入力です。", err.(validation.Errors)["token"].Error())
+
+	statusCode, err = testAuthService.UnlockAccount(ctx, apis.PostAuthUnlockJSONRequestBody{Token: "invalid"})
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), "ロック解除用のURLが無効か、有効期限が切れています。", err.(validation.Errors)["token"].Error())
+}
+
 func TestAuthService(t *testing.T) {
 	// テストスイートを実行
 	suite.Run(t, new(TestAuthServiceSuite))
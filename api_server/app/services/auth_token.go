@@ -0,0 +1,167 @@
+package services
+
+import (
+	apis "app/openapi"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+)
+
+const (
+	// NOTE: サーバ間の時刻のずれを許容する幅
+	AuthTokenLeeway = 30 * time.Second
+	// NOTE: 失効の反映を待たずに済むよう短くし、期限切れ後はリフレッシュトークンで再発行する
+	AuthTokenLifetime = 15 * time.Minute
+
+	defaultAuthTokenIssuer   = "tanstack_query_practice_api"
+	defaultAuthTokenAudience = "tanstack_query_practice_web"
+)
+
+// AuthTokenError ... 認証トークンの検証エラー、Reasonはクライアントが判定に利用する理由コード
+type AuthTokenError struct {
+	Reason  apis.UnauthorizedReason
+	Message string
+}
+
+func (e *AuthTokenError) Error() string {
+	return e.Message
+}
+
+// VerifiedAuthToken ... 検証済みの認証トークンから取り出した値
+type VerifiedAuthToken struct {
+	UserID    int
+	SessionID int64
+	ID        string
+	ExpiresAt time.Time
+}
+
+func authTokenIssuer() string {
+	if issuer := os.Getenv("JWT_ISSUER"); issuer != "" {
+		return issuer
+	}
+	return defaultAuthTokenIssuer
+}
+
+func authTokenAudience() string {
+	if audience := os.Getenv("JWT_AUDIENCE"); audience != "" {
+		return audience
+	}
+	return defaultAuthTokenAudience
+}
+
+func authTokenKey() []byte {
+	return []byte(os.Getenv("JWT_TOKEN_KEY"))
+}
+
+// NewAuthTokenClaims ... 認証トークンに含めるクレームを生成する
+func NewAuthTokenClaims(userID int, sessionID int64, now time.Time) (jwt.MapClaims, error) {
+	// NOTE: サインアウト時に個別のトークンを失効させるための識別子
+	randomBytes := make([]byte, 16)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return nil, err
+	}
+	return jwt.MapClaims{
+		"jti":     hex.EncodeToString(randomBytes),
+		"user_id": userID,
+		"sid":     sessionID,
+		"iss":     authTokenIssuer(),
+		"aud":     authTokenAudience(),
+		"iat":     now.Unix(),
+		"nbf":     now.Unix(),
+		"exp":     now.Add(AuthTokenLifetime).Unix(),
+	}, nil
+}
+
+// SignAuthToken ... ユーザの認証トークンを発行する
+func SignAuthToken(userID int, sessionID int64, now time.Time) (string, error) {
+	claims, err := NewAuthTokenClaims(userID, sessionID, now)
+	if err != nil {
+		return "", err
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(authTokenKey())
+}
+
+// VerifyAuthToken ... 認証トークンを検証する(失敗時は*AuthTokenError)
+func VerifyAuthToken(tokenString string, now time.Time) (*VerifiedAuthToken, error) {
+	if tokenString == "" {
+		return nil, &AuthTokenError{Reason: apis.TokenMissing, Message: "認証トークンがありません。"}
+	}
+
+	// NOTE: 時刻に関する検証は許容幅を考慮するため、署名の検証のみライブラリに任せる
+	parser := jwt.Parser{ValidMethods: []string{jwt.SigningMethodHS256.Alg()}, SkipClaimsValidation: true}
+	token, err := parser.ParseWithClaims(tokenString, jwt.MapClaims{}, func(token *jwt.Token) (interface{}, error) {
+		return authTokenKey(), nil
+	})
+	if err != nil {
+		var validationErr *jwt.ValidationError
+		if errors.As(err, &validationErr) && validationErr.Errors&(jwt.ValidationErrorSignatureInvalid|jwt.ValidationErrorUnverifiable) != 0 {
+			return nil, &AuthTokenError{Reason: apis.SignatureInvalid, Message: "認証トークンの署名が不正です。"}
+		}
+		return nil, &AuthTokenError{Reason: apis.TokenMalformed, Message: "認証トークンの形式が不正です。"}
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, &AuthTokenError{Reason: apis.TokenMalformed, Message: "認証トークンの形式が不正です。"}
+	}
+
+	// NOTE: exp・iatは必須、nbfは任意
+	exp, ok := numericClaim(claims, "exp")
+	if !ok {
+		return nil, &AuthTokenError{Reason: apis.TokenMalformed, Message: "認証トークンに有効期限がありません。"}
+	}
+	if now.After(time.Unix(exp, 0).Add(AuthTokenLeeway)) {
+		return nil, &AuthTokenError{Reason: apis.TokenExpired, Message: "認証トークンの有効期限が切れています。"}
+	}
+	if _, exists := claims["nbf"]; exists {
+		nbf, ok := numericClaim(claims, "nbf")
+		if !ok {
+			return nil, &AuthTokenError{Reason: apis.TokenMalformed, Message: "認証トークンの形式が不正です。"}
+		}
+		if now.Before(time.Unix(nbf, 0).Add(-AuthTokenLeeway)) {
+			return nil, &AuthTokenError{Reason: apis.TokenNotYetValid, Message: "認証トークンはまだ有効になっていません。"}
+		}
+	}
+	iat, ok := numericClaim(claims, "iat")
+	if !ok {
+		return nil, &AuthTokenError{Reason: apis.TokenMalformed, Message: "認証トークンに発行日時がありません。"}
+	}
+	if now.Before(time.Unix(iat, 0).Add(-AuthTokenLeeway)) {
+		return nil, &AuthTokenError{Reason: apis.TokenIssuedInFuture, Message: "認証トークンの発行日時が不正です。"}
+	}
+
+	if !claims.VerifyIssuer(authTokenIssuer(), true) {
+		return nil, &AuthTokenError{Reason: apis.IssuerInvalid, Message: "認証トークンの発行者が不正です。"}
+	}
+	if !claims.VerifyAudience(authTokenAudience(), true) {
+		return nil, &AuthTokenError{Reason: apis.AudienceInvalid, Message: "認証トークンの利用者が不正です。"}
+	}
+
+	userID, ok := numericClaim(claims, "user_id")
+	if !ok || userID <= 0 || userID > math.MaxInt32 {
+		return nil, &AuthTokenError{Reason: apis.SubjectInvalid, Message: fmt.Sprintf("認証トークンの%sが不正です。", "ユーザ")}
+	}
+	jti, ok := claims["jti"].(string)
+	if !ok || jti == "" || len(jti) > 64 {
+		return nil, &AuthTokenError{Reason: apis.TokenMalformed, Message: "認証トークンに識別子がありません。"}
+	}
+	sessionID, ok := numericClaim(claims, "sid")
+	if !ok || sessionID <= 0 {
+		return nil, &AuthTokenError{Reason: apis.TokenMalformed, Message: "認証トークンにセッションがありません。"}
+	}
+	return &VerifiedAuthToken{UserID: int(userID), SessionID: sessionID, ID: jti, ExpiresAt: time.Unix(exp, 0)}, nil
+}
+
+// NOTE: JSONの数値はfloat64として復号されるため、整数であることを確認して変換する
+func numericClaim(claims jwt.MapClaims, key string) (int64, bool) {
+	value, ok := claims[key].(float64)
+	if !ok || value != math.Trunc(value) || math.IsInf(value, 0) {
+		return 0, false
+	}
+	return int64(value), true
+}
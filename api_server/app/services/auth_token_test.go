@@ -0,0 +1,101 @@
+package services
+
+import (
+	apis "app/openapi"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
+)
+
+const testAuthTokenKey = "test-auth-token-key"
+
+func signTestAuthToken(t *testing.T, method jwt.SigningMethod, key interface{}, claims jwt.MapClaims) string {
+	tokenString, err := jwt.NewWithClaims(method, claims).SignedString(key)
+	if err != nil {
+		t.Fatalf("failed to sign token %v", err)
+	}
+	return tokenString
+}
+
+// NOTE: 正常なクレームを基に、一部のクレームを差し替える
+func testAuthTokenClaims(t *testing.T, now time.Time, overrides map[string]interface{}) jwt.MapClaims {
+	claims, err := NewAuthTokenClaims(1, 1, now)
+	if err != nil {
+		t.Fatalf("failed to create claims %v", err)
+	}
+	for key, value := range overrides {
+		if value == nil {
+			delete(claims, key)
+			continue
+		}
+		claims[key] = value
+	}
+	return claims
+}
+
+func TestVerifyAuthToken(t *testing.T) {
+	t.Setenv("JWT_TOKEN_KEY", testAuthTokenKey)
+	t.Setenv("JWT_ISSUER", "")
+	t.Setenv("JWT_AUDIENCE", "")
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	key := []byte(testAuthTokenKey)
+	sign := func(overrides map[string]interface{}) string {
+		return signTestAuthToken(t, jwt.SigningMethodHS256, key, testAuthTokenClaims(t, now, overrides))
+	}
+
+	testCases := []struct {
+		name        string
+		tokenString string
+		verifiedAt  time.Time
+		wantUserID  int
+		wantReason  apis.UnauthorizedReason
+	}{
+		{name: "valid", tokenString: sign(nil), verifiedAt: now, wantUserID: 1},
+		{name: "valid audience list", tokenString: sign(map[string]interface{}{"aud": []string{"other", defaultAuthTokenAudience}}), verifiedAt: now, wantUserID: 1},
+		{name: "expired within leeway", tokenString: sign(nil), verifiedAt: now.Add(AuthTokenLifetime + AuthTokenLeeway), wantUserID: 1},
+		{name: "issued in future within leeway", tokenString: sign(nil), verifiedAt: now.Add(-AuthTokenLeeway), wantUserID: 1},
+		{name: "missing", tokenString: "", verifiedAt: now, wantReason: apis.TokenMissing},
+		{name: "malformed", tokenString: "not-a-jwt", verifiedAt: now, wantReason: apis.TokenMalformed},
+		{name: "signature invalid", tokenString: signTestAuthToken(t, jwt.SigningMethodHS256, []byte("other-key"), testAuthTokenClaims(t, now, nil)), verifiedAt: now, wantReason: apis.SignatureInvalid},
+		{name: "unexpected algorithm", tokenString: signTestAuthToken(t, jwt.SigningMethodHS512, key, testAuthTokenClaims(t, now, nil)), verifiedAt: now, wantReason: apis.SignatureInvalid},
+		{name: "none algorithm", tokenString: signTestAuthToken(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, testAuthTokenClaims(t, now, nil)), verifiedAt: now, wantReason: apis.SignatureInvalid},
+		{name: "expired", tokenString: sign(nil), verifiedAt: now.Add(AuthTokenLifetime + AuthTokenLeeway + time.Second), wantReason: apis.TokenExpired},
+		{name: "exp missing", tokenString: sign(map[string]interface{}{"exp": nil}), verifiedAt: now, wantReason: apis.TokenMalformed},
+		{name: "not yet valid", tokenString: sign(map[string]interface{}{"nbf": now.Add(time.Minute).Unix()}), verifiedAt: now, wantReason: apis.TokenNotYetValid},
+		{name: "issued in future", tokenString: sign(map[string]interface{}{"nbf": nil, "iat": now.Add(time.Minute).Unix()}), verifiedAt: now, wantReason: apis.TokenIssuedInFuture},
+		{name: "iat missing", tokenString: sign(map[string]interface{}{"iat": nil}), verifiedAt: now, wantReason: apis.TokenMalformed},
+		{name: "issuer invalid", tokenString: sign(map[string]interface{}{"iss": "other"}), verifiedAt: now, wantReason: apis.IssuerInvalid},
+		{name: "issuer missing", tokenString: sign(map[string]interface{}{"iss": nil}), verifiedAt: now, wantReason: apis.IssuerInvalid},
+		{name: "audience invalid", tokenString: sign(map[string]interface{}{"aud": "other"}), verifiedAt: now, wantReason: apis.AudienceInvalid},
+		{name: "audience missing", tokenString: sign(map[string]interface{}{"aud": nil}), verifiedAt: now, wantReason: apis.AudienceInvalid},
+		{name: "user_id missing", tokenString: sign(map[string]interface{}{"user_id": nil}), verifiedAt: now, wantReason: apis.SubjectInvalid},
+		{name: "user_id zero", tokenString: sign(map[string]interface{}{"user_id": 0}), verifiedAt: now, wantReason: apis.SubjectInvalid},
+		{name: "user_id not a number", tokenString: sign(map[string]interface{}{"user_id": "1"}), verifiedAt: now, wantReason: apis.SubjectInvalid},
+		{name: "jti missing", tokenString: sign(map[string]interface{}{"jti": nil}), verifiedAt: now, wantReason: apis.TokenMalformed},
+		{name: "sid missing", tokenString: sign(map[string]interface{}{"sid": nil}), verifiedAt: now, wantReason: apis.TokenMalformed},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authToken, err := VerifyAuthToken(tc.tokenString, tc.verifiedAt)
+			if tc.wantReason == "" {
+				if assert.NoError(t, err) {
+					assert.Equal(t, tc.wantUserID, authToken.UserID)
+					assert.Equal(t, int64(1), authToken.SessionID)
+					assert.NotEmpty(t, authToken.ID)
+					assert.Equal(t, now.Add(AuthTokenLifetime).Unix(), authToken.ExpiresAt.Unix())
+				}
+				return
+			}
+
+			var authTokenErr *AuthTokenError
+			if assert.True(t, errors.As(err, &authTokenErr)) {
+				assert.Equal(t, tc.wantReason, authTokenErr.Reason)
+			}
+			assert.Nil(t, authToken)
+		})
+	}
+}
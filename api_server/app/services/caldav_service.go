@@ -0,0 +1,247 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/validator"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// NOTE: CalDAVクライアントから作成されていないTodoは「todo-{id}.ics」として公開する
+const (
+	calDAVDefaultNamePrefix = "todo-"
+	calDAVNameSuffix        = ".ics"
+	calDAVDefaultUIDSuffix  = "@tanstack-query-practice"
+)
+
+type CalDAVObject struct {
+	Name string
+	UID  string
+	ETag string
+	Data string
+}
+
+type CalDAVService interface {
+	FetchCollectionCTag(ctx context.Context, userID int64) (statusCode int64, ctag string, err error)
+	FetchObjectsList(ctx context.Context, userID int64) (statusCode int64, objectsList []*CalDAVObject, err error)
+	ShowObject(ctx context.Context, name string, userID int64) (statusCode int64, object *CalDAVObject, err error)
+	PutObject(ctx context.Context, name string, data string, ifMatch string, ifNoneMatch string, userID int64) (statusCode int64, err error)
+	DeleteObject(ctx context.Context, name string, ifMatch string, userID int64) (statusCode int64, err error)
+}
+
+type calDAVService struct {
+	db          *sql.DB
+	todoService TodoService
+}
+
+func NewCalDAVService(db *sql.DB, todoService TodoService) CalDAVService {
+	return &calDAVService{db, todoService}
+}
+
+// NOTE: Todoの変更は必ずアクティビティに記録されるため、最新のアクティビティIDをコレクションのCTagとする
+func (cs *calDAVService) FetchCollectionCTag(ctx context.Context, userID int64) (statusCode int64, ctag string, err error) {
+	activity, err := models.Activities(qm.Where("user_id = ?", userID), qm.OrderBy("id DESC")).One(ctx, cs.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return http.StatusOK, "0", nil
+	}
+	if err != nil {
+		return http.StatusInternalServerError, "", err
+	}
+	return http.StatusOK, strconv.FormatInt(activity.ID, 10), nil
+}
+
+func (cs *calDAVService) FetchObjectsList(ctx context.Context, userID int64) (statusCode int64, objectsList []*CalDAVObject, err error) {
+	statusCode, todos, err := cs.todoService.FetchTodosList(ctx, apis.GetTodosParams{}, userID)
+	if statusCode != http.StatusOK {
+		return statusCode, []*CalDAVObject{}, err
+	}
+
+	calDAVObjects, err := models.CaldavObjects(qm.Where("user_id = ?", userID)).All(ctx, cs.db)
+	if err != nil {
+		return http.StatusInternalServerError, []*CalDAVObject{}, err
+	}
+	calDAVObjectsByTodoID := map[int64]*models.CaldavObject{}
+	for _, calDAVObject := range calDAVObjects {
+		calDAVObjectsByTodoID[calDAVObject.TodoID] = calDAVObject
+	}
+
+	objectsList = []*CalDAVObject{}
+	for _, todo := range *todos {
+		objectsList = append(objectsList, cs.buildObject(todo, calDAVObjectsByTodoID[todo.ID]))
+	}
+	return http.StatusOK, objectsList, nil
+}
+
+func (cs *calDAVService) ShowObject(ctx context.Context, name string, userID int64) (statusCode int64, object *CalDAVObject, err error) {
+	todo, calDAVObject, err := cs.findTodoByName(ctx, name, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return http.StatusNotFound, &CalDAVObject{}, err
+	}
+	if err != nil {
+		return http.StatusInternalServerError, &CalDAVObject{}, err
+	}
+	return http.StatusOK, cs.buildObject(todo, calDAVObject), nil
+}
+
+// NOTE: 完了状態・期限(STATUS, COMPLETED, DUE)は保存できないため、変更が失われないよう403で拒否する
+// 	   : それ以外のTodoに対応しないプロパティ(PRIORITY等)は保存されない
+func (cs *calDAVService) PutObject(ctx context.Context, name string, data string, ifMatch string, ifNoneMatch string, userID int64) (statusCode int64, err error) {
+	if !strings.HasSuffix(name, calDAVNameSuffix) || len(name) > 255 {
+		return http.StatusBadRequest, errors.New("resource name must end with " + calDAVNameSuffix)
+	}
+
+	parsed, err := parseICalTodo(data)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	if len(parsed.UnsupportedProperties) > 0 {
+		return http.StatusForbidden, errors.New(strings.Join(parsed.UnsupportedProperties, ", ") + " is not supported")
+	}
+
+	todo, calDAVObject, err := cs.findTodoByName(ctx, name, userID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return http.StatusInternalServerError, err
+	}
+	exists := err == nil
+
+	if ifNoneMatch == "*" && exists {
+		return http.StatusPreconditionFailed, errors.New("resource already exists")
+	}
+	if ifMatch != "" {
+		if !exists {
+			return http.StatusPreconditionFailed, errors.New("resource does not exist")
+		}
+		if ifMatch != "*" && ifMatch != cs.buildObject(todo, calDAVObject).ETag {
+			return http.StatusPreconditionFailed, errors.New("etag does not match")
+		}
+	}
+
+	if exists {
+		// NOTE: 既存リソースのUIDは変更できない
+		if parsed.UID != cs.buildObject(todo, calDAVObject).UID {
+			return http.StatusConflict, errors.New("UID cannot be changed")
+		}
+		requestParams := apis.PatchTodoJSONRequestBody{Title: parsed.Summary, Content: parsed.Description}
+		statusCode, err := cs.todoService.UpdateTodo(ctx, todo.ID, requestParams, userID)
+		if statusCode != http.StatusOK {
+			return statusCode, err
+		}
+		return http.StatusNoContent, nil
+	}
+
+	// NOTE: 同じUIDのリソースは1つまで
+	uidExists, err := models.CaldavObjects(qm.Where("user_id = ? AND uid = ?", userID, parsed.UID)).Exists(ctx, cs.db)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	if uidExists {
+		return http.StatusConflict, errors.New("UID already exists")
+	}
+
+	requestParams := apis.PostTodosJSONRequestBody{Title: parsed.Summary, Content: parsed.Description}
+	if validationErrors := validator.ValidateCreateTodo(requestParams); validationErrors != nil {
+		return http.StatusBadRequest, validationErrors
+	}
+
+	// NOTE: Todoと対応付けを同じトランザクションで作成し、対応付けのないTodoが残らないようにする
+	tx, err := cs.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	defer tx.Rollback()
+
+	todo = &models.Todo{Title: requestParams.Title, Content: null.StringFrom(requestParams.Content), UserID: userID}
+	if err := insertTodo(ctx, tx, todo, userID); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	calDAVObject = &models.CaldavObject{UserID: userID, TodoID: todo.ID, UID: parsed.UID, ResourceName: name}
+	if err := calDAVObject.Insert(ctx, tx, boil.Infer()); err != nil {
+		// NOTE: 同じUID・名前で同時に作成された場合
+		if isDuplicateEntryError(err) {
+			return http.StatusConflict, errors.New("resource already exists")
+		}
+		return http.StatusInternalServerError, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusCreated, nil
+}
+
+func (cs *calDAVService) DeleteObject(ctx context.Context, name string, ifMatch string, userID int64) (statusCode int64, err error) {
+	todo, calDAVObject, err := cs.findTodoByName(ctx, name, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return http.StatusNotFound, err
+	}
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if ifMatch != "" && ifMatch != "*" && ifMatch != cs.buildObject(todo, calDAVObject).ETag {
+		return http.StatusPreconditionFailed, errors.New("etag does not match")
+	}
+
+	statusCode, err = cs.todoService.DeleteTodo(ctx, todo.ID, userID)
+	if statusCode != http.StatusOK {
+		return statusCode, err
+	}
+	return http.StatusNoContent, nil
+}
+
+func (cs *calDAVService) findTodoByName(ctx context.Context, name string, userID int64) (*models.Todo, *models.CaldavObject, error) {
+	calDAVObject, err := models.CaldavObjects(qm.Where("user_id = ? AND resource_name = ?", userID, name)).One(ctx, cs.db)
+	if err == nil {
+		todo, err := models.Todos(qm.Where("id = ? AND user_id = ?", calDAVObject.TodoID, userID)).One(ctx, cs.db)
+		return todo, calDAVObject, err
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, err
+	}
+
+	idString, found := strings.CutPrefix(strings.TrimSuffix(name, calDAVNameSuffix), calDAVDefaultNamePrefix)
+	todoID, parseErr := strconv.ParseInt(idString, 10, 64)
+	if !found || !strings.HasSuffix(name, calDAVNameSuffix) || parseErr != nil {
+		return nil, nil, sql.ErrNoRows
+	}
+
+	// NOTE: クライアントから作成されたTodoはクライアントが指定した名前でのみ参照できる
+	mapped, err := models.CaldavObjects(qm.Where("todo_id = ?", todoID)).Exists(ctx, cs.db)
+	if err != nil {
+		return nil, nil, err
+	}
+	if mapped {
+		return nil, nil, sql.ErrNoRows
+	}
+
+	todo, err := models.Todos(qm.Where("id = ? AND user_id = ?", todoID, userID)).One(ctx, cs.db)
+	return todo, nil, err
+}
+
+func (cs *calDAVService) buildObject(todo *models.Todo, calDAVObject *models.CaldavObject) *CalDAVObject {
+	object := &CalDAVObject{}
+	if calDAVObject != nil {
+		object.Name = calDAVObject.ResourceName
+		object.UID = calDAVObject.UID
+	} else {
+		idString := strconv.FormatInt(todo.ID, 10)
+		object.Name = calDAVDefaultNamePrefix + idString + calDAVNameSuffix
+		object.UID = calDAVDefaultNamePrefix + idString + calDAVDefaultUIDSuffix
+	}
+	object.Data = encodeICalTodo(object.UID, todo)
+
+	digest := sha256.Sum256([]byte(object.Data))
+	object.ETag = `"` + hex.EncodeToString(digest[:16]) + `"`
+	return object
+}
@@ -0,0 +1,171 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/test/factories"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type TestCalDAVServiceSuite struct {
+	WithDBSuite
+}
+
+var testCalDAVService CalDAVService
+
+const testICalTodo = "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//test//EN\r\nBEGIN:VTODO\r\nUID:client-uid-1\r\nSUMMARY:caldav title\r\nDESCRIPTION:line1\\nline2\\, with comma\r\nSTATUS:NEEDS-ACTION\r\nBEGIN:VALARM\r\nACTION:DISPLAY\r\nEND:VALARM\r\nEND:VTODO\r\nEND:VCALENDAR\r\n"
+
+func (s *TestCalDAVServiceSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	testCalDAVService = NewCalDAVService(DBCon, NewTodoService(DBCon))
+}
+
+func (s *TestCalDAVServiceSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *TestCalDAVServiceSuite) TestICalTodo_RoundTrip() {
+	todo := &models.Todo{Title: strings.Repeat("長いタイトル", 10)}
+	todo.Content.SetValid("a;b,c\nd")
+
+	parsed, err := parseICalTodo(encodeICalTodo("uid-1", todo))
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "uid-1", parsed.UID)
+	assert.Equal(s.T(), todo.Title, parsed.Summary)
+	assert.Equal(s.T(), "a;b,c\nd", parsed.Description)
+}
+
+func (s *TestCalDAVServiceSuite) TestParseICalTodo_Invalid() {
+	_, err := parseICalTodo("BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:1\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n")
+
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestCalDAVServiceSuite) TestFetchObjectsList() {
+	NewTodoService(DBCon).CreateTodo(ctx, apis.PostTodosJSONRequestBody{Title: "test title 1", Content: "test content 1"}, int64(user.ID))
+
+	statusCode, objectsList, err := testCalDAVService.FetchObjectsList(ctx, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), objectsList, 1)
+	assert.True(s.T(), strings.HasPrefix(objectsList[0].Name, "todo-"))
+	assert.Contains(s.T(), objectsList[0].Data, "SUMMARY:test title 1\r\n")
+}
+
+func (s *TestCalDAVServiceSuite) TestPutObject_Create() {
+	statusCode, err := testCalDAVService.PutObject(ctx, "client.ics", testICalTodo, "", "*", int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusCreated), statusCode)
+	assert.Nil(s.T(), err)
+
+	todo, _ := models.Todos(qm.Where("user_id = ?", user.ID)).One(ctx, DBCon)
+	assert.Equal(s.T(), "caldav title", todo.Title)
+	assert.Equal(s.T(), "line1\nline2, with comma", todo.Content.String)
+
+	_, object, _ := testCalDAVService.ShowObject(ctx, "client.ics", int64(user.ID))
+	assert.Equal(s.T(), "client-uid-1", object.UID)
+
+	// NOTE: 同じ名前での作成はIf-None-Matchにより失敗すること
+	statusCode, _ = testCalDAVService.PutObject(ctx, "client.ics", testICalTodo, "", "*", int64(user.ID))
+	assert.Equal(s.T(), int64(http.StatusPreconditionFailed), statusCode)
+
+	// NOTE: 同じUIDは別の名前で作成できないこと
+	statusCode, _ = testCalDAVService.PutObject(ctx, "other.ics", testICalTodo, "", "", int64(user.ID))
+	assert.Equal(s.T(), int64(http.StatusConflict), statusCode)
+}
+
+func (s *TestCalDAVServiceSuite) TestPutObject_Update() {
+	testCalDAVService.PutObject(ctx, "client.ics", testICalTodo, "", "", int64(user.ID))
+	_, object, _ := testCalDAVService.ShowObject(ctx, "client.ics", int64(user.ID))
+
+	updated := strings.Replace(testICalTodo, "SUMMARY:caldav title", "SUMMARY:updated title", 1)
+
+	// NOTE: ETagが一致しない場合は更新できないこと
+	statusCode, _ := testCalDAVService.PutObject(ctx, "client.ics", updated, `"stale"`, "", int64(user.ID))
+	assert.Equal(s.T(), int64(http.StatusPreconditionFailed), statusCode)
+
+	statusCode, err := testCalDAVService.PutObject(ctx, "client.ics", updated, object.ETag, "", int64(user.ID))
+	assert.Equal(s.T(), int64(http.StatusNoContent), statusCode)
+	assert.Nil(s.T(), err)
+
+	_, object, _ = testCalDAVService.ShowObject(ctx, "client.ics", int64(user.ID))
+	assert.Contains(s.T(), object.Data, "SUMMARY:updated title\r\n")
+
+	// NOTE: UIDは変更できないこと
+	statusCode, _ = testCalDAVService.PutObject(ctx, "client.ics", strings.Replace(testICalTodo, "client-uid-1", "client-uid-2", 1), "", "", int64(user.ID))
+	assert.Equal(s.T(), int64(http.StatusConflict), statusCode)
+}
+
+func (s *TestCalDAVServiceSuite) TestPutObject_BadRequest() {
+	statusCode, _ := testCalDAVService.PutObject(ctx, "client.ics", "invalid", "", "", int64(user.ID))
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+
+	statusCode, _ = testCalDAVService.PutObject(ctx, "client.txt", testICalTodo, "", "", int64(user.ID))
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+}
+
+func (s *TestCalDAVServiceSuite) TestPutObject_UnsupportedProperties() {
+	// NOTE: 保存できない完了状態・期限は、変更が失われないよう拒否すること
+	for _, property := range []string{"STATUS:COMPLETED", "COMPLETED:20261020T000000Z", "DUE:20261020T000000Z"} {
+		data := strings.Replace(testICalTodo, "STATUS:NEEDS-ACTION", property, 1)
+		statusCode, err := testCalDAVService.PutObject(ctx, "client.ics", data, "", "", int64(user.ID))
+		assert.Equal(s.T(), int64(http.StatusForbidden), statusCode)
+		assert.Equal(s.T(), strings.Split(property, ":")[0]+" is not supported", err.Error())
+	}
+
+	count, _ := models.Todos(qm.Where("user_id = ?", user.ID)).Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(0), count)
+}
+
+func (s *TestCalDAVServiceSuite) TestPutObject_CreateConflict() {
+	// NOTE: 同時に同じ名前で作成された場合を再現するため、参照できない対応付けを直接作成する
+	calDAVObject := &models.CaldavObject{UserID: int64(user.ID), TodoID: 0, UID: "other-uid", ResourceName: "client.ics"}
+	if err := calDAVObject.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create caldav object %v", err)
+	}
+
+	statusCode, _ := testCalDAVService.PutObject(ctx, "client.ics", testICalTodo, "", "", int64(user.ID))
+	assert.Equal(s.T(), int64(http.StatusConflict), statusCode)
+
+	// NOTE: 対応付けの作成に失敗した場合はTodoも作成されないこと
+	count, _ := models.Todos(qm.Where("user_id = ?", user.ID)).Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(0), count)
+}
+
+func (s *TestCalDAVServiceSuite) TestDeleteObject() {
+	testCalDAVService.PutObject(ctx, "client.ics", testICalTodo, "", "", int64(user.ID))
+	_, object, _ := testCalDAVService.ShowObject(ctx, "client.ics", int64(user.ID))
+
+	statusCode, _ := testCalDAVService.DeleteObject(ctx, "client.ics", `"stale"`, int64(user.ID))
+	assert.Equal(s.T(), int64(http.StatusPreconditionFailed), statusCode)
+
+	statusCode, err := testCalDAVService.DeleteObject(ctx, "client.ics", object.ETag, int64(user.ID))
+	assert.Equal(s.T(), int64(http.StatusNoContent), statusCode)
+	assert.Nil(s.T(), err)
+
+	count, _ := models.CaldavObjects().Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(0), count)
+	statusCode, _, _ = testCalDAVService.ShowObject(ctx, "client.ics", int64(user.ID))
+	assert.Equal(s.T(), int64(http.StatusNotFound), statusCode)
+}
+
+func TestCalDAVService(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestCalDAVServiceSuite))
+}
@@ -0,0 +1,243 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/validator"
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	defaultCommentsLimit = 20
+	maxCommentsLimit     = 100
+)
+
+// NOTE: 「@メールアドレス」の形式でメンションする
+var mentionPattern = regexp.MustCompile(`(?:^|\s)@([^\s@]+@[^\s@]+\.[^\s@.,!?、。]+)`)
+
+type CommentService interface {
+	CreateComment(ctx context.Context, todoID int64, requestParams apis.PostTodoCommentsJSONRequestBody, userID int64) (statusCode int64, comment *models.Comment, err error)
+	FetchCommentsList(ctx context.Context, todoID int64, userID int64, cursor *string, limit *int) (statusCode int64, commentsList *models.CommentSlice, nextCursor *string, err error)
+	UpdateComment(ctx context.Context, todoID int64, id int64, requestParams apis.PatchTodoCommentJSONRequestBody, userID int64) (statusCode int64, comment *models.Comment, err error)
+	DeleteComment(ctx context.Context, todoID int64, id int64, userID int64) (statusCode int64, err error)
+}
+
+type commentService struct {
+	db *sql.DB
+}
+
+func NewCommentService(db *sql.DB) CommentService {
+	return &commentService{db}
+}
+
+func (cs *commentService) CreateComment(ctx context.Context, todoID int64, requestParams apis.PostTodoCommentsJSONRequestBody, userID int64) (statusCode int64, comment *models.Comment, err error) {
+	todo, err := cs.findVisibleTodo(ctx, todoID, userID)
+	if err != nil {
+		return http.StatusNotFound, &models.Comment{}, err
+	}
+
+	// NOTE: バリデーションチェック
+	validationErrors := validator.ValidateCreateComment(requestParams)
+	if validationErrors != nil {
+		return http.StatusBadRequest, &models.Comment{}, validationErrors
+	}
+
+	tx, err := cs.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, &models.Comment{}, err
+	}
+	defer tx.Rollback()
+
+	comment = &models.Comment{}
+	comment.UserID = userID
+	comment.TodoID = todoID
+	comment.Body = requestParams.Body
+	if err := comment.Insert(ctx, tx, boil.Infer()); err != nil {
+		return http.StatusInternalServerError, &models.Comment{}, err
+	}
+
+	if err := cs.recordMentions(ctx, tx, todo, comment); err != nil {
+		return http.StatusInternalServerError, &models.Comment{}, err
+	}
+	if err := recordActivity(ctx, tx, todo, userID, ActivityCommentCreated); err != nil {
+		return http.StatusInternalServerError, &models.Comment{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, &models.Comment{}, err
+	}
+	return http.StatusOK, comment, nil
+}
+
+func (cs *commentService) FetchCommentsList(ctx context.Context, todoID int64, userID int64, cursor *string, limit *int) (statusCode int64, commentsList *models.CommentSlice, nextCursor *string, err error) {
+	if _, err := cs.findVisibleTodo(ctx, todoID, userID); err != nil {
+		return http.StatusNotFound, &models.CommentSlice{}, nil, err
+	}
+
+	pageSize := defaultCommentsLimit
+	if limit != nil {
+		if *limit < 1 || *limit > maxCommentsLimit {
+			return http.StatusBadRequest, &models.CommentSlice{}, nil, errors.New("commentsLimit must be between 1 and " + strconv.Itoa(maxCommentsLimit))
+		}
+		pageSize = *limit
+	}
+
+	// NOTE: カーソルは前ページ最後のコメントID
+	queryMods := []qm.QueryMod{qm.Where("todo_id = ?", todoID)}
+	if cursor != nil {
+		cursorID, err := strconv.ParseInt(*cursor, 10, 64)
+		if err != nil {
+			return http.StatusBadRequest, &models.CommentSlice{}, nil, errors.New("invalid commentsCursor")
+		}
+		queryMods = append(queryMods, qm.Where("id > ?", cursorID))
+	}
+	// NOTE: 次ページの有無を判定するため1件多く取得
+	queryMods = append(queryMods, qm.OrderBy("id ASC"), qm.Limit(pageSize+1))
+
+	comments, err := models.Comments(queryMods...).All(ctx, cs.db)
+	if err != nil {
+		return http.StatusInternalServerError, &models.CommentSlice{}, nil, err
+	}
+
+	if len(comments) > pageSize {
+		comments = comments[:pageSize]
+		next := strconv.FormatInt(comments[pageSize-1].ID, 10)
+		nextCursor = &next
+	}
+	return http.StatusOK, &comments, nextCursor, nil
+}
+
+func (cs *commentService) UpdateComment(ctx context.Context, todoID int64, id int64, requestParams apis.PatchTodoCommentJSONRequestBody, userID int64) (statusCode int64, comment *models.Comment, err error) {
+	todo, err := cs.findVisibleTodo(ctx, todoID, userID)
+	if err != nil {
+		return http.StatusNotFound, &models.Comment{}, err
+	}
+
+	// NOTE: コメントの編集は投稿者のみ可能
+	comment, err = models.Comments(qm.Where("id = ? AND todo_id = ? AND user_id = ?", id, todoID, userID)).One(ctx, cs.db)
+	if err != nil {
+		return http.StatusNotFound, &models.Comment{}, err
+	}
+
+	// NOTE: バリデーションチェック
+	validationErrors := validator.ValidateUpdateComment(requestParams)
+	if validationErrors != nil {
+		return http.StatusBadRequest, &models.Comment{}, validationErrors
+	}
+
+	tx, err := cs.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, &models.Comment{}, err
+	}
+	defer tx.Rollback()
+
+	comment.Body = requestParams.Body
+	if _, err := comment.Update(ctx, tx, boil.Infer()); err != nil {
+		return http.StatusInternalServerError, &models.Comment{}, err
+	}
+
+	// NOTE: 編集で新たにメンションされたユーザのみ通知を追加
+	if err := cs.recordMentions(ctx, tx, todo, comment); err != nil {
+		return http.StatusInternalServerError, &models.Comment{}, err
+	}
+	if err := recordActivity(ctx, tx, todo, userID, ActivityCommentUpdated); err != nil {
+		return http.StatusInternalServerError, &models.Comment{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, &models.Comment{}, err
+	}
+	return http.StatusOK, comment, nil
+}
+
+func (cs *commentService) DeleteComment(ctx context.Context, todoID int64, id int64, userID int64) (statusCode int64, err error) {
+	todo, err := cs.findVisibleTodo(ctx, todoID, userID)
+	if err != nil {
+		return http.StatusNotFound, err
+	}
+
+	// NOTE: コメントの削除は投稿者のみ可能
+	comment, err := models.Comments(qm.Where("id = ? AND todo_id = ? AND user_id = ?", id, todoID, userID)).One(ctx, cs.db)
+	if err != nil {
+		return http.StatusNotFound, err
+	}
+
+	tx, err := cs.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	defer tx.Rollback()
+
+	if _, err := models.MentionNotifications(qm.Where("comment_id = ?", comment.ID)).DeleteAll(ctx, tx); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	if _, err := comment.Delete(ctx, tx); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	if err := recordActivity(ctx, tx, todo, userID, ActivityCommentDeleted); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusOK, nil
+}
+
+// NOTE: 現状Todoを閲覧できるのは作成者のみ
+func (cs *commentService) findVisibleTodo(ctx context.Context, todoID int64, userID int64) (*models.Todo, error) {
+	return models.Todos(qm.Where("id = ? AND user_id = ?", todoID, userID)).One(ctx, cs.db)
+}
+
+func (cs *commentService) recordMentions(ctx context.Context, exec boil.ContextExecutor, todo *models.Todo, comment *models.Comment) error {
+	emails := parseMentions(comment.Body)
+	if len(emails) == 0 {
+		return nil
+	}
+
+	// NOTE: Todoを閲覧できるユーザのみメンション対象とする
+	mentionedUsers, err := models.Users(
+		qm.WhereIn("email IN ?", emails...),
+		qm.Where("id = ?", todo.UserID),
+	).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, mentionedUser := range mentionedUsers {
+		exists, err := models.MentionNotifications(qm.Where("comment_id = ? AND user_id = ?", comment.ID, mentionedUser.ID)).Exists(ctx, exec)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+
+		notification := &models.MentionNotification{UserID: int64(mentionedUser.ID), CommentID: comment.ID}
+		if err := notification.Insert(ctx, exec, boil.Infer()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseMentions(body string) []interface{} {
+	var emails []interface{}
+	seen := map[string]bool{}
+	for _, match := range mentionPattern.FindAllStringSubmatch(body, -1) {
+		if seen[match[1]] {
+			continue
+		}
+		seen[match[1]] = true
+		emails = append(emails, match[1])
+	}
+	return emails
+}
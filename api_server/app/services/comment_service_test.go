@@ -0,0 +1,182 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/test/factories"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type TestCommentServiceSuite struct {
+	WithDBSuite
+}
+
+var (
+	testCommentService CommentService
+	commentedTodo      *models.Todo
+)
+
+func (s *TestCommentServiceSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	// NOTE: コメント対象のTodoの作成
+	commentedTodo = &models.Todo{Title: "test title 1", Content: null.String{String: "test content 1", Valid: true}, UserID: int64(user.ID)}
+	if err := commentedTodo.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	testCommentService = NewCommentService(DBCon)
+}
+
+func (s *TestCommentServiceSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *TestCommentServiceSuite) TestCreateComment_StatusOk() {
+	requestParams := apis.PostTodoCommentsJSONRequestBody{Body: "test comment"}
+
+	statusCode, comment, err := testCommentService.CreateComment(ctx, commentedTodo.ID, requestParams, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "test comment", comment.Body)
+
+	// NOTE: コメントが作成されていることを確認
+	isExistComment, _ := models.Comments(qm.Where("todo_id = ?", commentedTodo.ID)).Exists(ctx, DBCon)
+	assert.True(s.T(), isExistComment)
+}
+
+func (s *TestCommentServiceSuite) TestCreateComment_WithMentions() {
+	// NOTE: Todoを閲覧できないユーザ
+	otherUser := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "other@example.com"}).(*models.User)
+	if err := otherUser.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create other user %v", err)
+	}
+	requestParams := apis.PostTodoCommentsJSONRequestBody{Body: "@test@example.com @other@example.com @unknown@example.com please check."}
+
+	statusCode, comment, err := testCommentService.CreateComment(ctx, commentedTodo.ID, requestParams, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+
+	// NOTE: Todoを閲覧できるユーザのみメンション通知が記録されていることを確認
+	notifications, _ := models.MentionNotifications(qm.Where("comment_id = ?", comment.ID)).All(ctx, DBCon)
+	assert.Len(s.T(), notifications, 1)
+	assert.Equal(s.T(), int64(user.ID), notifications[0].UserID)
+}
+
+func (s *TestCommentServiceSuite) TestCreateComment_ValidationError() {
+	requestParams := apis.PostTodoCommentsJSONRequestBody{Body: ""}
+
+	statusCode, _, err := testCommentService.CreateComment(ctx, commentedTodo.ID, requestParams, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), "body: コメントは必須入力です。.", err.Error())
+}
+
+func (s *TestCommentServiceSuite) TestCreateComment_NotFound() {
+	requestParams := apis.PostTodoCommentsJSONRequestBody{Body: "test comment"}
+
+	statusCode, _, err := testCommentService.CreateComment(ctx, commentedTodo.ID, requestParams, int64(user.ID+1))
+
+	assert.Equal(s.T(), int64(http.StatusNotFound), statusCode)
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestCommentServiceSuite) TestFetchCommentsList() {
+	for _, body := range []string{"comment 1", "comment 2", "comment 3"} {
+		testCommentService.CreateComment(ctx, commentedTodo.ID, apis.PostTodoCommentsJSONRequestBody{Body: body}, int64(user.ID))
+	}
+	limit := 2
+
+	statusCode, commentsList, nextCursor, err := testCommentService.FetchCommentsList(ctx, commentedTodo.ID, int64(user.ID), nil, &limit)
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), *commentsList, 2)
+	assert.Equal(s.T(), "comment 1", (*commentsList)[0].Body)
+	assert.NotNil(s.T(), nextCursor)
+
+	// NOTE: カーソル以降のコメントが取得できることを確認
+	statusCode, commentsList, nextCursor, err = testCommentService.FetchCommentsList(ctx, commentedTodo.ID, int64(user.ID), nextCursor, &limit)
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), *commentsList, 1)
+	assert.Equal(s.T(), "comment 3", (*commentsList)[0].Body)
+	assert.Nil(s.T(), nextCursor)
+}
+
+func (s *TestCommentServiceSuite) TestFetchCommentsList_InvalidCursor() {
+	cursor := "invalid"
+
+	statusCode, _, _, err := testCommentService.FetchCommentsList(ctx, commentedTodo.ID, int64(user.ID), &cursor, nil)
+
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestCommentServiceSuite) TestUpdateComment_StatusOk() {
+	_, comment, _ := testCommentService.CreateComment(ctx, commentedTodo.ID, apis.PostTodoCommentsJSONRequestBody{Body: "test comment"}, int64(user.ID))
+	requestParams := apis.PatchTodoCommentJSONRequestBody{Body: "updated comment @test@example.com"}
+
+	statusCode, updatedComment, err := testCommentService.UpdateComment(ctx, commentedTodo.ID, comment.ID, requestParams, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "updated comment @test@example.com", updatedComment.Body)
+
+	// NOTE: 編集で追加されたメンションが記録されていることを確認
+	isExistNotification, _ := models.MentionNotifications(qm.Where("comment_id = ?", comment.ID)).Exists(ctx, DBCon)
+	assert.True(s.T(), isExistNotification)
+}
+
+func (s *TestCommentServiceSuite) TestUpdateComment_NotFound() {
+	_, comment, _ := testCommentService.CreateComment(ctx, commentedTodo.ID, apis.PostTodoCommentsJSONRequestBody{Body: "test comment"}, int64(user.ID))
+	requestParams := apis.PatchTodoCommentJSONRequestBody{Body: "updated comment"}
+
+	statusCode, _, err := testCommentService.UpdateComment(ctx, commentedTodo.ID, comment.ID+1, requestParams, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusNotFound), statusCode)
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestCommentServiceSuite) TestDeleteComment_StatusOk() {
+	_, comment, _ := testCommentService.CreateComment(ctx, commentedTodo.ID, apis.PostTodoCommentsJSONRequestBody{Body: "@test@example.com"}, int64(user.ID))
+
+	statusCode, err := testCommentService.DeleteComment(ctx, commentedTodo.ID, comment.ID, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+
+	// NOTE: コメントとメンション通知が削除されていることを確認
+	isExistComment, _ := models.Comments(qm.Where("id = ?", comment.ID)).Exists(ctx, DBCon)
+	assert.False(s.T(), isExistComment)
+	isExistNotification, _ := models.MentionNotifications(qm.Where("comment_id = ?", comment.ID)).Exists(ctx, DBCon)
+	assert.False(s.T(), isExistNotification)
+}
+
+func (s *TestCommentServiceSuite) TestDeleteComment_NotFound() {
+	statusCode, err := testCommentService.DeleteComment(ctx, commentedTodo.ID, 1, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusNotFound), statusCode)
+	assert.NotNil(s.T(), err)
+}
+
+func TestCommentService(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestCommentServiceSuite))
+}
@@ -0,0 +1,136 @@
+package services
+
+import (
+	models "app/models/generated"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// EmailVerificationPolicy ... メールアドレスが未確認のユーザに対する制限
+type EmailVerificationPolicy string
+
+const (
+	// NOTE: 未確認でも全ての機能を利用できる
+	EmailVerificationPolicyNone EmailVerificationPolicy = "none"
+	// NOTE: 未確認の間は参照とアカウント関連の操作のみ利用できる
+	EmailVerificationPolicyRestrict EmailVerificationPolicy = "restrict"
+	// NOTE: 未確認の間はサインインできない
+	EmailVerificationPolicyBlock EmailVerificationPolicy = "block"
+)
+
+const (
+	EmailVerificationTokenLifetime = 24 * time.Hour
+	// NOTE: 確認メールを大量に送信させないよう、送信の間隔を空ける
+	EmailVerificationResendInterval = time.Minute
+	defaultEmailVerificationURL     = "http://localhost:3002/verifyEmail"
+)
+
+var errInvalidEmailVerificationToken = errors.New("メールアドレス確認用のURLが無効か、有効期限が切れています。")
+
+// CurrentEmailVerificationPolicy ... 未設定または不正な値の場合はrestrictとする
+func CurrentEmailVerificationPolicy() EmailVerificationPolicy {
+	switch policy := EmailVerificationPolicy(os.Getenv("EMAIL_VERIFICATION_POLICY")); policy {
+	case EmailVerificationPolicyNone, EmailVerificationPolicyRestrict, EmailVerificationPolicyBlock:
+		return policy
+	}
+	return EmailVerificationPolicyRestrict
+}
+
+type emailVerificationClaims struct {
+	UserID    int    `json:"user_id"`
+	Email     string `json:"email"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// NOTE: 確認用トークンは「クレーム.署名」の形式とし、DBには保存せずメールアドレスの一致で使い捨てにする
+func signEmailVerificationToken(userID int, email string, now time.Time) (string, error) {
+	claims, err := json.Marshal(emailVerificationClaims{UserID: userID, Email: email, ExpiresAt: now.Add(EmailVerificationTokenLifetime).Unix()})
+	if err != nil {
+		return "", err
+	}
+	payload := base64.RawURLEncoding.EncodeToString(claims)
+	return payload + "." + signEmailVerificationPayload(payload), nil
+}
+
+func verifyEmailVerificationToken(token string, now time.Time) (*emailVerificationClaims, error) {
+	payload, signature, found := strings.Cut(token, ".")
+	if !found || !hmac.Equal([]byte(signature), []byte(signEmailVerificationPayload(payload))) {
+		return nil, errInvalidEmailVerificationToken
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, errInvalidEmailVerificationToken
+	}
+	var claims emailVerificationClaims
+	if err := json.Unmarshal(decoded, &claims); err != nil {
+		return nil, errInvalidEmailVerificationToken
+	}
+	if now.Unix() > claims.ExpiresAt {
+		return nil, errInvalidEmailVerificationToken
+	}
+	return &claims, nil
+}
+
+func signEmailVerificationPayload(payload string) string {
+	mac := hmac.New(sha256.New, []byte(os.Getenv("EMAIL_VERIFICATION_TOKEN_KEY")))
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func emailVerificationURL(token string) string {
+	return envOrDefault("EMAIL_VERIFICATION_URL", defaultEmailVerificationURL) + "?token=" + url.QueryEscape(token)
+}
+
+func newEmailVerificationMailMessage(email string, token string) MailMessage {
+	body := fmt.Sprintf(`メールアドレスの確認をお願いします。
+以下のURLを開くと、このメールアドレスの確認が完了します。
+
+%s
+
+このURLの有効期限は%d時間です。
+お心当たりがない場合は、このメールを破棄してください。
+`, emailVerificationURL(token), int(EmailVerificationTokenLifetime.Hours()))
+	return MailMessage{To: email, Subject: "メールアドレス確認のお願い", Body: body}
+}
+
+func newEmailChangeNoticeMailMessage(email string, newEmail string) MailMessage {
+	body := fmt.Sprintf(`アカウントのメールアドレスを以下に変更する手続きを受け付けました。
+
+%s
+
+変更後のメールアドレスで確認が完了すると、このメールアドレスではサインインできなくなります。
+お心当たりがない場合は、すぐにパスワードを変更し、全ての端末からサインアウトしてください。
+`, newEmail)
+	return MailMessage{To: email, Subject: "メールアドレス変更のお知らせ", Body: body}
+}
+
+func canResendEmailVerification(user *models.User, now time.Time) bool {
+	return !user.EmailVerificationSentAt.Valid || now.Sub(user.EmailVerificationSentAt.Time) >= EmailVerificationResendInterval
+}
+
+// NOTE: 送信日時を記録してから確認メールを送信する
+func sendEmailVerificationMail(ctx context.Context, exec boil.ContextExecutor, mailer Mailer, user *models.User, email string, now time.Time) error {
+	token, err := signEmailVerificationToken(user.ID, email, now)
+	if err != nil {
+		return err
+	}
+
+	user.EmailVerificationSentAt = null.TimeFrom(now.Truncate(time.Second))
+	if _, err := user.Update(ctx, exec, boil.Whitelist("email_verification_sent_at", "updated_at")); err != nil {
+		return err
+	}
+	return mailer.Send(ctx, newEmailVerificationMailMessage(email, token))
+}
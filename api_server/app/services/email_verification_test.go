@@ -0,0 +1,63 @@
+package services
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyEmailVerificationToken(t *testing.T) {
+	t.Setenv("EMAIL_VERIFICATION_TOKEN_KEY", "test-email-verification-key")
+	now := time.Now()
+	token, err := signEmailVerificationToken(1, "test@example.com", now)
+	if err != nil {
+		t.Fatalf("failed to sign token %v", err)
+	}
+	payload, _, _ := strings.Cut(token, ".")
+
+	tests := []struct {
+		name  string
+		token string
+		now   time.Time
+		isOK  bool
+	}{
+		{name: "valid", token: token, now: now, isOK: true},
+		{name: "expired", token: token, now: now.Add(EmailVerificationTokenLifetime + time.Second), isOK: false},
+		{name: "tampered signature", token: payload + ".invalid", now: now, isOK: false},
+		{name: "no signature", token: payload, now: now, isOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := verifyEmailVerificationToken(tt.token, tt.now)
+			if !tt.isOK {
+				assert.Equal(t, errInvalidEmailVerificationToken, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, 1, claims.UserID)
+				assert.Equal(t, "test@example.com", claims.Email)
+			}
+		})
+	}
+
+	// NOTE: 別の鍵で署名されたトークンは利用できないことを確認
+	t.Setenv("EMAIL_VERIFICATION_TOKEN_KEY", "other-key")
+	_, err = verifyEmailVerificationToken(token, now)
+	assert.Equal(t, errInvalidEmailVerificationToken, err)
+}
+
+func TestCurrentEmailVerificationPolicy(t *testing.T) {
+	tests := map[string]EmailVerificationPolicy{
+		"":         EmailVerificationPolicyRestrict,
+		"none":     EmailVerificationPolicyNone,
+		"restrict": EmailVerificationPolicyRestrict,
+		"block":    EmailVerificationPolicyBlock,
+		"unknown":  EmailVerificationPolicyRestrict,
+	}
+	for value, expected := range tests {
+		t.Setenv("EMAIL_VERIFICATION_POLICY", value)
+		assert.Equal(t, expected, CurrentEmailVerificationPolicy(), value)
+	}
+}
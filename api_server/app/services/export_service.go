@@ -0,0 +1,221 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	ExportFormatCSV      = "csv"
+	ExportFormatJSON     = "json"
+	ExportFormatMarkdown = "markdown"
+)
+
+var exportFormats = []string{ExportFormatCSV, ExportFormatJSON, ExportFormatMarkdown}
+
+var exportFileExtensions = map[string]string{
+	ExportFormatCSV:      ".csv",
+	ExportFormatJSON:     ".json",
+	ExportFormatMarkdown: ".md",
+}
+
+// NOTE: 全件をメモリに載せないよう、この件数ずつ読み込んで書き出す
+const exportBatchSize = 500
+
+type ExportService interface {
+	ExportTodos(ctx context.Context, requestParams apis.GetExportsParams, userID int64) (statusCode int64, body io.ReadCloser, fileName string, err error)
+}
+
+type exportService struct {
+	db *sql.DB
+}
+
+func NewExportService(db *sql.DB) ExportService {
+	return &exportService{db}
+}
+
+// NOTE: JSON形式はインポート(importJSONDocument)でそのまま読み込める形とする
+type exportJSONTodo struct {
+	ID        int64     `json:"id"`
+	Title     string    `json:"title"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type todoExportWriter interface {
+	writeHeader() error
+	writeTodo(index int, todo *models.Todo) error
+	writeFooter() error
+}
+
+// NOTE: 書き出しは別goroutineで行い、呼び出し側が読み込んだ分だけレスポンスに流す
+func (es *exportService) ExportTodos(ctx context.Context, requestParams apis.GetExportsParams, userID int64) (statusCode int64, body io.ReadCloser, fileName string, err error) {
+	format := string(requestParams.Format)
+	if !slices.Contains(exportFormats, format) {
+		return http.StatusBadRequest, nil, "", errors.New("format must be one of " + strings.Join(exportFormats, ", "))
+	}
+
+	// NOTE: 絞り込み条件はGET /todosと同じものを使い、エラーは書き出しを始める前に返す
+	statusCode, queryMods, err := filteredTodoQueryMods(ctx, es.db, requestParams.Q, requestParams.FilterId, userID, time.Now())
+	if err != nil {
+		return statusCode, nil, "", err
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(es.writeTodos(ctx, format, queryMods, writer))
+	}()
+
+	fileName = "todos-" + time.Now().Format("20060102") + exportFileExtensions[format]
+	return http.StatusOK, reader, fileName, nil
+}
+
+func (es *exportService) writeTodos(ctx context.Context, format string, queryMods []qm.QueryMod, writer io.Writer) error {
+	bufferedWriter := bufio.NewWriter(writer)
+	exportWriter := newTodoExportWriter(format, bufferedWriter)
+
+	if err := exportWriter.writeHeader(); err != nil {
+		return err
+	}
+
+	// NOTE: IDをカーソルにして一定件数ずつ取得する
+	var lastID int64
+	index := 0
+	for {
+		batchQueryMods := append(slices.Clone(queryMods), qm.Where("id > ?", lastID), qm.OrderBy("id ASC"), qm.Limit(exportBatchSize))
+		todos, err := models.Todos(batchQueryMods...).All(ctx, es.db)
+		if err != nil {
+			return err
+		}
+
+		for _, todo := range todos {
+			if err := exportWriter.writeTodo(index, todo); err != nil {
+				return err
+			}
+			index++
+		}
+		if len(todos) < exportBatchSize {
+			break
+		}
+		lastID = todos[len(todos)-1].ID
+	}
+
+	if err := exportWriter.writeFooter(); err != nil {
+		return err
+	}
+	return bufferedWriter.Flush()
+}
+
+func newTodoExportWriter(format string, writer *bufio.Writer) todoExportWriter {
+	switch format {
+	case ExportFormatJSON:
+		return &jsonTodoExportWriter{writer}
+	case ExportFormatMarkdown:
+		return &markdownTodoExportWriter{writer}
+	}
+	return &csvTodoExportWriter{csv.NewWriter(writer)}
+}
+
+type csvTodoExportWriter struct {
+	writer *csv.Writer
+}
+
+func (w *csvTodoExportWriter) writeHeader() error {
+	return w.writer.Write([]string{"id", "title", "content", "createdAt", "updatedAt"})
+}
+
+func (w *csvTodoExportWriter) writeTodo(index int, todo *models.Todo) error {
+	return w.writer.Write([]string{
+		strconv.FormatInt(todo.ID, 10),
+		todo.Title,
+		todo.Content.String,
+		todo.CreatedAt.Format(time.RFC3339),
+		todo.UpdatedAt.Format(time.RFC3339),
+	})
+}
+
+func (w *csvTodoExportWriter) writeFooter() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+type jsonTodoExportWriter struct {
+	writer *bufio.Writer
+}
+
+func (w *jsonTodoExportWriter) writeHeader() error {
+	_, err := w.writer.WriteString(`{"todos":[`)
+	return err
+}
+
+func (w *jsonTodoExportWriter) writeTodo(index int, todo *models.Todo) error {
+	if index > 0 {
+		if err := w.writer.WriteByte(','); err != nil {
+			return err
+		}
+	}
+	todoJSON, err := json.Marshal(exportJSONTodo{
+		ID:        todo.ID,
+		Title:     todo.Title,
+		Content:   todo.Content.String,
+		CreatedAt: todo.CreatedAt,
+		UpdatedAt: todo.UpdatedAt,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = w.writer.Write(todoJSON)
+	return err
+}
+
+func (w *jsonTodoExportWriter) writeFooter() error {
+	_, err := w.writer.WriteString("]}\n")
+	return err
+}
+
+// NOTE: 完了状態を持たないため、全て未完了のタスクリストとして書き出す
+type markdownTodoExportWriter struct {
+	writer *bufio.Writer
+}
+
+func (w *markdownTodoExportWriter) writeHeader() error {
+	_, err := w.writer.WriteString("# Todos\n\n")
+	return err
+}
+
+func (w *markdownTodoExportWriter) writeTodo(index int, todo *models.Todo) error {
+	title := strings.Join(strings.Fields(todo.Title), " ")
+	if _, err := w.writer.WriteString("- [ ] " + title + "\n"); err != nil {
+		return err
+	}
+
+	// NOTE: 内容はリスト項目の子要素になるようインデントする
+	if todo.Content.String == "" {
+		return nil
+	}
+	for _, line := range strings.Split(strings.ReplaceAll(todo.Content.String, "\r\n", "\n"), "\n") {
+		if _, err := w.writer.WriteString(strings.TrimRight("  "+line, " ") + "\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *markdownTodoExportWriter) writeFooter() error {
+	return nil
+}
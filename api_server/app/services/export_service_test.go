@@ -0,0 +1,140 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/test/factories"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type TestExportServiceSuite struct {
+	WithDBSuite
+}
+
+var testExportService ExportService
+
+func (s *TestExportServiceSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	todoService := NewTodoService(DBCon)
+	todoService.CreateTodo(ctx, apis.PostTodosJSONRequestBody{Title: "test title 1", Content: "line 1\nline 2"}, int64(user.ID))
+	todoService.CreateTodo(ctx, apis.PostTodosJSONRequestBody{Title: "title, \"quoted\"", Content: ""}, int64(user.ID))
+
+	testExportService = NewExportService(DBCon)
+}
+
+func (s *TestExportServiceSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *TestExportServiceSuite) export(format string) (string, string) {
+	statusCode, body, fileName, err := testExportService.ExportTodos(ctx, apis.GetExportsParams{Format: apis.GetExportsParamsFormat(format)}, int64(user.ID))
+	if statusCode != http.StatusOK {
+		s.T().Fatalf("failed to export todos %v", err)
+	}
+	defer body.Close()
+
+	content, err := io.ReadAll(body)
+	if err != nil {
+		s.T().Fatalf("failed to read export %v", err)
+	}
+	return string(content), fileName
+}
+
+func (s *TestExportServiceSuite) TestExportTodos_CSV() {
+	content, fileName := s.export("csv")
+
+	assert.True(s.T(), strings.HasSuffix(fileName, ".csv"))
+	lines := strings.Split(content, "\n")
+	assert.Equal(s.T(), "id,title,content,createdAt,updatedAt", lines[0])
+	assert.Contains(s.T(), content, ",\"title, \"\"quoted\"\"\",,")
+
+	// NOTE: CSVのインポートでそのまま読み込めること
+	rows, err := parseImportRows(importFormatCSV, content)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []importRow{{Row: 1, Title: "test title 1", Content: "line 1\nline 2"}, {Row: 2, Title: "title, \"quoted\"", Content: ""}}, rows)
+}
+
+func (s *TestExportServiceSuite) TestExportTodos_JSON() {
+	content, fileName := s.export("json")
+
+	assert.True(s.T(), strings.HasSuffix(fileName, ".json"))
+	assert.True(s.T(), strings.HasPrefix(content, `{"todos":[{"id":`))
+
+	// NOTE: JSONのインポートでそのまま読み込めること
+	rows, err := parseImportRows(importFormatJSON, content)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []importRow{{Row: 1, Title: "test title 1", Content: "line 1\nline 2"}, {Row: 2, Title: "title, \"quoted\"", Content: ""}}, rows)
+}
+
+func (s *TestExportServiceSuite) TestExportTodos_Markdown() {
+	content, fileName := s.export("markdown")
+
+	assert.True(s.T(), strings.HasSuffix(fileName, ".md"))
+	assert.Equal(s.T(), "# Todos\n\n- [ ] test title 1\n  line 1\n  line 2\n- [ ] title, \"quoted\"\n", content)
+}
+
+func (s *TestExportServiceSuite) TestExportTodos_Empty() {
+	statusCode, body, _, _ := testExportService.ExportTodos(ctx, apis.GetExportsParams{Format: "json"}, int64(user.ID)+1)
+	content, _ := io.ReadAll(body)
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Equal(s.T(), "{\"todos\":[]}\n", string(content))
+}
+
+func (s *TestExportServiceSuite) TestExportTodos_InvalidFormat() {
+	statusCode, _, _, err := testExportService.ExportTodos(ctx, apis.GetExportsParams{Format: "xlsx"}, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), "format must be one of csv, json, markdown", err.Error())
+}
+
+func (s *TestExportServiceSuite) TestExportTodos_Filtered() {
+	q := "title:quoted"
+	statusCode, body, _, err := testExportService.ExportTodos(ctx, apis.GetExportsParams{Format: "markdown", Q: &q}, int64(user.ID))
+	content, _ := io.ReadAll(body)
+
+	// NOTE: GET /todosと同じ条件で絞り込まれること
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "# Todos\n\n- [ ] title, \"quoted\"\n", string(content))
+
+	savedFilter := &models.SavedFilter{UserID: int64(user.ID), Name: "test filter", Query: "content:line"}
+	if err := savedFilter.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test saved filter %v", err)
+	}
+	filterID := int(savedFilter.ID)
+	statusCode, body, _, _ = testExportService.ExportTodos(ctx, apis.GetExportsParams{Format: "markdown", FilterId: &filterID}, int64(user.ID))
+	content, _ = io.ReadAll(body)
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Equal(s.T(), "# Todos\n\n- [ ] test title 1\n  line 1\n  line 2\n", string(content))
+}
+
+func (s *TestExportServiceSuite) TestExportTodos_InvalidFilter() {
+	q := "created>abc"
+	statusCode, _, _, _ := testExportService.ExportTodos(ctx, apis.GetExportsParams{Format: "csv", Q: &q}, int64(user.ID))
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+
+	// NOTE: 他のユーザの保存済みフィルタは使えないこと
+	filterID := 0
+	statusCode, _, _, _ = testExportService.ExportTodos(ctx, apis.GetExportsParams{Format: "csv", FilterId: &filterID}, int64(user.ID))
+	assert.Equal(s.T(), int64(http.StatusNotFound), statusCode)
+}
+
+func TestExportService(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestExportServiceSuite))
+}
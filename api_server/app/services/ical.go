@@ -0,0 +1,170 @@
+package services
+
+import (
+	models "app/models/generated"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// NOTE: RFC 5545のうち、Todoに対応するVTODOのUID・SUMMARY・DESCRIPTIONのみ読み書きする
+// 	   : 完了状態・期限は保存できないため、UnsupportedPropertiesに記録して呼び出し元で拒否する
+type icalTodo struct {
+	UID                   string
+	Summary               string
+	Description           string
+	UnsupportedProperties []string
+}
+
+const (
+	icalProductID = "-//tanstack-query-practice//CalDAV//JA"
+	// NOTE: 1行の最大オクテット数(改行を除く)
+	icalMaxLineOctets = 75
+)
+
+func encodeICalTodo(uid string, todo *models.Todo) string {
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:" + icalProductID,
+		"BEGIN:VTODO",
+		"UID:" + escapeICalText(uid),
+		"DTSTAMP:" + formatICalTime(todo.UpdatedAt),
+		"CREATED:" + formatICalTime(todo.CreatedAt),
+		"LAST-MODIFIED:" + formatICalTime(todo.UpdatedAt),
+		"SUMMARY:" + escapeICalText(todo.Title),
+	}
+	if todo.Content.String != "" {
+		lines = append(lines, "DESCRIPTION:"+escapeICalText(todo.Content.String))
+	}
+	lines = append(lines, "END:VTODO", "END:VCALENDAR")
+
+	var builder strings.Builder
+	for _, line := range lines {
+		builder.WriteString(foldICalLine(line))
+		builder.WriteString("\r\n")
+	}
+	return builder.String()
+}
+
+func parseICalTodo(data string) (*icalTodo, error) {
+	// NOTE: 折り返された行を元に戻す
+	unfolded := strings.NewReplacer("\r\n ", "", "\r\n\t", "", "\n ", "", "\n\t", "").Replace(data)
+	lines := strings.Split(strings.ReplaceAll(unfolded, "\r\n", "\n"), "\n")
+
+	var components []string
+	var todo *icalTodo
+	for _, line := range lines {
+		if line == "" {
+			continue
+		}
+		name, value, found := cutICalProperty(line)
+		if !found {
+			return nil, errors.New("invalid iCalendar line")
+		}
+
+		switch name {
+		case "BEGIN":
+			component := strings.ToUpper(value)
+			if len(components) == 0 && component != "VCALENDAR" {
+				return nil, errors.New("VCALENDAR is required")
+			}
+			if component == "VTODO" && len(components) == 1 {
+				if todo != nil {
+					return nil, errors.New("only one VTODO is allowed")
+				}
+				todo = &icalTodo{}
+			}
+			components = append(components, component)
+			continue
+		case "END":
+			if len(components) == 0 || components[len(components)-1] != strings.ToUpper(value) {
+				return nil, errors.New("unbalanced END")
+			}
+			components = components[:len(components)-1]
+			continue
+		}
+
+		// NOTE: VALARM等、VTODO内のサブコンポーネントのプロパティは無視
+		if todo == nil || len(components) != 2 || components[1] != "VTODO" {
+			continue
+		}
+		switch name {
+		case "UID":
+			todo.UID = unescapeICalText(value)
+		case "SUMMARY":
+			todo.Summary = unescapeICalText(value)
+		case "DESCRIPTION":
+			todo.Description = unescapeICalText(value)
+		case "STATUS":
+			// NOTE: 未完了を表す値は、Todoの状態と同じため受け付ける
+			if status := strings.ToUpper(value); status != "NEEDS-ACTION" && status != "IN-PROCESS" {
+				todo.UnsupportedProperties = append(todo.UnsupportedProperties, name)
+			}
+		case "COMPLETED", "DUE":
+			todo.UnsupportedProperties = append(todo.UnsupportedProperties, name)
+		}
+	}
+
+	if len(components) != 0 {
+		return nil, errors.New("unterminated component")
+	}
+	if todo == nil {
+		return nil, errors.New("VTODO is required")
+	}
+	if todo.UID == "" {
+		return nil, errors.New("UID is required")
+	}
+	return todo, nil
+}
+
+// NOTE: 「名前;パラメータ:値」から名前と値を取り出す。パラメータ中の引用符内の「:」は区切りとみなさない
+func cutICalProperty(line string) (name string, value string, found bool) {
+	inQuote := false
+	for i, r := range line {
+		switch {
+		case r == '"':
+			inQuote = !inQuote
+		case r == ':' && !inQuote:
+			name, _, _ = strings.Cut(line[:i], ";")
+			return strings.ToUpper(name), line[i+1:], true
+		}
+	}
+	return "", "", false
+}
+
+func escapeICalText(text string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(text)
+}
+
+func unescapeICalText(text string) string {
+	return strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n").Replace(text)
+}
+
+func foldICalLine(line string) string {
+	if len(line) <= icalMaxLineOctets {
+		return line
+	}
+
+	var builder strings.Builder
+	limit := icalMaxLineOctets
+	for len(line) > limit {
+		// NOTE: マルチバイト文字の途中で折り返さない
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		builder.WriteString(line[:cut])
+		builder.WriteString("\r\n ")
+		line = line[cut:]
+		// NOTE: 継続行は先頭の空白を含めて75オクテット以内
+		limit = icalMaxLineOctets - 1
+	}
+	builder.WriteString(line)
+	return builder.String()
+}
+
+func formatICalTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
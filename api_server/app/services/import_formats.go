@@ -0,0 +1,167 @@
+package services
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+)
+
+const (
+	importFormatCSV     = "csv"
+	importFormatJSON    = "json"
+	importFormatTodoist = "todoist"
+	importFormatTrello  = "trello"
+)
+
+var importFormats = []string{
+	importFormatCSV,
+	importFormatJSON,
+	importFormatTodoist,
+	importFormatTrello,
+}
+
+// NOTE: Rowはエラーレポートで該当箇所を示すための元ファイル上の位置(CSVはヘッダを除いたレコード番号、JSONは配列の1始まりの位置)
+type importRow struct {
+	Row     int
+	Title   string
+	Content string
+}
+
+// NOTE: 自前のJSON形式。エクスポートしたファイルをそのままインポートできるよう、未知のフィールドは無視する
+type importJSONDocument struct {
+	Todos []struct {
+		Title   string `json:"title"`
+		Content string `json:"content"`
+	} `json:"todos"`
+}
+
+// NOTE: Trelloのボードのエクスポートのうち、カードのみを扱う
+type importTrelloBoard struct {
+	Cards []struct {
+		Name   string `json:"name"`
+		Desc   string `json:"desc"`
+		Closed bool   `json:"closed"`
+	} `json:"cards"`
+}
+
+func parseImportRows(format string, payload string) ([]importRow, error) {
+	switch format {
+	case importFormatCSV:
+		return parseCSVImportRows(payload)
+	case importFormatJSON:
+		return parseJSONImportRows(payload)
+	case importFormatTodoist:
+		return parseTodoistImportRows(payload)
+	case importFormatTrello:
+		return parseTrelloImportRows(payload)
+	}
+	return nil, errors.New("unsupported import format: " + format)
+}
+
+// NOTE: ヘッダ行のtitle, content列を読み込む(列の順序・大文字小文字は問わない)
+func parseCSVImportRows(payload string) ([]importRow, error) {
+	header, records, err := readImportCSV(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	titleIndex, contentIndex := csvColumnIndex(header, "title"), csvColumnIndex(header, "content")
+	if titleIndex < 0 {
+		return nil, errors.New("CSVにtitle列がありません。")
+	}
+
+	rows := []importRow{}
+	for i, record := range records {
+		rows = append(rows, importRow{Row: i + 1, Title: csvField(record, titleIndex), Content: csvField(record, contentIndex)})
+	}
+	return rows, nil
+}
+
+func parseJSONImportRows(payload string) ([]importRow, error) {
+	var document importJSONDocument
+	if err := json.Unmarshal([]byte(payload), &document); err != nil {
+		return nil, errors.New("JSONの形式が正しくありません: " + err.Error())
+	}
+
+	rows := []importRow{}
+	for i, todo := range document.Todos {
+		rows = append(rows, importRow{Row: i + 1, Title: todo.Title, Content: todo.Content})
+	}
+	return rows, nil
+}
+
+// NOTE: TodoistのCSVエクスポートのうち、TYPEがtaskの行のみ取り込む(section, noteは対象外)
+func parseTodoistImportRows(payload string) ([]importRow, error) {
+	header, records, err := readImportCSV(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	typeIndex, contentIndex, descriptionIndex := csvColumnIndex(header, "type"), csvColumnIndex(header, "content"), csvColumnIndex(header, "description")
+	if typeIndex < 0 || contentIndex < 0 {
+		return nil, errors.New("TodoistのCSVにTYPE列またはCONTENT列がありません。")
+	}
+
+	rows := []importRow{}
+	for i, record := range records {
+		if !strings.EqualFold(csvField(record, typeIndex), "task") {
+			continue
+		}
+		rows = append(rows, importRow{Row: i + 1, Title: csvField(record, contentIndex), Content: csvField(record, descriptionIndex)})
+	}
+	return rows, nil
+}
+
+// NOTE: アーカイブ済みのカードは取り込まない
+func parseTrelloImportRows(payload string) ([]importRow, error) {
+	var board importTrelloBoard
+	if err := json.Unmarshal([]byte(payload), &board); err != nil {
+		return nil, errors.New("TrelloのJSONの形式が正しくありません: " + err.Error())
+	}
+
+	rows := []importRow{}
+	for i, card := range board.Cards {
+		if card.Closed {
+			continue
+		}
+		rows = append(rows, importRow{Row: i + 1, Title: card.Name, Content: card.Desc})
+	}
+	return rows, nil
+}
+
+func readImportCSV(payload string) ([]string, [][]string, error) {
+	reader := csv.NewReader(strings.NewReader(strings.TrimPrefix(payload, "\ufeff")))
+	reader.FieldsPerRecord = -1
+
+	headerRecord, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil, errors.New("CSVにヘッダ行がありません。")
+	}
+	if err != nil {
+		return nil, nil, errors.New("CSVの形式が正しくありません: " + err.Error())
+	}
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, nil, errors.New("CSVの形式が正しくありません: " + err.Error())
+	}
+	return headerRecord, records, nil
+}
+
+// NOTE: 存在しない列は-1を返す
+func csvColumnIndex(header []string, name string) int {
+	for i, column := range header {
+		if strings.EqualFold(strings.TrimSpace(column), name) {
+			return i
+		}
+	}
+	return -1
+}
+
+func csvField(record []string, index int) string {
+	if index < 0 || index >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[index])
+}
@@ -0,0 +1,242 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/validator"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log"
+	"net/http"
+	"sort"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	importStatusQueued     = "queued"
+	importStatusProcessing = "processing"
+	importStatusCompleted  = "completed"
+	importStatusFailed     = "failed"
+)
+
+const (
+	importWorkerInterval  = 5 * time.Second
+	importWorkerBatchSize = 10
+	// NOTE: 進捗を保存する間隔(行数)
+	importProgressInterval = 20
+	// NOTE: 処理中のワーカーは進捗の保存ごとに期限を延長し、期限切れのものは中断されたとみなす
+	importLeaseDuration = 5 * time.Minute
+)
+
+type ImportService interface {
+	CreateImport(ctx context.Context, requestParams apis.PostImportsMultipartRequestBody, userID int64) (statusCode int64, importJob *models.Import, err error)
+	ShowImport(ctx context.Context, id int64, userID int64) (statusCode int64, importJob *models.Import, err error)
+	ProcessPendingImports(ctx context.Context) error
+}
+
+type importService struct {
+	db          *sql.DB
+	todoService TodoService
+}
+
+// NOTE: 各行はTodoServiceを通して作成し、通常の作成と同じバリデーション・アクティビティ記録を行う
+func NewImportService(db *sql.DB, todoService TodoService) ImportService {
+	return &importService{db, todoService}
+}
+
+type importRowError struct {
+	Row      int      `json:"row"`
+	Messages []string `json:"messages"`
+}
+
+func (is *importService) CreateImport(ctx context.Context, requestParams apis.PostImportsMultipartRequestBody, userID int64) (statusCode int64, importJob *models.Import, err error) {
+	// NOTE: バリデーションチェック
+	validationErrors := validator.ValidateCreateImport(&requestParams, importFormats)
+	if validationErrors != nil {
+		return http.StatusBadRequest, &models.Import{}, validationErrors
+	}
+
+	payload, err := requestParams.File.Bytes()
+	if err != nil {
+		return http.StatusInternalServerError, &models.Import{}, err
+	}
+
+	importJob = &models.Import{}
+	importJob.UserID = userID
+	importJob.Format = requestParams.Format
+	importJob.Status = importStatusQueued
+	importJob.Payload = string(payload)
+	importJob.RowErrors = "[]"
+	if err := importJob.Insert(ctx, is.db, boil.Infer()); err != nil {
+		return http.StatusInternalServerError, &models.Import{}, err
+	}
+	return http.StatusOK, importJob, nil
+}
+
+func (is *importService) ShowImport(ctx context.Context, id int64, userID int64) (statusCode int64, importJob *models.Import, err error) {
+	importJob, err = models.Imports(qm.Where("id = ? AND user_id = ?", id, userID)).One(ctx, is.db)
+	if err != nil {
+		return http.StatusNotFound, &models.Import{}, err
+	}
+	return http.StatusOK, importJob, nil
+}
+
+func (is *importService) ProcessPendingImports(ctx context.Context) error {
+	if err := is.failStaleImports(ctx); err != nil {
+		return err
+	}
+
+	importJobs, err := models.Imports(
+		qm.Where("status = ?", importStatusQueued),
+		qm.OrderBy("id ASC"),
+		qm.Limit(importWorkerBatchSize),
+	).All(ctx, is.db)
+	if err != nil {
+		return err
+	}
+
+	for _, importJob := range importJobs {
+		// NOTE: 複数のワーカーで同じインポートを処理しないよう、ステータスの更新に成功した場合のみ処理する
+		lockedUntil := time.Now().Add(importLeaseDuration)
+		rowsAff, err := models.Imports(qm.Where("id = ? AND status = ?", importJob.ID, importStatusQueued)).UpdateAll(ctx, is.db, models.M{"status": importStatusProcessing, "locked_until": lockedUntil})
+		if err != nil {
+			return err
+		}
+		if rowsAff == 0 {
+			continue
+		}
+		importJob.Status = importStatusProcessing
+		importJob.LockedUntil = null.TimeFrom(lockedUntil)
+
+		// NOTE: 途中でエラーになった場合も処理中のまま残さず、失敗として終了させる
+		if err := is.processImport(ctx, importJob); err != nil {
+			if finishErr := is.finishImport(ctx, importJob, importStatusFailed, err.Error()); finishErr != nil {
+				log.Printf("failed to mark import %d as failed: %v", importJob.ID, finishErr)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// NOTE: ワーカーの停止などで期限内に進捗が保存されなかったインポートは、作成済みのTodoが重複しないよう再処理せず失敗とする
+func (is *importService) failStaleImports(ctx context.Context) error {
+	_, err := models.Imports(qm.Where("status = ? AND locked_until < ?", importStatusProcessing, time.Now())).UpdateAll(ctx, is.db, models.M{
+		"status":        importStatusFailed,
+		"payload":       "",
+		"error_message": "インポートの処理が中断されました。取り込まれなかった行を再度インポートしてください。",
+		"locked_until":  nil,
+		"updated_at":    time.Now(),
+	})
+	return err
+}
+
+func (is *importService) processImport(ctx context.Context, importJob *models.Import) error {
+	rows, err := parseImportRows(importJob.Format, importJob.Payload)
+	if err != nil {
+		return is.finishImport(ctx, importJob, importStatusFailed, err.Error())
+	}
+
+	importJob.TotalRows = len(rows)
+	if _, err := importJob.Update(ctx, is.db, boil.Infer()); err != nil {
+		return err
+	}
+
+	rowErrors := []importRowError{}
+	for i, row := range rows {
+		requestParams := apis.PostTodosJSONRequestBody{Title: row.Title, Content: row.Content}
+		statusCode, _, err := is.todoService.CreateTodo(ctx, requestParams, importJob.UserID)
+		switch statusCode {
+		case http.StatusBadRequest:
+			rowErrors = append(rowErrors, importRowError{Row: row.Row, Messages: validationErrorMessages(err)})
+			importJob.SkippedRows++
+		case http.StatusInternalServerError:
+			if err := is.saveRowErrors(importJob, rowErrors); err != nil {
+				return err
+			}
+			return is.finishImport(ctx, importJob, importStatusFailed, err.Error())
+		default:
+			importJob.ImportedRows++
+		}
+		importJob.ProcessedRows++
+
+		if (i+1)%importProgressInterval == 0 {
+			if err := is.saveRowErrors(importJob, rowErrors); err != nil {
+				return err
+			}
+			importJob.LockedUntil = null.TimeFrom(time.Now().Add(importLeaseDuration))
+			if _, err := importJob.Update(ctx, is.db, boil.Infer()); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := is.saveRowErrors(importJob, rowErrors); err != nil {
+		return err
+	}
+	return is.finishImport(ctx, importJob, importStatusCompleted, "")
+}
+
+// NOTE: 処理済みのファイルは再利用しないため、終了時に破棄する
+func (is *importService) finishImport(ctx context.Context, importJob *models.Import, status string, errorMessage string) error {
+	importJob.Status = status
+	importJob.Payload = ""
+	importJob.LockedUntil = null.Time{}
+	if errorMessage != "" {
+		importJob.ErrorMessage = null.StringFrom(errorMessage)
+	}
+	_, err := importJob.Update(ctx, is.db, boil.Infer())
+	return err
+}
+
+func (is *importService) saveRowErrors(importJob *models.Import, rowErrors []importRowError) error {
+	rowErrorsJSON, err := json.Marshal(rowErrors)
+	if err != nil {
+		return err
+	}
+	importJob.RowErrors = string(rowErrorsJSON)
+	return nil
+}
+
+// NOTE: バリデーションエラーをフィールド名順のメッセージの一覧にする
+func validationErrorMessages(err error) []string {
+	validationErrors, ok := err.(validation.Errors)
+	if !ok {
+		return []string{err.Error()}
+	}
+
+	fields := make([]string, 0, len(validationErrors))
+	for field := range validationErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	messages := make([]string, 0, len(fields))
+	for _, field := range fields {
+		messages = append(messages, validationErrors[field].Error())
+	}
+	return messages
+}
+
+// NOTE: 登録待ちのインポートを定期的にポーリングして処理するワーカー
+func RunImportWorker(ctx context.Context, importService ImportService) {
+	ticker := time.NewTicker(importWorkerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := importService.ProcessPendingImports(ctx); err != nil {
+				log.Println("failed to process imports:", err)
+			}
+		}
+	}
+}
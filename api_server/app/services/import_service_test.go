@@ -0,0 +1,178 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/test/factories"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+type TestImportServiceSuite struct {
+	WithDBSuite
+}
+
+var testImportService ImportService
+
+func (s *TestImportServiceSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	testImportService = NewImportService(DBCon, NewTodoService(DBCon))
+}
+
+func (s *TestImportServiceSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *TestImportServiceSuite) importFile(format string, content string) *models.Import {
+	requestParams := apis.PostImportsMultipartRequestBody{Format: format}
+	requestParams.File.InitFromBytes([]byte(content), "import."+format)
+
+	statusCode, importJob, err := testImportService.CreateImport(ctx, requestParams, int64(user.ID))
+	if statusCode != http.StatusOK {
+		s.T().Fatalf("failed to create test import %v", err)
+	}
+	if err := testImportService.ProcessPendingImports(ctx); err != nil {
+		s.T().Fatalf("failed to process imports %v", err)
+	}
+
+	_, importJob, _ = testImportService.ShowImport(ctx, importJob.ID, int64(user.ID))
+	return importJob
+}
+
+func (s *TestImportServiceSuite) fetchTodoTitles() []string {
+	todos, _ := models.Todos(qm.Where("user_id = ?", user.ID), qm.OrderBy("id ASC")).All(ctx, DBCon)
+	var titles []string
+	for _, todo := range todos {
+		titles = append(titles, todo.Title)
+	}
+	return titles
+}
+
+func (s *TestImportServiceSuite) TestCreateImport() {
+	requestParams := apis.PostImportsMultipartRequestBody{Format: "csv"}
+	requestParams.File.InitFromBytes([]byte("title,content\ntest title 1,test content 1\n"), "todos.csv")
+
+	statusCode, importJob, err := testImportService.CreateImport(ctx, requestParams, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), importStatusQueued, importJob.Status)
+	// NOTE: ワーカーが処理するまでTodoは作成されないこと
+	assert.Len(s.T(), s.fetchTodoTitles(), 0)
+}
+
+func (s *TestImportServiceSuite) TestCreateImport_ValidationError() {
+	requestParams := apis.PostImportsMultipartRequestBody{Format: "xlsx", File: openapi_types.File{}}
+
+	statusCode, _, err := testImportService.CreateImport(ctx, requestParams, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), "file: インポートファイルは必須入力です。; format: 形式はcsv, json, todoist, trelloのいずれかでお願いします。.", err.Error())
+}
+
+func (s *TestImportServiceSuite) TestProcessImport_CSV() {
+	// NOTE: 2行目はタイトルが空のためスキップされる
+	importJob := s.importFile("csv", "Content,Title\r\ncontent 1,title 1\r\ncontent 2,\r\n\"multi\nline\",\"title, 3\"\r\n")
+
+	assert.Equal(s.T(), importStatusCompleted, importJob.Status)
+	assert.Equal(s.T(), 3, importJob.TotalRows)
+	assert.Equal(s.T(), 3, importJob.ProcessedRows)
+	assert.Equal(s.T(), 2, importJob.ImportedRows)
+	assert.Equal(s.T(), 1, importJob.SkippedRows)
+	assert.JSONEq(s.T(), `[{"row":2,"messages":["タイトルは必須入力です。"]}]`, importJob.RowErrors)
+	assert.Equal(s.T(), "", importJob.Payload)
+	assert.Equal(s.T(), []string{"title 1", "title, 3"}, s.fetchTodoTitles())
+}
+
+func (s *TestImportServiceSuite) TestProcessImport_JSON() {
+	importJob := s.importFile("json", `{"todos":[{"id":1,"title":"title 1","content":"content 1"},{"title":"`+strings.Repeat("a", 51)+`"}]}`)
+
+	assert.Equal(s.T(), importStatusCompleted, importJob.Status)
+	assert.Equal(s.T(), 1, importJob.ImportedRows)
+
+	var rowErrors []importRowError
+	json.Unmarshal([]byte(importJob.RowErrors), &rowErrors)
+	assert.Equal(s.T(), []importRowError{{Row: 2, Messages: []string{"タイトルは1 ~ 50文字での入力をお願いします。"}}}, rowErrors)
+
+	todo, _ := models.Todos(qm.Where("user_id = ?", user.ID)).One(ctx, DBCon)
+	assert.Equal(s.T(), "content 1", todo.Content.String)
+}
+
+func (s *TestImportServiceSuite) TestProcessImport_Todoist() {
+	payload := "TYPE,CONTENT,DESCRIPTION,PRIORITY,INDENT,AUTHOR,RESPONSIBLE,DATE,DATE_LANG,TIMEZONE\n" +
+		"section,Section 1,,,,,,,,\n" +
+		"task,Buy milk,2 bottles,4,1,user,,,en,Asia/Tokyo\n" +
+		"note,a note,,,,,,,,\n"
+	importJob := s.importFile("todoist", payload)
+
+	assert.Equal(s.T(), importStatusCompleted, importJob.Status)
+	assert.Equal(s.T(), 1, importJob.TotalRows)
+	assert.Equal(s.T(), []string{"Buy milk"}, s.fetchTodoTitles())
+}
+
+func (s *TestImportServiceSuite) TestProcessImport_Trello() {
+	importJob := s.importFile("trello", `{"name":"board","lists":[{"id":"l1","name":"To Do"}],"cards":[{"name":"card 1","desc":"desc 1","closed":false},{"name":"archived","closed":true}]}`)
+
+	assert.Equal(s.T(), importStatusCompleted, importJob.Status)
+	assert.Equal(s.T(), 1, importJob.TotalRows)
+	assert.Equal(s.T(), []string{"card 1"}, s.fetchTodoTitles())
+}
+
+func (s *TestImportServiceSuite) TestProcessImport_Failed() {
+	importJob := s.importFile("json", `{"todos":`)
+
+	assert.Equal(s.T(), importStatusFailed, importJob.Status)
+	assert.True(s.T(), strings.HasPrefix(importJob.ErrorMessage.String, "JSONの形式が正しくありません"))
+	assert.Len(s.T(), s.fetchTodoTitles(), 0)
+}
+
+func (s *TestImportServiceSuite) TestProcessImport_StaleProcessing() {
+	// NOTE: 処理中にワーカーが停止し、期限が切れたインポートと期限内のインポート
+	staleImport := &models.Import{UserID: int64(user.ID), Format: "csv", Status: importStatusProcessing, Payload: "title,content\ntodo,content", RowErrors: "[]", ProcessedRows: 20, LockedUntil: null.TimeFrom(time.Now().Add(-time.Minute))}
+	activeImport := &models.Import{UserID: int64(user.ID), Format: "csv", Status: importStatusProcessing, Payload: "title,content\ntodo,content", RowErrors: "[]", LockedUntil: null.TimeFrom(time.Now().Add(time.Minute))}
+	for _, importJob := range []*models.Import{staleImport, activeImport} {
+		if err := importJob.Insert(ctx, DBCon, boil.Infer()); err != nil {
+			s.T().Fatalf("failed to create test import %v", err)
+		}
+	}
+
+	err := testImportService.ProcessPendingImports(ctx)
+
+	assert.Nil(s.T(), err)
+	_, staleImport, _ = testImportService.ShowImport(ctx, staleImport.ID, int64(user.ID))
+	assert.Equal(s.T(), importStatusFailed, staleImport.Status)
+	assert.Equal(s.T(), "インポートの処理が中断されました。取り込まれなかった行を再度インポートしてください。", staleImport.ErrorMessage.String)
+	assert.Equal(s.T(), 20, staleImport.ProcessedRows)
+	_, activeImport, _ = testImportService.ShowImport(ctx, activeImport.ID, int64(user.ID))
+	assert.Equal(s.T(), importStatusProcessing, activeImport.Status)
+	assert.Len(s.T(), s.fetchTodoTitles(), 0)
+}
+
+func (s *TestImportServiceSuite) TestShowImport_NotFound() {
+	statusCode, _, _ := testImportService.ShowImport(ctx, 0, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusNotFound), statusCode)
+}
+
+func TestImportService(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestImportServiceSuite))
+}
@@ -0,0 +1,115 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	defaultSMTPHost = "localhost"
+	defaultSMTPPort = "1025"
+	defaultMailFrom = "no-reply@example.com"
+)
+
+// MailMessage ... 送信するメールの内容
+type MailMessage struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer ... メールの送信手段を差し替えられるようにする
+type Mailer interface {
+	Send(ctx context.Context, message MailMessage) error
+}
+
+type smtpMailer struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+// NewSMTPMailer ... usernameが空の場合は認証なしで送信する
+func NewSMTPMailer(host string, port string, username string, password string, from string) Mailer {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+	return &smtpMailer{addr: net.JoinHostPort(host, port), from: from, auth: auth}
+}
+
+// NewSMTPMailerFromEnv ... 未設定の項目はローカルのSMTPサーバを想定した値を利用する
+func NewSMTPMailerFromEnv() Mailer {
+	return NewSMTPMailer(
+		envOrDefault("SMTP_HOST", defaultSMTPHost),
+		envOrDefault("SMTP_PORT", defaultSMTPPort),
+		os.Getenv("SMTP_USERNAME"),
+		os.Getenv("SMTP_PASSWORD"),
+		envOrDefault("MAIL_FROM", defaultMailFrom),
+	)
+}
+
+func (sm *smtpMailer) Send(ctx context.Context, message MailMessage) error {
+	return smtp.SendMail(sm.addr, sm.auth, sm.from, []string{message.To}, buildMailData(sm.from, message, time.Now()))
+}
+
+// NOTE: 件名・本文に日本語を含むため、件名はMIMEエンコードし、本文はbase64で送信する
+func buildMailData(from string, message MailMessage, now time.Time) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", message.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.BEncoding.Encode("UTF-8", message.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: base64\r\n")
+	buf.WriteString("\r\n")
+
+	encoded := base64.StdEncoding.EncodeToString([]byte(message.Body))
+	for len(encoded) > 76 {
+		buf.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	buf.WriteString(encoded + "\r\n")
+	return buf.Bytes()
+}
+
+func envOrDefault(key string, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// MemoryMailer ... 送信したメールをプロセス内に保持するため、テストでの利用を想定
+type MemoryMailer struct {
+	mu       sync.Mutex
+	messages []MailMessage
+}
+
+func NewMemoryMailer() *MemoryMailer {
+	return &MemoryMailer{}
+}
+
+func (mm *MemoryMailer) Send(ctx context.Context, message MailMessage) error {
+	mm.mu.Lock()
+	defer mm.mu.Unlock()
+
+	mm.messages = append(mm.messages, message)
+	return nil
+}
+
+func (mm *MemoryMailer) Messages() []MailMessage {
+	mm.mu.Lock()
+	defer mm.mu.Unlock()
+
+	return append([]MailMessage{}, mm.messages...)
+}
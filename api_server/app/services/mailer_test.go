@@ -0,0 +1,110 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"io"
+	"mime"
+	"net"
+	"net/mail"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type receivedMail struct {
+	from string
+	to   []string
+	data string
+}
+
+// NOTE: ローカルのSMTPサーバの代わりに、受信した内容をチャネルへ送るだけの最小限のサーバを立てる
+func startTestSMTPServer(t *testing.T) (host string, port string, received <-chan receivedMail) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	mails := make(chan receivedMail, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		reader := bufio.NewReader(conn)
+		reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+		var mail receivedMail
+		reply("220 localhost ESMTP")
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			command := strings.TrimRight(line, "\r\n")
+			switch {
+			case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+				reply("250 localhost")
+			case strings.HasPrefix(command, "MAIL FROM:"):
+				mail.from = strings.Trim(strings.TrimPrefix(command, "MAIL FROM:"), "<>")
+				reply("250 OK")
+			case strings.HasPrefix(command, "RCPT TO:"):
+				mail.to = append(mail.to, strings.Trim(strings.TrimPrefix(command, "RCPT TO:"), "<>"))
+				reply("250 OK")
+			case command == "DATA":
+				reply("354 End data with <CR><LF>.<CR><LF>")
+				var data strings.Builder
+				for {
+					dataLine, err := reader.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if dataLine == ".\r\n" {
+						break
+					}
+					data.WriteString(dataLine)
+				}
+				mail.data = data.String()
+				reply("250 OK")
+			case command == "QUIT":
+				reply("221 Bye")
+				mails <- mail
+				return
+			default:
+				reply("250 OK")
+			}
+		}
+	}()
+
+	host, port, _ = net.SplitHostPort(listener.Addr().String())
+	return host, port, mails
+}
+
+func TestSMTPMailer_Send(t *testing.T) {
+	host, port, received := startTestSMTPServer(t)
+	mailer := NewSMTPMailer(host, port, "", "", "no-reply@example.com")
+
+	err := mailer.Send(context.Background(), MailMessage{To: "test@example.com", Subject: "パスワード再設定のご案内", Body: "本文です。"})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	mailData := <-received
+	assert.Equal(t, "no-reply@example.com", mailData.from)
+	assert.Equal(t, []string{"test@example.com"}, mailData.to)
+
+	// NOTE: 日本語の件名・本文がデコードできることを確認
+	message, err := mail.ReadMessage(strings.NewReader(mailData.data))
+	if !assert.NoError(t, err) {
+		return
+	}
+	subject, _ := new(mime.WordDecoder).DecodeHeader(message.Header.Get("Subject"))
+	assert.Equal(t, "パスワード再設定のご案内", subject)
+	encodedBody, _ := io.ReadAll(message.Body)
+	body, _ := base64.StdEncoding.DecodeString(strings.ReplaceAll(string(encodedBody), "\r\n", ""))
+	assert.Equal(t, "本文です。", string(body))
+}
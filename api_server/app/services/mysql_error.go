@@ -0,0 +1,16 @@
+package services
+
+import (
+	"errors"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// NOTE: ユニークキーの重複(ER_DUP_ENTRY)
+const mysqlErrDuplicateEntry = 1062
+
+// NOTE: 同時に登録された場合など、ユニークキーの制約に違反したエラーか判定する
+func isDuplicateEntryError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry
+}
@@ -0,0 +1,236 @@
+package services
+
+import (
+	models "app/models/generated"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	outboxAggregateTodo = "todo"
+	outboxAggregateUser = "user"
+)
+
+// NOTE: 配信するのはTodoとユーザの集約のイベントのみとする
+//     : ユーザのイベントはアカウントの設定の変更を対象とし、セッションやサインインの失敗回数、確認用のトークン等の認証の途中の状態は含めない
+//     : コメント・添付ファイル・時間記録等のTodoに付随するリソースの変更は配信しない
+const (
+	OutboxTodoCreated = "TodoCreated"
+	OutboxTodoUpdated = "TodoUpdated"
+	OutboxTodoDeleted = "TodoDeleted"
+
+	OutboxUserSignedUp             = "UserSignedUp"
+	OutboxUserProfileUpdated       = "UserProfileUpdated"
+	OutboxUserEmailChangeRequested = "UserEmailChangeRequested"
+	OutboxUserEmailVerified        = "UserEmailVerified"
+	OutboxUserEmailChanged         = "UserEmailChanged"
+	// NOTE: パスワードの再設定で削除したアプリパスワード・パスキーは、個別のイベントを記録しない
+	OutboxUserPasswordReset        = "UserPasswordReset"
+	OutboxUserUnlocked             = "UserUnlocked"
+	OutboxUserTwoFactorEnabled     = "UserTwoFactorEnabled"
+	OutboxUserTwoFactorDisabled    = "UserTwoFactorDisabled"
+	OutboxUserRecoveryCodesRenewed = "UserRecoveryCodesRenewed"
+	OutboxUserPasskeyAdded         = "UserPasskeyAdded"
+	OutboxUserPasskeyRemoved       = "UserPasskeyRemoved"
+	OutboxUserAppPasswordCreated   = "UserAppPasswordCreated"
+	OutboxUserAppPasswordRevoked   = "UserAppPasswordRevoked"
+)
+
+const (
+	outboxRelayInterval  = time.Second
+	outboxRelayBatchSize = 100
+	// NOTE: 配信に失敗したイベントは間隔を倍にしながら再送し、上限に達したらデッドレターとして再送をやめる
+	outboxRetryBaseDelay = time.Second
+	outboxRetryMaxDelay  = time.Hour
+	outboxMaxAttempts    = 10
+	// NOTE: 複数プロセスでリレーが動いても配信順序が崩れないよう、名前付きロックで同時実行を1つに制限する
+	outboxRelayLockName = "outbox_relay"
+)
+
+// NOTE: ドメインイベントの配信先。同じイベントが複数回届く可能性があるため、冪等に処理すること
+type OutboxSink interface {
+	Publish(ctx context.Context, event *models.Outbox) error
+}
+
+type logOutboxSink struct{}
+
+func NewLogOutboxSink() OutboxSink {
+	return &logOutboxSink{}
+}
+
+func (ls *logOutboxSink) Publish(ctx context.Context, event *models.Outbox) error {
+	log.Printf("outbox event published: id=%d aggregate=%s/%d type=%s payload=%s", event.ID, event.AggregateType, event.AggregateID, event.EventType, event.Payload)
+	return nil
+}
+
+type OutboxRelay interface {
+	RelayOutboxEvents(ctx context.Context) error
+}
+
+type outboxRelay struct {
+	db    *sql.DB
+	sinks []OutboxSink
+}
+
+func NewOutboxRelay(db *sql.DB, sinks ...OutboxSink) OutboxRelay {
+	return &outboxRelay{db, sinks}
+}
+
+type todoOutboxPayload struct {
+	ID      int64  `json:"id"`
+	UserID  int64  `json:"userId"`
+	Title   string `json:"title"`
+	Content string `json:"content"`
+}
+
+type userOutboxPayload struct {
+	ID           int    `json:"id"`
+	Email        string `json:"email"`
+	PendingEmail string `json:"pendingEmail,omitempty"`
+}
+
+type userProfileOutboxPayload struct {
+	ID        int       `json:"id"`
+	FirstName string    `json:"firstName"`
+	LastName  string    `json:"lastName"`
+	Birthday  null.Time `json:"birthday"`
+}
+
+// NOTE: パスキー・アプリパスワードのイベントは、ユーザの集約として順序を保つ
+type userCredentialOutboxPayload struct {
+	ID     int64  `json:"id"`
+	UserID int64  `json:"userId"`
+	Name   string `json:"name"`
+}
+
+func (or *outboxRelay) RelayOutboxEvents(ctx context.Context) error {
+	conn, err := or.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	var locked sql.NullInt64
+	if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, 0)", outboxRelayLockName).Scan(&locked); err != nil {
+		return err
+	}
+	if !locked.Valid || locked.Int64 != 1 {
+		return nil
+	}
+	defer conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", outboxRelayLockName)
+
+	// NOTE: 再送待ち・デッドレターのイベントと、それより後の同じ集約のイベントはクエリの段階で除外し、他の集約の配信を妨げないようにする
+	now := time.Now()
+	events, err := models.Outboxes(
+		qm.Where("published_at IS NULL AND dead_lettered_at IS NULL AND (next_attempt_at IS NULL OR next_attempt_at <= ?)", now),
+		qm.Where("NOT EXISTS (SELECT 1 FROM outbox AS blocked WHERE blocked.aggregate_type = outbox.aggregate_type AND blocked.aggregate_id = outbox.aggregate_id AND blocked.id < outbox.id AND blocked.published_at IS NULL AND (blocked.dead_lettered_at IS NOT NULL OR blocked.next_attempt_at > ?))", now),
+		qm.OrderBy("id ASC"),
+		qm.Limit(outboxRelayBatchSize),
+	).All(ctx, or.db)
+	if err != nil {
+		return err
+	}
+
+	// NOTE: 配信に失敗した集約の後続イベントは、順序を守るため次回以降に持ち越す
+	blockedAggregates := map[string]bool{}
+	for _, event := range events {
+		aggregateKey := event.AggregateType + "/" + strconv.FormatInt(event.AggregateID, 10)
+		if blockedAggregates[aggregateKey] {
+			continue
+		}
+
+		event.Attempts++
+		if err := or.publish(ctx, event); err != nil {
+			blockedAggregates[aggregateKey] = true
+			event.LastError = null.StringFrom(err.Error())
+			if event.Attempts >= outboxMaxAttempts {
+				event.DeadLetteredAt = null.TimeFrom(now)
+				log.Printf("outbox event dead-lettered: id=%d aggregate=%s attempts=%d error=%v", event.ID, aggregateKey, event.Attempts, err)
+			} else {
+				// NOTE: DBの秒単位の精度で丸められても待ち時間より早く再送しないよう、秒単位に切り上げる
+				event.NextAttemptAt = null.TimeFrom(now.Add(outboxRetryDelay(event.Attempts)).Truncate(time.Second).Add(time.Second))
+			}
+		} else {
+			event.PublishedAt = null.TimeFrom(time.Now())
+			event.LastError = null.String{}
+			event.NextAttemptAt = null.Time{}
+		}
+		if _, err := event.Update(ctx, or.db, boil.Infer()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func outboxRetryDelay(attempts int) time.Duration {
+	delay := outboxRetryBaseDelay
+	for i := 1; i < attempts && delay < outboxRetryMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, outboxRetryMaxDelay)
+}
+
+func (or *outboxRelay) publish(ctx context.Context, event *models.Outbox) error {
+	for _, sink := range or.sinks {
+		if err := sink.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NOTE: outboxを定期的にポーリングして配信先に送るワーカー
+func RunOutboxRelay(ctx context.Context, relay OutboxRelay) {
+	ticker := time.NewTicker(outboxRelayInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := relay.RelayOutboxEvents(ctx); err != nil {
+				log.Println("failed to relay outbox events:", err)
+			}
+		}
+	}
+}
+
+// NOTE: 各サービスの更新処理と同一トランザクション内でドメインイベントを記録する
+func recordOutboxEvent(ctx context.Context, exec boil.ContextExecutor, aggregateType string, aggregateID int64, eventType string, payload interface{}) error {
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	event := &models.Outbox{
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		EventType:     eventType,
+		Payload:       string(payloadBytes),
+	}
+	return event.Insert(ctx, exec, boil.Infer())
+}
+
+func recordTodoOutboxEvent(ctx context.Context, exec boil.ContextExecutor, todo *models.Todo, eventType string) error {
+	payload := todoOutboxPayload{ID: todo.ID, UserID: todo.UserID, Title: todo.Title, Content: todo.Content.String}
+	return recordOutboxEvent(ctx, exec, outboxAggregateTodo, todo.ID, eventType, payload)
+}
+
+func recordUserOutboxEvent(ctx context.Context, exec boil.ContextExecutor, user *models.User, eventType string) error {
+	payload := userOutboxPayload{ID: user.ID, Email: user.Email, PendingEmail: user.PendingEmail.String}
+	return recordOutboxEvent(ctx, exec, outboxAggregateUser, int64(user.ID), eventType, payload)
+}
+
+func recordUserCredentialOutboxEvent(ctx context.Context, exec boil.ContextExecutor, id int64, userID int64, name string, eventType string) error {
+	payload := userCredentialOutboxPayload{ID: id, UserID: userID, Name: name}
+	return recordOutboxEvent(ctx, exec, outboxAggregateUser, userID, eventType, payload)
+}
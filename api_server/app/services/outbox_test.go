@@ -0,0 +1,288 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/test/factories"
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type TestOutboxSuite struct {
+	WithDBSuite
+}
+
+// NOTE: 配信されたイベントを記録するテスト用の配信先
+type recordingOutboxSink struct {
+	published         []*models.Outbox
+	failedAggregateID int64
+}
+
+func (rs *recordingOutboxSink) Publish(ctx context.Context, event *models.Outbox) error {
+	if event.AggregateID == rs.failedAggregateID {
+		return errors.New("sink unavailable")
+	}
+	rs.published = append(rs.published, event)
+	return nil
+}
+
+var (
+	testOutboxSink  *recordingOutboxSink
+	testOutboxRelay OutboxRelay
+)
+
+func (s *TestOutboxSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	testOutboxSink = &recordingOutboxSink{}
+	testOutboxRelay = NewOutboxRelay(DBCon, testOutboxSink)
+}
+
+func (s *TestOutboxSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *TestOutboxSuite) createTodo(title string) *models.Todo {
+	NewTodoService(DBCon).CreateTodo(ctx, apis.PostTodosJSONRequestBody{Title: title, Content: "test content"}, int64(user.ID))
+
+	todo, err := models.Todos(qm.Where("title = ?", title)).One(ctx, DBCon)
+	if err != nil {
+		s.T().Fatalf("failed to fetch test todo %v", err)
+	}
+	return todo
+}
+
+func (s *TestOutboxSuite) TestRecordTodoEvents() {
+	todo := s.createTodo("test title 1")
+	todoService := NewTodoService(DBCon)
+	todoService.UpdateTodo(ctx, todo.ID, apis.PatchTodoJSONRequestBody{Title: "updated title", Content: "updated content"}, int64(user.ID))
+	todoService.DeleteTodo(ctx, todo.ID, int64(user.ID))
+
+	// NOTE: Todoの各操作がドメインイベントとして記録されていることを確認
+	events, _ := models.Outboxes(qm.Where("aggregate_type = ? AND aggregate_id = ?", outboxAggregateTodo, todo.ID), qm.OrderBy("id ASC")).All(ctx, DBCon)
+	var eventTypes []string
+	for _, event := range events {
+		eventTypes = append(eventTypes, event.EventType)
+		assert.False(s.T(), event.PublishedAt.Valid)
+	}
+	assert.Equal(s.T(), []string{OutboxTodoCreated, OutboxTodoUpdated, OutboxTodoDeleted}, eventTypes)
+	assert.JSONEq(s.T(), `{"id": `+strconv.FormatInt(todo.ID, 10)+`, "userId": `+strconv.Itoa(user.ID)+`, "title": "updated title", "content": "updated content"}`, events[1].Payload)
+}
+
+func (s *TestOutboxSuite) TestRecordTodoEvents_ValidationError() {
+	NewTodoService(DBCon).CreateTodo(ctx, apis.PostTodosJSONRequestBody{Title: "", Content: "test content"}, int64(user.ID))
+
+	// NOTE: 更新処理が失敗した場合はイベントも記録されないこと
+	isExistEvent, _ := models.Outboxes().Exists(ctx, DBCon)
+	assert.False(s.T(), isExistEvent)
+}
+
+func (s *TestOutboxSuite) TestRecordUserSignedUp() {
+	requestParams := apis.PostAuthSignUpMultipartRequestBody{FirstName: "first_name", LastName: "last_name", Email: "signup@example.com", Password: "Password"}
+	err := NewAuthService(DBCon, NewMemoryTokenDenylistStore(), NewMemoryMailer()).SignUp(ctx, requestParams)
+	assert.Nil(s.T(), err)
+
+	signedUpUser, _ := models.Users(qm.Where("email = ?", "signup@example.com")).One(ctx, DBCon)
+	event, err := models.Outboxes(qm.Where("aggregate_type = ? AND aggregate_id = ?", outboxAggregateUser, signedUpUser.ID)).One(ctx, DBCon)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), OutboxUserSignedUp, event.EventType)
+}
+
+// NOTE: ユーザの集約に記録されたイベントの種類を記録順に返す
+func (s *TestOutboxSuite) userEventTypes() []string {
+	events, _ := models.Outboxes(qm.Where("aggregate_type = ? AND aggregate_id = ?", outboxAggregateUser, user.ID), qm.OrderBy("id ASC")).All(ctx, DBCon)
+	eventTypes := []string{}
+	for _, event := range events {
+		eventTypes = append(eventTypes, event.EventType)
+	}
+	return eventTypes
+}
+
+func (s *TestOutboxSuite) TestRecordUserEvents() {
+	userService := NewUserService(DBCon, NewMemoryMailer())
+	firstName := "updated"
+	userService.UpdateMe(ctx, apis.PatchUsersMeJSONRequestBody{FirstName: &firstName}, int64(user.ID))
+	userService.ChangeEmail(ctx, apis.PostUsersMeEmailJSONRequestBody{Email: "changed@example.com", Password: "password"}, int64(user.ID), "192.0.2.1")
+	token, _ := signEmailVerificationToken(user.ID, "changed@example.com", time.Now())
+	NewAuthService(DBCon, NewMemoryTokenDenylistStore(), NewMemoryMailer()).VerifyEmail(ctx, apis.PostAuthEmailVerifyJSONRequestBody{Token: token})
+
+	appPasswordService := NewAppPasswordService(DBCon)
+	_, appPassword, _, _ := appPasswordService.CreateAppPassword(ctx, apis.PostAppPasswordsJSONRequestBody{Name: "iPhone"}, int64(user.ID))
+	appPasswordService.RevokeAppPassword(ctx, appPassword.ID, int64(user.ID))
+
+	enableTwoFactor(s.T(), int64(user.ID))
+
+	passkey := &models.WebauthnCredential{UserID: int64(user.ID), CredentialID: "credential-id", PublicKey: []byte("public-key"), Aaguid: []byte{}, Name: "passkey"}
+	if err := passkey.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test passkey %v", err)
+	}
+	NewPasskeyService(DBCon, NewMemoryMailer()).DeletePasskey(ctx, passkey.ID, int64(user.ID))
+
+	// NOTE: アカウントの設定の各変更がユーザの集約のイベントとして記録されていることを確認
+	assert.Equal(s.T(), []string{
+		OutboxUserProfileUpdated,
+		OutboxUserEmailChangeRequested,
+		OutboxUserEmailChanged,
+		OutboxUserAppPasswordCreated,
+		OutboxUserAppPasswordRevoked,
+		OutboxUserTwoFactorEnabled,
+		OutboxUserPasskeyRemoved,
+	}, s.userEventTypes())
+
+	event, _ := models.Outboxes(qm.Where("event_type = ?", OutboxUserEmailChangeRequested)).One(ctx, DBCon)
+	assert.JSONEq(s.T(), `{"id": `+strconv.Itoa(user.ID)+`, "email": "test@example.com", "pendingEmail": "changed@example.com"}`, event.Payload)
+	event, _ = models.Outboxes(qm.Where("event_type = ?", OutboxUserAppPasswordRevoked)).One(ctx, DBCon)
+	assert.JSONEq(s.T(), `{"id": `+strconv.FormatInt(appPassword.ID, 10)+`, "userId": `+strconv.Itoa(user.ID)+`, "name": "iPhone"}`, event.Payload)
+}
+
+func (s *TestOutboxSuite) TestRecordUserEvents_ResetPasswordAndUnlock() {
+	authService := NewAuthService(DBCon, NewMemoryTokenDenylistStore(), NewMemoryMailer())
+	resetToken, err := issuePasswordResetToken(ctx, DBCon, int64(user.ID), time.Now())
+	if err != nil {
+		s.T().Fatalf("failed to issue password reset token %v", err)
+	}
+	authService.ResetPassword(ctx, apis.PostAuthPasswordResetJSONRequestBody{Token: resetToken, Password: "newPassword"})
+
+	lockout := &models.SignInLockout{Scope: signInLockoutScopeAccount, Email: null.StringFrom("test@example.com"), UserID: null.Int64From(int64(user.ID)), LockedUntil: time.Now().Add(AccountLockoutDuration), UnlockTokenHash: null.StringFrom(hashToken("unlock-token"))}
+	if err := lockout.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test lockout %v", err)
+	}
+	authService.UnlockAccount(ctx, apis.PostAuthUnlockJSONRequestBody{Token: "unlock-token"})
+
+	assert.Equal(s.T(), []string{OutboxUserPasswordReset, OutboxUserUnlocked}, s.userEventTypes())
+}
+
+func (s *TestOutboxSuite) TestRecordUserEvents_ValidationError() {
+	// NOTE: 再確認に失敗した場合はイベントも記録されないこと
+	NewUserService(DBCon, NewMemoryMailer()).ChangeEmail(ctx, apis.PostUsersMeEmailJSONRequestBody{Email: "changed@example.com", Password: "wrongPassword"}, int64(user.ID), "192.0.2.1")
+	NewAppPasswordService(DBCon).RevokeAppPassword(ctx, 1, int64(user.ID))
+
+	assert.Empty(s.T(), s.userEventTypes())
+}
+
+func (s *TestOutboxSuite) TestRelayOutboxEvents() {
+	todo := s.createTodo("test title 1")
+	NewTodoService(DBCon).UpdateTodo(ctx, todo.ID, apis.PatchTodoJSONRequestBody{Title: "updated title", Content: "updated content"}, int64(user.ID))
+
+	err := testOutboxRelay.RelayOutboxEvents(ctx)
+
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), testOutboxSink.published, 2)
+	assert.Equal(s.T(), OutboxTodoCreated, testOutboxSink.published[0].EventType)
+	assert.Equal(s.T(), OutboxTodoUpdated, testOutboxSink.published[1].EventType)
+	isExistUnpublished, _ := models.Outboxes(qm.Where("published_at IS NULL")).Exists(ctx, DBCon)
+	assert.False(s.T(), isExistUnpublished)
+
+	// NOTE: 配信済みのイベントは再送されないこと
+	testOutboxRelay.RelayOutboxEvents(ctx)
+	assert.Len(s.T(), testOutboxSink.published, 2)
+}
+
+func (s *TestOutboxSuite) TestRelayOutboxEvents_OrderedPerAggregate() {
+	failedTodo := s.createTodo("test title 1")
+	otherTodo := s.createTodo("test title 2")
+	NewTodoService(DBCon).UpdateTodo(ctx, failedTodo.ID, apis.PatchTodoJSONRequestBody{Title: "updated title", Content: "updated content"}, int64(user.ID))
+	testOutboxSink.failedAggregateID = failedTodo.ID
+
+	testOutboxRelay.RelayOutboxEvents(ctx)
+
+	// NOTE: 配信に失敗した集約の後続イベントは送らず、他の集約は配信されること
+	assert.Len(s.T(), testOutboxSink.published, 1)
+	assert.Equal(s.T(), otherTodo.ID, testOutboxSink.published[0].AggregateID)
+	failedEvent, _ := models.Outboxes(qm.Where("aggregate_id = ? AND event_type = ?", failedTodo.ID, OutboxTodoCreated)).One(ctx, DBCon)
+	assert.Equal(s.T(), 1, failedEvent.Attempts)
+	assert.Equal(s.T(), "sink unavailable", failedEvent.LastError.String)
+	assert.True(s.T(), failedEvent.NextAttemptAt.Valid)
+	skippedEvent, _ := models.Outboxes(qm.Where("aggregate_id = ? AND event_type = ?", failedTodo.ID, OutboxTodoUpdated)).One(ctx, DBCon)
+	assert.Equal(s.T(), 0, skippedEvent.Attempts)
+
+	// NOTE: 再送までの待ち時間中は配信しないこと
+	testOutboxSink.failedAggregateID = 0
+	testOutboxRelay.RelayOutboxEvents(ctx)
+	assert.Len(s.T(), testOutboxSink.published, 1)
+
+	s.elapseOutboxRetryDelay()
+	testOutboxRelay.RelayOutboxEvents(ctx)
+
+	// NOTE: 復旧後は同じ集約のイベントが順序通りに配信されること
+	assert.Len(s.T(), testOutboxSink.published, 3)
+	assert.Equal(s.T(), OutboxTodoCreated, testOutboxSink.published[1].EventType)
+	assert.Equal(s.T(), OutboxTodoUpdated, testOutboxSink.published[2].EventType)
+}
+
+// NOTE: 再送までの待ち時間が経過した状態にする
+func (s *TestOutboxSuite) elapseOutboxRetryDelay() {
+	if _, err := models.Outboxes(qm.Where("next_attempt_at IS NOT NULL")).UpdateAll(ctx, DBCon, models.M{"next_attempt_at": time.Now().Add(-time.Second)}); err != nil {
+		s.T().Fatalf("failed to update outbox events %v", err)
+	}
+}
+
+func (s *TestOutboxSuite) TestRelayOutboxEvents_BlockedAggregateAtHead() {
+	// NOTE: 1回の取得件数を超える失敗中の集約のイベントが、正常な集約のイベントより前に並んでいる場合
+	for i := 0; i < outboxRelayBatchSize+20; i++ {
+		if err := recordOutboxEvent(ctx, DBCon, outboxAggregateTodo, 1, OutboxTodoUpdated, map[string]int{"sequence": i}); err != nil {
+			s.T().Fatalf("failed to record outbox event %v", err)
+		}
+	}
+	if err := recordOutboxEvent(ctx, DBCon, outboxAggregateTodo, 2, OutboxTodoCreated, map[string]int{"id": 2}); err != nil {
+		s.T().Fatalf("failed to record outbox event %v", err)
+	}
+	testOutboxSink.failedAggregateID = 1
+
+	testOutboxRelay.RelayOutboxEvents(ctx)
+	testOutboxRelay.RelayOutboxEvents(ctx)
+
+	// NOTE: 失敗中の集約は先頭のイベントのみ試行し、他の集約のイベントは配信されること
+	if assert.Len(s.T(), testOutboxSink.published, 1) {
+		assert.Equal(s.T(), int64(2), testOutboxSink.published[0].AggregateID)
+	}
+	attemptedCount, _ := models.Outboxes(qm.Where("aggregate_id = ? AND attempts > 0", 1)).Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(1), attemptedCount)
+}
+
+func (s *TestOutboxSuite) TestRelayOutboxEvents_DeadLetter() {
+	todo := s.createTodo("test title 1")
+	testOutboxSink.failedAggregateID = todo.ID
+
+	// NOTE: 待ち時間は失敗するごとに倍になること
+	var delays []time.Duration
+	for i := 0; i < outboxMaxAttempts; i++ {
+		testOutboxRelay.RelayOutboxEvents(ctx)
+		event, _ := models.Outboxes(qm.Where("aggregate_id = ?", todo.ID)).One(ctx, DBCon)
+		if event.NextAttemptAt.Valid {
+			delays = append(delays, time.Until(event.NextAttemptAt.Time))
+		}
+		s.elapseOutboxRetryDelay()
+	}
+	for i, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second} {
+		assert.InDelta(s.T(), float64(expected), float64(delays[i]), float64(time.Second))
+	}
+
+	// NOTE: 上限に達したイベントはデッドレターとなり、復旧後も再送されないこと
+	event, _ := models.Outboxes(qm.Where("aggregate_id = ?", todo.ID)).One(ctx, DBCon)
+	assert.Equal(s.T(), outboxMaxAttempts, event.Attempts)
+	assert.True(s.T(), event.DeadLetteredAt.Valid)
+	testOutboxSink.failedAggregateID = 0
+	testOutboxRelay.RelayOutboxEvents(ctx)
+	assert.Len(s.T(), testOutboxSink.published, 0)
+}
+
+func TestOutbox(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestOutboxSuite))
+}
@@ -0,0 +1,176 @@
+package services
+
+import (
+	models "app/models/generated"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	WebAuthnCeremonyLifetime     = 5 * time.Minute
+	webAuthnCeremonyRegistration = "registration"
+	webAuthnCeremonySignIn       = "sign_in"
+	webAuthnUserHandleSize       = 32
+	defaultWebAuthnRPName        = "tanstack_query_practice"
+)
+
+var (
+	errPasskeyCeremonyFailed     = errors.New("パスキーの操作の有効期限が切れました。もう一度お試しください。")
+	errPasskeyVerificationFailed = errors.New("パスキーを確認できませんでした。")
+)
+
+// NOTE: Relying Partyの設定は環境変数から読み込み、未設定の場合はローカル開発用の値とする
+func newWebAuthn() (*webauthn.WebAuthn, error) {
+	timeout := webauthn.TimeoutConfig{Enforce: true, Timeout: WebAuthnCeremonyLifetime, TimeoutUVD: WebAuthnCeremonyLifetime}
+	return webauthn.New(&webauthn.Config{
+		RPID:          envOrDefault("WEBAUTHN_RP_ID", "localhost"),
+		RPDisplayName: envOrDefault("WEBAUTHN_RP_NAME", defaultWebAuthnRPName),
+		RPOrigins:     strings.Split(envOrDefault("WEBAUTHN_RP_ORIGINS", "http://localhost:5173"), ","),
+		Timeouts:      webauthn.TimeoutsConfig{Login: timeout, Registration: timeout},
+	})
+}
+
+func newPasskeyAddedNoticeMailMessage(email string, name string) MailMessage {
+	body := fmt.Sprintf(`アカウントに以下のパスキーが登録されました。
+
+%s
+
+お心当たりがない場合は、すぐにパスワードを変更し、登録されたパスキーを削除してください。
+`, name)
+	return MailMessage{To: email, Subject: "パスキー登録のお知らせ", Body: body}
+}
+
+// webAuthnUser ... go-webauthnのUserインターフェースを満たすためのラッパー
+type webAuthnUser struct {
+	user        *models.User
+	credentials models.WebauthnCredentialSlice
+}
+
+// NOTE: ユーザIDやメールアドレスを認証器に渡さないよう、ランダムな値をユーザハンドルとする
+func (u *webAuthnUser) WebAuthnID() []byte {
+	return []byte(u.user.WebauthnUserHandle.String)
+}
+
+func (u *webAuthnUser) WebAuthnName() string {
+	return u.user.Email
+}
+
+func (u *webAuthnUser) WebAuthnDisplayName() string {
+	return u.user.LastName + " " + u.user.FirstName
+}
+
+func (u *webAuthnUser) WebAuthnCredentials() []webauthn.Credential {
+	credentials := make([]webauthn.Credential, 0, len(u.credentials))
+	for _, credential := range u.credentials {
+		credentialID, err := base64.RawURLEncoding.DecodeString(credential.CredentialID)
+		if err != nil {
+			continue
+		}
+		credentials = append(credentials, webauthn.Credential{
+			ID:              credentialID,
+			PublicKey:       credential.PublicKey,
+			AttestationType: credential.AttestationType,
+			Transport:       splitTransports(credential.Transports),
+			Flags: webauthn.CredentialFlags{
+				UserPresent:    true,
+				UserVerified:   true,
+				BackupEligible: credential.BackupEligible,
+				BackupState:    credential.BackupState,
+			},
+			Authenticator: webauthn.Authenticator{
+				AAGUID:    credential.Aaguid,
+				SignCount: uint32(credential.SignCount),
+			},
+		})
+	}
+	return credentials
+}
+
+func loadWebAuthnUser(ctx context.Context, exec boil.ContextExecutor, user *models.User) (*webAuthnUser, error) {
+	credentials, err := models.WebauthnCredentials(qm.Where("user_id = ?", user.ID), qm.OrderBy("id ASC")).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	return &webAuthnUser{user: user, credentials: credentials}, nil
+}
+
+// NOTE: 対応する通信方式はカンマ区切りで保存する
+func joinTransports(transports []protocol.AuthenticatorTransport) string {
+	values := make([]string, 0, len(transports))
+	for _, transport := range transports {
+		values = append(values, string(transport))
+	}
+	return strings.Join(values, ",")
+}
+
+func splitTransports(value string) []protocol.AuthenticatorTransport {
+	transports := []protocol.AuthenticatorTransport{}
+	for _, transport := range strings.Split(value, ",") {
+		if transport != "" {
+			transports = append(transports, protocol.AuthenticatorTransport(transport))
+		}
+	}
+	return transports
+}
+
+// NOTE: 開始から完了までの間、チャレンジを含むセッション情報をDBに保持し、トークンで紐づける
+func saveWebAuthnCeremony(ctx context.Context, exec boil.ContextExecutor, ceremony string, userID null.Int64, sessionData *webauthn.SessionData, now time.Time) (string, error) {
+	token, err := generateRandomToken(32)
+	if err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(sessionData)
+	if err != nil {
+		return "", err
+	}
+
+	webAuthnCeremony := &models.WebauthnCeremony{
+		UserID:      userID,
+		TokenHash:   hashToken(token),
+		Ceremony:    ceremony,
+		SessionData: string(data),
+		ExpiresAt:   now.Add(WebAuthnCeremonyLifetime).Truncate(time.Second),
+	}
+	if err := webAuthnCeremony.Insert(ctx, exec, boil.Infer()); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// NOTE: チャレンジを使い回されないよう、検証の成否に関わらず削除してから返す
+func consumeWebAuthnCeremony(ctx context.Context, exec boil.ContextExecutor, token string, ceremony string, now time.Time) (*models.WebauthnCeremony, *webauthn.SessionData, error) {
+	webAuthnCeremony, err := models.WebauthnCeremonies(qm.Where("token_hash = ? AND ceremony = ?", hashToken(token), ceremony)).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, errPasskeyCeremonyFailed
+		}
+		return nil, nil, err
+	}
+
+	// NOTE: 同時に完了させようとした場合は、削除できた1件のみを有効とする
+	rowsAff, err := models.WebauthnCeremonies(qm.Where("id = ?", webAuthnCeremony.ID)).DeleteAll(ctx, exec)
+	if err != nil {
+		return nil, nil, err
+	}
+	if rowsAff == 0 || webAuthnCeremony.ExpiresAt.Before(now) {
+		return nil, nil, errPasskeyCeremonyFailed
+	}
+
+	var sessionData webauthn.SessionData
+	if err := json.Unmarshal([]byte(webAuthnCeremony.SessionData), &sessionData); err != nil {
+		return nil, nil, err
+	}
+	return webAuthnCeremony, &sessionData, nil
+}
@@ -0,0 +1,349 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/validator"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type PasskeyService interface {
+	FetchPasskeysList(ctx context.Context, userID int64) (statusCode int64, passkeys models.WebauthnCredentialSlice, err error)
+	BeginRegistration(ctx context.Context, requestParams apis.PostAuthPasskeysRegisterBeginJSONRequestBody, userID int64, ipAddress string) (statusCode int64, ceremonyToken string, options *protocol.PublicKeyCredentialCreationOptions, err error)
+	FinishRegistration(ctx context.Context, requestParams apis.PostAuthPasskeysRegisterFinishJSONRequestBody, userID int64) (statusCode int64, passkey *models.WebauthnCredential, err error)
+	BeginSignIn(ctx context.Context) (statusCode int64, ceremonyToken string, options *protocol.PublicKeyCredentialRequestOptions, err error)
+	FinishSignIn(ctx context.Context, requestParams apis.PostAuthPasskeysSignInFinishJSONRequestBody, userAgent string, ipAddress string) (statusCode int64, tokenPair *AuthTokenPair, err error)
+	DeletePasskey(ctx context.Context, id int64, userID int64) (statusCode int64, err error)
+}
+
+type passkeyService struct {
+	db     *sql.DB
+	mailer Mailer
+}
+
+func NewPasskeyService(db *sql.DB, mailer Mailer) PasskeyService {
+	return &passkeyService{db, mailer}
+}
+
+func (ps *passkeyService) FetchPasskeysList(ctx context.Context, userID int64) (statusCode int64, passkeys models.WebauthnCredentialSlice, err error) {
+	passkeys, err = models.WebauthnCredentials(qm.Where("user_id = ?", userID), qm.OrderBy("created_at DESC, id DESC")).All(ctx, ps.db)
+	if err != nil {
+		return http.StatusInternalServerError, models.WebauthnCredentialSlice{}, err
+	}
+	return http.StatusOK, passkeys, nil
+}
+
+// NOTE: パスキーでのサインインは2段階認証を求めないため、セッションを盗まれても追加できないよう、2段階認証の無効化と同じく本人であることを再確認する
+func (ps *passkeyService) BeginRegistration(ctx context.Context, requestParams apis.PostAuthPasskeysRegisterBeginJSONRequestBody, userID int64, ipAddress string) (statusCode int64, ceremonyToken string, options *protocol.PublicKeyCredentialCreationOptions, err error) {
+	// NOTE: バリデーションチェック
+	if err := validator.ValidateBeginPasskeyRegistration(&requestParams); err != nil {
+		return http.StatusBadRequest, "", nil, err
+	}
+
+	user, err := models.FindUser(ctx, ps.db, int(userID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return http.StatusNotFound, "", nil, errors.New("user not found")
+		}
+		return http.StatusInternalServerError, "", nil, err
+	}
+
+	now := time.Now()
+	if statusCode, err := verifyCurrentPassword(ctx, ps.db, ps.mailer, user, requestParams.Password, ipAddress, now); err != nil {
+		return statusCode, "", nil, err
+	}
+	if user.TotpEnabledAt.Valid {
+		if requestParams.Code == nil || *requestParams.Code == "" {
+			return http.StatusBadRequest, "", nil, validation.Errors{"code": errors.New("認証コードは必須入力です。")}
+		}
+		if statusCode, err := verifyCurrentSecondFactor(ctx, ps.db, ps.mailer, user, *requestParams.Code, ipAddress, now); err != nil {
+			return statusCode, "", nil, err
+		}
+	}
+
+	// NOTE: ユーザハンドルは初回の登録時に発行し、以降は同じ値を使い続ける
+	if !user.WebauthnUserHandle.Valid {
+		userHandle, err := generateRandomToken(webAuthnUserHandleSize)
+		if err != nil {
+			return http.StatusInternalServerError, "", nil, err
+		}
+		user.WebauthnUserHandle = null.StringFrom(userHandle)
+		if _, err := user.Update(ctx, ps.db, boil.Whitelist("webauthn_user_handle", "updated_at")); err != nil {
+			return http.StatusInternalServerError, "", nil, err
+		}
+	}
+
+	webAuthn, err := newWebAuthn()
+	if err != nil {
+		return http.StatusInternalServerError, "", nil, err
+	}
+	webAuthnUser, err := loadWebAuthnUser(ctx, ps.db, user)
+	if err != nil {
+		return http.StatusInternalServerError, "", nil, err
+	}
+
+	// NOTE: メールアドレスを入力せずにサインインできるよう、認証器に保存されるパスキーのみを受け付ける
+	requireResidentKey := true
+	creation, sessionData, err := webAuthn.BeginRegistration(
+		webAuthnUser,
+		webauthn.WithAuthenticatorSelection(protocol.AuthenticatorSelection{
+			ResidentKey:        protocol.ResidentKeyRequirementRequired,
+			RequireResidentKey: &requireResidentKey,
+			UserVerification:   protocol.VerificationRequired,
+		}),
+		webauthn.WithExclusions(webauthn.Credentials(webAuthnUser.WebAuthnCredentials()).CredentialDescriptors()),
+	)
+	if err != nil {
+		return http.StatusInternalServerError, "", nil, err
+	}
+
+	ceremonyToken, err = saveWebAuthnCeremony(ctx, ps.db, webAuthnCeremonyRegistration, null.Int64From(userID), sessionData, now)
+	if err != nil {
+		return http.StatusInternalServerError, "", nil, err
+	}
+	return http.StatusOK, ceremonyToken, &creation.Response, nil
+}
+
+func (ps *passkeyService) FinishRegistration(ctx context.Context, requestParams apis.PostAuthPasskeysRegisterFinishJSONRequestBody, userID int64) (statusCode int64, passkey *models.WebauthnCredential, err error) {
+	// NOTE: バリデーションチェック
+	if err := validator.ValidateRegisterPasskey(&requestParams); err != nil {
+		return http.StatusBadRequest, &models.WebauthnCredential{}, err
+	}
+
+	now := time.Now()
+	webAuthnCeremony, sessionData, err := consumeWebAuthnCeremony(ctx, ps.db, requestParams.CeremonyToken, webAuthnCeremonyRegistration, now)
+	if err != nil {
+		if errors.Is(err, errPasskeyCeremonyFailed) {
+			return http.StatusBadRequest, &models.WebauthnCredential{}, validation.Errors{"ceremonyToken": err}
+		}
+		return http.StatusInternalServerError, &models.WebauthnCredential{}, err
+	}
+	if webAuthnCeremony.UserID.Int64 != userID {
+		return http.StatusBadRequest, &models.WebauthnCredential{}, validation.Errors{"ceremonyToken": errPasskeyCeremonyFailed}
+	}
+
+	user, err := models.FindUser(ctx, ps.db, int(userID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return http.StatusNotFound, &models.WebauthnCredential{}, errors.New("user not found")
+		}
+		return http.StatusInternalServerError, &models.WebauthnCredential{}, err
+	}
+	webAuthnUser, err := loadWebAuthnUser(ctx, ps.db, user)
+	if err != nil {
+		return http.StatusInternalServerError, &models.WebauthnCredential{}, err
+	}
+	webAuthn, err := newWebAuthn()
+	if err != nil {
+		return http.StatusInternalServerError, &models.WebauthnCredential{}, err
+	}
+
+	// NOTE: 認証器から返却された値を検証し、公開鍵を取り出す
+	credentialJSON, err := json.Marshal(requestParams.Credential)
+	if err != nil {
+		return http.StatusInternalServerError, &models.WebauthnCredential{}, err
+	}
+	parsedResponse, err := protocol.ParseCredentialCreationResponseBytes(credentialJSON)
+	if err != nil {
+		return http.StatusBadRequest, &models.WebauthnCredential{}, validation.Errors{"credential": errPasskeyVerificationFailed}
+	}
+	credential, err := webAuthn.CreateCredential(webAuthnUser, *sessionData, parsedResponse)
+	if err != nil {
+		return http.StatusBadRequest, &models.WebauthnCredential{}, validation.Errors{"credential": errPasskeyVerificationFailed}
+	}
+
+	credentialID := base64.RawURLEncoding.EncodeToString(credential.ID)
+	exists, err := models.WebauthnCredentials(qm.Where("credential_id = ?", credentialID)).Exists(ctx, ps.db)
+	if err != nil {
+		return http.StatusInternalServerError, &models.WebauthnCredential{}, err
+	}
+	if exists {
+		return http.StatusBadRequest, &models.WebauthnCredential{}, validation.Errors{"credential": errors.New("このパスキーは既に登録されています。")}
+	}
+
+	name := now.Format("2006/01/02") + "に登録したパスキー"
+	if requestParams.Name != nil && *requestParams.Name != "" {
+		name = *requestParams.Name
+	}
+	passkey = &models.WebauthnCredential{
+		UserID:          userID,
+		CredentialID:    credentialID,
+		PublicKey:       credential.PublicKey,
+		AttestationType: credential.AttestationType,
+		Aaguid:          credential.Authenticator.AAGUID,
+		SignCount:       int64(credential.Authenticator.SignCount),
+		Transports:      joinTransports(credential.Transport),
+		BackupEligible:  credential.Flags.BackupEligible,
+		BackupState:     credential.Flags.BackupState,
+		Name:            name,
+	}
+
+	tx, err := ps.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, &models.WebauthnCredential{}, err
+	}
+	defer tx.Rollback()
+
+	if err := passkey.Insert(ctx, tx, boil.Infer()); err != nil {
+		return http.StatusInternalServerError, &models.WebauthnCredential{}, err
+	}
+	if err := recordUserCredentialOutboxEvent(ctx, tx, passkey.ID, userID, passkey.Name, OutboxUserPasskeyAdded); err != nil {
+		return http.StatusInternalServerError, &models.WebauthnCredential{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, &models.WebauthnCredential{}, err
+	}
+
+	// NOTE: 本人以外による登録に気づけるよう通知する。送信に失敗しても登録は取り消さず、ログにのみ残す
+	if err := ps.mailer.Send(ctx, newPasskeyAddedNoticeMailMessage(user.Email, passkey.Name)); err != nil {
+		log.Printf("failed to send passkey added notice mail to user %d: %v", user.ID, err)
+	}
+	return http.StatusOK, passkey, nil
+}
+
+func (ps *passkeyService) BeginSignIn(ctx context.Context) (statusCode int64, ceremonyToken string, options *protocol.PublicKeyCredentialRequestOptions, err error) {
+	webAuthn, err := newWebAuthn()
+	if err != nil {
+		return http.StatusInternalServerError, "", nil, err
+	}
+
+	// NOTE: ユーザを特定せずに開始し、アカウントの存在を推測されないようにする
+	assertion, sessionData, err := webAuthn.BeginDiscoverableLogin(webauthn.WithUserVerification(protocol.VerificationRequired))
+	if err != nil {
+		return http.StatusInternalServerError, "", nil, err
+	}
+
+	ceremonyToken, err = saveWebAuthnCeremony(ctx, ps.db, webAuthnCeremonySignIn, null.Int64{}, sessionData, time.Now())
+	if err != nil {
+		return http.StatusInternalServerError, "", nil, err
+	}
+	return http.StatusOK, ceremonyToken, &assertion.Response, nil
+}
+
+func (ps *passkeyService) FinishSignIn(ctx context.Context, requestParams apis.PostAuthPasskeysSignInFinishJSONRequestBody, userAgent string, ipAddress string) (statusCode int64, tokenPair *AuthTokenPair, err error) {
+	// NOTE: バリデーションチェック
+	if err := validator.ValidateSignInPasskey(&requestParams); err != nil {
+		return http.StatusBadRequest, &AuthTokenPair{}, err
+	}
+
+	now := time.Now()
+	_, sessionData, err := consumeWebAuthnCeremony(ctx, ps.db, requestParams.CeremonyToken, webAuthnCeremonySignIn, now)
+	if err != nil {
+		if errors.Is(err, errPasskeyCeremonyFailed) {
+			return http.StatusBadRequest, &AuthTokenPair{}, err
+		}
+		return http.StatusInternalServerError, &AuthTokenPair{}, err
+	}
+	webAuthn, err := newWebAuthn()
+	if err != nil {
+		return http.StatusInternalServerError, &AuthTokenPair{}, err
+	}
+
+	credentialJSON, err := json.Marshal(requestParams.Credential)
+	if err != nil {
+		return http.StatusInternalServerError, &AuthTokenPair{}, err
+	}
+	parsedResponse, err := protocol.ParseCredentialRequestResponseBytes(credentialJSON)
+	if err != nil {
+		return http.StatusBadRequest, &AuthTokenPair{}, errPasskeyVerificationFailed
+	}
+
+	// NOTE: 認証器が返したユーザハンドルからユーザを特定し、署名を検証する
+	var signInUser *webAuthnUser
+	handler := func(rawID, userHandle []byte) (webauthn.User, error) {
+		user, err := models.Users(qm.Where("webauthn_user_handle = ?", string(userHandle))).One(ctx, ps.db)
+		if err != nil {
+			return nil, err
+		}
+		signInUser, err = loadWebAuthnUser(ctx, ps.db, user)
+		if err != nil {
+			return nil, err
+		}
+		return signInUser, nil
+	}
+	_, credential, err := webAuthn.ValidatePasskeyLogin(handler, *sessionData, parsedResponse)
+	if err != nil {
+		return http.StatusBadRequest, &AuthTokenPair{}, errPasskeyVerificationFailed
+	}
+	// NOTE: 署名回数が増えていない場合は認証器が複製された可能性があるため、サインインさせない
+	if credential.Authenticator.CloneWarning {
+		return http.StatusBadRequest, &AuthTokenPair{}, errPasskeyVerificationFailed
+	}
+
+	user := signInUser.user
+	if CurrentEmailVerificationPolicy() == EmailVerificationPolicyBlock && !user.EmailVerifiedAt.Valid {
+		return http.StatusBadRequest, &AuthTokenPair{}, errors.New("メールアドレスの確認が完了していません。確認メールに記載のURLを開いてください。")
+	}
+
+	tx, err := ps.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, &AuthTokenPair{}, err
+	}
+	defer tx.Rollback()
+
+	// NOTE: パスキーはユーザの検証を必須としているため、2段階認証は求めない
+	_, err = models.WebauthnCredentials(qm.Where("credential_id = ?", base64.RawURLEncoding.EncodeToString(credential.ID))).UpdateAll(ctx, tx, models.M{
+		"sign_count":   int64(credential.Authenticator.SignCount),
+		"backup_state": credential.Flags.BackupState,
+		"last_used_at": now,
+		"updated_at":   now,
+	})
+	if err != nil {
+		return http.StatusInternalServerError, &AuthTokenPair{}, err
+	}
+	session, err := createSession(ctx, tx, int64(user.ID), userAgent, ipAddress, now)
+	if err != nil {
+		return http.StatusInternalServerError, &AuthTokenPair{}, err
+	}
+	tokenPair, err = issueAuthTokenPair(ctx, tx, session, now)
+	if err != nil {
+		return http.StatusInternalServerError, &AuthTokenPair{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, &AuthTokenPair{}, err
+	}
+	return http.StatusOK, tokenPair, nil
+}
+
+func (ps *passkeyService) DeletePasskey(ctx context.Context, id int64, userID int64) (statusCode int64, err error) {
+	tx, err := ps.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	defer tx.Rollback()
+
+	passkey, err := models.WebauthnCredentials(qm.Where("id = ? AND user_id = ?", id, userID), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return http.StatusNotFound, errors.New("passkey not found")
+		}
+		return http.StatusInternalServerError, err
+	}
+	if _, err := passkey.Delete(ctx, tx); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	if err := recordUserCredentialOutboxEvent(ctx, tx, passkey.ID, userID, passkey.Name, OutboxUserPasskeyRemoved); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusOK, nil
+}
@@ -0,0 +1,236 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/test/authenticators"
+	"app/test/factories"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type TestPasskeyServiceSuite struct {
+	WithDBSuite
+}
+
+var testPasskeyService PasskeyService
+
+func (s *TestPasskeyServiceSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	testMailer = NewMemoryMailer()
+	testPasskeyService = NewPasskeyService(DBCon, testMailer)
+}
+
+func (s *TestPasskeyServiceSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+// NOTE: ブラウザと同様に、オプションをJSONの形で認証器に渡す
+func (s *TestPasskeyServiceSuite) toJSONObject(options interface{}) map[string]interface{} {
+	data, _ := json.Marshal(options)
+	var object map[string]interface{}
+	if err := json.Unmarshal(data, &object); err != nil {
+		s.T().Fatalf("failed to convert options %v", err)
+	}
+	return object
+}
+
+func (s *TestPasskeyServiceSuite) beginRegistration(authenticator *authenticators.SoftwareAuthenticator) (string, map[string]interface{}) {
+	_, ceremonyToken, options, err := testPasskeyService.BeginRegistration(ctx, apis.PostAuthPasskeysRegisterBeginJSONRequestBody{Password: "password"}, int64(user.ID), "192.0.2.1")
+	if err != nil {
+		s.T().Fatalf("failed to begin registration %v", err)
+	}
+	credential, err := authenticator.Register(s.toJSONObject(options))
+	if err != nil {
+		s.T().Fatalf("failed to create credential %v", err)
+	}
+	return ceremonyToken, credential
+}
+
+func (s *TestPasskeyServiceSuite) registerPasskey(authenticator *authenticators.SoftwareAuthenticator) *models.WebauthnCredential {
+	ceremonyToken, credential := s.beginRegistration(authenticator)
+	_, passkey, err := testPasskeyService.FinishRegistration(ctx, apis.PostAuthPasskeysRegisterFinishJSONRequestBody{CeremonyToken: ceremonyToken, Credential: credential}, int64(user.ID))
+	if err != nil {
+		s.T().Fatalf("failed to register passkey %v", err)
+	}
+	return passkey
+}
+
+func (s *TestPasskeyServiceSuite) signIn(authenticator *authenticators.SoftwareAuthenticator) (int64, *AuthTokenPair, error) {
+	_, ceremonyToken, options, err := testPasskeyService.BeginSignIn(ctx)
+	if err != nil {
+		s.T().Fatalf("failed to begin sign in %v", err)
+	}
+	credential, err := authenticator.SignIn(s.toJSONObject(options))
+	if err != nil {
+		s.T().Fatalf("failed to create assertion %v", err)
+	}
+	return testPasskeyService.FinishSignIn(ctx, apis.PostAuthPasskeysSignInFinishJSONRequestBody{CeremonyToken: ceremonyToken, Credential: credential}, "Mozilla/5.0", "192.0.2.1")
+}
+
+func (s *TestPasskeyServiceSuite) TestBeginRegistration() {
+	statusCode, ceremonyToken, options, err := testPasskeyService.BeginRegistration(ctx, apis.PostAuthPasskeysRegisterBeginJSONRequestBody{Password: "password"}, int64(user.ID), "192.0.2.1")
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.NotEmpty(s.T(), ceremonyToken)
+	assert.Equal(s.T(), "localhost", options.RelyingParty.ID)
+	assert.Equal(s.T(), "required", string(options.AuthenticatorSelection.ResidentKey))
+
+	// NOTE: ユーザハンドルにはユーザIDではなくランダムな値を用いる
+	user.Reload(ctx, DBCon)
+	assert.True(s.T(), user.WebauthnUserHandle.Valid)
+	assert.Equal(s.T(), protocol.URLEncodedBase64(user.WebauthnUserHandle.String), options.User.ID)
+}
+
+func (s *TestPasskeyServiceSuite) TestBeginRegistration_BadRequest() {
+	statusCode, _, _, err := testPasskeyService.BeginRegistration(ctx, apis.PostAuthPasskeysRegisterBeginJSONRequestBody{Password: "wrongPassword"}, int64(user.ID), "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), errIncorrectPassword, err.(validation.Errors)["password"])
+
+	// NOTE: 2段階認証を有効にしている場合は、パスワードに加えて認証コードが必要
+	secret, _ := enableTwoFactor(s.T(), int64(user.ID))
+	statusCode, _, _, err = testPasskeyService.BeginRegistration(ctx, apis.PostAuthPasskeysRegisterBeginJSONRequestBody{Password: "password"}, int64(user.ID), "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.NotNil(s.T(), err.(validation.Errors)["code"])
+
+	invalidCode := "000000-invalid"
+	statusCode, _, _, err = testPasskeyService.BeginRegistration(ctx, apis.PostAuthPasskeysRegisterBeginJSONRequestBody{Password: "password", Code: &invalidCode}, int64(user.ID), "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), errInvalidTwoFactorCode, err.(validation.Errors)["code"])
+
+	isExistCeremony, _ := models.WebauthnCeremonies().Exists(ctx, DBCon)
+	assert.False(s.T(), isExistCeremony)
+
+	code := currentTOTPCode(secret, time.Now())
+	statusCode, _, _, err = testPasskeyService.BeginRegistration(ctx, apis.PostAuthPasskeysRegisterBeginJSONRequestBody{Password: "password", Code: &code}, int64(user.ID), "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+}
+
+func (s *TestPasskeyServiceSuite) TestBeginRegistration_TooManyRequests() {
+	// NOTE: パスワードの誤りはサインインと同じ失敗回数の制限の対象になる
+	for i := 0; i < accountSignInDelayThreshold; i++ {
+		testPasskeyService.BeginRegistration(ctx, apis.PostAuthPasskeysRegisterBeginJSONRequestBody{Password: "wrongPassword"}, int64(user.ID), "192.0.2.1")
+	}
+
+	statusCode, _, _, err := testPasskeyService.BeginRegistration(ctx, apis.PostAuthPasskeysRegisterBeginJSONRequestBody{Password: "password"}, int64(user.ID), "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusTooManyRequests), statusCode)
+	assert.IsType(s.T(), &SignInThrottledError{}, err)
+}
+
+func (s *TestPasskeyServiceSuite) TestFinishRegistration_StatusOK() {
+	ceremonyToken, credential := s.beginRegistration(authenticators.NewSoftwareAuthenticator("http://localhost:5173"))
+	name := "MacBook"
+
+	statusCode, passkey, err := testPasskeyService.FinishRegistration(ctx, apis.PostAuthPasskeysRegisterFinishJSONRequestBody{CeremonyToken: ceremonyToken, Name: &name, Credential: credential}, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "MacBook", passkey.Name)
+	assert.Equal(s.T(), credential["id"], passkey.CredentialID)
+	assert.Equal(s.T(), "internal", passkey.Transports)
+	assert.NotEmpty(s.T(), passkey.PublicKey)
+
+	// NOTE: 登録したことをアカウントのメールアドレスに通知する
+	if messages := testMailer.Messages(); assert.Len(s.T(), messages, 1) {
+		assert.Equal(s.T(), "test@example.com", messages[0].To)
+		assert.Equal(s.T(), "パスキー登録のお知らせ", messages[0].Subject)
+		assert.Contains(s.T(), messages[0].Body, "MacBook")
+	}
+
+	// NOTE: 完了したセレモニーは再利用できない
+	statusCode, _, err = testPasskeyService.FinishRegistration(ctx, apis.PostAuthPasskeysRegisterFinishJSONRequestBody{CeremonyToken: ceremonyToken, Credential: credential}, int64(user.ID))
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), errPasskeyCeremonyFailed, err.(validation.Errors)["ceremonyToken"])
+}
+
+func (s *TestPasskeyServiceSuite) TestFinishRegistration_BadRequest() {
+	// NOTE: 別のオリジンで作成された場合
+	ceremonyToken, credential := s.beginRegistration(authenticators.NewSoftwareAuthenticator("https://evil.example.com"))
+	statusCode, _, err := testPasskeyService.FinishRegistration(ctx, apis.PostAuthPasskeysRegisterFinishJSONRequestBody{CeremonyToken: ceremonyToken, Credential: credential}, int64(user.ID))
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), errPasskeyVerificationFailed, err.(validation.Errors)["credential"])
+
+	// NOTE: 別のユーザが開始したセレモニーの場合
+	ceremonyToken, credential = s.beginRegistration(authenticators.NewSoftwareAuthenticator("http://localhost:5173"))
+	statusCode, _, err = testPasskeyService.FinishRegistration(ctx, apis.PostAuthPasskeysRegisterFinishJSONRequestBody{CeremonyToken: ceremonyToken, Credential: credential}, int64(user.ID)+1)
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), errPasskeyCeremonyFailed, err.(validation.Errors)["ceremonyToken"])
+
+	count, _ := models.WebauthnCredentials(qm.Where("user_id = ?", user.ID)).Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(0), count)
+}
+
+func (s *TestPasskeyServiceSuite) TestFinishSignIn_StatusOK() {
+	authenticator := authenticators.NewSoftwareAuthenticator("http://localhost:5173")
+	passkey := s.registerPasskey(authenticator)
+
+	statusCode, tokenPair, err := s.signIn(authenticator)
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.NotEmpty(s.T(), tokenPair.AccessToken)
+	assert.NotEmpty(s.T(), tokenPair.RefreshToken)
+	passkey.Reload(ctx, DBCon)
+	assert.Equal(s.T(), int64(1), passkey.SignCount)
+	assert.True(s.T(), passkey.LastUsedAt.Valid)
+	count, _ := models.Sessions(qm.Where("user_id = ?", user.ID)).Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(1), count)
+}
+
+func (s *TestPasskeyServiceSuite) TestFinishSignIn_CloneWarning() {
+	authenticator := authenticators.NewSoftwareAuthenticator("http://localhost:5173")
+	s.registerPasskey(authenticator)
+	s.signIn(authenticator)
+
+	// NOTE: 署名回数が増えていない場合は、認証器が複製されたとみなす
+	authenticator.ReplayCounter = true
+	statusCode, _, err := s.signIn(authenticator)
+
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), errPasskeyVerificationFailed, err)
+}
+
+func (s *TestPasskeyServiceSuite) TestFinishSignIn_DeletedPasskey() {
+	authenticator := authenticators.NewSoftwareAuthenticator("http://localhost:5173")
+	passkey := s.registerPasskey(authenticator)
+	testPasskeyService.DeletePasskey(ctx, passkey.ID, int64(user.ID))
+
+	statusCode, _, err := s.signIn(authenticator)
+
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), errPasskeyVerificationFailed, err)
+}
+
+func (s *TestPasskeyServiceSuite) TestDeletePasskey_StatusNotFound() {
+	passkey := s.registerPasskey(authenticators.NewSoftwareAuthenticator("http://localhost:5173"))
+
+	// NOTE: 他のユーザのパスキーは削除できない
+	statusCode, _ := testPasskeyService.DeletePasskey(ctx, passkey.ID, int64(user.ID)+1)
+
+	assert.Equal(s.T(), int64(http.StatusNotFound), statusCode)
+	exists, _ := models.WebauthnCredentialExists(ctx, DBCon, passkey.ID)
+	assert.True(s.T(), exists)
+}
+
+func TestPasskeyService(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestPasskeyServiceSuite))
+}
@@ -0,0 +1,52 @@
+package services
+
+import (
+	models "app/models/generated"
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const (
+	// NOTE: メールが第三者に読まれた場合の影響を抑えるため、有効期限は短くする
+	PasswordResetTokenLifetime = time.Hour
+	defaultPasswordResetURL    = "http://localhost:3002/resetPassword"
+)
+
+func passwordResetURL(token string) string {
+	return envOrDefault("PASSWORD_RESET_URL", defaultPasswordResetURL) + "?token=" + url.QueryEscape(token)
+}
+
+// NOTE: 平文のトークンはメールでのみ送信し、DBにはハッシュ値を保存する
+func issuePasswordResetToken(ctx context.Context, exec boil.ContextExecutor, userID int64, now time.Time) (string, error) {
+	token, err := generateRandomToken(32)
+	if err != nil {
+		return "", err
+	}
+
+	passwordResetToken := &models.PasswordResetToken{
+		UserID:    userID,
+		TokenHash: hashToken(token),
+		ExpiresAt: now.Add(PasswordResetTokenLifetime).Truncate(time.Second),
+	}
+	if err := passwordResetToken.Insert(ctx, exec, boil.Infer()); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+func newPasswordResetMailMessage(email string, token string) MailMessage {
+	body := fmt.Sprintf(`パスワード再設定のリクエストを受け付けました。
+以下のURLから新しいパスワードを設定してください。
+
+%s
+
+このURLの有効期限は%d分です。
+パスワードを再設定すると、全ての端末からサインアウトされ、発行済みのアプリパスワードと登録済みのパスキーも削除されます。
+お心当たりがない場合は、このメールを破棄してください。
+`, passwordResetURL(token), int(PasswordResetTokenLifetime.Minutes()))
+	return MailMessage{To: email, Subject: "パスワード再設定のご案内", Body: body}
+}
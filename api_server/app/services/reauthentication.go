@@ -0,0 +1,53 @@
+package services
+
+import (
+	models "app/models/generated"
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var errIncorrectPassword = errors.New("パスワードが正しくありません。")
+
+// NOTE: アカウントの重要な設定を変更する前に、サインイン中のユーザ本人であることをパスワードで再確認する
+//     : セッションを盗まれた場合にパスワードを総当たりされないよう、サインインと同じ失敗回数の制限の対象にする
+func verifyCurrentPassword(ctx context.Context, exec boil.ContextExecutor, mailer Mailer, user *models.User, password string, ipAddress string, now time.Time) (statusCode int64, err error) {
+	email := normalizeSignInEmail(user.Email)
+	ipAddress = normalizeSignInIPAddress(ipAddress)
+	retryAfter, err := checkSignInThrottle(ctx, exec, email, ipAddress, now)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	if retryAfter > 0 {
+		return http.StatusTooManyRequests, &SignInThrottledError{RetryAfter: retryAfter}
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)); err != nil {
+		if err := recordSignInFailure(ctx, exec, mailer, email, ipAddress, user, now); err != nil {
+			return http.StatusInternalServerError, err
+		}
+		return http.StatusBadRequest, validation.Errors{"password": errIncorrectPassword}
+	}
+	return http.StatusOK, nil
+}
+
+// NOTE: 2段階認証を有効にしている場合は、パスワードに加えてTOTPのコードまたはリカバリーコードで再確認する
+//     : 失敗を記録した場合は、呼び出し元でトランザクションを確定してから400を返すこと
+func verifyCurrentSecondFactor(ctx context.Context, exec boil.ContextExecutor, mailer Mailer, user *models.User, code string, ipAddress string, now time.Time) (statusCode int64, err error) {
+	ok, err := verifySecondFactor(ctx, exec, user, code, now)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	if !ok {
+		if err := recordSignInFailure(ctx, exec, mailer, normalizeSignInEmail(user.Email), normalizeSignInIPAddress(ipAddress), user, now); err != nil {
+			return http.StatusInternalServerError, err
+		}
+		return http.StatusBadRequest, validation.Errors{"code": errInvalidTwoFactorCode}
+	}
+	return http.StatusOK, nil
+}
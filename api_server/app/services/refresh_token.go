@@ -0,0 +1,62 @@
+package services
+
+import (
+	models "app/models/generated"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// NOTE: 利用されるたびに同じファミリー内で新しいトークンへ置き換えるため、有効期限は最後の利用から数える
+const RefreshTokenLifetime = 30 * 24 * time.Hour
+
+// NOTE: 同時に送られたリフレッシュで使用済みとなった直後のトークンは、漏洩ではなく競合とみなす猶予期間
+const RefreshTokenReuseGracePeriod = 30 * time.Second
+
+// AuthTokenPair ... サインイン・リフレッシュ時に発行するトークンの組
+type AuthTokenPair struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+func generateRandomToken(size int) (string, error) {
+	randomBytes := make([]byte, size)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(randomBytes), nil
+}
+
+// NOTE: DBには平文を保存せず、ハッシュ値で照合する
+func hashToken(token string) string {
+	digest := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(digest[:])
+}
+
+// NOTE: リフレッシュトークンのファミリーはセッションごとに1つとする
+func issueAuthTokenPair(ctx context.Context, exec boil.ContextExecutor, session *models.Session, now time.Time) (*AuthTokenPair, error) {
+	accessToken, err := SignAuthToken(int(session.UserID), session.ID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := generateRandomToken(32)
+	if err != nil {
+		return nil, err
+	}
+	refreshTokenModel := &models.RefreshToken{
+		UserID:    session.UserID,
+		FamilyID:  session.FamilyID,
+		TokenHash: hashToken(refreshToken),
+		ExpiresAt: now.Add(RefreshTokenLifetime).Truncate(time.Second),
+	}
+	if err := refreshTokenModel.Insert(ctx, exec, boil.Infer()); err != nil {
+		return nil, err
+	}
+	return &AuthTokenPair{AccessToken: accessToken, RefreshToken: refreshToken}, nil
+}
@@ -0,0 +1,103 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/validator"
+	"context"
+	"database/sql"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type SavedFilterService interface {
+	CreateSavedFilter(ctx context.Context, requestParams apis.PostSavedFiltersJSONRequestBody, userID int64) (statusCode int64, savedFilter *models.SavedFilter, err error)
+	FetchSavedFiltersList(ctx context.Context, userID int64) (statusCode int64, savedFiltersList *models.SavedFilterSlice, err error)
+	UpdateSavedFilter(ctx context.Context, id int64, requestParams apis.PutSavedFilterJSONRequestBody, userID int64) (statusCode int64, savedFilter *models.SavedFilter, err error)
+	DeleteSavedFilter(ctx context.Context, id int64, userID int64) (statusCode int64, err error)
+}
+
+type savedFilterService struct {
+	db *sql.DB
+}
+
+func NewSavedFilterService(db *sql.DB) SavedFilterService {
+	return &savedFilterService{db}
+}
+
+func (sfs *savedFilterService) CreateSavedFilter(ctx context.Context, requestParams apis.PostSavedFiltersJSONRequestBody, userID int64) (statusCode int64, savedFilter *models.SavedFilter, err error) {
+	// NOTE: バリデーションチェック
+	validationErrors := validateSavedFilter(requestParams)
+	if validationErrors != nil {
+		return http.StatusBadRequest, &models.SavedFilter{}, validationErrors
+	}
+
+	savedFilter = &models.SavedFilter{}
+	savedFilter.UserID = userID
+	savedFilter.Name = requestParams.Name
+	savedFilter.Query = requestParams.Query
+	if err := savedFilter.Insert(ctx, sfs.db, boil.Infer()); err != nil {
+		return http.StatusInternalServerError, &models.SavedFilter{}, err
+	}
+	return http.StatusOK, savedFilter, nil
+}
+
+func (sfs *savedFilterService) FetchSavedFiltersList(ctx context.Context, userID int64) (statusCode int64, savedFiltersList *models.SavedFilterSlice, err error) {
+	savedFilters, err := models.SavedFilters(qm.Where("user_id = ?", userID), qm.OrderBy("id ASC")).All(ctx, sfs.db)
+	if err != nil {
+		return http.StatusInternalServerError, &models.SavedFilterSlice{}, err
+	}
+	return http.StatusOK, &savedFilters, nil
+}
+
+func (sfs *savedFilterService) UpdateSavedFilter(ctx context.Context, id int64, requestParams apis.PutSavedFilterJSONRequestBody, userID int64) (statusCode int64, savedFilter *models.SavedFilter, err error) {
+	savedFilter, err = models.SavedFilters(qm.Where("id = ? AND user_id = ?", id, userID)).One(ctx, sfs.db)
+	if err != nil {
+		return http.StatusNotFound, &models.SavedFilter{}, err
+	}
+
+	// NOTE: バリデーションチェック
+	validationErrors := validateSavedFilter(apis.PostSavedFiltersJSONRequestBody(requestParams))
+	if validationErrors != nil {
+		return http.StatusBadRequest, &models.SavedFilter{}, validationErrors
+	}
+
+	savedFilter.Name = requestParams.Name
+	savedFilter.Query = requestParams.Query
+	if _, err := savedFilter.Update(ctx, sfs.db, boil.Infer()); err != nil {
+		return http.StatusInternalServerError, &models.SavedFilter{}, err
+	}
+	return http.StatusOK, savedFilter, nil
+}
+
+func (sfs *savedFilterService) DeleteSavedFilter(ctx context.Context, id int64, userID int64) (statusCode int64, err error) {
+	savedFilter, err := models.SavedFilters(qm.Where("id = ? AND user_id = ?", id, userID)).One(ctx, sfs.db)
+	if err != nil {
+		return http.StatusNotFound, err
+	}
+
+	if _, err := savedFilter.Delete(ctx, sfs.db); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusOK, nil
+}
+
+// NOTE: 入力値の検証に加えて、保存前にクエリを解析して構文エラーを返す
+func validateSavedFilter(requestParams apis.PostSavedFiltersJSONRequestBody) error {
+	validationErrors, _ := validator.ValidateSavedFilter(requestParams).(validation.Errors)
+	if validationErrors == nil {
+		validationErrors = validation.Errors{}
+	}
+	if _, ok := validationErrors["query"]; !ok {
+		if _, err := parseTodoQuery(requestParams.Query); err != nil {
+			validationErrors["query"] = err
+		}
+	}
+	if len(validationErrors) == 0 {
+		return nil
+	}
+	return validationErrors
+}
@@ -0,0 +1,119 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/test/factories"
+	"net/http"
+	"testing"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type TestSavedFilterServiceSuite struct {
+	WithDBSuite
+}
+
+var testSavedFilterService SavedFilterService
+
+func (s *TestSavedFilterServiceSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	testSavedFilterService = NewSavedFilterService(DBCon)
+}
+
+func (s *TestSavedFilterServiceSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *TestSavedFilterServiceSuite) createSavedFilter() *models.SavedFilter {
+	requestParams := apis.PostSavedFiltersJSONRequestBody{Name: "今週の会議", Query: "title:会議 AND created<7d"}
+	statusCode, savedFilter, err := testSavedFilterService.CreateSavedFilter(ctx, requestParams, int64(user.ID))
+	if statusCode != http.StatusOK {
+		s.T().Fatalf("failed to create test saved filter %v", err)
+	}
+	return savedFilter
+}
+
+func (s *TestSavedFilterServiceSuite) TestCreateSavedFilter() {
+	savedFilter := s.createSavedFilter()
+
+	assert.Equal(s.T(), "今週の会議", savedFilter.Name)
+	assert.Equal(s.T(), "title:会議 AND created<7d", savedFilter.Query)
+	assert.Equal(s.T(), int64(user.ID), savedFilter.UserID)
+}
+
+func (s *TestSavedFilterServiceSuite) TestCreateSavedFilter_BadRequest() {
+	requestParams := apis.PostSavedFiltersJSONRequestBody{Name: "", Query: ""}
+
+	statusCode, _, err := testSavedFilterService.CreateSavedFilter(ctx, requestParams, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	validationErrors := err.(validation.Errors)
+	assert.Equal(s.T(), "名前は必須入力です。", validationErrors["name"].Error())
+	assert.Equal(s.T(), "クエリは必須入力です。", validationErrors["query"].Error())
+}
+
+func (s *TestSavedFilterServiceSuite) TestCreateSavedFilter_InvalidQuery() {
+	requestParams := apis.PostSavedFiltersJSONRequestBody{Name: "仕事", Query: "title:会議 AND tag:work"}
+
+	statusCode, _, err := testSavedFilterService.CreateSavedFilter(ctx, requestParams, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), "14文字目: 不明なフィールドです: tag（指定できるフィールド: content, created, title, updated）", err.(validation.Errors)["query"].Error())
+}
+
+func (s *TestSavedFilterServiceSuite) TestFetchSavedFiltersList() {
+	s.createSavedFilter()
+
+	statusCode, savedFiltersList, err := testSavedFilterService.FetchSavedFiltersList(ctx, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), *savedFiltersList, 1)
+}
+
+func (s *TestSavedFilterServiceSuite) TestUpdateSavedFilter() {
+	savedFilter := s.createSavedFilter()
+	requestParams := apis.PutSavedFilterJSONRequestBody{Name: "買い物", Query: "title:買い物"}
+
+	statusCode, updatedSavedFilter, err := testSavedFilterService.UpdateSavedFilter(ctx, savedFilter.ID, requestParams, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "買い物", updatedSavedFilter.Name)
+	assert.Equal(s.T(), "title:買い物", updatedSavedFilter.Query)
+}
+
+func (s *TestSavedFilterServiceSuite) TestUpdateSavedFilter_NotFound() {
+	requestParams := apis.PutSavedFilterJSONRequestBody{Name: "買い物", Query: "title:買い物"}
+
+	statusCode, _, _ := testSavedFilterService.UpdateSavedFilter(ctx, 0, requestParams, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusNotFound), statusCode)
+}
+
+func (s *TestSavedFilterServiceSuite) TestDeleteSavedFilter() {
+	savedFilter := s.createSavedFilter()
+
+	statusCode, err := testSavedFilterService.DeleteSavedFilter(ctx, savedFilter.ID, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	exists, _ := models.SavedFilterExists(ctx, DBCon, savedFilter.ID)
+	assert.False(s.T(), exists)
+}
+
+func TestSavedFilterService(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestSavedFilterServiceSuite))
+}
@@ -0,0 +1,163 @@
+package services
+
+import (
+	models "app/models/generated"
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	// NOTE: リクエストのたびに書き込まないよう、最終アクセス日時はこの間隔を空けて更新する
+	sessionLastSeenInterval   = 5 * time.Minute
+	sessionUserAgentMaxLength = 512
+	sessionIPAddressMaxLength = 45
+)
+
+type SessionService interface {
+	FetchSessionsList(ctx context.Context, userID int64) (statusCode int64, sessions models.SessionSlice, err error)
+	RevokeSession(ctx context.Context, id int64, userID int64) (statusCode int64, err error)
+	RevokeOtherSessions(ctx context.Context, currentSessionID int64, userID int64) (statusCode int64, err error)
+	TouchSession(ctx context.Context, id int64, userID int64, now time.Time) (isActive bool, err error)
+}
+
+type sessionService struct {
+	db *sql.DB
+}
+
+func NewSessionService(db *sql.DB) SessionService {
+	return &sessionService{db}
+}
+
+func (ss *sessionService) FetchSessionsList(ctx context.Context, userID int64) (statusCode int64, sessions models.SessionSlice, err error) {
+	// NOTE: リフレッシュトークンの有効期限を過ぎたセッションは再開できないため一覧に含めない
+	sessions, err = models.Sessions(
+		qm.Where("user_id = ? AND revoked_at IS NULL AND last_seen_at >= ?", userID, time.Now().Add(-RefreshTokenLifetime)),
+		qm.OrderBy("last_seen_at DESC, id DESC"),
+	).All(ctx, ss.db)
+	if err != nil {
+		return http.StatusInternalServerError, models.SessionSlice{}, err
+	}
+	return http.StatusOK, sessions, nil
+}
+
+func (ss *sessionService) RevokeSession(ctx context.Context, id int64, userID int64) (statusCode int64, err error) {
+	session, err := models.Sessions(qm.Where("id = ? AND user_id = ? AND revoked_at IS NULL", id, userID)).One(ctx, ss.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return http.StatusNotFound, errors.New("session not found")
+		}
+		return http.StatusInternalServerError, err
+	}
+
+	tx, err := ss.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	defer tx.Rollback()
+
+	if err := revokeSessions(ctx, tx, models.SessionSlice{session}, time.Now()); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusOK, nil
+}
+
+func (ss *sessionService) RevokeOtherSessions(ctx context.Context, currentSessionID int64, userID int64) (statusCode int64, err error) {
+	sessions, err := models.Sessions(qm.Where("user_id = ? AND id <> ? AND revoked_at IS NULL", userID, currentSessionID)).All(ctx, ss.db)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	tx, err := ss.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	defer tx.Rollback()
+
+	if err := revokeSessions(ctx, tx, sessions, time.Now()); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusOK, nil
+}
+
+func (ss *sessionService) TouchSession(ctx context.Context, id int64, userID int64, now time.Time) (isActive bool, err error) {
+	session, err := models.Sessions(qm.Where("id = ? AND user_id = ?", id, userID)).One(ctx, ss.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+	if session.RevokedAt.Valid {
+		return false, nil
+	}
+
+	if now.Sub(session.LastSeenAt) >= sessionLastSeenInterval {
+		session.LastSeenAt = now.Truncate(time.Second)
+		if _, err := session.Update(ctx, ss.db, boil.Whitelist("last_seen_at", "updated_at")); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// NOTE: サインイン時に利用端末の情報とともにセッションを作成する
+func createSession(ctx context.Context, exec boil.ContextExecutor, userID int64, userAgent string, ipAddress string, now time.Time) (*models.Session, error) {
+	familyID, err := generateRandomToken(16)
+	if err != nil {
+		return nil, err
+	}
+
+	session := &models.Session{
+		UserID:     userID,
+		FamilyID:   familyID,
+		UserAgent:  truncateRunes(userAgent, sessionUserAgentMaxLength),
+		IPAddress:  truncateRunes(ipAddress, sessionIPAddressMaxLength),
+		LastSeenAt: now.Truncate(time.Second),
+	}
+	if err := session.Insert(ctx, exec, boil.Infer()); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// NOTE: セッションと、そのセッションに紐づくリフレッシュトークンをまとめて失効させる
+func revokeSessions(ctx context.Context, exec boil.ContextExecutor, sessions models.SessionSlice, now time.Time) error {
+	if len(sessions) == 0 {
+		return nil
+	}
+
+	ids := make([]interface{}, 0, len(sessions))
+	familyIDs := make([]interface{}, 0, len(sessions))
+	for _, session := range sessions {
+		ids = append(ids, session.ID)
+		familyIDs = append(familyIDs, session.FamilyID)
+	}
+	if _, err := models.Sessions(qm.WhereIn("id IN ?", ids...), qm.Where("revoked_at IS NULL")).UpdateAll(ctx, exec, models.M{"revoked_at": null.TimeFrom(now)}); err != nil {
+		return err
+	}
+	_, err := models.RefreshTokens(qm.WhereIn("family_id IN ?", familyIDs...), qm.Where("revoked_at IS NULL")).UpdateAll(ctx, exec, models.M{"revoked_at": null.TimeFrom(now)})
+	return err
+}
+
+func truncateRunes(value string, maxLength int) string {
+	runes := []rune(value)
+	if len(runes) <= maxLength {
+		return value
+	}
+	return string(runes[:maxLength])
+}
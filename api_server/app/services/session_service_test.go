@@ -0,0 +1,144 @@
+package services
+
+import (
+	models "app/models/generated"
+	"app/test/factories"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type TestSessionServiceSuite struct {
+	WithDBSuite
+}
+
+var testSessionService SessionService
+
+func (s *TestSessionServiceSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	testSessionService = NewSessionService(DBCon)
+}
+
+func (s *TestSessionServiceSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *TestSessionServiceSuite) createSession(userID int64, lastSeenAt time.Time) *models.Session {
+	session, err := createSession(ctx, DBCon, userID, "Mozilla/5.0", "192.0.2.1", lastSeenAt)
+	if err != nil {
+		s.T().Fatalf("failed to create session %v", err)
+	}
+	if _, err := issueAuthTokenPair(ctx, DBCon, session, lastSeenAt); err != nil {
+		s.T().Fatalf("failed to issue tokens %v", err)
+	}
+	return session
+}
+
+func (s *TestSessionServiceSuite) TestFetchSessionsList() {
+	activeSession := s.createSession(int64(user.ID), time.Now())
+	revokedSession := s.createSession(int64(user.ID), time.Now())
+	if err := revokeSessions(ctx, DBCon, models.SessionSlice{revokedSession}, time.Now()); err != nil {
+		s.T().Fatalf("failed to revoke session %v", err)
+	}
+	// NOTE: リフレッシュトークンの有効期限を過ぎたセッション
+	s.createSession(int64(user.ID), time.Now().Add(-RefreshTokenLifetime-time.Hour))
+
+	statusCode, sessions, err := testSessionService.FetchSessionsList(ctx, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	if assert.Len(s.T(), sessions, 1) {
+		assert.Equal(s.T(), activeSession.ID, sessions[0].ID)
+		assert.Equal(s.T(), "Mozilla/5.0", sessions[0].UserAgent)
+		assert.Equal(s.T(), "192.0.2.1", sessions[0].IPAddress)
+	}
+}
+
+func (s *TestSessionServiceSuite) TestRevokeSession_StatusOK() {
+	session := s.createSession(int64(user.ID), time.Now())
+
+	statusCode, err := testSessionService.RevokeSession(ctx, session.ID, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+
+	// NOTE: セッションに紐づくリフレッシュトークンも失効していることを確認
+	session, _ = models.FindSession(ctx, DBCon, session.ID)
+	assert.True(s.T(), session.RevokedAt.Valid)
+	count, _ := models.RefreshTokens(qm.Where("family_id = ? AND revoked_at IS NULL", session.FamilyID)).Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(0), count)
+}
+
+func (s *TestSessionServiceSuite) TestRevokeSession_StatusNotFound() {
+	// NOTE: 他のユーザのセッション
+	otherUser := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "other@example.com"}).(*models.User)
+	if err := otherUser.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	session := s.createSession(int64(otherUser.ID), time.Now())
+
+	statusCode, err := testSessionService.RevokeSession(ctx, session.ID, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusNotFound), statusCode)
+	assert.NotNil(s.T(), err)
+	session, _ = models.FindSession(ctx, DBCon, session.ID)
+	assert.False(s.T(), session.RevokedAt.Valid)
+}
+
+func (s *TestSessionServiceSuite) TestRevokeOtherSessions() {
+	currentSession := s.createSession(int64(user.ID), time.Now())
+	otherSession := s.createSession(int64(user.ID), time.Now())
+
+	statusCode, err := testSessionService.RevokeOtherSessions(ctx, currentSession.ID, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	currentSession, _ = models.FindSession(ctx, DBCon, currentSession.ID)
+	assert.False(s.T(), currentSession.RevokedAt.Valid)
+	otherSession, _ = models.FindSession(ctx, DBCon, otherSession.ID)
+	assert.True(s.T(), otherSession.RevokedAt.Valid)
+}
+
+func (s *TestSessionServiceSuite) TestTouchSession() {
+	lastSeenAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	session := s.createSession(int64(user.ID), lastSeenAt)
+
+	// NOTE: 更新間隔を空けずにアクセスした場合は最終アクセス日時を更新しない
+	isActive, err := testSessionService.TouchSession(ctx, session.ID, int64(user.ID), lastSeenAt.Add(time.Minute))
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), isActive)
+	session, _ = models.FindSession(ctx, DBCon, session.ID)
+	assert.True(s.T(), lastSeenAt.Equal(session.LastSeenAt))
+
+	now := time.Now().Truncate(time.Second)
+	isActive, err = testSessionService.TouchSession(ctx, session.ID, int64(user.ID), now)
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), isActive)
+	session, _ = models.FindSession(ctx, DBCon, session.ID)
+	assert.True(s.T(), now.Equal(session.LastSeenAt))
+
+	// NOTE: 失効したセッションは無効とする
+	if err := revokeSessions(ctx, DBCon, models.SessionSlice{session}, now); err != nil {
+		s.T().Fatalf("failed to revoke session %v", err)
+	}
+	isActive, err = testSessionService.TouchSession(ctx, session.ID, int64(user.ID), now)
+	assert.Nil(s.T(), err)
+	assert.False(s.T(), isActive)
+}
+
+func TestSessionService(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestSessionServiceSuite))
+}
@@ -0,0 +1,236 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/validator"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type ShareLinkService interface {
+	CreateShareLink(ctx context.Context, todoID int64, requestParams apis.PostTodoShareLinksJSONRequestBody, userID int64) (statusCode int64, shareLink *models.ShareLink, token string, err error)
+	FetchShareLinksList(ctx context.Context, userID int64) (statusCode int64, shareLinksList *models.ShareLinkSlice, err error)
+	RevokeShareLink(ctx context.Context, id int64, userID int64) (statusCode int64, err error)
+	ShowSharedTodo(ctx context.Context, token string, password *string, ipAddress string) (statusCode int64, todo *models.Todo, err error)
+}
+
+// ShareLinkThrottledError ... パスワードの試行回数の制限により照合しなかったエラー、RetryAfterは再試行できるまでの時間
+type ShareLinkThrottledError struct {
+	RetryAfter time.Duration
+}
+
+func (e *ShareLinkThrottledError) Error() string {
+	return "パスワードの試行回数が上限を超えました。しばらく時間を空けてからお試しください。"
+}
+
+type shareLinkService struct {
+	db *sql.DB
+}
+
+func NewShareLinkService(db *sql.DB) ShareLinkService {
+	return &shareLinkService{db}
+}
+
+func (ss *shareLinkService) CreateShareLink(ctx context.Context, todoID int64, requestParams apis.PostTodoShareLinksJSONRequestBody, userID int64) (statusCode int64, shareLink *models.ShareLink, token string, err error) {
+	// NOTE: 自身のTodoのみ共有可能
+	todo, err := models.Todos(qm.Where("id = ? AND user_id = ?", todoID, userID)).One(ctx, ss.db)
+	if err != nil {
+		return http.StatusNotFound, &models.ShareLink{}, "", err
+	}
+
+	// NOTE: バリデーションチェック
+	validationErrors := validator.ValidateCreateShareLink(requestParams)
+	if validationErrors != nil {
+		return http.StatusBadRequest, &models.ShareLink{}, "", validationErrors
+	}
+
+	token, err = ss.generateToken()
+	if err != nil {
+		return http.StatusInternalServerError, &models.ShareLink{}, "", err
+	}
+
+	shareLink = &models.ShareLink{}
+	shareLink.UserID = userID
+	shareLink.TodoID = todoID
+	// NOTE: トークンはハッシュ化した値のみ保存する
+	shareLink.TokenDigest = ss.digestToken(token)
+	if requestParams.ExpiresAt != nil {
+		shareLink.ExpiresAt = null.TimeFrom(*requestParams.ExpiresAt)
+	}
+	if requestParams.Password != nil {
+		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(*requestParams.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return http.StatusInternalServerError, &models.ShareLink{}, "", err
+		}
+		shareLink.Password = null.StringFrom(string(hashedPassword))
+	}
+
+	tx, err := ss.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, &models.ShareLink{}, "", err
+	}
+	defer tx.Rollback()
+
+	if err := shareLink.Insert(ctx, tx, boil.Infer()); err != nil {
+		return http.StatusInternalServerError, &models.ShareLink{}, "", err
+	}
+	if err := recordActivity(ctx, tx, todo, userID, ActivityShareLinkCreated); err != nil {
+		return http.StatusInternalServerError, &models.ShareLink{}, "", err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, &models.ShareLink{}, "", err
+	}
+	return http.StatusOK, shareLink, token, nil
+}
+
+func (ss *shareLinkService) FetchShareLinksList(ctx context.Context, userID int64) (statusCode int64, shareLinksList *models.ShareLinkSlice, err error) {
+	// NOTE: 失効・期限切れのものを除いた有効な共有リンクのみ取得
+	shareLinks, err := models.ShareLinks(
+		qm.Where("user_id = ? AND revoked_at IS NULL", userID),
+		qm.Where("(expires_at IS NULL OR expires_at > ?)", time.Now()),
+		qm.OrderBy("id DESC"),
+	).All(ctx, ss.db)
+	if err != nil {
+		return http.StatusInternalServerError, &models.ShareLinkSlice{}, err
+	}
+
+	return http.StatusOK, &shareLinks, nil
+}
+
+func (ss *shareLinkService) RevokeShareLink(ctx context.Context, id int64, userID int64) (statusCode int64, err error) {
+	shareLink, err := models.ShareLinks(qm.Where("id = ? AND user_id = ? AND revoked_at IS NULL", id, userID)).One(ctx, ss.db)
+	if err != nil {
+		return http.StatusNotFound, err
+	}
+
+	todo, err := models.Todos(qm.Where("id = ?", shareLink.TodoID)).One(ctx, ss.db)
+	if err != nil {
+		return http.StatusNotFound, err
+	}
+
+	tx, err := ss.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	defer tx.Rollback()
+
+	shareLink.RevokedAt = null.TimeFrom(time.Now())
+	if _, err := shareLink.Update(ctx, tx, boil.Infer()); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	if err := recordActivity(ctx, tx, todo, userID, ActivityShareLinkRevoked); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusOK, nil
+}
+
+func (ss *shareLinkService) ShowSharedTodo(ctx context.Context, token string, password *string, ipAddress string) (statusCode int64, todo *models.Todo, err error) {
+	// NOTE: 署名が正しくないトークンはDBを参照せずに弾く
+	if !ss.verifyToken(token) {
+		return http.StatusNotFound, &models.Todo{}, errors.New("invalid share token")
+	}
+
+	now := time.Now()
+	shareLink, err := models.ShareLinks(
+		qm.Where("token_digest = ? AND revoked_at IS NULL", ss.digestToken(token)),
+		qm.Where("(expires_at IS NULL OR expires_at > ?)", now),
+	).One(ctx, ss.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return http.StatusNotFound, &models.Todo{}, err
+		}
+		return http.StatusInternalServerError, &models.Todo{}, err
+	}
+
+	// NOTE: パスワード付きの共有リンクの場合はパスワードを照合
+	if shareLink.Password.Valid {
+		if password == nil {
+			return http.StatusUnauthorized, &models.Todo{}, errors.New("password is required")
+		}
+
+		// NOTE: サインインと同じく、共有リンクごと・IPアドレスごとの失敗回数で総当たりを制限する
+		throttleKey := shareLinkThrottleKey(shareLink.ID)
+		ipAddress = normalizeSignInIPAddress(ipAddress)
+		retryAfter, err := checkSignInThrottle(ctx, ss.db, throttleKey, ipAddress, now)
+		if err != nil {
+			return http.StatusInternalServerError, &models.Todo{}, err
+		}
+		if retryAfter > 0 {
+			return http.StatusTooManyRequests, &models.Todo{}, &ShareLinkThrottledError{RetryAfter: retryAfter}
+		}
+		if err := bcrypt.CompareHashAndPassword([]byte(shareLink.Password.String), []byte(*password)); err != nil {
+			if err := recordSignInFailure(ctx, ss.db, nil, throttleKey, ipAddress, nil, now); err != nil {
+				return http.StatusInternalServerError, &models.Todo{}, err
+			}
+			return http.StatusUnauthorized, &models.Todo{}, errors.New("password is incorrect")
+		}
+		if err := clearSignInFailures(ctx, ss.db, throttleKey); err != nil {
+			return http.StatusInternalServerError, &models.Todo{}, err
+		}
+	}
+
+	todo, err = models.Todos(qm.Where("id = ? AND user_id = ?", shareLink.TodoID, shareLink.UserID)).One(ctx, ss.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return http.StatusNotFound, &models.Todo{}, err
+		}
+		return http.StatusInternalServerError, &models.Todo{}, err
+	}
+	return http.StatusOK, todo, nil
+}
+
+// NOTE: 失敗の記録はサインインと同じテーブルを使い、メールアドレスの代わりに共有リンクを識別する値で数える
+func shareLinkThrottleKey(shareLinkID int64) string {
+	return fmt.Sprintf("share_link:%d", shareLinkID)
+}
+
+// NOTE: 共有トークンは「ランダム値.署名」の形式で生成する
+func (ss *shareLinkService) generateToken() (string, error) {
+	randomBytes := make([]byte, 32)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return "", err
+	}
+	payload := base64.RawURLEncoding.EncodeToString(randomBytes)
+	return payload + "." + ss.sign(payload), nil
+}
+
+func (ss *shareLinkService) verifyToken(token string) bool {
+	payload, signature, found := strings.Cut(token, ".")
+	if !found {
+		return false
+	}
+	return hmac.Equal([]byte(signature), []byte(ss.sign(payload)))
+}
+
+func (ss *shareLinkService) sign(payload string) string {
+	mac := hmac.New(sha256.New, []byte(os.Getenv("SHARE_LINK_TOKEN_KEY")))
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (ss *shareLinkService) digestToken(token string) string {
+	digest := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(digest[:])
+}
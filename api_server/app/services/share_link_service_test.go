@@ -0,0 +1,225 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/test/factories"
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type TestShareLinkServiceSuite struct {
+	WithDBSuite
+}
+
+var (
+	testShareLinkService ShareLinkService
+	sharedTodo           *models.Todo
+)
+
+func (s *TestShareLinkServiceSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	// NOTE: 共有対象のTodoの作成
+	sharedTodo = &models.Todo{Title: "test title 1", Content: null.String{String: "test content 1", Valid: true}, UserID: int64(user.ID)}
+	if err := sharedTodo.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	testShareLinkService = NewShareLinkService(DBCon)
+}
+
+func (s *TestShareLinkServiceSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *TestShareLinkServiceSuite) TestCreateShareLink_StatusOk() {
+	expiresAt := time.Now().Add(time.Hour)
+	password := "password"
+	requestParams := apis.PostTodoShareLinksJSONRequestBody{ExpiresAt: &expiresAt, Password: &password}
+
+	statusCode, shareLink, token, err := testShareLinkService.CreateShareLink(ctx, sharedTodo.ID, requestParams, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.NotEmpty(s.T(), token)
+	// NOTE: トークン・パスワードはそのまま保存されていないこと
+	if err := shareLink.Reload(ctx, DBCon); err != nil {
+		s.T().Fatalf("failed to reload share link %v", err)
+	}
+	assert.NotEqual(s.T(), token, shareLink.TokenDigest)
+	assert.True(s.T(), shareLink.Password.Valid)
+	assert.NotEqual(s.T(), password, shareLink.Password.String)
+	assert.True(s.T(), shareLink.ExpiresAt.Valid)
+}
+
+func (s *TestShareLinkServiceSuite) TestCreateShareLink_ValidationError() {
+	expiresAt := time.Now().Add(-time.Hour)
+	password := "short"
+	requestParams := apis.PostTodoShareLinksJSONRequestBody{ExpiresAt: &expiresAt, Password: &password}
+
+	statusCode, _, token, err := testShareLinkService.CreateShareLink(ctx, sharedTodo.ID, requestParams, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), "", token)
+	assert.Contains(s.T(), err.Error(), "有効期限は現在より後の日時を指定してください。")
+	assert.Contains(s.T(), err.Error(), "パスワードは8 ~ 24文字での入力をお願いします。")
+}
+
+func (s *TestShareLinkServiceSuite) TestCreateShareLink_NotFound() {
+	statusCode, _, _, err := testShareLinkService.CreateShareLink(ctx, sharedTodo.ID, apis.PostTodoShareLinksJSONRequestBody{}, int64(user.ID+1))
+
+	assert.Equal(s.T(), int64(http.StatusNotFound), statusCode)
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestShareLinkServiceSuite) TestFetchShareLinksList() {
+	_, activeShareLink, _, _ := testShareLinkService.CreateShareLink(ctx, sharedTodo.ID, apis.PostTodoShareLinksJSONRequestBody{}, int64(user.ID))
+	_, revokedShareLink, _, _ := testShareLinkService.CreateShareLink(ctx, sharedTodo.ID, apis.PostTodoShareLinksJSONRequestBody{}, int64(user.ID))
+	testShareLinkService.RevokeShareLink(ctx, revokedShareLink.ID, int64(user.ID))
+	// NOTE: 期限切れの共有リンク
+	expiredShareLink := models.ShareLink{UserID: int64(user.ID), TodoID: sharedTodo.ID, TokenDigest: "expired", ExpiresAt: null.TimeFrom(time.Now().Add(-time.Hour))}
+	if err := expiredShareLink.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create expired share link %v", err)
+	}
+
+	statusCode, shareLinksList, err := testShareLinkService.FetchShareLinksList(ctx, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), *shareLinksList, 1)
+	assert.Equal(s.T(), activeShareLink.ID, (*shareLinksList)[0].ID)
+}
+
+func (s *TestShareLinkServiceSuite) TestRevokeShareLink_StatusOk() {
+	_, shareLink, _, _ := testShareLinkService.CreateShareLink(ctx, sharedTodo.ID, apis.PostTodoShareLinksJSONRequestBody{}, int64(user.ID))
+
+	statusCode, err := testShareLinkService.RevokeShareLink(ctx, shareLink.ID, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	// NOTE: 失効日時が記録されていることの確認
+	if err := shareLink.Reload(ctx, DBCon); err != nil {
+		s.T().Fatalf("failed to reload share link %v", err)
+	}
+	assert.True(s.T(), shareLink.RevokedAt.Valid)
+}
+
+func (s *TestShareLinkServiceSuite) TestRevokeShareLink_NotFound() {
+	_, shareLink, _, _ := testShareLinkService.CreateShareLink(ctx, sharedTodo.ID, apis.PostTodoShareLinksJSONRequestBody{}, int64(user.ID))
+
+	statusCode, err := testShareLinkService.RevokeShareLink(ctx, shareLink.ID, int64(user.ID+1))
+
+	assert.Equal(s.T(), int64(http.StatusNotFound), statusCode)
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestShareLinkServiceSuite) TestShowSharedTodo_StatusOk() {
+	_, _, token, _ := testShareLinkService.CreateShareLink(ctx, sharedTodo.ID, apis.PostTodoShareLinksJSONRequestBody{}, int64(user.ID))
+
+	statusCode, todo, err := testShareLinkService.ShowSharedTodo(ctx, token, nil, "192.0.2.1")
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "test title 1", todo.Title)
+}
+
+func (s *TestShareLinkServiceSuite) TestShowSharedTodo_WithPassword() {
+	password := "password"
+	_, _, token, _ := testShareLinkService.CreateShareLink(ctx, sharedTodo.ID, apis.PostTodoShareLinksJSONRequestBody{Password: &password}, int64(user.ID))
+
+	statusCode, _, _ := testShareLinkService.ShowSharedTodo(ctx, token, nil, "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusUnauthorized), statusCode)
+
+	wrongPassword := "wrong_password"
+	statusCode, _, _ = testShareLinkService.ShowSharedTodo(ctx, token, &wrongPassword, "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusUnauthorized), statusCode)
+
+	statusCode, todo, err := testShareLinkService.ShowSharedTodo(ctx, token, &password, "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "test title 1", todo.Title)
+}
+
+func (s *TestShareLinkServiceSuite) TestShowSharedTodo_PasswordThrottle() {
+	password := "password"
+	_, shareLink, token, _ := testShareLinkService.CreateShareLink(ctx, sharedTodo.ID, apis.PostTodoShareLinksJSONRequestBody{Password: &password}, int64(user.ID))
+
+	// NOTE: 共有リンクごとの失敗回数が上限に達した後は、正しいパスワードでも照合しない
+	wrongPassword := "wrong_password"
+	for i := 0; i < AccountLockoutThreshold; i++ {
+		failure := &models.SignInFailure{Email: shareLinkThrottleKey(shareLink.ID), IPAddress: "198.51.100.1", CreatedAt: time.Now().Add(-10 * time.Minute)}
+		if err := failure.Insert(ctx, DBCon, boil.Infer()); err != nil {
+			s.T().Fatalf("failed to create sign in failure %v", err)
+		}
+	}
+	statusCode, _, _ := testShareLinkService.ShowSharedTodo(ctx, token, &wrongPassword, "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusUnauthorized), statusCode)
+
+	statusCode, _, err := testShareLinkService.ShowSharedTodo(ctx, token, &password, "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusTooManyRequests), statusCode)
+	var throttledErr *ShareLinkThrottledError
+	if assert.ErrorAs(s.T(), err, &throttledErr) {
+		assert.InDelta(s.T(), AccountLockoutDuration.Seconds(), throttledErr.RetryAfter.Seconds(), 5)
+	}
+
+	// NOTE: 他の共有リンクは影響を受けない
+	_, _, otherToken, _ := testShareLinkService.CreateShareLink(ctx, sharedTodo.ID, apis.PostTodoShareLinksJSONRequestBody{Password: &password}, int64(user.ID))
+	statusCode, _, _ = testShareLinkService.ShowSharedTodo(ctx, otherToken, &password, "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+}
+
+func (s *TestShareLinkServiceSuite) TestShowSharedTodo_InternalServerError() {
+	_, _, token, _ := testShareLinkService.CreateShareLink(ctx, sharedTodo.ID, apis.PostTodoShareLinksJSONRequestBody{}, int64(user.ID))
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	// NOTE: 該当なし以外のDBのエラーは404にしない
+	statusCode, _, err := testShareLinkService.ShowSharedTodo(canceledCtx, token, nil, "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusInternalServerError), statusCode)
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestShareLinkServiceSuite) TestShowSharedTodo_NotFound() {
+	_, shareLink, token, _ := testShareLinkService.CreateShareLink(ctx, sharedTodo.ID, apis.PostTodoShareLinksJSONRequestBody{}, int64(user.ID))
+
+	// NOTE: 署名が改ざんされたトークン
+	statusCode, _, _ := testShareLinkService.ShowSharedTodo(ctx, token+"x", nil, "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusNotFound), statusCode)
+
+	// NOTE: 失効済みのトークン
+	testShareLinkService.RevokeShareLink(ctx, shareLink.ID, int64(user.ID))
+	statusCode, _, _ = testShareLinkService.ShowSharedTodo(ctx, token, nil, "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusNotFound), statusCode)
+}
+
+func (s *TestShareLinkServiceSuite) TestShowSharedTodo_Expired() {
+	_, shareLink, token, _ := testShareLinkService.CreateShareLink(ctx, sharedTodo.ID, apis.PostTodoShareLinksJSONRequestBody{}, int64(user.ID))
+	shareLink.ExpiresAt = null.TimeFrom(time.Now().Add(-time.Minute))
+	if _, err := shareLink.Update(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to update share link %v", err)
+	}
+
+	statusCode, _, err := testShareLinkService.ShowSharedTodo(ctx, token, nil, "192.0.2.1")
+
+	assert.Equal(s.T(), int64(http.StatusNotFound), statusCode)
+	assert.NotNil(s.T(), err)
+}
+
+func TestShareLinkService(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestShareLinkServiceSuite))
+}
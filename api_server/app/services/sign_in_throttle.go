@@ -0,0 +1,228 @@
+package services
+
+import (
+	models "app/models/generated"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// NOTE: この期間内の失敗回数で、待ち時間とロックを判定する
+	SignInFailureWindow = 15 * time.Minute
+	// NOTE: ロックの期間は判定期間より長くし、解除された時点で以前の失敗が数えられないようにする
+	AccountLockoutThreshold = 10
+	AccountLockoutDuration  = 30 * time.Minute
+	// NOTE: 複数のアカウントに対する総当たりを防ぐため、IPアドレスごとにも上限を設ける
+	IPLockoutThreshold = 50
+	IPLockoutDuration  = 30 * time.Minute
+
+	accountSignInDelayThreshold = 3
+	ipSignInDelayThreshold      = 10
+	signInBaseDelay             = time.Second
+	signInMaxDelay              = 30 * time.Second
+
+	signInLockoutScopeAccount = "account"
+	signInLockoutScopeIP      = "ip"
+	defaultAccountUnlockURL   = "http://localhost:3002/unlockAccount"
+)
+
+// SignInThrottledError ... 試行回数の制限によりサインインを受け付けなかったエラー、RetryAfterは再試行できるまでの時間
+type SignInThrottledError struct {
+	RetryAfter time.Duration
+}
+
+// NOTE: アカウントの有無を推測されないよう、ロックと待ち時間で同じメッセージを返す
+func (e *SignInThrottledError) Error() string {
+	return "サインインの試行回数が上限を超えました。しばらく時間を空けてからお試しください。"
+}
+
+// NOTE: 存在しないユーザの場合もパスワードを照合し、応答時間からアカウントの有無を推測されないようにする
+var dummyPasswordHash = sync.OnceValue(func() string {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("dummy-password"), bcrypt.DefaultCost)
+	return string(hash)
+})
+
+// NOTE: 大文字・小文字や前後の空白を変えて失敗回数の制限を回避されないよう、正規化して数える
+func normalizeSignInEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// NOTE: 不正な値や長すぎる値で失敗の記録に失敗し、回数の制限を回避されないよう正規化する
+func normalizeSignInIPAddress(ipAddress string) string {
+	if ip := net.ParseIP(strings.TrimSpace(ipAddress)); ip != nil {
+		return ip.String()
+	}
+	return truncateRunes(ipAddress, sessionIPAddressMaxLength)
+}
+
+// NOTE: 閾値を超えた後は、失敗するごとに待ち時間を倍にする
+func signInDelay(failures int64, threshold int64) time.Duration {
+	if failures < threshold {
+		return 0
+	}
+	delay := signInBaseDelay
+	for i := threshold; i < failures && delay < signInMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, signInMaxDelay)
+}
+
+func recentSignInFailures(ctx context.Context, exec boil.ContextExecutor, column string, value string, now time.Time) (int64, time.Time, error) {
+	query := []qm.QueryMod{qm.Where(column+" = ? AND created_at > ?", value, now.Add(-SignInFailureWindow))}
+	count, err := models.SignInFailures(query...).Count(ctx, exec)
+	if err != nil || count == 0 {
+		return 0, time.Time{}, err
+	}
+	lastFailure, err := models.SignInFailures(append(query, qm.OrderBy("created_at DESC"))...).One(ctx, exec)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	return count, lastFailure.CreatedAt, nil
+}
+
+// NOTE: ロック中、または前回の失敗から待ち時間が経過していない場合に、再試行できるまでの時間を返す
+func checkSignInThrottle(ctx context.Context, exec boil.ContextExecutor, email string, ipAddress string, now time.Time) (time.Duration, error) {
+	lockout, err := models.SignInLockouts(
+		qm.Where("unlocked_at IS NULL AND locked_until > ?", now),
+		qm.Where("((scope = ? AND email = ?) OR (scope = ? AND ip_address = ?))", signInLockoutScopeAccount, email, signInLockoutScopeIP, ipAddress),
+		qm.OrderBy("locked_until DESC"),
+	).One(ctx, exec)
+	if err == nil {
+		return lockout.LockedUntil.Sub(now), nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return 0, err
+	}
+
+	var retryAfter time.Duration
+	for _, target := range []struct {
+		column    string
+		value     string
+		threshold int64
+	}{
+		{column: "email", value: email, threshold: accountSignInDelayThreshold},
+		{column: "ip_address", value: ipAddress, threshold: ipSignInDelayThreshold},
+	} {
+		count, lastFailedAt, err := recentSignInFailures(ctx, exec, target.column, target.value, now)
+		if err != nil {
+			return 0, err
+		}
+		delay := signInDelay(count, target.threshold)
+		if wait := lastFailedAt.Add(delay).Sub(now); delay > 0 && wait > retryAfter {
+			retryAfter = wait
+		}
+	}
+	return retryAfter, nil
+}
+
+// NOTE: 失敗を記録し、上限に達した場合はアカウントまたはIPアドレスをロックする
+func recordSignInFailure(ctx context.Context, exec boil.ContextExecutor, mailer Mailer, email string, ipAddress string, user *models.User, now time.Time) error {
+	failure := &models.SignInFailure{Email: email, IPAddress: ipAddress}
+	if err := failure.Insert(ctx, exec, boil.Infer()); err != nil {
+		return err
+	}
+
+	accountFailures, _, err := recentSignInFailures(ctx, exec, "email", email, now)
+	if err != nil {
+		return err
+	}
+	if accountFailures >= AccountLockoutThreshold {
+		if err := lockAccount(ctx, exec, mailer, email, ipAddress, user, accountFailures, now); err != nil {
+			return err
+		}
+	}
+
+	ipFailures, _, err := recentSignInFailures(ctx, exec, "ip_address", ipAddress, now)
+	if err != nil {
+		return err
+	}
+	if ipFailures >= IPLockoutThreshold {
+		lockout := &models.SignInLockout{
+			Scope:          signInLockoutScopeIP,
+			IPAddress:      ipAddress,
+			FailedAttempts: int(ipFailures),
+			LockedUntil:    now.Add(IPLockoutDuration).Truncate(time.Second),
+		}
+		if err := lockout.Insert(ctx, exec, boil.Infer()); err != nil {
+			return err
+		}
+		log.Printf("sign-in locked: lockout %d, scope %s, ip %s", lockout.ID, lockout.Scope, ipAddress)
+	}
+	return nil
+}
+
+// NOTE: 登録済みのアカウントの場合のみ、本人が解除できるようメールを送る
+func lockAccount(ctx context.Context, exec boil.ContextExecutor, mailer Mailer, email string, ipAddress string, user *models.User, failures int64, now time.Time) error {
+	lockout := &models.SignInLockout{
+		Scope:          signInLockoutScopeAccount,
+		Email:          null.StringFrom(email),
+		IPAddress:      ipAddress,
+		FailedAttempts: int(failures),
+		LockedUntil:    now.Add(AccountLockoutDuration).Truncate(time.Second),
+	}
+	var token string
+	if user != nil {
+		var err error
+		token, err = generateRandomToken(32)
+		if err != nil {
+			return err
+		}
+		lockout.UserID = null.Int64From(int64(user.ID))
+		lockout.UnlockTokenHash = null.StringFrom(hashToken(token))
+	}
+	if err := lockout.Insert(ctx, exec, boil.Infer()); err != nil {
+		return err
+	}
+	log.Printf("sign-in locked: lockout %d, scope %s, user %d", lockout.ID, lockout.Scope, lockout.UserID.Int64)
+
+	if user == nil {
+		return nil
+	}
+	// NOTE: 送信に失敗した場合もロックは維持し、ログにのみ残す
+	if err := mailer.Send(ctx, newAccountUnlockMailMessage(user.Email, token)); err != nil {
+		log.Printf("failed to send account unlock mail to user %d: %v", user.ID, err)
+	}
+	return nil
+}
+
+// NOTE: ロックを解除し、以前の失敗を数えないようにする
+func unlockAccount(ctx context.Context, exec boil.ContextExecutor, email string, now time.Time) error {
+	if _, err := models.SignInLockouts(qm.Where("scope = ? AND email = ? AND unlocked_at IS NULL", signInLockoutScopeAccount, email)).UpdateAll(ctx, exec, models.M{"unlocked_at": null.TimeFrom(now), "updated_at": now}); err != nil {
+		return err
+	}
+	return clearSignInFailures(ctx, exec, email)
+}
+
+func clearSignInFailures(ctx context.Context, exec boil.ContextExecutor, email string) error {
+	_, err := models.SignInFailures(qm.Where("email = ?", email)).DeleteAll(ctx, exec)
+	return err
+}
+
+func accountUnlockURL(token string) string {
+	return envOrDefault("ACCOUNT_UNLOCK_URL", defaultAccountUnlockURL) + "?token=" + url.QueryEscape(token)
+}
+
+func newAccountUnlockMailMessage(email string, token string) MailMessage {
+	body := fmt.Sprintf(`サインインに続けて失敗したため、アカウントを一時的にロックしました。
+ご本人の操作の場合は、以下のURLからロックを解除できます。
+
+%s
+
+ロックは%d分後に自動で解除されます。
+お心当たりがない場合は、第三者がパスワードを試している可能性があります。パスワードの変更をご検討ください。
+`, accountUnlockURL(token), int(AccountLockoutDuration.Minutes()))
+	return MailMessage{To: email, Subject: "アカウントのロックのお知らせ", Body: body}
+}
@@ -0,0 +1,30 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"cloud.google.com/go/storage"
+)
+
+func uploadObject(ctx context.Context, bucket *storage.BucketHandle, path string, reader io.Reader) error {
+	writer := bucket.Object(path).NewWriter(ctx)
+	// NOTE: ファイルをCloud Storageにコピー
+	if _, err := io.Copy(writer, reader); err != nil {
+		writer.Close()
+		return err
+	}
+	// NOTE: Writerを閉じて完了
+	return writer.Close()
+}
+
+func deleteObjects(ctx context.Context, bucket *storage.BucketHandle, paths []string) error {
+	for _, path := range paths {
+		// NOTE: 既に存在しないファイルは削除済みとして扱う
+		if err := bucket.Object(path).Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+			return err
+		}
+	}
+	return nil
+}
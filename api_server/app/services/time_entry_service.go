@@ -0,0 +1,295 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/validator"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// NOTE: レポートで集計できる期間の上限(日数)
+const maxTimeReportDays = 366
+
+const timeReportDateLayout = "2006-01-02"
+
+type TimeEntryService interface {
+	StartTimer(ctx context.Context, todoID int64, userID int64) (statusCode int64, timeEntry *models.TimeEntry, err error)
+	StopTimer(ctx context.Context, todoID int64, userID int64) (statusCode int64, timeEntry *models.TimeEntry, err error)
+	CreateTimeEntry(ctx context.Context, requestParams apis.PostTimeEntriesJSONRequestBody, userID int64) (statusCode int64, timeEntry *models.TimeEntry, err error)
+	FetchTimeEntriesList(ctx context.Context, requestParams apis.GetTimeEntriesParams, userID int64) (statusCode int64, timeEntriesList *models.TimeEntrySlice, err error)
+	DeleteTimeEntry(ctx context.Context, id int64, userID int64) (statusCode int64, err error)
+	FetchTimeReport(ctx context.Context, from openapi_types.Date, to openapi_types.Date, userID int64) (statusCode int64, timeReport *TimeReport, err error)
+	ExportTimeReport(ctx context.Context, from openapi_types.Date, to openapi_types.Date, userID int64) (statusCode int64, body io.Reader, fileName string, err error)
+}
+
+type timeEntryService struct {
+	db *sql.DB
+}
+
+func NewTimeEntryService(db *sql.DB) TimeEntryService {
+	return &timeEntryService{db}
+}
+
+type TimeReport struct {
+	From         time.Time
+	To           time.Time
+	TotalSeconds int64
+	Days         []TimeReportDay
+}
+
+type TimeReportDay struct {
+	Date         time.Time
+	TotalSeconds int64
+	Todos        []TimeReportTodo
+}
+
+type TimeReportTodo struct {
+	TodoID       int64
+	Title        string
+	TotalSeconds int64
+}
+
+// NOTE: 実行中のタイマーはnowまでの経過時間を返す
+func TimeEntryDurationSeconds(timeEntry *models.TimeEntry, now time.Time) int64 {
+	stoppedAt := now
+	if timeEntry.StoppedAt.Valid {
+		stoppedAt = timeEntry.StoppedAt.Time
+	}
+	if stoppedAt.Before(timeEntry.StartedAt) {
+		return 0
+	}
+	return int64(stoppedAt.Sub(timeEntry.StartedAt) / time.Second)
+}
+
+func (tes *timeEntryService) StartTimer(ctx context.Context, todoID int64, userID int64) (statusCode int64, timeEntry *models.TimeEntry, err error) {
+	isExistTodo, err := models.Todos(qm.Where("id = ? AND user_id = ?", todoID, userID)).Exists(ctx, tes.db)
+	if err != nil {
+		return http.StatusInternalServerError, &models.TimeEntry{}, err
+	}
+	if !isExistTodo {
+		return http.StatusNotFound, &models.TimeEntry{}, errors.New("todo not found")
+	}
+
+	tx, err := tes.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, &models.TimeEntry{}, err
+	}
+	defer tx.Rollback()
+
+	// NOTE: 同時に開始された場合にタイマーが2つ動かないよう、ユーザの行をロックしてから確認する
+	if _, err := models.Users(qm.Where("id = ?", userID), qm.For("UPDATE")).One(ctx, tx); err != nil {
+		return http.StatusInternalServerError, &models.TimeEntry{}, err
+	}
+	isRunning, err := models.TimeEntries(qm.Where("user_id = ? AND stopped_at IS NULL", userID)).Exists(ctx, tx)
+	if err != nil {
+		return http.StatusInternalServerError, &models.TimeEntry{}, err
+	}
+	if isRunning {
+		return http.StatusBadRequest, &models.TimeEntry{}, errors.New("実行中のタイマーがあります。停止してから開始してください。")
+	}
+
+	timeEntry = &models.TimeEntry{}
+	timeEntry.UserID = userID
+	timeEntry.TodoID = todoID
+	timeEntry.StartedAt = time.Now().Truncate(time.Second)
+	if err := timeEntry.Insert(ctx, tx, boil.Infer()); err != nil {
+		return http.StatusInternalServerError, &models.TimeEntry{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, &models.TimeEntry{}, err
+	}
+	return http.StatusOK, timeEntry, nil
+}
+
+func (tes *timeEntryService) StopTimer(ctx context.Context, todoID int64, userID int64) (statusCode int64, timeEntry *models.TimeEntry, err error) {
+	timeEntry, err = models.TimeEntries(qm.Where("todo_id = ? AND user_id = ? AND stopped_at IS NULL", todoID, userID)).One(ctx, tes.db)
+	if err != nil {
+		return http.StatusNotFound, &models.TimeEntry{}, err
+	}
+
+	timeEntry.StoppedAt = null.TimeFrom(time.Now().Truncate(time.Second))
+	if _, err := timeEntry.Update(ctx, tes.db, boil.Infer()); err != nil {
+		return http.StatusInternalServerError, &models.TimeEntry{}, err
+	}
+	return http.StatusOK, timeEntry, nil
+}
+
+func (tes *timeEntryService) CreateTimeEntry(ctx context.Context, requestParams apis.PostTimeEntriesJSONRequestBody, userID int64) (statusCode int64, timeEntry *models.TimeEntry, err error) {
+	// NOTE: バリデーションチェック
+	validationErrors := validator.ValidateCreateTimeEntry(requestParams, time.Now())
+	if validationErrors != nil {
+		return http.StatusBadRequest, &models.TimeEntry{}, validationErrors
+	}
+
+	isExistTodo, err := models.Todos(qm.Where("id = ? AND user_id = ?", requestParams.TodoId, userID)).Exists(ctx, tes.db)
+	if err != nil {
+		return http.StatusInternalServerError, &models.TimeEntry{}, err
+	}
+	if !isExistTodo {
+		return http.StatusBadRequest, &models.TimeEntry{}, validation.Errors{"todoId": errors.New("Todoが見つかりません。")}
+	}
+
+	timeEntry = &models.TimeEntry{}
+	timeEntry.UserID = userID
+	timeEntry.TodoID = int64(requestParams.TodoId)
+	timeEntry.StartedAt = requestParams.StartedAt.In(time.Local).Truncate(time.Second)
+	timeEntry.StoppedAt = null.TimeFrom(requestParams.StoppedAt.In(time.Local).Truncate(time.Second))
+	if requestParams.Note != nil {
+		timeEntry.Note = *requestParams.Note
+	}
+	if err := timeEntry.Insert(ctx, tes.db, boil.Infer()); err != nil {
+		return http.StatusInternalServerError, &models.TimeEntry{}, err
+	}
+	return http.StatusOK, timeEntry, nil
+}
+
+func (tes *timeEntryService) FetchTimeEntriesList(ctx context.Context, requestParams apis.GetTimeEntriesParams, userID int64) (statusCode int64, timeEntriesList *models.TimeEntrySlice, err error) {
+	if requestParams.From != nil && requestParams.To != nil && requestParams.To.Before(requestParams.From.Time) {
+		return http.StatusBadRequest, &models.TimeEntrySlice{}, errors.New("終了日は開始日以降を指定してください。")
+	}
+
+	queryMods := []qm.QueryMod{qm.Where("user_id = ?", userID)}
+	if requestParams.TodoId != nil {
+		queryMods = append(queryMods, qm.Where("todo_id = ?", *requestParams.TodoId))
+	}
+	if requestParams.From != nil {
+		queryMods = append(queryMods, qm.Where("started_at >= ?", localDate(*requestParams.From)))
+	}
+	if requestParams.To != nil {
+		queryMods = append(queryMods, qm.Where("started_at < ?", localDate(*requestParams.To).AddDate(0, 0, 1)))
+	}
+
+	timeEntries, err := models.TimeEntries(append(queryMods, qm.OrderBy("started_at ASC, id ASC"))...).All(ctx, tes.db)
+	if err != nil {
+		return http.StatusInternalServerError, &models.TimeEntrySlice{}, err
+	}
+	return http.StatusOK, &timeEntries, nil
+}
+
+func (tes *timeEntryService) DeleteTimeEntry(ctx context.Context, id int64, userID int64) (statusCode int64, err error) {
+	timeEntry, err := models.TimeEntries(qm.Where("id = ? AND user_id = ?", id, userID)).One(ctx, tes.db)
+	if err != nil {
+		return http.StatusNotFound, err
+	}
+
+	if _, err := timeEntry.Delete(ctx, tes.db); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusOK, nil
+}
+
+// NOTE: 停止済みの記録を開始日時の日付ごと・Todoごとに集計する(日をまたぐ記録は開始日に計上する)
+func (tes *timeEntryService) FetchTimeReport(ctx context.Context, from openapi_types.Date, to openapi_types.Date, userID int64) (statusCode int64, timeReport *TimeReport, err error) {
+	fromDate, toDate := localDate(from), localDate(to)
+	if toDate.Before(fromDate) {
+		return http.StatusBadRequest, &TimeReport{}, errors.New("終了日は開始日以降を指定してください。")
+	}
+	if toDate.After(fromDate.AddDate(0, 0, maxTimeReportDays-1)) {
+		return http.StatusBadRequest, &TimeReport{}, fmt.Errorf("期間は%d日以内でお願いします。", maxTimeReportDays)
+	}
+
+	timeEntries, err := models.TimeEntries(
+		qm.Where("user_id = ? AND stopped_at IS NOT NULL", userID),
+		qm.Where("started_at >= ? AND started_at < ?", fromDate, toDate.AddDate(0, 0, 1)),
+	).All(ctx, tes.db)
+	if err != nil {
+		return http.StatusInternalServerError, &TimeReport{}, err
+	}
+
+	todoIDs := []interface{}{}
+	for _, timeEntry := range timeEntries {
+		todoIDs = append(todoIDs, timeEntry.TodoID)
+	}
+	titles := map[int64]string{}
+	if len(todoIDs) > 0 {
+		todos, err := models.Todos(qm.Select("id", "title"), qm.WhereIn("id IN ?", todoIDs...)).All(ctx, tes.db)
+		if err != nil {
+			return http.StatusInternalServerError, &TimeReport{}, err
+		}
+		for _, todo := range todos {
+			titles[todo.ID] = todo.Title
+		}
+	}
+
+	secondsByDay := map[string]map[int64]int64{}
+	for _, timeEntry := range timeEntries {
+		day := timeEntry.StartedAt.In(time.Local).Format(timeReportDateLayout)
+		if secondsByDay[day] == nil {
+			secondsByDay[day] = map[int64]int64{}
+		}
+		secondsByDay[day][timeEntry.TodoID] += TimeEntryDurationSeconds(timeEntry, time.Now())
+	}
+
+	timeReport = &TimeReport{From: fromDate, To: toDate, Days: []TimeReportDay{}}
+	for date := fromDate; !date.After(toDate); date = date.AddDate(0, 0, 1) {
+		secondsByTodo, ok := secondsByDay[date.Format(timeReportDateLayout)]
+		if !ok {
+			continue
+		}
+		reportDay := TimeReportDay{Date: date, Todos: []TimeReportTodo{}}
+		for todoID, seconds := range secondsByTodo {
+			reportDay.Todos = append(reportDay.Todos, TimeReportTodo{TodoID: todoID, Title: titles[todoID], TotalSeconds: seconds})
+			reportDay.TotalSeconds += seconds
+		}
+		sort.Slice(reportDay.Todos, func(i, j int) bool { return reportDay.Todos[i].TodoID < reportDay.Todos[j].TodoID })
+		timeReport.Days = append(timeReport.Days, reportDay)
+		timeReport.TotalSeconds += reportDay.TotalSeconds
+	}
+	return http.StatusOK, timeReport, nil
+}
+
+// NOTE: 集計結果の行数は期間の日数×Todo数に収まるため、メモリ上で書き出す
+func (tes *timeEntryService) ExportTimeReport(ctx context.Context, from openapi_types.Date, to openapi_types.Date, userID int64) (statusCode int64, body io.Reader, fileName string, err error) {
+	statusCode, timeReport, err := tes.FetchTimeReport(ctx, from, to, userID)
+	if statusCode != http.StatusOK {
+		return statusCode, nil, "", err
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write([]string{"date", "todoId", "title", "seconds", "hours"}); err != nil {
+		return http.StatusInternalServerError, nil, "", err
+	}
+	for _, reportDay := range timeReport.Days {
+		for _, reportTodo := range reportDay.Todos {
+			record := []string{
+				reportDay.Date.Format(timeReportDateLayout),
+				strconv.FormatInt(reportTodo.TodoID, 10),
+				reportTodo.Title,
+				strconv.FormatInt(reportTodo.TotalSeconds, 10),
+				strconv.FormatFloat(float64(reportTodo.TotalSeconds)/3600, 'f', 2, 64),
+			}
+			if err := writer.Write(record); err != nil {
+				return http.StatusInternalServerError, nil, "", err
+			}
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return http.StatusInternalServerError, nil, "", err
+	}
+
+	fileName = "time-report-" + timeReport.From.Format("20060102") + "-" + timeReport.To.Format("20060102") + ".csv"
+	return http.StatusOK, &buf, fileName, nil
+}
+
+func localDate(date openapi_types.Date) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.Local)
+}
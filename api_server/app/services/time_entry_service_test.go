@@ -0,0 +1,202 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/test/factories"
+	"io"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type TestTimeEntryServiceSuite struct {
+	WithDBSuite
+}
+
+var (
+	testTimeEntryService TimeEntryService
+	timeEntryTodos       models.TodoSlice
+)
+
+func (s *TestTimeEntryServiceSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	timeEntryTodos = models.TodoSlice{}
+	for _, title := range []string{"設計", "実装"} {
+		todo := &models.Todo{Title: title, Content: null.String{String: "", Valid: true}, UserID: int64(user.ID)}
+		if err := todo.Insert(ctx, DBCon, boil.Infer()); err != nil {
+			s.T().Fatalf("failed to create test todos %v", err)
+		}
+		timeEntryTodos = append(timeEntryTodos, todo)
+	}
+
+	testTimeEntryService = NewTimeEntryService(DBCon)
+}
+
+func (s *TestTimeEntryServiceSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *TestTimeEntryServiceSuite) createTimeEntry(todo *models.Todo, startedAt time.Time, duration time.Duration) {
+	requestParams := apis.PostTimeEntriesJSONRequestBody{TodoId: int(todo.ID), StartedAt: startedAt, StoppedAt: startedAt.Add(duration)}
+	statusCode, _, err := testTimeEntryService.CreateTimeEntry(ctx, requestParams, int64(user.ID))
+	if statusCode != http.StatusOK {
+		s.T().Fatalf("failed to create test time entry %v", err)
+	}
+}
+
+func (s *TestTimeEntryServiceSuite) TestStartAndStopTimer() {
+	statusCode, timeEntry, err := testTimeEntryService.StartTimer(ctx, timeEntryTodos[0].ID, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.False(s.T(), timeEntry.StoppedAt.Valid)
+
+	statusCode, stoppedTimeEntry, err := testTimeEntryService.StopTimer(ctx, timeEntryTodos[0].ID, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), timeEntry.ID, stoppedTimeEntry.ID)
+	assert.True(s.T(), stoppedTimeEntry.StoppedAt.Valid)
+}
+
+func (s *TestTimeEntryServiceSuite) TestStartTimer_AlreadyRunning() {
+	testTimeEntryService.StartTimer(ctx, timeEntryTodos[0].ID, int64(user.ID))
+
+	// NOTE: 別のTodoであってもタイマーは1つしか動かせない
+	statusCode, _, err := testTimeEntryService.StartTimer(ctx, timeEntryTodos[1].ID, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), "実行中のタイマーがあります。停止してから開始してください。", err.Error())
+	count, _ := models.TimeEntries(qm.Where("user_id = ?", user.ID)).Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(1), count)
+}
+
+func (s *TestTimeEntryServiceSuite) TestStartTimer_NotFound() {
+	statusCode, _, _ := testTimeEntryService.StartTimer(ctx, 0, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusNotFound), statusCode)
+}
+
+func (s *TestTimeEntryServiceSuite) TestStopTimer_NotRunning() {
+	statusCode, _, _ := testTimeEntryService.StopTimer(ctx, timeEntryTodos[0].ID, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusNotFound), statusCode)
+}
+
+func (s *TestTimeEntryServiceSuite) TestCreateTimeEntry_BadRequest() {
+	startedAt := time.Now().Add(-time.Hour)
+	requestParams := apis.PostTimeEntriesJSONRequestBody{TodoId: int(timeEntryTodos[0].ID), StartedAt: startedAt, StoppedAt: startedAt.Add(-time.Minute)}
+
+	statusCode, _, err := testTimeEntryService.CreateTimeEntry(ctx, requestParams, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), "終了日時は開始日時より後を指定してください。", err.(validation.Errors)["stoppedAt"].Error())
+}
+
+func (s *TestTimeEntryServiceSuite) TestCreateTimeEntry_OtherUsersTodo() {
+	startedAt := time.Now().Add(-time.Hour)
+	requestParams := apis.PostTimeEntriesJSONRequestBody{TodoId: int(timeEntryTodos[0].ID), StartedAt: startedAt, StoppedAt: startedAt.Add(time.Minute)}
+
+	statusCode, _, err := testTimeEntryService.CreateTimeEntry(ctx, requestParams, int64(user.ID)+1)
+
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), "Todoが見つかりません。", err.(validation.Errors)["todoId"].Error())
+}
+
+func (s *TestTimeEntryServiceSuite) TestFetchTimeEntriesList() {
+	yesterday := time.Now().AddDate(0, 0, -1)
+	s.createTimeEntry(timeEntryTodos[0], yesterday.Add(-2*time.Hour), time.Hour)
+	s.createTimeEntry(timeEntryTodos[1], yesterday.Add(-time.Hour), 30*time.Minute)
+	s.createTimeEntry(timeEntryTodos[0], yesterday.AddDate(0, 0, -3), time.Hour)
+
+	todoID := int(timeEntryTodos[0].ID)
+	from := openapi_types.Date{Time: yesterday}
+	statusCode, timeEntriesList, err := testTimeEntryService.FetchTimeEntriesList(ctx, apis.GetTimeEntriesParams{TodoId: &todoID, From: &from}, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), *timeEntriesList, 1)
+	assert.Equal(s.T(), int64(3600), TimeEntryDurationSeconds((*timeEntriesList)[0], time.Now()))
+}
+
+func (s *TestTimeEntryServiceSuite) TestFetchTimeReport() {
+	day1 := time.Date(2026, 10, 1, 9, 0, 0, 0, time.Local)
+	day2 := time.Date(2026, 10, 3, 9, 0, 0, 0, time.Local)
+	s.createTimeEntry(timeEntryTodos[0], day1, time.Hour)
+	s.createTimeEntry(timeEntryTodos[1], day1.Add(2*time.Hour), 30*time.Minute)
+	s.createTimeEntry(timeEntryTodos[0], day1.Add(4*time.Hour), 15*time.Minute)
+	s.createTimeEntry(timeEntryTodos[1], day2, 45*time.Minute)
+	// NOTE: 期間外の記録は集計しない
+	s.createTimeEntry(timeEntryTodos[1], day2.AddDate(0, 0, 1), time.Hour)
+
+	statusCode, timeReport, err := testTimeEntryService.FetchTimeReport(ctx, openapi_types.Date{Time: day1}, openapi_types.Date{Time: day2}, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), int64(9000), timeReport.TotalSeconds)
+	assert.Len(s.T(), timeReport.Days, 2)
+	assert.Equal(s.T(), "2026-10-01", timeReport.Days[0].Date.Format("2006-01-02"))
+	assert.Equal(s.T(), int64(6300), timeReport.Days[0].TotalSeconds)
+	assert.Equal(s.T(), []TimeReportTodo{
+		{TodoID: timeEntryTodos[0].ID, Title: "設計", TotalSeconds: 4500},
+		{TodoID: timeEntryTodos[1].ID, Title: "実装", TotalSeconds: 1800},
+	}, timeReport.Days[0].Todos)
+	assert.Equal(s.T(), "2026-10-03", timeReport.Days[1].Date.Format("2006-01-02"))
+	assert.Equal(s.T(), int64(2700), timeReport.Days[1].TotalSeconds)
+}
+
+func (s *TestTimeEntryServiceSuite) TestFetchTimeReport_InvalidRange() {
+	from := time.Date(2026, 10, 3, 0, 0, 0, 0, time.Local)
+
+	statusCode, _, err := testTimeEntryService.FetchTimeReport(ctx, openapi_types.Date{Time: from}, openapi_types.Date{Time: from.AddDate(0, 0, -1)}, int64(user.ID))
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), "終了日は開始日以降を指定してください。", err.Error())
+
+	statusCode, _, err = testTimeEntryService.FetchTimeReport(ctx, openapi_types.Date{Time: from}, openapi_types.Date{Time: from.AddDate(0, 0, 366)}, int64(user.ID))
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), "期間は366日以内でお願いします。", err.Error())
+}
+
+func (s *TestTimeEntryServiceSuite) TestExportTimeReport() {
+	day := time.Date(2026, 10, 1, 9, 0, 0, 0, time.Local)
+	s.createTimeEntry(timeEntryTodos[0], day, 90*time.Minute)
+
+	statusCode, body, fileName, err := testTimeEntryService.ExportTimeReport(ctx, openapi_types.Date{Time: day}, openapi_types.Date{Time: day.AddDate(0, 0, 6)}, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "time-report-20261001-20261007.csv", fileName)
+	csvBody, _ := io.ReadAll(body)
+	assert.Equal(s.T(), "date,todoId,title,seconds,hours\n2026-10-01,"+strconv.FormatInt(timeEntryTodos[0].ID, 10)+",設計,5400,1.50\n", string(csvBody))
+}
+
+func (s *TestTimeEntryServiceSuite) TestDeleteTodo_DeletesTimeEntries() {
+	s.createTimeEntry(timeEntryTodos[0], time.Now().Add(-time.Hour), time.Minute)
+
+	NewTodoService(DBCon).DeleteTodo(ctx, timeEntryTodos[0].ID, int64(user.ID))
+
+	count, _ := models.TimeEntries(qm.Where("todo_id = ?", timeEntryTodos[0].ID)).Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(0), count)
+}
+
+func TestTimeEntryService(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestTimeEntryServiceSuite))
+}
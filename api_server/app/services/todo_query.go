@@ -0,0 +1,423 @@
+package services
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// NOTE: Todoの絞り込みに使うクエリ言語
+//
+//	query   := or
+//	or      := and ("OR" and)*
+//	and     := unary (["AND"] unary)*
+//	unary   := "NOT" unary | primary
+//	primary := "(" or ")" | field op value | value
+//
+// 例: `title:会議 AND NOT (content:"議事録" OR created>30d)`
+//
+// 値は全てプレースホルダで渡し、カラム名は todoQueryTextColumns / todoQueryTimeColumns に定義したもののみ使う
+// Todoにタグ・期限・完了状態がないため、tag・due・doneなどのフィールドは受け付けない
+const (
+	MaxTodoQueryLength = 1000
+	maxTodoQueryDepth  = 20
+)
+
+var todoQueryTextColumns = map[string]string{
+	"title":   "title",
+	"content": "COALESCE(content, '')",
+}
+
+var todoQueryTimeColumns = map[string]string{
+	"created": "created_at",
+	"updated": "updated_at",
+}
+
+// NOTE: 「7d」のような経過時間の指定(h: 時間, d: 日, w: 週)
+var todoQueryDurationPattern = regexp.MustCompile(`^(\d{1,5})([hdw])$`)
+
+const todoQueryDateLayout = "2006-01-02"
+
+// NOTE: Positionはクエリ上の1始まりの文字位置
+type TodoQueryError struct {
+	Position int
+	Message  string
+}
+
+func (e *TodoQueryError) Error() string {
+	return fmt.Sprintf("%d文字目: %s", e.Position, e.Message)
+}
+
+type todoQueryTokenKind int
+
+const (
+	todoQueryTokenEOF todoQueryTokenKind = iota
+	todoQueryTokenWord
+	todoQueryTokenString
+	todoQueryTokenOperator
+	todoQueryTokenLeftParen
+	todoQueryTokenRightParen
+	todoQueryTokenAnd
+	todoQueryTokenOr
+	todoQueryTokenNot
+)
+
+type todoQueryToken struct {
+	kind     todoQueryTokenKind
+	value    string
+	position int
+}
+
+func tokenizeTodoQuery(input string) ([]todoQueryToken, error) {
+	runes := []rune(input)
+	tokens := []todoQueryToken{}
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		position := i + 1
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, todoQueryToken{todoQueryTokenLeftParen, "(", position})
+			i++
+		case r == ')':
+			tokens = append(tokens, todoQueryToken{todoQueryTokenRightParen, ")", position})
+			i++
+		case r == ':' || r == '=':
+			tokens = append(tokens, todoQueryToken{todoQueryTokenOperator, string(r), position})
+			i++
+		case r == '<' || r == '>':
+			operator := string(r)
+			i++
+			if i < len(runes) && runes[i] == '=' {
+				operator += "="
+				i++
+			}
+			tokens = append(tokens, todoQueryToken{todoQueryTokenOperator, operator, position})
+		case r == '"':
+			// NOTE: 「\"」「\\」のみエスケープとして扱う
+			var value strings.Builder
+			i++
+			closed := false
+			for i < len(runes) {
+				if runes[i] == '\\' && i+1 < len(runes) && (runes[i+1] == '"' || runes[i+1] == '\\') {
+					value.WriteRune(runes[i+1])
+					i += 2
+					continue
+				}
+				if runes[i] == '"' {
+					closed = true
+					i++
+					break
+				}
+				value.WriteRune(runes[i])
+				i++
+			}
+			if !closed {
+				return nil, &TodoQueryError{position, "閉じられていない引用符があります。"}
+			}
+			tokens = append(tokens, todoQueryToken{todoQueryTokenString, value.String(), position})
+		default:
+			start := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && !strings.ContainsRune(`()":=<>`, runes[i]) {
+				i++
+			}
+			word := string(runes[start:i])
+			kind := todoQueryTokenWord
+			// NOTE: 検索語と区別するため、論理演算子は大文字のみ受け付ける
+			switch word {
+			case "AND":
+				kind = todoQueryTokenAnd
+			case "OR":
+				kind = todoQueryTokenOr
+			case "NOT":
+				kind = todoQueryTokenNot
+			}
+			tokens = append(tokens, todoQueryToken{kind, word, position})
+		}
+	}
+	return append(tokens, todoQueryToken{todoQueryTokenEOF, "", len(runes) + 1}), nil
+}
+
+// NOTE: 構文木の各ノードはWHERE句の断片とその引数を返す
+type todoQueryNode interface {
+	sql(now time.Time) (string, []interface{})
+}
+
+type todoQueryAnd struct{ left, right todoQueryNode }
+type todoQueryOr struct{ left, right todoQueryNode }
+type todoQueryNot struct{ operand todoQueryNode }
+
+func (n *todoQueryAnd) sql(now time.Time) (string, []interface{}) {
+	left, leftArgs := n.left.sql(now)
+	right, rightArgs := n.right.sql(now)
+	return "(" + left + " AND " + right + ")", append(leftArgs, rightArgs...)
+}
+
+func (n *todoQueryOr) sql(now time.Time) (string, []interface{}) {
+	left, leftArgs := n.left.sql(now)
+	right, rightArgs := n.right.sql(now)
+	return "(" + left + " OR " + right + ")", append(leftArgs, rightArgs...)
+}
+
+func (n *todoQueryNot) sql(now time.Time) (string, []interface{}) {
+	operand, args := n.operand.sql(now)
+	return "(NOT " + operand + ")", args
+}
+
+// NOTE: フィールドを指定しない検索語はタイトル・内容のいずれかに含まれるものに一致する
+type todoQueryText struct {
+	columns []string
+	value   string
+}
+
+func (n *todoQueryText) sql(now time.Time) (string, []interface{}) {
+	pattern := "%" + escapeTodoQueryLike(n.value) + "%"
+	conditions := make([]string, 0, len(n.columns))
+	args := make([]interface{}, 0, len(n.columns))
+	for _, column := range n.columns {
+		conditions = append(conditions, column+" LIKE ?")
+		args = append(args, pattern)
+	}
+	return "(" + strings.Join(conditions, " OR ") + ")", args
+}
+
+type todoQueryTime struct {
+	column   string
+	operator string
+	// NOTE: 日付指定の場合はその日の0時、経過時間指定の場合はnowからdurationを引いた時刻を基準とする
+	date     time.Time
+	duration time.Duration
+}
+
+func (n *todoQueryTime) sql(now time.Time) (string, []interface{}) {
+	if n.duration > 0 {
+		// NOTE: 「created<7d」は作成から7日未満を表すため、時刻の比較は逆向きになる
+		threshold := now.Add(-n.duration)
+		switch n.operator {
+		case "<":
+			return n.column + " > ?", []interface{}{threshold}
+		case "<=":
+			return n.column + " >= ?", []interface{}{threshold}
+		case ">":
+			return n.column + " < ?", []interface{}{threshold}
+		}
+		return n.column + " <= ?", []interface{}{threshold}
+	}
+
+	nextDate := n.date.AddDate(0, 0, 1)
+	switch n.operator {
+	case "<":
+		return n.column + " < ?", []interface{}{n.date}
+	case "<=":
+		return n.column + " < ?", []interface{}{nextDate}
+	case ">":
+		return n.column + " >= ?", []interface{}{nextDate}
+	case ">=":
+		return n.column + " >= ?", []interface{}{n.date}
+	}
+	return "(" + n.column + " >= ? AND " + n.column + " < ?)", []interface{}{n.date, nextDate}
+}
+
+func escapeTodoQueryLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
+
+type todoQueryParser struct {
+	tokens []todoQueryToken
+	index  int
+	depth  int
+}
+
+func (p *todoQueryParser) peek() todoQueryToken {
+	return p.tokens[p.index]
+}
+
+func (p *todoQueryParser) next() todoQueryToken {
+	token := p.tokens[p.index]
+	if token.kind != todoQueryTokenEOF {
+		p.index++
+	}
+	return token
+}
+
+func (p *todoQueryParser) parseOr() (todoQueryNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == todoQueryTokenOr {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &todoQueryOr{left, right}
+	}
+	return left, nil
+}
+
+func (p *todoQueryParser) parseAnd() (todoQueryNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch p.peek().kind {
+		case todoQueryTokenAnd:
+			p.next()
+		case todoQueryTokenWord, todoQueryTokenString, todoQueryTokenLeftParen, todoQueryTokenNot:
+			// NOTE: 演算子を省略して並べた条件はANDとして扱う
+		default:
+			return left, nil
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &todoQueryAnd{left, right}
+	}
+}
+
+func (p *todoQueryParser) parseUnary() (todoQueryNode, error) {
+	token := p.peek()
+	if token.kind != todoQueryTokenNot && token.kind != todoQueryTokenLeftParen {
+		return p.parseTerm()
+	}
+
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > maxTodoQueryDepth {
+		return nil, &TodoQueryError{token.position, fmt.Sprintf("入れ子は%d段までです。", maxTodoQueryDepth)}
+	}
+
+	p.next()
+	if token.kind == todoQueryTokenNot {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &todoQueryNot{operand}, nil
+	}
+
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if closing := p.next(); closing.kind != todoQueryTokenRightParen {
+		return nil, &TodoQueryError{token.position, "閉じ括弧がありません。"}
+	}
+	return node, nil
+}
+
+func (p *todoQueryParser) parseTerm() (todoQueryNode, error) {
+	token := p.next()
+	switch token.kind {
+	case todoQueryTokenString:
+		return &todoQueryText{[]string{todoQueryTextColumns["title"], todoQueryTextColumns["content"]}, token.value}, nil
+	case todoQueryTokenWord:
+	case todoQueryTokenEOF:
+		return nil, &TodoQueryError{token.position, "条件が必要です。"}
+	default:
+		return nil, &TodoQueryError{token.position, "「" + token.value + "」の位置に条件が必要です。"}
+	}
+
+	if p.peek().kind != todoQueryTokenOperator {
+		return &todoQueryText{[]string{todoQueryTextColumns["title"], todoQueryTextColumns["content"]}, token.value}, nil
+	}
+
+	operator := p.next()
+	value := p.next()
+	if value.kind != todoQueryTokenWord && value.kind != todoQueryTokenString {
+		return nil, &TodoQueryError{value.position, "「" + token.value + operator.value + "」の後に値が必要です。"}
+	}
+
+	field := strings.ToLower(token.value)
+	if column, ok := todoQueryTextColumns[field]; ok {
+		if operator.value != ":" {
+			return nil, &TodoQueryError{operator.position, field + "には「:」のみ指定できます。"}
+		}
+		return &todoQueryText{[]string{column}, value.value}, nil
+	}
+	if column, ok := todoQueryTimeColumns[field]; ok {
+		return parseTodoQueryTime(column, field, operator, value)
+	}
+	return nil, &TodoQueryError{token.position, "不明なフィールドです: " + token.value + "（指定できるフィールド: " + todoQueryFieldNames() + "）"}
+}
+
+func todoQueryFieldNames() string {
+	fields := make([]string, 0, len(todoQueryTextColumns)+len(todoQueryTimeColumns))
+	for field := range todoQueryTextColumns {
+		fields = append(fields, field)
+	}
+	for field := range todoQueryTimeColumns {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return strings.Join(fields, ", ")
+}
+
+func parseTodoQueryTime(column string, field string, operator todoQueryToken, value todoQueryToken) (todoQueryNode, error) {
+	if operator.value == ":" {
+		return nil, &TodoQueryError{operator.position, field + "には「<」「<=」「>」「>=」「=」のいずれかを指定してください。"}
+	}
+
+	if match := todoQueryDurationPattern.FindStringSubmatch(value.value); match != nil {
+		if operator.value == "=" {
+			return nil, &TodoQueryError{operator.position, "経過時間の指定には「=」は使えません。"}
+		}
+		amount, _ := strconv.Atoi(match[1])
+		unit := map[string]time.Duration{"h": time.Hour, "d": 24 * time.Hour, "w": 7 * 24 * time.Hour}[match[2]]
+		if amount == 0 {
+			return nil, &TodoQueryError{value.position, "経過時間は1以上で指定してください。"}
+		}
+		return &todoQueryTime{column: column, operator: operator.value, duration: time.Duration(amount) * unit}, nil
+	}
+
+	date, err := time.ParseInLocation(todoQueryDateLayout, value.value, time.Local)
+	if err != nil {
+		return nil, &TodoQueryError{value.position, field + "には「2006-01-02」形式の日付か「7d」形式の経過時間を指定してください。"}
+	}
+	return &todoQueryTime{column: column, operator: operator.value, date: date}, nil
+}
+
+// NOTE: 空のクエリは全件に一致する(nilを返す)
+func parseTodoQuery(input string) (todoQueryNode, error) {
+	if utf8.RuneCountInString(input) > MaxTodoQueryLength {
+		return nil, &TodoQueryError{MaxTodoQueryLength + 1, fmt.Sprintf("クエリは%d文字以下でお願いします。", MaxTodoQueryLength)}
+	}
+
+	tokens, err := tokenizeTodoQuery(input)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 1 {
+		return nil, nil
+	}
+
+	parser := &todoQueryParser{tokens: tokens}
+	node, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if token := parser.peek(); token.kind != todoQueryTokenEOF {
+		return nil, &TodoQueryError{token.position, "「" + token.value + "」は不正な位置にあります。"}
+	}
+	return node, nil
+}
+
+func todoQueryMods(input string, now time.Time) ([]qm.QueryMod, error) {
+	node, err := parseTodoQuery(input)
+	if err != nil || node == nil {
+		return nil, err
+	}
+	clause, args := node.sql(now)
+	return []qm.QueryMod{qm.Where(clause, args...)}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"database/sql"
 	"net/http"
+	"time"
 
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
@@ -14,8 +15,8 @@ import (
 )
 
 type TodoService interface {
-	CreateTodo(ctx context.Context, requestParams apis.PostTodosJSONRequestBody, userID int64) (statusCode int64, err error)
-	FetchTodosList(ctx context.Context, userID int64) (statusCode int64, todosList *models.TodoSlice, err error)
+	CreateTodo(ctx context.Context, requestParams apis.PostTodosJSONRequestBody, userID int64) (statusCode int64, todo *models.Todo, err error)
+	FetchTodosList(ctx context.Context, requestParams apis.GetTodosParams, userID int64) (statusCode int64, todosList *models.TodoSlice, err error)
 	ShowTodo(ctx context.Context, id int64, userID int64) (statusCode int64, todo *models.Todo)
 	UpdateTodo(ctx context.Context, id int64, requestParams apis.PatchTodoJSONRequestBody, userID int64) (statusCode int64, err error)
 	DeleteTodo(ctx context.Context, id int64, userID int64) (statusCode int64, err error)
@@ -29,27 +30,42 @@ func NewTodoService(db *sql.DB) TodoService {
 	return &todoService{db}
 }
 
-func (ts *todoService) CreateTodo(ctx context.Context, requestParams apis.PostTodosJSONRequestBody, userID int64) (statusCode int64, err error) {
+func (ts *todoService) CreateTodo(ctx context.Context, requestParams apis.PostTodosJSONRequestBody, userID int64) (statusCode int64, todo *models.Todo, err error) {
 	// NOTE: バリデーションチェック
 	validationErrors := validator.ValidateCreateTodo(requestParams)
 	if validationErrors != nil {
-		return int64(http.StatusBadRequest), validationErrors
+		return int64(http.StatusBadRequest), &models.Todo{}, validationErrors
 	}
 
-	todo := &models.Todo{}
+	todo = &models.Todo{}
 	todo.Title = requestParams.Title
 	todo.Content = null.String{String: requestParams.Content, Valid: true}
 	todo.UserID = userID
-	// NOTE: Create処理
-	err = todo.Insert(ctx, ts.db, boil.Infer())
+
+	tx, err := ts.db.BeginTx(ctx, nil)
 	if err != nil {
-		return int64(http.StatusInternalServerError), err
+		return int64(http.StatusInternalServerError), &models.Todo{}, err
 	}
-	return int64(http.StatusOK), nil
+	defer tx.Rollback()
+
+	// NOTE: Create処理
+	if err := insertTodo(ctx, tx, todo, userID); err != nil {
+		return int64(http.StatusInternalServerError), &models.Todo{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return int64(http.StatusInternalServerError), &models.Todo{}, err
+	}
+	return int64(http.StatusOK), todo, nil
 }
 
-func (ts *todoService) FetchTodosList(ctx context.Context, userID int64) (statusCode int64, todosList *models.TodoSlice, err error) {
-	todos, err := models.Todos(qm.Where("user_id = ?", userID)).All(ctx, ts.db)
+func (ts *todoService) FetchTodosList(ctx context.Context, requestParams apis.GetTodosParams, userID int64) (statusCode int64, todosList *models.TodoSlice, err error) {
+	statusCode, queryMods, err := filteredTodoQueryMods(ctx, ts.db, requestParams.Q, requestParams.FilterId, userID, time.Now())
+	if err != nil {
+		return statusCode, &models.TodoSlice{}, err
+	}
+
+	todos, err := models.Todos(queryMods...).All(ctx, ts.db)
 	if err != nil {
 		return int64(http.StatusInternalServerError), &models.TodoSlice{}, err
 	}
@@ -57,6 +73,27 @@ func (ts *todoService) FetchTodosList(ctx context.Context, userID int64) (status
 	return int64(http.StatusOK), &todos, nil
 }
 
+// NOTE: 一覧とエクスポートで同じ絞り込みになるよう、ユーザと検索条件のクエリをまとめて組み立てる
+func filteredTodoQueryMods(ctx context.Context, exec boil.ContextExecutor, q *string, filterID *int, userID int64, now time.Time) (statusCode int64, queryMods []qm.QueryMod, err error) {
+	// NOTE: 保存済みフィルタが指定された場合はそのクエリで絞り込む
+	query := ""
+	if filterID != nil {
+		savedFilter, err := models.SavedFilters(qm.Where("id = ? AND user_id = ?", *filterID, userID)).One(ctx, exec)
+		if err != nil {
+			return int64(http.StatusNotFound), nil, err
+		}
+		query = savedFilter.Query
+	} else if q != nil {
+		query = *q
+	}
+
+	queryMods, err = todoQueryMods(query, now)
+	if err != nil {
+		return int64(http.StatusBadRequest), nil, err
+	}
+	return int64(http.StatusOK), append([]qm.QueryMod{qm.Where("user_id = ?", userID)}, queryMods...), nil
+}
+
 func (ts *todoService) ShowTodo(ctx context.Context, id int64, userID int64) (statusCode int64, todo *models.Todo) {
 	todo, err := models.Todos(qm.Where("id = ? AND user_id = ?", id, userID)).One(ctx, ts.db)
 	if err != nil {
@@ -81,11 +118,27 @@ func (ts *todoService) UpdateTodo(ctx context.Context, id int64, requestParams a
 	todo.Title = requestParams.Title
 	todo.Content = null.String{String: requestParams.Content, Valid: true}
 
+	tx, err := ts.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	defer tx.Rollback()
+
 	// NOTE: Update処理
-	_, updateError := todo.Update(ctx, ts.db, boil.Infer())
+	_, updateError := todo.Update(ctx, tx, boil.Infer())
 	if updateError != nil {
 		return http.StatusInternalServerError, updateError
 	}
+	if err := recordActivity(ctx, tx, todo, userID, ActivityTodoUpdated); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	if err := recordTodoOutboxEvent(ctx, tx, todo, OutboxTodoUpdated); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, err
+	}
 	return http.StatusOK, nil
 }
 
@@ -95,9 +148,77 @@ func (ts *todoService) DeleteTodo(ctx context.Context, id int64, userID int64) (
 		return http.StatusNotFound, err
 	}
 
-	_, deleteError := todo.Delete(ctx, ts.db)
+	tx, err := ts.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	defer tx.Rollback()
+
+	_, deleteError := todo.Delete(ctx, tx)
 	if deleteError != nil {
 		return http.StatusInternalServerError, deleteError
 	}
+
+	// NOTE: 削除したTodoの共有リンクも合わせて削除
+	_, deleteShareLinksError := models.ShareLinks(qm.Where("todo_id = ?", id)).DeleteAll(ctx, tx)
+	if deleteShareLinksError != nil {
+		return http.StatusInternalServerError, deleteShareLinksError
+	}
+
+	// NOTE: 削除したTodoのコメントとメンション通知も合わせて削除
+	_, deleteMentionsError := models.MentionNotifications(qm.Where("comment_id IN (SELECT id FROM comments WHERE todo_id = ?)", id)).DeleteAll(ctx, tx)
+	if deleteMentionsError != nil {
+		return http.StatusInternalServerError, deleteMentionsError
+	}
+	_, deleteCommentsError := models.Comments(qm.Where("todo_id = ?", id)).DeleteAll(ctx, tx)
+	if deleteCommentsError != nil {
+		return http.StatusInternalServerError, deleteCommentsError
+	}
+
+	// NOTE: CalDAVのリソースとの対応付けも合わせて削除
+	_, deleteCalDAVObjectsError := models.CaldavObjects(qm.Where("todo_id = ?", id)).DeleteAll(ctx, tx)
+	if deleteCalDAVObjectsError != nil {
+		return http.StatusInternalServerError, deleteCalDAVObjectsError
+	}
+
+	// NOTE: 削除したTodoの作業時間の記録も合わせて削除
+	_, deleteTimeEntriesError := models.TimeEntries(qm.Where("todo_id = ?", id)).DeleteAll(ctx, tx)
+	if deleteTimeEntriesError != nil {
+		return http.StatusInternalServerError, deleteTimeEntriesError
+	}
+
+	attachments, err := models.Attachments(qm.Where("todo_id = ?", id)).All(ctx, tx)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	if _, deleteAttachmentsError := attachments.DeleteAll(ctx, tx); deleteAttachmentsError != nil {
+		return http.StatusInternalServerError, deleteAttachmentsError
+	}
+
+	// NOTE: Todo削除後もアクティビティは履歴として残す
+	if err := recordActivity(ctx, tx, todo, userID, ActivityTodoDeleted); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	if err := recordTodoOutboxEvent(ctx, tx, todo, OutboxTodoDeleted); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	// NOTE: 削除したTodoの添付ファイルもCloud Storageから合わせて削除
+	deleteAttachmentObjects(ctx, attachments)
 	return http.StatusOK, nil
 }
+
+// NOTE: Todoの作成とアクティビティ・outboxへの記録を呼び出し元のトランザクション内で行う
+func insertTodo(ctx context.Context, exec boil.ContextExecutor, todo *models.Todo, actorID int64) error {
+	if err := todo.Insert(ctx, exec, boil.Infer()); err != nil {
+		return err
+	}
+	if err := recordActivity(ctx, exec, todo, actorID, ActivityTodoCreated); err != nil {
+		return err
+	}
+	return recordTodoOutboxEvent(ctx, exec, todo, OutboxTodoCreated)
+}
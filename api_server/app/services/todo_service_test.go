@@ -6,6 +6,7 @@ import (
 	"app/test/factories"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -42,7 +43,7 @@ func (s *TestTodoServiceSuite) TearDownTest() {
 func (s *TestTodoServiceSuite) TestCreateTodo() {
 	requestParams := apis.PostTodosJSONRequestBody{Title: "test title 1", Content: "test content 1"}
 
-	statusCode, err := testTodoService.CreateTodo(ctx, requestParams, int64(user.ID))
+	statusCode, _, err := testTodoService.CreateTodo(ctx, requestParams, int64(user.ID))
 
 	assert.Nil(s.T(), err)
 	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
@@ -57,7 +58,7 @@ func (s *TestTodoServiceSuite) TestCreateTodo() {
 func (s *TestTodoServiceSuite) TestCreateTodo_ValidationError() {
 	requestParams := apis.PostTodosJSONRequestBody{Title: "", Content: "test content 1"}
 
-	statusCode, err := testTodoService.CreateTodo(ctx, requestParams, int64(user.ID))
+	statusCode, _, err := testTodoService.CreateTodo(ctx, requestParams, int64(user.ID))
 
 	assert.Contains(s.T(), err.Error(), "タイトルは必須入力です。")
 	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
@@ -86,13 +87,105 @@ func (s *TestTodoServiceSuite) TestFetchTodosList() {
 		s.T().Fatalf("failed to create TestFetchTodosList Data: %v", err)
 	}
 
-	statusCode, todosList, err := testTodoService.FetchTodosList(ctx, int64(user.ID))
+	statusCode, todosList, err := testTodoService.FetchTodosList(ctx, apis.GetTodosParams{}, int64(user.ID))
 
 	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
 	assert.Len(s.T(), *todosList, 2)
 	assert.Nil(s.T(), err)
 }
 
+func (s *TestTodoServiceSuite) createQueryTestTodos() {
+	var todosSlice models.TodoSlice
+	todosSlice = append(todosSlice, &models.Todo{Title: "定例会議", Content: null.String{String: "議事録を共有", Valid: true}, UserID: int64(user.ID)})
+	todosSlice = append(todosSlice, &models.Todo{Title: "買い物", Content: null.String{String: "牛乳 100%", Valid: true}, UserID: int64(user.ID)})
+	todosSlice = append(todosSlice, &models.Todo{Title: "古い会議", Content: null.String{}, UserID: int64(user.ID)})
+	if _, err := todosSlice.InsertAll(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create query test todos: %v", err)
+	}
+
+	// NOTE: 経過時間の絞り込みを確認するため、1件の作成日時を過去にする
+	if _, err := models.Todos(qm.Where("title = ?", "古い会議")).UpdateAll(ctx, DBCon, models.M{"created_at": time.Now().AddDate(0, 0, -30)}); err != nil {
+		s.T().Fatalf("failed to update created_at: %v", err)
+	}
+}
+
+func (s *TestTodoServiceSuite) fetchTodoTitles(query string) ([]string, int64, error) {
+	statusCode, todosList, err := testTodoService.FetchTodosList(ctx, apis.GetTodosParams{Q: &query}, int64(user.ID))
+	titles := []string{}
+	for _, todo := range *todosList {
+		titles = append(titles, todo.Title)
+	}
+	return titles, statusCode, err
+}
+
+func (s *TestTodoServiceSuite) TestFetchTodosList_Query() {
+	s.createQueryTestTodos()
+
+	cases := []struct {
+		query  string
+		titles []string
+	}{
+		{"会議", []string{"定例会議", "古い会議"}},
+		{"title:会議 AND NOT created>7d", []string{"定例会議"}},
+		{"title:会議 created<7d", []string{"定例会議"}},
+		{"NOT content:議事録", []string{"買い物", "古い会議"}},
+		{`content:"100%" OR (title:古い AND created<=` + time.Now().Format("2006-01-02") + ")", []string{"買い物", "古い会議"}},
+		{"created=" + time.Now().Format("2006-01-02"), []string{"定例会議", "買い物"}},
+		{"%", []string{"買い物"}},
+		// NOTE: 値はプレースホルダで渡すため、SQLとして解釈されない
+		{`"' OR 1=1 --"`, []string{}},
+	}
+	for _, c := range cases {
+		titles, statusCode, err := s.fetchTodoTitles(c.query)
+		assert.Equal(s.T(), int64(http.StatusOK), statusCode, c.query)
+		assert.Nil(s.T(), err, c.query)
+		assert.ElementsMatch(s.T(), c.titles, titles, c.query)
+	}
+}
+
+func (s *TestTodoServiceSuite) TestFetchTodosList_InvalidQuery() {
+	cases := []struct {
+		query   string
+		message string
+	}{
+		{"tag:work AND due<7d", "1文字目: 不明なフィールドです: tag（指定できるフィールド: content, created, title, updated）"},
+		{"(title:会議 OR 買い物", "1文字目: 閉じ括弧がありません。"},
+		{"title:会議 AND", "13文字目: 条件が必要です。"},
+		{"created:7d", "8文字目: createdには「<」「<=」「>」「>=」「=」のいずれかを指定してください。"},
+		{"created<yesterday", "9文字目: createdには「2006-01-02」形式の日付か「7d」形式の経過時間を指定してください。"},
+		{`title:"会議`, "7文字目: 閉じられていない引用符があります。"},
+		{"会議 )", "4文字目: 「)」は不正な位置にあります。"},
+	}
+	for _, c := range cases {
+		_, statusCode, err := s.fetchTodoTitles(c.query)
+		assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode, c.query)
+		assert.EqualError(s.T(), err, c.message, c.query)
+	}
+}
+
+func (s *TestTodoServiceSuite) TestFetchTodosList_SavedFilter() {
+	s.createQueryTestTodos()
+	savedFilter := &models.SavedFilter{UserID: int64(user.ID), Name: "会議", Query: "title:会議"}
+	if err := savedFilter.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create saved filter: %v", err)
+	}
+	filterID := int(savedFilter.ID)
+
+	statusCode, todosList, err := testTodoService.FetchTodosList(ctx, apis.GetTodosParams{FilterId: &filterID}, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), *todosList, 2)
+}
+
+func (s *TestTodoServiceSuite) TestFetchTodosList_SavedFilterNotFound() {
+	filterID := 0
+
+	statusCode, _, _ := testTodoService.FetchTodosList(ctx, apis.GetTodosParams{FilterId: &filterID}, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusNotFound), statusCode)
+}
+
 func (s *TestTodoServiceSuite) TestFetchTodo_StatusOk() {
 	testTodo := models.Todo{Title: "test title 1", Content: null.String{String: "test content 1", Valid: true}, UserID: int64(user.ID)}
 	if err := testTodo.Insert(ctx, DBCon, boil.Infer()); err != nil {
@@ -0,0 +1,211 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/validator"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"golang.org/x/exp/slices"
+)
+
+// NOTE: 「{{name}}」の形式で変数を参照する。組み込みの「{{date}}」は作成日、「{{date+7}}」のように日数のオフセットを指定できる
+var todoTemplatePlaceholderPattern = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*(?:([+-])\s*(\d{1,4})\s*)?\}\}`)
+
+const (
+	todoTemplateDateVariable = "date"
+	todoTemplateDateLayout   = "2006-01-02"
+)
+
+type TodoTemplateService interface {
+	CreateTodoTemplate(ctx context.Context, requestParams apis.PostTodoTemplatesJSONRequestBody, userID int64) (statusCode int64, todoTemplate *models.TodoTemplate, err error)
+	FetchTodoTemplatesList(ctx context.Context, userID int64) (statusCode int64, todoTemplatesList *models.TodoTemplateSlice, err error)
+	UpdateTodoTemplate(ctx context.Context, id int64, requestParams apis.PutTodoTemplateJSONRequestBody, userID int64) (statusCode int64, todoTemplate *models.TodoTemplate, err error)
+	DeleteTodoTemplate(ctx context.Context, id int64, userID int64) (statusCode int64, err error)
+	InstantiateTodoTemplate(ctx context.Context, id int64, requestParams apis.PostTodoTemplateInstantiateJSONRequestBody, userID int64) (statusCode int64, todosList *models.TodoSlice, err error)
+}
+
+type todoTemplateService struct {
+	db *sql.DB
+}
+
+func NewTodoTemplateService(db *sql.DB) TodoTemplateService {
+	return &todoTemplateService{db}
+}
+
+func (tts *todoTemplateService) CreateTodoTemplate(ctx context.Context, requestParams apis.PostTodoTemplatesJSONRequestBody, userID int64) (statusCode int64, todoTemplate *models.TodoTemplate, err error) {
+	// NOTE: バリデーションチェック
+	validationErrors := validator.ValidateTodoTemplate(requestParams)
+	if validationErrors != nil {
+		return http.StatusBadRequest, &models.TodoTemplate{}, validationErrors
+	}
+
+	// NOTE: 項目はテンプレートと常に一緒に読み書きするため、JSONで保存する
+	items, err := json.Marshal(requestParams.Items)
+	if err != nil {
+		return http.StatusInternalServerError, &models.TodoTemplate{}, err
+	}
+
+	todoTemplate = &models.TodoTemplate{}
+	todoTemplate.UserID = userID
+	todoTemplate.Name = requestParams.Name
+	todoTemplate.Items = string(items)
+	if err := todoTemplate.Insert(ctx, tts.db, boil.Infer()); err != nil {
+		return http.StatusInternalServerError, &models.TodoTemplate{}, err
+	}
+	return http.StatusOK, todoTemplate, nil
+}
+
+func (tts *todoTemplateService) FetchTodoTemplatesList(ctx context.Context, userID int64) (statusCode int64, todoTemplatesList *models.TodoTemplateSlice, err error) {
+	todoTemplates, err := models.TodoTemplates(qm.Where("user_id = ?", userID), qm.OrderBy("id ASC")).All(ctx, tts.db)
+	if err != nil {
+		return http.StatusInternalServerError, &models.TodoTemplateSlice{}, err
+	}
+	return http.StatusOK, &todoTemplates, nil
+}
+
+func (tts *todoTemplateService) UpdateTodoTemplate(ctx context.Context, id int64, requestParams apis.PutTodoTemplateJSONRequestBody, userID int64) (statusCode int64, todoTemplate *models.TodoTemplate, err error) {
+	todoTemplate, err = models.TodoTemplates(qm.Where("id = ? AND user_id = ?", id, userID)).One(ctx, tts.db)
+	if err != nil {
+		return http.StatusNotFound, &models.TodoTemplate{}, err
+	}
+
+	// NOTE: バリデーションチェック
+	validationErrors := validator.ValidateTodoTemplate(apis.PostTodoTemplatesJSONRequestBody(requestParams))
+	if validationErrors != nil {
+		return http.StatusBadRequest, &models.TodoTemplate{}, validationErrors
+	}
+
+	items, err := json.Marshal(requestParams.Items)
+	if err != nil {
+		return http.StatusInternalServerError, &models.TodoTemplate{}, err
+	}
+
+	todoTemplate.Name = requestParams.Name
+	todoTemplate.Items = string(items)
+	if _, err := todoTemplate.Update(ctx, tts.db, boil.Infer()); err != nil {
+		return http.StatusInternalServerError, &models.TodoTemplate{}, err
+	}
+	return http.StatusOK, todoTemplate, nil
+}
+
+func (tts *todoTemplateService) DeleteTodoTemplate(ctx context.Context, id int64, userID int64) (statusCode int64, err error) {
+	todoTemplate, err := models.TodoTemplates(qm.Where("id = ? AND user_id = ?", id, userID)).One(ctx, tts.db)
+	if err != nil {
+		return http.StatusNotFound, err
+	}
+
+	if _, err := todoTemplate.Delete(ctx, tts.db); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusOK, nil
+}
+
+func (tts *todoTemplateService) InstantiateTodoTemplate(ctx context.Context, id int64, requestParams apis.PostTodoTemplateInstantiateJSONRequestBody, userID int64) (statusCode int64, todosList *models.TodoSlice, err error) {
+	todoTemplate, err := models.TodoTemplates(qm.Where("id = ? AND user_id = ?", id, userID)).One(ctx, tts.db)
+	if err != nil {
+		return http.StatusNotFound, &models.TodoSlice{}, err
+	}
+
+	var items []apis.TodoTemplateItem
+	if err := json.Unmarshal([]byte(todoTemplate.Items), &items); err != nil {
+		return http.StatusInternalServerError, &models.TodoSlice{}, err
+	}
+
+	variables := map[string]string{}
+	if requestParams.Variables != nil {
+		variables = *requestParams.Variables
+	}
+	now := time.Now()
+
+	// NOTE: 全ての項目を展開・検証してから作成し、1件でも不正な場合は何も作成しない
+	todos := models.TodoSlice{}
+	for i, item := range items {
+		title, err := renderTodoTemplateText(item.Title, variables, now)
+		if err != nil {
+			return http.StatusBadRequest, &models.TodoSlice{}, err
+		}
+		content, err := renderTodoTemplateText(item.Content, variables, now)
+		if err != nil {
+			return http.StatusBadRequest, &models.TodoSlice{}, err
+		}
+
+		validationErrors := validator.ValidateCreateTodo(apis.PostTodosJSONRequestBody{Title: title, Content: content})
+		if validationErrors != nil {
+			return http.StatusBadRequest, &models.TodoSlice{}, fmt.Errorf("%d件目: %s", i+1, strings.Join(validationErrorMessages(validationErrors), " "))
+		}
+
+		todo := &models.Todo{}
+		todo.Title = title
+		todo.Content = null.String{String: content, Valid: true}
+		todo.UserID = userID
+		todos = append(todos, todo)
+	}
+
+	tx, err := tts.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, &models.TodoSlice{}, err
+	}
+	defer tx.Rollback()
+
+	for _, todo := range todos {
+		if err := insertTodo(ctx, tx, todo, userID); err != nil {
+			return http.StatusInternalServerError, &models.TodoSlice{}, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, &models.TodoSlice{}, err
+	}
+	return http.StatusOK, &todos, nil
+}
+
+func renderTodoTemplateText(text string, variables map[string]string, now time.Time) (string, error) {
+	var missingVariables []string
+	var renderErr error
+	rendered := todoTemplatePlaceholderPattern.ReplaceAllStringFunc(text, func(placeholder string) string {
+		match := todoTemplatePlaceholderPattern.FindStringSubmatch(placeholder)
+		name, sign, offset := match[1], match[2], match[3]
+
+		if name == todoTemplateDateVariable {
+			days, _ := strconv.Atoi(offset)
+			if sign == "-" {
+				days = -days
+			}
+			return now.AddDate(0, 0, days).Format(todoTemplateDateLayout)
+		}
+		if sign != "" {
+			renderErr = errors.New("日数のオフセットは" + todoTemplateDateVariable + "にのみ指定できます: " + placeholder)
+			return placeholder
+		}
+
+		value, ok := variables[name]
+		if !ok {
+			if !slices.Contains(missingVariables, name) {
+				missingVariables = append(missingVariables, name)
+			}
+			return placeholder
+		}
+		return value
+	})
+
+	if renderErr != nil {
+		return "", renderErr
+	}
+	if len(missingVariables) > 0 {
+		return "", errors.New("変数が指定されていません: " + strings.Join(missingVariables, ", "))
+	}
+	return rendered, nil
+}
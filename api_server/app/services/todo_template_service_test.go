@@ -0,0 +1,198 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/test/factories"
+	"net/http"
+	"testing"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type TestTodoTemplateServiceSuite struct {
+	WithDBSuite
+}
+
+var testTodoTemplateService TodoTemplateService
+
+func (s *TestTodoTemplateServiceSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	testTodoTemplateService = NewTodoTemplateService(DBCon)
+}
+
+func (s *TestTodoTemplateServiceSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *TestTodoTemplateServiceSuite) createTodoTemplate(items []apis.TodoTemplateItem) *models.TodoTemplate {
+	requestParams := apis.PostTodoTemplatesJSONRequestBody{Name: "週次レビュー", Items: items}
+	statusCode, todoTemplate, err := testTodoTemplateService.CreateTodoTemplate(ctx, requestParams, int64(user.ID))
+	if statusCode != http.StatusOK {
+		s.T().Fatalf("failed to create test todo template %v", err)
+	}
+	return todoTemplate
+}
+
+func (s *TestTodoTemplateServiceSuite) TestCreateTodoTemplate() {
+	todoTemplate := s.createTodoTemplate([]apis.TodoTemplateItem{{Title: "振り返り", Content: "今週の振り返り"}})
+
+	assert.Equal(s.T(), "週次レビュー", todoTemplate.Name)
+	assert.JSONEq(s.T(), `[{"title":"振り返り","content":"今週の振り返り"}]`, todoTemplate.Items)
+	assert.Equal(s.T(), int64(user.ID), todoTemplate.UserID)
+}
+
+func (s *TestTodoTemplateServiceSuite) TestCreateTodoTemplate_BadRequest() {
+	requestParams := apis.PostTodoTemplatesJSONRequestBody{Name: "", Items: []apis.TodoTemplateItem{}}
+
+	statusCode, _, err := testTodoTemplateService.CreateTodoTemplate(ctx, requestParams, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	validationErrors := err.(validation.Errors)
+	assert.Equal(s.T(), "名前は必須入力です。", validationErrors["name"].Error())
+	assert.Equal(s.T(), "項目は1つ以上入力してください。", validationErrors["items"].Error())
+}
+
+func (s *TestTodoTemplateServiceSuite) TestCreateTodoTemplate_InvalidItem() {
+	requestParams := apis.PostTodoTemplatesJSONRequestBody{Name: "週次レビュー", Items: []apis.TodoTemplateItem{{Title: "振り返り"}, {Title: ""}}}
+
+	statusCode, _, err := testTodoTemplateService.CreateTodoTemplate(ctx, requestParams, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), "2件目のタイトルは必須入力です。", err.(validation.Errors)["items"].Error())
+}
+
+func (s *TestTodoTemplateServiceSuite) TestFetchTodoTemplatesList() {
+	s.createTodoTemplate([]apis.TodoTemplateItem{{Title: "振り返り"}})
+
+	// NOTE: 他のユーザのテンプレートは含まれない
+	otherUser := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "other@example.com"}).(*models.User)
+	if err := otherUser.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create other user %v", err)
+	}
+	testTodoTemplateService.CreateTodoTemplate(ctx, apis.PostTodoTemplatesJSONRequestBody{Name: "other", Items: []apis.TodoTemplateItem{{Title: "other"}}}, int64(otherUser.ID))
+
+	statusCode, todoTemplatesList, err := testTodoTemplateService.FetchTodoTemplatesList(ctx, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), *todoTemplatesList, 1)
+	assert.Equal(s.T(), "週次レビュー", (*todoTemplatesList)[0].Name)
+}
+
+func (s *TestTodoTemplateServiceSuite) TestUpdateTodoTemplate() {
+	todoTemplate := s.createTodoTemplate([]apis.TodoTemplateItem{{Title: "振り返り"}})
+	requestParams := apis.PutTodoTemplateJSONRequestBody{Name: "月次レビュー", Items: []apis.TodoTemplateItem{{Title: "目標の確認", Content: ""}}}
+
+	statusCode, updatedTodoTemplate, err := testTodoTemplateService.UpdateTodoTemplate(ctx, todoTemplate.ID, requestParams, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "月次レビュー", updatedTodoTemplate.Name)
+	assert.JSONEq(s.T(), `[{"title":"目標の確認","content":""}]`, updatedTodoTemplate.Items)
+}
+
+func (s *TestTodoTemplateServiceSuite) TestUpdateTodoTemplate_NotFound() {
+	requestParams := apis.PutTodoTemplateJSONRequestBody{Name: "月次レビュー", Items: []apis.TodoTemplateItem{{Title: "目標の確認"}}}
+
+	statusCode, _, _ := testTodoTemplateService.UpdateTodoTemplate(ctx, 0, requestParams, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusNotFound), statusCode)
+}
+
+func (s *TestTodoTemplateServiceSuite) TestDeleteTodoTemplate() {
+	todoTemplate := s.createTodoTemplate([]apis.TodoTemplateItem{{Title: "振り返り"}})
+
+	statusCode, err := testTodoTemplateService.DeleteTodoTemplate(ctx, todoTemplate.ID, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	exists, _ := models.TodoTemplateExists(ctx, DBCon, todoTemplate.ID)
+	assert.False(s.T(), exists)
+}
+
+func (s *TestTodoTemplateServiceSuite) TestInstantiateTodoTemplate() {
+	todoTemplate := s.createTodoTemplate([]apis.TodoTemplateItem{
+		{Title: "{{client}}との打ち合わせ", Content: "{{date}}に準備"},
+		{Title: "{{ client }}へ議事録を送付", Content: "期限: {{date+7}}"},
+	})
+	variables := map[string]string{"client": "A社"}
+	now := time.Now()
+
+	statusCode, todosList, err := testTodoTemplateService.InstantiateTodoTemplate(ctx, todoTemplate.ID, apis.PostTodoTemplateInstantiateJSONRequestBody{Variables: &variables}, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), *todosList, 2)
+	assert.Equal(s.T(), "A社との打ち合わせ", (*todosList)[0].Title)
+	assert.Equal(s.T(), now.Format("2006-01-02")+"に準備", (*todosList)[0].Content.String)
+	assert.Equal(s.T(), "A社へ議事録を送付", (*todosList)[1].Title)
+	assert.Equal(s.T(), "期限: "+now.AddDate(0, 0, 7).Format("2006-01-02"), (*todosList)[1].Content.String)
+
+	// NOTE: 通常の作成と同様にアクティビティが記録される
+	activities, _ := models.Activities(qm.Where("user_id = ? AND event_type = ?", user.ID, ActivityTodoCreated)).All(ctx, DBCon)
+	assert.Len(s.T(), activities, 2)
+}
+
+func (s *TestTodoTemplateServiceSuite) TestInstantiateTodoTemplate_MissingVariable() {
+	todoTemplate := s.createTodoTemplate([]apis.TodoTemplateItem{
+		{Title: "振り返り"},
+		{Title: "{{client}}への連絡", Content: "{{owner}}が担当、{{client}}"},
+	})
+
+	statusCode, _, err := testTodoTemplateService.InstantiateTodoTemplate(ctx, todoTemplate.ID, apis.PostTodoTemplateInstantiateJSONRequestBody{}, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), "変数が指定されていません: client", err.Error())
+	count, _ := models.Todos(qm.Where("user_id = ?", user.ID)).Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(0), count)
+}
+
+func (s *TestTodoTemplateServiceSuite) TestInstantiateTodoTemplate_OffsetOnVariable() {
+	todoTemplate := s.createTodoTemplate([]apis.TodoTemplateItem{{Title: "{{client+1}}への連絡"}})
+	variables := map[string]string{"client": "A社"}
+
+	statusCode, _, err := testTodoTemplateService.InstantiateTodoTemplate(ctx, todoTemplate.ID, apis.PostTodoTemplateInstantiateJSONRequestBody{Variables: &variables}, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), "日数のオフセットはdateにのみ指定できます: {{client+1}}", err.Error())
+}
+
+func (s *TestTodoTemplateServiceSuite) TestInstantiateTodoTemplate_InvalidRenderedTodo() {
+	todoTemplate := s.createTodoTemplate([]apis.TodoTemplateItem{
+		{Title: "{{client}}との打ち合わせ"},
+		{Title: "振り返り"},
+	})
+	// NOTE: 展開後のタイトルが上限を超える場合は1件も作成しない
+	variables := map[string]string{"client": "あいうえおかきくけこあいうえおかきくけこあいうえおかきくけこあいうえおかきくけこあいうえおかきくけこ"}
+
+	statusCode, _, err := testTodoTemplateService.InstantiateTodoTemplate(ctx, todoTemplate.ID, apis.PostTodoTemplateInstantiateJSONRequestBody{Variables: &variables}, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), "1件目: タイトルは1 ~ 50文字での入力をお願いします。", err.Error())
+	count, _ := models.Todos(qm.Where("user_id = ?", user.ID)).Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(0), count)
+}
+
+func (s *TestTodoTemplateServiceSuite) TestInstantiateTodoTemplate_NotFound() {
+	statusCode, _, _ := testTodoTemplateService.InstantiateTodoTemplate(ctx, 0, apis.PostTodoTemplateInstantiateJSONRequestBody{}, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusNotFound), statusCode)
+}
+
+func TestTodoTemplateService(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestTodoTemplateServiceSuite))
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	models "app/models/generated"
+	"context"
+	"database/sql"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const tokenDenylistPurgeInterval = time.Hour
+
+// TokenDenylistStore ... 失効させた認証トークンのjtiを保持する
+// NOTE: 有効期限を過ぎたトークンはAuthMiddlewareで拒否されるため、期限切れのエントリは削除してよい
+type TokenDenylistStore interface {
+	Revoke(ctx context.Context, tokenID string, expiresAt time.Time) error
+	IsRevoked(ctx context.Context, tokenID string, now time.Time) (bool, error)
+	PurgeExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+type mysqlTokenDenylistStore struct {
+	db *sql.DB
+}
+
+func NewMySQLTokenDenylistStore(db *sql.DB) TokenDenylistStore {
+	return &mysqlTokenDenylistStore{db}
+}
+
+func (ms *mysqlTokenDenylistStore) Revoke(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	// NOTE: 同じトークンで同時に複数回サインアウトされた場合も、既に失効済みであれば何もしない
+	revokedToken := &models.RevokedToken{Jti: tokenID, ExpiresAt: expiresAt}
+	if err := revokedToken.Insert(ctx, ms.db, boil.Infer()); err != nil && !isDuplicateEntryError(err) {
+		return err
+	}
+	return nil
+}
+
+func (ms *mysqlTokenDenylistStore) IsRevoked(ctx context.Context, tokenID string, now time.Time) (bool, error) {
+	return models.RevokedTokens(qm.Where("jti = ? AND expires_at >= ?", tokenID, now)).Exists(ctx, ms.db)
+}
+
+func (ms *mysqlTokenDenylistStore) PurgeExpired(ctx context.Context, now time.Time) (int64, error) {
+	return models.RevokedTokens(qm.Where("expires_at < ?", now)).DeleteAll(ctx, ms.db)
+}
+
+type memoryTokenDenylistStore struct {
+	mu      sync.Mutex
+	entries map[string]time.Time
+}
+
+// NewMemoryTokenDenylistStore ... プロセス内でのみ保持するため、テストや単一プロセスでの利用を想定
+func NewMemoryTokenDenylistStore() TokenDenylistStore {
+	return &memoryTokenDenylistStore{entries: map[string]time.Time{}}
+}
+
+func (ms *memoryTokenDenylistStore) Revoke(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if _, ok := ms.entries[tokenID]; !ok {
+		ms.entries[tokenID] = expiresAt
+	}
+	return nil
+}
+
+func (ms *memoryTokenDenylistStore) IsRevoked(ctx context.Context, tokenID string, now time.Time) (bool, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	expiresAt, ok := ms.entries[tokenID]
+	return ok && !expiresAt.Before(now), nil
+}
+
+func (ms *memoryTokenDenylistStore) PurgeExpired(ctx context.Context, now time.Time) (int64, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	var purgedCount int64
+	for tokenID, expiresAt := range ms.entries {
+		if expiresAt.Before(now) {
+			delete(ms.entries, tokenID)
+			purgedCount++
+		}
+	}
+	return purgedCount, nil
+}
+
+func RunTokenDenylistPurger(ctx context.Context, store TokenDenylistStore) {
+	ticker := time.NewTicker(tokenDenylistPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := store.PurgeExpired(ctx, time.Now()); err != nil {
+				log.Println("failed to purge revoked tokens:", err)
+			}
+		}
+	}
+}
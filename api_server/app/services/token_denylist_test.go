@@ -0,0 +1,93 @@
+package services
+
+import (
+	models "app/models/generated"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestTokenDenylistSuite struct {
+	WithDBSuite
+}
+
+func (s *TestTokenDenylistSuite) SetupTest() {
+	s.SetDBCon()
+}
+
+func (s *TestTokenDenylistSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+// NOTE: 失効リストの実装によらず同じ振る舞いになることを確認する
+func (s *TestTokenDenylistSuite) assertTokenDenylistStore(store TokenDenylistStore) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	assert.NoError(s.T(), store.Revoke(ctx, "active", now.Add(time.Hour)))
+	assert.NoError(s.T(), store.Revoke(ctx, "expired", now.Add(-time.Hour)))
+	// NOTE: 同じトークンを再度失効させてもエラーにならない
+	assert.NoError(s.T(), store.Revoke(ctx, "active", now.Add(time.Hour)))
+
+	isRevoked, err := store.IsRevoked(ctx, "active", now)
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), isRevoked)
+
+	isRevoked, err = store.IsRevoked(ctx, "expired", now)
+	assert.NoError(s.T(), err)
+	assert.False(s.T(), isRevoked)
+
+	isRevoked, err = store.IsRevoked(ctx, "unknown", now)
+	assert.NoError(s.T(), err)
+	assert.False(s.T(), isRevoked)
+
+	purgedCount, err := store.PurgeExpired(ctx, now)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(1), purgedCount)
+
+	// NOTE: 期限内のエントリは削除されない
+	isRevoked, err = store.IsRevoked(ctx, "active", now)
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), isRevoked)
+}
+
+func (s *TestTokenDenylistSuite) TestMySQLTokenDenylistStore() {
+	s.assertTokenDenylistStore(NewMySQLTokenDenylistStore(DBCon))
+
+	count, _ := models.RevokedTokens().Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(1), count)
+}
+
+func (s *TestTokenDenylistSuite) TestMySQLTokenDenylistStore_ConcurrentRevoke() {
+	store := NewMySQLTokenDenylistStore(DBCon)
+	expiresAt := time.Now().Add(time.Hour)
+
+	// NOTE: ダブルクリックなどで同じトークンが同時に失効された場合もエラーにならないこと
+	var wg sync.WaitGroup
+	errs := make([]error, 5)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = store.Revoke(ctx, "concurrent", expiresAt)
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		assert.NoError(s.T(), err)
+	}
+	count, _ := models.RevokedTokens().Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(1), count)
+}
+
+func (s *TestTokenDenylistSuite) TestMemoryTokenDenylistStore() {
+	s.assertTokenDenylistStore(NewMemoryTokenDenylistStore())
+}
+
+func TestTokenDenylist(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestTokenDenylistSuite))
+}
@@ -0,0 +1,91 @@
+package services
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// NOTE: RFC 6238 Appendix BのSHA-1のテストベクタの下6桁
+func TestGenerateTOTPCode(t *testing.T) {
+	key := []byte("12345678901234567890")
+	tests := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	}
+	for unixTime, expected := range tests {
+		assert.Equal(t, expected, generateTOTPCode(key, totpStep(time.Unix(unixTime, 0))))
+	}
+}
+
+func TestValidateTOTPCode(t *testing.T) {
+	secret, err := generateTOTPSecret()
+	if err != nil {
+		t.Fatalf("failed to generate secret %v", err)
+	}
+	key, _ := totpSecretEncoding.DecodeString(secret)
+	now := time.Now()
+	currentStep := totpStep(now)
+
+	tests := []struct {
+		name         string
+		code         string
+		lastUsedStep int64
+		isOK         bool
+	}{
+		{name: "current step", code: generateTOTPCode(key, currentStep), isOK: true},
+		{name: "previous step", code: generateTOTPCode(key, currentStep-1), isOK: true},
+		{name: "next step", code: generateTOTPCode(key, currentStep+1), isOK: true},
+		{name: "with spaces", code: generateTOTPCode(key, currentStep)[:3] + " " + generateTOTPCode(key, currentStep)[3:], isOK: true},
+		{name: "out of window", code: generateTOTPCode(key, currentStep-2), isOK: false},
+		{name: "already used", code: generateTOTPCode(key, currentStep), lastUsedStep: currentStep, isOK: false},
+		{name: "wrong length", code: "12345", isOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, ok := validateTOTPCode(secret, tt.code, now, tt.lastUsedStep)
+			assert.Equal(t, tt.isOK, ok)
+		})
+	}
+}
+
+func TestTOTPURI(t *testing.T) {
+	t.Setenv("TOTP_ISSUER", "Todo App")
+
+	uri, err := url.Parse(totpURI("JBSWY3DPEHPK3PXP", "test@example.com"))
+	if err != nil {
+		t.Fatalf("failed to parse uri %v", err)
+	}
+	assert.Equal(t, "otpauth", uri.Scheme)
+	assert.Equal(t, "totp", uri.Host)
+	assert.Equal(t, "/Todo App:test@example.com", uri.Path)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", uri.Query().Get("secret"))
+	assert.Equal(t, "Todo App", uri.Query().Get("issuer"))
+	assert.Equal(t, "6", uri.Query().Get("digits"))
+	assert.Equal(t, "30", uri.Query().Get("period"))
+}
+
+func TestEncryptTOTPSecret(t *testing.T) {
+	t.Setenv("TOTP_SECRET_KEY", "test-totp-secret-key")
+	encrypted, err := encryptTOTPSecret("JBSWY3DPEHPK3PXP")
+	if err != nil {
+		t.Fatalf("failed to encrypt secret %v", err)
+	}
+	assert.False(t, strings.Contains(encrypted, "JBSWY3DPEHPK3PXP"))
+
+	secret, err := decryptTOTPSecret(encrypted)
+	assert.Nil(t, err)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", secret)
+
+	// NOTE: 別の鍵では復号できないことを確認
+	t.Setenv("TOTP_SECRET_KEY", "other-key")
+	_, err = decryptTOTPSecret(encrypted)
+	assert.NotNil(t, err)
+}
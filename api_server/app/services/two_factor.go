@@ -0,0 +1,144 @@
+package services
+
+import (
+	models "app/models/generated"
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	TwoFactorChallengeLifetime = 5 * time.Minute
+	// NOTE: 6桁のコードを総当たりされないよう、1つのトークンで試行できる回数を制限する
+	twoFactorChallengeMaxAttempts = 5
+	// NOTE: トークンを発行し直して試行回数の制限を回避されないよう、ユーザごとの発行数も制限する
+	twoFactorChallengeIssueLimit = 5
+	recoveryCodeCount            = 10
+)
+
+var (
+	errInvalidTwoFactorCode     = errors.New("認証コードが正しくありません。")
+	errTwoFactorChallengeFailed = errors.New("認証の有効期限が切れました。再度サインインしてください。")
+)
+
+// NOTE: パスワードの照合後、2段階目の認証を終えるまでの間だけ有効なトークンを発行する
+func issueTwoFactorChallenge(ctx context.Context, exec boil.ContextExecutor, userID int64, now time.Time) (string, error) {
+	token, err := generateRandomToken(32)
+	if err != nil {
+		return "", err
+	}
+
+	challenge := &models.TwoFactorChallenge{
+		UserID:    userID,
+		TokenHash: hashToken(token),
+		ExpiresAt: now.Add(TwoFactorChallengeLifetime).Truncate(time.Second),
+	}
+	if err := challenge.Insert(ctx, exec, boil.Infer()); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// NOTE: 判定期間内の発行数が上限に達している場合に、再発行できるまでの時間を返す
+func checkTwoFactorChallengeLimit(ctx context.Context, exec boil.ContextExecutor, userID int64, now time.Time) (time.Duration, error) {
+	challenges, err := models.TwoFactorChallenges(
+		qm.Where("user_id = ? AND created_at > ?", userID, now.Add(-SignInFailureWindow)),
+		qm.OrderBy("created_at DESC"),
+		qm.Limit(twoFactorChallengeIssueLimit),
+	).All(ctx, exec)
+	if err != nil {
+		return 0, err
+	}
+	if len(challenges) < twoFactorChallengeIssueLimit {
+		return 0, nil
+	}
+	return challenges[len(challenges)-1].CreatedAt.Add(SignInFailureWindow).Sub(now), nil
+}
+
+// NOTE: 入力しやすいよう「xxxx-xxxx-xxxx-xxxx」の形式で生成する
+func generateRecoveryCode() (string, error) {
+	randomBytes := make([]byte, 10)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return "", err
+	}
+	encoded := strings.ToLower(base32.StdEncoding.EncodeToString(randomBytes))
+
+	var groups []string
+	for i := 0; i < len(encoded); i += 4 {
+		groups = append(groups, encoded[i:i+4])
+	}
+	return strings.Join(groups, "-"), nil
+}
+
+// NOTE: 区切り文字・大文字小文字の違いは無視して照合する
+func hashRecoveryCode(code string) string {
+	return hashToken(strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code)))
+}
+
+// NOTE: 既存のリカバリーコードは全て破棄し、新しいコードに置き換える
+func replaceRecoveryCodes(ctx context.Context, exec boil.ContextExecutor, userID int64) ([]string, error) {
+	if _, err := models.RecoveryCodes(qm.Where("user_id = ?", userID)).DeleteAll(ctx, exec); err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		recoveryCode := &models.RecoveryCode{UserID: userID, CodeHash: hashRecoveryCode(code)}
+		if err := recoveryCode.Insert(ctx, exec, boil.Infer()); err != nil {
+			return nil, err
+		}
+		codes = append(codes, code)
+	}
+	return codes, nil
+}
+
+// NOTE: TOTPのコードまたは未使用のリカバリーコードで照合し、同時に送信されても1回しか成功しないよう条件付きの更新で使用済みにする
+func verifySecondFactor(ctx context.Context, exec boil.ContextExecutor, user *models.User, code string, now time.Time) (bool, error) {
+	if user.TotpSecret.Valid {
+		secret, err := decryptTOTPSecret(user.TotpSecret.String)
+		if err != nil {
+			return false, err
+		}
+		if step, ok := validateTOTPCode(secret, code, now, user.TotpLastUsedStep.Int64); ok {
+			rowsAff, err := models.Users(
+				qm.Where("id = ?", user.ID),
+				qm.Where("(totp_last_used_step IS NULL OR totp_last_used_step < ?)", step),
+			).UpdateAll(ctx, exec, models.M{"totp_last_used_step": step})
+			if err != nil {
+				return false, err
+			}
+			user.TotpLastUsedStep = null.Int64From(step)
+			return rowsAff > 0, nil
+		}
+	}
+
+	rowsAff, err := models.RecoveryCodes(
+		qm.Where("user_id = ? AND code_hash = ? AND used_at IS NULL", user.ID, hashRecoveryCode(code)),
+	).UpdateAll(ctx, exec, models.M{"used_at": null.TimeFrom(now)})
+	if err != nil {
+		return false, err
+	}
+	return rowsAff > 0, nil
+}
+
+func disableTwoFactor(ctx context.Context, exec boil.ContextExecutor, user *models.User) error {
+	user.TotpSecret = null.String{}
+	user.TotpEnabledAt = null.Time{}
+	user.TotpLastUsedStep = null.Int64{}
+	if _, err := user.Update(ctx, exec, boil.Whitelist("totp_secret", "totp_enabled_at", "totp_last_used_step", "updated_at")); err != nil {
+		return err
+	}
+	_, err := models.RecoveryCodes(qm.Where("user_id = ?", user.ID)).DeleteAll(ctx, exec)
+	return err
+}
@@ -0,0 +1,221 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/validator"
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+var (
+	errTwoFactorAlreadyEnabled = errors.New("2段階認証は既に有効です。")
+	errTwoFactorNotEnabled     = errors.New("2段階認証は有効になっていません。")
+)
+
+type TwoFactorService interface {
+	SetupTwoFactor(ctx context.Context, userID int64) (statusCode int64, secret string, otpauthURI string, err error)
+	ConfirmTwoFactor(ctx context.Context, requestParams apis.PostAuthTwoFactorConfirmJSONRequestBody, userID int64) (statusCode int64, recoveryCodes []string, err error)
+	DisableTwoFactor(ctx context.Context, requestParams apis.PostAuthTwoFactorDisableJSONRequestBody, userID int64, ipAddress string) (statusCode int64, err error)
+	RegenerateRecoveryCodes(ctx context.Context, requestParams apis.PostAuthTwoFactorRecoveryCodesJSONRequestBody, userID int64, ipAddress string) (statusCode int64, recoveryCodes []string, err error)
+}
+
+type twoFactorService struct {
+	db     *sql.DB
+	mailer Mailer
+}
+
+func NewTwoFactorService(db *sql.DB, mailer Mailer) TwoFactorService {
+	return &twoFactorService{db, mailer}
+}
+
+func (ts *twoFactorService) SetupTwoFactor(ctx context.Context, userID int64) (statusCode int64, secret string, otpauthURI string, err error) {
+	statusCode, user, err := ts.findUser(ctx, userID)
+	if err != nil {
+		return statusCode, "", "", err
+	}
+	if user.TotpEnabledAt.Valid {
+		return http.StatusBadRequest, "", "", errTwoFactorAlreadyEnabled
+	}
+
+	// NOTE: 確認が完了するまでは有効にせず、設定をやり直した場合は秘密鍵を置き換える
+	secret, err = generateTOTPSecret()
+	if err != nil {
+		return http.StatusInternalServerError, "", "", err
+	}
+	encryptedSecret, err := encryptTOTPSecret(secret)
+	if err != nil {
+		return http.StatusInternalServerError, "", "", err
+	}
+	user.TotpSecret = null.StringFrom(encryptedSecret)
+	user.TotpLastUsedStep = null.Int64{}
+	if _, err := user.Update(ctx, ts.db, boil.Whitelist("totp_secret", "totp_last_used_step", "updated_at")); err != nil {
+		return http.StatusInternalServerError, "", "", err
+	}
+	return http.StatusOK, secret, totpURI(secret, user.Email), nil
+}
+
+func (ts *twoFactorService) ConfirmTwoFactor(ctx context.Context, requestParams apis.PostAuthTwoFactorConfirmJSONRequestBody, userID int64) (statusCode int64, recoveryCodes []string, err error) {
+	// NOTE: バリデーションチェック
+	if err := validator.ValidateConfirmTwoFactor(&requestParams); err != nil {
+		return http.StatusBadRequest, []string{}, err
+	}
+
+	statusCode, user, err := ts.findUser(ctx, userID)
+	if err != nil {
+		return statusCode, []string{}, err
+	}
+	if user.TotpEnabledAt.Valid {
+		return http.StatusBadRequest, []string{}, validation.Errors{"code": errTwoFactorAlreadyEnabled}
+	}
+	if !user.TotpSecret.Valid {
+		return http.StatusBadRequest, []string{}, validation.Errors{"code": errors.New("2段階認証の設定を開始してください。")}
+	}
+
+	// NOTE: 認証アプリに正しく登録できたことを確認するため、リカバリーコードは受け付けない
+	secret, err := decryptTOTPSecret(user.TotpSecret.String)
+	if err != nil {
+		return http.StatusInternalServerError, []string{}, err
+	}
+	now := time.Now()
+	step, ok := validateTOTPCode(secret, requestParams.Code, now, 0)
+	if !ok {
+		return http.StatusBadRequest, []string{}, validation.Errors{"code": errInvalidTwoFactorCode}
+	}
+
+	tx, err := ts.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, []string{}, err
+	}
+	defer tx.Rollback()
+
+	user.TotpEnabledAt = null.TimeFrom(now)
+	user.TotpLastUsedStep = null.Int64From(step)
+	if _, err := user.Update(ctx, tx, boil.Whitelist("totp_enabled_at", "totp_last_used_step", "updated_at")); err != nil {
+		return http.StatusInternalServerError, []string{}, err
+	}
+	recoveryCodes, err = replaceRecoveryCodes(ctx, tx, int64(user.ID))
+	if err != nil {
+		return http.StatusInternalServerError, []string{}, err
+	}
+	if err := recordUserOutboxEvent(ctx, tx, user, OutboxUserTwoFactorEnabled); err != nil {
+		return http.StatusInternalServerError, []string{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, []string{}, err
+	}
+	return http.StatusOK, recoveryCodes, nil
+}
+
+func (ts *twoFactorService) DisableTwoFactor(ctx context.Context, requestParams apis.PostAuthTwoFactorDisableJSONRequestBody, userID int64, ipAddress string) (statusCode int64, err error) {
+	// NOTE: バリデーションチェック
+	if err := validator.ValidateDisableTwoFactor(&requestParams); err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	statusCode, user, err := ts.findUser(ctx, userID)
+	if err != nil {
+		return statusCode, err
+	}
+	if !user.TotpEnabledAt.Valid {
+		return http.StatusBadRequest, validation.Errors{"code": errTwoFactorNotEnabled}
+	}
+	now := time.Now()
+	if statusCode, err := verifyCurrentPassword(ctx, ts.db, ts.mailer, user, requestParams.Password, ipAddress, now); err != nil {
+		return statusCode, err
+	}
+
+	tx, err := ts.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	defer tx.Rollback()
+
+	if statusCode, err := verifyCurrentSecondFactor(ctx, tx, ts.mailer, user, requestParams.Code, ipAddress, now); err != nil {
+		return ts.commitFailure(tx, statusCode, err)
+	}
+	if err := disableTwoFactor(ctx, tx, user); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	if err := recordUserOutboxEvent(ctx, tx, user, OutboxUserTwoFactorDisabled); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusOK, nil
+}
+
+// NOTE: セッションを盗まれた場合にコードを総当たりされないよう、無効化と同じくパスワードで再確認し、失敗回数をサインインと合わせて制限する
+func (ts *twoFactorService) RegenerateRecoveryCodes(ctx context.Context, requestParams apis.PostAuthTwoFactorRecoveryCodesJSONRequestBody, userID int64, ipAddress string) (statusCode int64, recoveryCodes []string, err error) {
+	// NOTE: バリデーションチェック
+	if err := validator.ValidateRegenerateRecoveryCodes(&requestParams); err != nil {
+		return http.StatusBadRequest, []string{}, err
+	}
+
+	statusCode, user, err := ts.findUser(ctx, userID)
+	if err != nil {
+		return statusCode, []string{}, err
+	}
+	if !user.TotpEnabledAt.Valid {
+		return http.StatusBadRequest, []string{}, validation.Errors{"code": errTwoFactorNotEnabled}
+	}
+	now := time.Now()
+	if statusCode, err := verifyCurrentPassword(ctx, ts.db, ts.mailer, user, requestParams.Password, ipAddress, now); err != nil {
+		return statusCode, []string{}, err
+	}
+
+	tx, err := ts.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, []string{}, err
+	}
+	defer tx.Rollback()
+
+	if statusCode, err := verifyCurrentSecondFactor(ctx, tx, ts.mailer, user, requestParams.Code, ipAddress, now); err != nil {
+		statusCode, err = ts.commitFailure(tx, statusCode, err)
+		return statusCode, []string{}, err
+	}
+	recoveryCodes, err = replaceRecoveryCodes(ctx, tx, int64(user.ID))
+	if err != nil {
+		return http.StatusInternalServerError, []string{}, err
+	}
+	if err := recordUserOutboxEvent(ctx, tx, user, OutboxUserRecoveryCodesRenewed); err != nil {
+		return http.StatusInternalServerError, []string{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, []string{}, err
+	}
+	return http.StatusOK, recoveryCodes, nil
+}
+
+// NOTE: コードの照合に失敗した場合は、記録した失敗回数が残るようトランザクションを確定してから返す
+func (ts *twoFactorService) commitFailure(tx *sql.Tx, statusCode int64, err error) (int64, error) {
+	if statusCode != http.StatusBadRequest {
+		return statusCode, err
+	}
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return statusCode, err
+}
+
+func (ts *twoFactorService) findUser(ctx context.Context, userID int64) (statusCode int64, user *models.User, err error) {
+	user, err = models.FindUser(ctx, ts.db, int(userID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return http.StatusNotFound, &models.User{}, errors.New("user not found")
+		}
+		return http.StatusInternalServerError, &models.User{}, err
+	}
+	return http.StatusOK, user, nil
+}
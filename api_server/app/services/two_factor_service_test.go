@@ -0,0 +1,192 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/test/factories"
+	"net/http"
+	"testing"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type TestTwoFactorServiceSuite struct {
+	WithDBSuite
+}
+
+var testTwoFactorService TwoFactorService
+
+func (s *TestTwoFactorServiceSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	testMailer = NewMemoryMailer()
+	testTwoFactorService = NewTwoFactorService(DBCon, testMailer)
+}
+
+func (s *TestTwoFactorServiceSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+// NOTE: 指定した時刻のステップに対応するTOTPのコードを返す
+func currentTOTPCode(secret string, now time.Time) string {
+	key, _ := totpSecretEncoding.DecodeString(secret)
+	return generateTOTPCode(key, totpStep(now))
+}
+
+// NOTE: 2段階認証を有効にし、秘密鍵とリカバリーコードを返す
+func enableTwoFactor(t *testing.T, userID int64) (string, []string) {
+	twoFactorService := NewTwoFactorService(DBCon, NewMemoryMailer())
+	_, secret, _, err := twoFactorService.SetupTwoFactor(ctx, userID)
+	if err != nil {
+		t.Fatalf("failed to set up two-factor %v", err)
+	}
+	// NOTE: 確認時に使用したステップは再利用できないため、1つ前のステップのコードで確認する
+	_, recoveryCodes, err := twoFactorService.ConfirmTwoFactor(ctx, apis.PostAuthTwoFactorConfirmJSONRequestBody{Code: currentTOTPCode(secret, time.Now().Add(-totpPeriod*time.Second))}, userID)
+	if err != nil {
+		t.Fatalf("failed to confirm two-factor %v", err)
+	}
+	return secret, recoveryCodes
+}
+
+func (s *TestTwoFactorServiceSuite) TestSetupTwoFactor() {
+	statusCode, secret, otpauthURI, err := testTwoFactorService.SetupTwoFactor(ctx, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Contains(s.T(), otpauthURI, "secret="+secret)
+
+	// NOTE: 秘密鍵は暗号化して保存し、確認が完了するまでは有効にしない
+	user.Reload(ctx, DBCon)
+	assert.True(s.T(), user.TotpSecret.Valid)
+	assert.NotEqual(s.T(), secret, user.TotpSecret.String)
+	assert.False(s.T(), user.TotpEnabledAt.Valid)
+}
+
+func (s *TestTwoFactorServiceSuite) TestSetupTwoFactor_AlreadyEnabled() {
+	enableTwoFactor(s.T(), int64(user.ID))
+
+	statusCode, _, _, err := testTwoFactorService.SetupTwoFactor(ctx, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), errTwoFactorAlreadyEnabled, err)
+}
+
+func (s *TestTwoFactorServiceSuite) TestConfirmTwoFactor_StatusOK() {
+	_, secret, _, _ := testTwoFactorService.SetupTwoFactor(ctx, int64(user.ID))
+
+	statusCode, recoveryCodes, err := testTwoFactorService.ConfirmTwoFactor(ctx, apis.PostAuthTwoFactorConfirmJSONRequestBody{Code: currentTOTPCode(secret, time.Now())}, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), recoveryCodes, recoveryCodeCount)
+	user.Reload(ctx, DBCon)
+	assert.True(s.T(), user.TotpEnabledAt.Valid)
+
+	// NOTE: リカバリーコードは平文で保存しない
+	storedCodes, _ := models.RecoveryCodes(qm.Where("user_id = ?", user.ID)).All(ctx, DBCon)
+	if assert.Len(s.T(), storedCodes, recoveryCodeCount) {
+		assert.Equal(s.T(), hashRecoveryCode(recoveryCodes[0]), storedCodes[0].CodeHash)
+	}
+}
+
+func (s *TestTwoFactorServiceSuite) TestConfirmTwoFactor_BadRequest() {
+	// NOTE: 設定を開始していない場合
+	statusCode, _, err := testTwoFactorService.ConfirmTwoFactor(ctx, apis.PostAuthTwoFactorConfirmJSONRequestBody{Code: "123456"}, int64(user.ID))
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.NotNil(s.T(), err.(validation.Errors)["code"])
+
+	// NOTE: コードが誤っている場合
+	_, secret, _, _ := testTwoFactorService.SetupTwoFactor(ctx, int64(user.ID))
+	wrongCode := currentTOTPCode(secret, time.Now().Add(-time.Hour))
+	statusCode, _, err = testTwoFactorService.ConfirmTwoFactor(ctx, apis.PostAuthTwoFactorConfirmJSONRequestBody{Code: wrongCode}, int64(user.ID))
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), errInvalidTwoFactorCode, err.(validation.Errors)["code"])
+	user.Reload(ctx, DBCon)
+	assert.False(s.T(), user.TotpEnabledAt.Valid)
+}
+
+func (s *TestTwoFactorServiceSuite) TestDisableTwoFactor_StatusOK() {
+	_, recoveryCodes := enableTwoFactor(s.T(), int64(user.ID))
+
+	// NOTE: 認証アプリを紛失した場合を想定し、リカバリーコードで無効にする
+	statusCode, err := testTwoFactorService.DisableTwoFactor(ctx, apis.PostAuthTwoFactorDisableJSONRequestBody{Password: "password", Code: recoveryCodes[0]}, int64(user.ID), "192.0.2.1")
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	user.Reload(ctx, DBCon)
+	assert.False(s.T(), user.TotpEnabledAt.Valid)
+	assert.False(s.T(), user.TotpSecret.Valid)
+	count, _ := models.RecoveryCodes(qm.Where("user_id = ?", user.ID)).Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(0), count)
+}
+
+func (s *TestTwoFactorServiceSuite) TestDisableTwoFactor_BadRequest() {
+	secret, _ := enableTwoFactor(s.T(), int64(user.ID))
+
+	statusCode, err := testTwoFactorService.DisableTwoFactor(ctx, apis.PostAuthTwoFactorDisableJSONRequestBody{Password: "wrongPassword", Code: currentTOTPCode(secret, time.Now())}, int64(user.ID), "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.NotNil(s.T(), err.(validation.Errors)["password"])
+
+	statusCode, err = testTwoFactorService.DisableTwoFactor(ctx, apis.PostAuthTwoFactorDisableJSONRequestBody{Password: "password", Code: "000000-invalid"}, int64(user.ID), "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), errInvalidTwoFactorCode, err.(validation.Errors)["code"])
+
+	user.Reload(ctx, DBCon)
+	assert.True(s.T(), user.TotpEnabledAt.Valid)
+}
+
+func (s *TestTwoFactorServiceSuite) TestRegenerateRecoveryCodes() {
+	secret, oldRecoveryCodes := enableTwoFactor(s.T(), int64(user.ID))
+
+	statusCode, recoveryCodes, err := testTwoFactorService.RegenerateRecoveryCodes(ctx, apis.PostAuthTwoFactorRecoveryCodesJSONRequestBody{Password: "password", Code: currentTOTPCode(secret, time.Now())}, int64(user.ID), "192.0.2.1")
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), recoveryCodes, recoveryCodeCount)
+
+	// NOTE: 以前のリカバリーコードは利用できないことを確認
+	ok, err := verifySecondFactor(ctx, DBCon, user, oldRecoveryCodes[0], time.Now())
+	assert.Nil(s.T(), err)
+	assert.False(s.T(), ok)
+	ok, err = verifySecondFactor(ctx, DBCon, user, recoveryCodes[0], time.Now())
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), ok)
+}
+
+func (s *TestTwoFactorServiceSuite) TestRegenerateRecoveryCodes_BadRequest() {
+	secret, _ := enableTwoFactor(s.T(), int64(user.ID))
+
+	statusCode, _, err := testTwoFactorService.RegenerateRecoveryCodes(ctx, apis.PostAuthTwoFactorRecoveryCodesJSONRequestBody{Password: "wrongPassword", Code: currentTOTPCode(secret, time.Now())}, int64(user.ID), "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), "パスワードが正しくありません。", err.(validation.Errors)["password"].Error())
+
+	statusCode, _, err = testTwoFactorService.RegenerateRecoveryCodes(ctx, apis.PostAuthTwoFactorRecoveryCodesJSONRequestBody{Password: "password", Code: "000000"}, int64(user.ID), "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), errInvalidTwoFactorCode, err.(validation.Errors)["code"])
+
+	// NOTE: パスワードとコードの失敗はサインインと合わせて数え、待ち時間中は正しいコードでも拒否すること
+	count, _ := models.SignInFailures(qm.Where("email = ?", "test@example.com")).Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(2), count)
+	for i := count; i < accountSignInDelayThreshold; i++ {
+		testTwoFactorService.RegenerateRecoveryCodes(ctx, apis.PostAuthTwoFactorRecoveryCodesJSONRequestBody{Password: "password", Code: "000000"}, int64(user.ID), "192.0.2.1")
+	}
+	statusCode, _, err = testTwoFactorService.RegenerateRecoveryCodes(ctx, apis.PostAuthTwoFactorRecoveryCodesJSONRequestBody{Password: "password", Code: currentTOTPCode(secret, time.Now())}, int64(user.ID), "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusTooManyRequests), statusCode)
+	assert.IsType(s.T(), &SignInThrottledError{}, err)
+}
+
+func TestTwoFactorService(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestTwoFactorServiceSuite))
+}
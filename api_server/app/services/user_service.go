@@ -0,0 +1,148 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/validator"
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type UserService interface {
+	FetchMe(ctx context.Context, userID int64) (statusCode int64, user *models.User, err error)
+	UpdateMe(ctx context.Context, requestParams apis.PatchUsersMeJSONRequestBody, userID int64) (statusCode int64, user *models.User, err error)
+	ChangeEmail(ctx context.Context, requestParams apis.PostUsersMeEmailJSONRequestBody, userID int64, ipAddress string) (statusCode int64, user *models.User, err error)
+	IsEmailVerified(ctx context.Context, userID int64) (bool, error)
+}
+
+type userService struct {
+	db     *sql.DB
+	mailer Mailer
+}
+
+func NewUserService(db *sql.DB, mailer Mailer) UserService {
+	return &userService{db, mailer}
+}
+
+func (us *userService) FetchMe(ctx context.Context, userID int64) (statusCode int64, user *models.User, err error) {
+	user, err = models.FindUser(ctx, us.db, int(userID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return http.StatusNotFound, &models.User{}, errors.New("user not found")
+		}
+		return http.StatusInternalServerError, &models.User{}, err
+	}
+	return http.StatusOK, user, nil
+}
+
+func (us *userService) UpdateMe(ctx context.Context, requestParams apis.PatchUsersMeJSONRequestBody, userID int64) (statusCode int64, user *models.User, err error) {
+	// NOTE: バリデーションチェック
+	if err := validator.ValidateUpdateMe(&requestParams); err != nil {
+		return http.StatusBadRequest, &models.User{}, err
+	}
+
+	statusCode, user, err = us.FetchMe(ctx, userID)
+	if err != nil {
+		return statusCode, &models.User{}, err
+	}
+
+	// NOTE: 指定された項目のみ更新する
+	if requestParams.FirstName != nil {
+		user.FirstName = *requestParams.FirstName
+	}
+	if requestParams.LastName != nil {
+		user.LastName = *requestParams.LastName
+	}
+	if requestParams.Birthday != nil {
+		user.Birthday = null.TimeFrom(requestParams.Birthday.Time)
+	}
+
+	tx, err := us.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, &models.User{}, err
+	}
+	defer tx.Rollback()
+
+	if _, err := user.Update(ctx, tx, boil.Whitelist("first_name", "last_name", "birthday", "updated_at")); err != nil {
+		return http.StatusInternalServerError, &models.User{}, err
+	}
+	payload := userProfileOutboxPayload{ID: user.ID, FirstName: user.FirstName, LastName: user.LastName, Birthday: user.Birthday}
+	if err := recordOutboxEvent(ctx, tx, outboxAggregateUser, int64(user.ID), OutboxUserProfileUpdated, payload); err != nil {
+		return http.StatusInternalServerError, &models.User{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, &models.User{}, err
+	}
+	return http.StatusOK, user, nil
+}
+
+func (us *userService) ChangeEmail(ctx context.Context, requestParams apis.PostUsersMeEmailJSONRequestBody, userID int64, ipAddress string) (statusCode int64, user *models.User, err error) {
+	// NOTE: バリデーションチェック
+	if err := validator.ValidateChangeEmail(&requestParams); err != nil {
+		return http.StatusBadRequest, &models.User{}, err
+	}
+
+	statusCode, user, err = us.FetchMe(ctx, userID)
+	if err != nil {
+		return statusCode, &models.User{}, err
+	}
+	// NOTE: セッションを盗まれた場合にメールアドレスを乗っ取られないよう、パスワードを再確認する
+	now := time.Now()
+	if statusCode, err := verifyCurrentPassword(ctx, us.db, us.mailer, user, requestParams.Password, ipAddress, now); err != nil {
+		return statusCode, &models.User{}, err
+	}
+	if user.Email == requestParams.Email {
+		return http.StatusBadRequest, &models.User{}, validation.Errors{"email": errors.New("現在のメールアドレスと同じです。")}
+	}
+	isExist, err := models.Users(qm.Where("email = ?", requestParams.Email)).Exists(ctx, us.db)
+	if err != nil {
+		return http.StatusInternalServerError, &models.User{}, err
+	}
+	if isExist {
+		return http.StatusBadRequest, &models.User{}, validation.Errors{"email": errors.New("このメールアドレスは既に利用されています。")}
+	}
+	if !canResendEmailVerification(user, now) {
+		return http.StatusTooManyRequests, &models.User{}, errors.New("確認メールを送信したばかりです。しばらく時間を空けてからお試しください。")
+	}
+
+	// NOTE: 本人以外による変更に気づけるよう、変更前のメールアドレスにも通知する
+	if err := us.mailer.Send(ctx, newEmailChangeNoticeMailMessage(user.Email, requestParams.Email)); err != nil {
+		return http.StatusInternalServerError, &models.User{}, err
+	}
+
+	tx, err := us.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, &models.User{}, err
+	}
+	defer tx.Rollback()
+
+	// NOTE: 確認が完了するまでは変更前のメールアドレスを利用する
+	user.PendingEmail = null.StringFrom(requestParams.Email)
+	if _, err := user.Update(ctx, tx, boil.Whitelist("pending_email", "updated_at")); err != nil {
+		return http.StatusInternalServerError, &models.User{}, err
+	}
+	if err := recordUserOutboxEvent(ctx, tx, user, OutboxUserEmailChangeRequested); err != nil {
+		return http.StatusInternalServerError, &models.User{}, err
+	}
+	if err := sendEmailVerificationMail(ctx, tx, us.mailer, user, requestParams.Email, now); err != nil {
+		return http.StatusInternalServerError, &models.User{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, &models.User{}, err
+	}
+	return http.StatusOK, user, nil
+}
+
+func (us *userService) IsEmailVerified(ctx context.Context, userID int64) (bool, error) {
+	return models.Users(qm.Where("id = ? AND email_verified_at IS NOT NULL", userID)).Exists(ctx, us.db)
+}
@@ -0,0 +1,160 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/test/factories"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+type TestUserServiceSuite struct {
+	WithDBSuite
+}
+
+var testUserService UserService
+
+func (s *TestUserServiceSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com", "FirstName": "太郎", "LastName": "山田"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	testMailer = NewMemoryMailer()
+	testUserService = NewUserService(DBCon, testMailer)
+}
+
+func (s *TestUserServiceSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *TestUserServiceSuite) TestFetchMe() {
+	statusCode, me, err := testUserService.FetchMe(ctx, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), user.ID, me.ID)
+	assert.Equal(s.T(), "test@example.com", me.Email)
+}
+
+func (s *TestUserServiceSuite) TestFetchMe_StatusNotFound() {
+	statusCode, _, err := testUserService.FetchMe(ctx, int64(user.ID+1))
+
+	assert.Equal(s.T(), int64(http.StatusNotFound), statusCode)
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestUserServiceSuite) TestUpdateMe() {
+	firstName := "花子"
+	birthday := openapi_types.Date{Time: time.Date(1990, 4, 1, 0, 0, 0, 0, time.UTC)}
+	requestParams := apis.PatchUsersMeJSONRequestBody{FirstName: &firstName, Birthday: &birthday}
+
+	statusCode, _, err := testUserService.UpdateMe(ctx, requestParams, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+
+	// NOTE: 指定していない項目は更新されないことを確認
+	updatedUser, _ := models.FindUser(ctx, DBCon, user.ID)
+	assert.Equal(s.T(), "花子", updatedUser.FirstName)
+	assert.Equal(s.T(), "山田", updatedUser.LastName)
+	assert.Equal(s.T(), "1990-04-01", updatedUser.Birthday.Time.Format("2006-01-02"))
+}
+
+func (s *TestUserServiceSuite) TestUpdateMe_ValidationErrors() {
+	emptyName := ""
+	longName := "あいうえおかきくけこさしすせそたちつてとな"
+	futureBirthday := openapi_types.Date{Time: time.Now().AddDate(1, 0, 0)}
+	requestParams := apis.PatchUsersMeJSONRequestBody{FirstName: &emptyName, LastName: &longName, Birthday: &futureBirthday}
+
+	statusCode, _, err := testUserService.UpdateMe(ctx, requestParams, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	if validationErrors, ok := err.(validation.Errors); assert.True(s.T(), ok) {
+		assert.Equal(s.T(), "名は必須入力です。", validationErrors["firstName"].Error())
+		assert.Equal(s.T(), "姓は1 ~ 20文字での入力をお願いします。", validationErrors["lastName"].Error())
+		assert.Equal(s.T(), "誕生日に未来の日付は指定できません。", validationErrors["birthday"].Error())
+	}
+
+	updatedUser, _ := models.FindUser(ctx, DBCon, user.ID)
+	assert.Equal(s.T(), "太郎", updatedUser.FirstName)
+}
+
+func (s *TestUserServiceSuite) TestChangeEmail() {
+	statusCode, me, err := testUserService.ChangeEmail(ctx, apis.PostUsersMeEmailJSONRequestBody{Email: "new@example.com", Password: "password"}, int64(user.ID), "192.0.2.1")
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+
+	// NOTE: 確認が完了するまではメールアドレスを変更しないことを確認
+	assert.Equal(s.T(), "test@example.com", me.Email)
+	assert.Equal(s.T(), "new@example.com", me.PendingEmail.String)
+
+	// NOTE: 変更前のメールアドレスに通知し、変更後のメールアドレスに確認メールを送ることを確認
+	messages := testMailer.Messages()
+	if assert.Len(s.T(), messages, 2) {
+		assert.Equal(s.T(), "test@example.com", messages[0].To)
+		assert.Equal(s.T(), "メールアドレス変更のお知らせ", messages[0].Subject)
+		assert.Contains(s.T(), messages[0].Body, "new@example.com")
+		assert.Equal(s.T(), "new@example.com", messages[1].To)
+	}
+
+	// NOTE: 送信間隔を空けずに変更した場合は送信しないことを確認
+	statusCode, _, _ = testUserService.ChangeEmail(ctx, apis.PostUsersMeEmailJSONRequestBody{Email: "other@example.com", Password: "password"}, int64(user.ID), "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusTooManyRequests), statusCode)
+	assert.Len(s.T(), testMailer.Messages(), 2)
+}
+
+func (s *TestUserServiceSuite) TestChangeEmail_IncorrectPassword() {
+	statusCode, _, err := testUserService.ChangeEmail(ctx, apis.PostUsersMeEmailJSONRequestBody{Email: "new@example.com", Password: "wrongPassword"}, int64(user.ID), "192.0.2.1")
+
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), "パスワードが正しくありません。", err.(validation.Errors)["password"].Error())
+	assert.Len(s.T(), testMailer.Messages(), 0)
+
+	// NOTE: サインインと同じく、失敗が続いた場合は正しいパスワードでも待ち時間中は拒否すること
+	for i := 1; i < accountSignInDelayThreshold; i++ {
+		testUserService.ChangeEmail(ctx, apis.PostUsersMeEmailJSONRequestBody{Email: "new@example.com", Password: "wrongPassword"}, int64(user.ID), "192.0.2.1")
+	}
+	statusCode, _, err = testUserService.ChangeEmail(ctx, apis.PostUsersMeEmailJSONRequestBody{Email: "new@example.com", Password: "password"}, int64(user.ID), "192.0.2.1")
+	assert.Equal(s.T(), int64(http.StatusTooManyRequests), statusCode)
+	assert.IsType(s.T(), &SignInThrottledError{}, err)
+	me, _ := models.FindUser(ctx, DBCon, user.ID)
+	assert.False(s.T(), me.PendingEmail.Valid)
+}
+
+func (s *TestUserServiceSuite) TestChangeEmail_ValidationErrors() {
+	otherUser := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "other@example.com"}).(*models.User)
+	if err := otherUser.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	tests := map[string]string{
+		"invalid":           "Emailの形式での入力をお願いします。",
+		"test@example.com":  "現在のメールアドレスと同じです。",
+		"other@example.com": "このメールアドレスは既に利用されています。",
+	}
+	for email, message := range tests {
+		statusCode, _, err := testUserService.ChangeEmail(ctx, apis.PostUsersMeEmailJSONRequestBody{Email: email, Password: "password"}, int64(user.ID), "192.0.2.1")
+
+		assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+		assert.Equal(s.T(), message, err.(validation.Errors)["email"].Error())
+	}
+	assert.Len(s.T(), testMailer.Messages(), 0)
+}
+
+func TestUserService(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestUserServiceSuite))
+}
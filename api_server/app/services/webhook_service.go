@@ -0,0 +1,359 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/validator"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	webhookDeliveryPending   = "pending"
+	webhookDeliverySucceeded = "succeeded"
+	webhookDeliveryFailed    = "failed"
+)
+
+const (
+	// NOTE: 初回を含めた最大送信回数。超えた場合はfailedとして再送を打ち切る
+	webhookMaxAttempts = 8
+	// NOTE: 再送間隔は30秒から倍々に伸ばす(30秒, 1分, 2分, ... 32分)
+	webhookBaseBackoff = 30 * time.Second
+	webhookTimeout     = 10 * time.Second
+	// NOTE: 送信中の配信を他のワーカーが重複して取得しないよう、取得時に次回送信日時を先送りする
+	webhookClaimLease      = time.Minute
+	webhookWorkerInterval  = 5 * time.Second
+	webhookWorkerBatchSize = 50
+)
+
+const (
+	defaultWebhookDeliveriesLimit = 20
+	maxWebhookDeliveriesLimit     = 100
+)
+
+type WebhookService interface {
+	CreateWebhook(ctx context.Context, requestParams apis.PostWebhooksJSONRequestBody, userID int64) (statusCode int64, webhook *models.Webhook, err error)
+	FetchWebhooksList(ctx context.Context, userID int64) (statusCode int64, webhooksList *models.WebhookSlice, err error)
+	DeleteWebhook(ctx context.Context, id int64, userID int64) (statusCode int64, err error)
+	FetchWebhookDeliveriesList(ctx context.Context, webhookID int64, params apis.GetWebhookDeliveriesParams, userID int64) (statusCode int64, deliveriesList *models.WebhookDeliverySlice, nextCursor *string, err error)
+	RedeliverWebhook(ctx context.Context, webhookID int64, id int64, userID int64) (statusCode int64, delivery *models.WebhookDelivery, err error)
+	DeliverPendingWebhooks(ctx context.Context) error
+}
+
+type webhookService struct {
+	db     *sql.DB
+	client *http.Client
+}
+
+func NewWebhookService(db *sql.DB) WebhookService {
+	client := &http.Client{
+		Timeout: webhookTimeout,
+		// NOTE: リダイレクトは追従せず配信失敗として扱う
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	return &webhookService{db, client}
+}
+
+type webhookPayload struct {
+	ID         int64              `json:"id"`
+	Type       string             `json:"type"`
+	OccurredAt time.Time          `json:"occurredAt"`
+	ActorID    int64              `json:"actorId"`
+	Todo       webhookPayloadTodo `json:"todo"`
+}
+
+type webhookPayloadTodo struct {
+	ID    int64  `json:"id"`
+	Title string `json:"title"`
+}
+
+func (ws *webhookService) CreateWebhook(ctx context.Context, requestParams apis.PostWebhooksJSONRequestBody, userID int64) (statusCode int64, webhook *models.Webhook, err error) {
+	// NOTE: バリデーションチェック
+	validationErrors := validator.ValidateCreateWebhook(requestParams, activityEventTypes)
+	if validationErrors != nil {
+		return http.StatusBadRequest, &models.Webhook{}, validationErrors
+	}
+
+	webhook = &models.Webhook{}
+	webhook.UserID = userID
+	webhook.URL = requestParams.Url
+	webhook.Secret = requestParams.Secret
+	webhook.EventTypes = strings.Join(requestParams.EventTypes, ",")
+	if err := webhook.Insert(ctx, ws.db, boil.Infer()); err != nil {
+		return http.StatusInternalServerError, &models.Webhook{}, err
+	}
+	return http.StatusOK, webhook, nil
+}
+
+func (ws *webhookService) FetchWebhooksList(ctx context.Context, userID int64) (statusCode int64, webhooksList *models.WebhookSlice, err error) {
+	webhooks, err := models.Webhooks(qm.Where("user_id = ?", userID), qm.OrderBy("id ASC")).All(ctx, ws.db)
+	if err != nil {
+		return http.StatusInternalServerError, &models.WebhookSlice{}, err
+	}
+	return http.StatusOK, &webhooks, nil
+}
+
+func (ws *webhookService) DeleteWebhook(ctx context.Context, id int64, userID int64) (statusCode int64, err error) {
+	webhook, err := models.Webhooks(qm.Where("id = ? AND user_id = ?", id, userID)).One(ctx, ws.db)
+	if err != nil {
+		return http.StatusNotFound, err
+	}
+
+	tx, err := ws.db.BeginTx(ctx, nil)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	defer tx.Rollback()
+
+	// NOTE: 未送信の配信が残らないよう配信履歴もあわせて削除
+	if _, err := models.WebhookDeliveries(qm.Where("webhook_id = ?", webhook.ID)).DeleteAll(ctx, tx); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	if _, err := webhook.Delete(ctx, tx); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusOK, nil
+}
+
+func (ws *webhookService) FetchWebhookDeliveriesList(ctx context.Context, webhookID int64, params apis.GetWebhookDeliveriesParams, userID int64) (statusCode int64, deliveriesList *models.WebhookDeliverySlice, nextCursor *string, err error) {
+	exists, err := models.Webhooks(qm.Where("id = ? AND user_id = ?", webhookID, userID)).Exists(ctx, ws.db)
+	if err != nil {
+		return http.StatusInternalServerError, &models.WebhookDeliverySlice{}, nil, err
+	}
+	if !exists {
+		return http.StatusNotFound, &models.WebhookDeliverySlice{}, nil, errors.New("webhook not found")
+	}
+
+	pageSize := defaultWebhookDeliveriesLimit
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > maxWebhookDeliveriesLimit {
+			return http.StatusBadRequest, &models.WebhookDeliverySlice{}, nil, errors.New("limit must be between 1 and " + strconv.Itoa(maxWebhookDeliveriesLimit))
+		}
+		pageSize = *params.Limit
+	}
+
+	queryMods := []qm.QueryMod{qm.Where("webhook_id = ?", webhookID)}
+	if params.Cursor != nil {
+		cursorID, err := strconv.ParseInt(*params.Cursor, 10, 64)
+		if err != nil {
+			return http.StatusBadRequest, &models.WebhookDeliverySlice{}, nil, errors.New("invalid cursor")
+		}
+		queryMods = append(queryMods, qm.Where("id < ?", cursorID))
+	}
+	// NOTE: 新しい順に並べ、次ページの有無を判定するため1件多く取得
+	queryMods = append(queryMods, qm.OrderBy("id DESC"), qm.Limit(pageSize+1))
+
+	deliveries, err := models.WebhookDeliveries(queryMods...).All(ctx, ws.db)
+	if err != nil {
+		return http.StatusInternalServerError, &models.WebhookDeliverySlice{}, nil, err
+	}
+
+	if len(deliveries) > pageSize {
+		deliveries = deliveries[:pageSize]
+		next := strconv.FormatInt(deliveries[pageSize-1].ID, 10)
+		nextCursor = &next
+	}
+	return http.StatusOK, &deliveries, nextCursor, nil
+}
+
+func (ws *webhookService) RedeliverWebhook(ctx context.Context, webhookID int64, id int64, userID int64) (statusCode int64, delivery *models.WebhookDelivery, err error) {
+	webhook, err := models.Webhooks(qm.Where("id = ? AND user_id = ?", webhookID, userID)).One(ctx, ws.db)
+	if err != nil {
+		return http.StatusNotFound, &models.WebhookDelivery{}, err
+	}
+
+	original, err := models.WebhookDeliveries(qm.Where("id = ? AND webhook_id = ?", id, webhook.ID)).One(ctx, ws.db)
+	if err != nil {
+		return http.StatusNotFound, &models.WebhookDelivery{}, err
+	}
+
+	// NOTE: 配信履歴を残すため、同じペイロードで新たな配信を登録する
+	delivery = &models.WebhookDelivery{}
+	delivery.WebhookID = webhook.ID
+	delivery.EventID = original.EventID
+	delivery.EventType = original.EventType
+	delivery.Payload = original.Payload
+	delivery.Status = webhookDeliveryPending
+	// NOTE: DATETIME型は秒未満が丸められるため、切り捨てて即時送信対象とする
+	delivery.NextAttemptAt = time.Now().Truncate(time.Second)
+	if err := delivery.Insert(ctx, ws.db, boil.Infer()); err != nil {
+		return http.StatusInternalServerError, &models.WebhookDelivery{}, err
+	}
+	return http.StatusOK, delivery, nil
+}
+
+func (ws *webhookService) DeliverPendingWebhooks(ctx context.Context) error {
+	now := time.Now()
+	deliveries, err := models.WebhookDeliveries(
+		qm.Where("status = ? AND next_attempt_at <= ?", webhookDeliveryPending, now),
+		qm.OrderBy("next_attempt_at ASC, id ASC"),
+		qm.Limit(webhookWorkerBatchSize),
+	).All(ctx, ws.db)
+	if err != nil {
+		return err
+	}
+
+	for _, delivery := range deliveries {
+		claimed, err := ws.claimDelivery(ctx, delivery, now)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			continue
+		}
+
+		webhook, err := models.FindWebhook(ctx, ws.db, delivery.WebhookID)
+		if errors.Is(err, sql.ErrNoRows) {
+			// NOTE: 取得後にWebhookが削除された場合は送信しない
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := ws.deliver(ctx, webhook, delivery); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ws *webhookService) claimDelivery(ctx context.Context, delivery *models.WebhookDelivery, now time.Time) (bool, error) {
+	rowsAff, err := models.WebhookDeliveries(
+		qm.Where("id = ? AND status = ? AND next_attempt_at = ?", delivery.ID, webhookDeliveryPending, delivery.NextAttemptAt),
+	).UpdateAll(ctx, ws.db, models.M{"next_attempt_at": now.Add(webhookClaimLease)})
+	if err != nil {
+		return false, err
+	}
+	return rowsAff == 1, nil
+}
+
+func (ws *webhookService) deliver(ctx context.Context, webhook *models.Webhook, delivery *models.WebhookDelivery) error {
+	now := time.Now()
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, strings.NewReader(delivery.Payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Webhook-Delivery", strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set("X-Webhook-Event", delivery.EventType)
+	req.Header.Set("X-Webhook-Timestamp", timestamp)
+	req.Header.Set("X-Webhook-Signature", "sha256="+signWebhookPayload(webhook.Secret, timestamp, delivery.Payload))
+
+	delivery.Attempts++
+	delivery.LastAttemptedAt = null.TimeFrom(now)
+	delivery.ResponseStatus = null.Int{}
+	delivery.ErrorMessage = null.String{}
+
+	res, err := ws.client.Do(req)
+	if err != nil {
+		delivery.ErrorMessage = null.StringFrom(err.Error())
+	} else {
+		io.Copy(io.Discard, io.LimitReader(res.Body, 64*1024))
+		res.Body.Close()
+		delivery.ResponseStatus = null.IntFrom(res.StatusCode)
+		if res.StatusCode < 200 || res.StatusCode >= 300 {
+			delivery.ErrorMessage = null.StringFrom("unexpected status code: " + strconv.Itoa(res.StatusCode))
+		}
+	}
+
+	switch {
+	case !delivery.ErrorMessage.Valid:
+		delivery.Status = webhookDeliverySucceeded
+	case delivery.Attempts >= webhookMaxAttempts:
+		delivery.Status = webhookDeliveryFailed
+	default:
+		delivery.NextAttemptAt = now.Add(webhookBaseBackoff << (delivery.Attempts - 1))
+	}
+	_, err = delivery.Update(ctx, ws.db, boil.Infer())
+	return err
+}
+
+// NOTE: 受信側は「タイムスタンプ.ペイロード」をシークレットでHMAC-SHA256した値と照合する
+func signWebhookPayload(secret string, timestamp string, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "." + payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NOTE: アクティビティの記録と同一トランザクション内で配信キューに登録する
+func enqueueWebhookDeliveries(ctx context.Context, exec boil.ContextExecutor, activity *models.Activity) error {
+	webhooks, err := models.Webhooks(qm.Where("user_id = ?", activity.UserID)).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	var payload []byte
+	for _, webhook := range webhooks {
+		if !slices.Contains(strings.Split(webhook.EventTypes, ","), activity.EventType) {
+			continue
+		}
+
+		if payload == nil {
+			payload, err = json.Marshal(webhookPayload{
+				ID:         activity.ID,
+				Type:       activity.EventType,
+				OccurredAt: activity.CreatedAt,
+				ActorID:    activity.ActorID,
+				Todo:       webhookPayloadTodo{ID: activity.TodoID, Title: activity.TodoTitle},
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		delivery := &models.WebhookDelivery{
+			WebhookID:     webhook.ID,
+			EventID:       activity.ID,
+			EventType:     activity.EventType,
+			Payload:       string(payload),
+			Status:        webhookDeliveryPending,
+			NextAttemptAt: activity.CreatedAt.Truncate(time.Second),
+		}
+		if err := delivery.Insert(ctx, exec, boil.Infer()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NOTE: 配信キューを定期的にポーリングして送信するワーカー
+func RunWebhookWorker(ctx context.Context, webhookService WebhookService) {
+	ticker := time.NewTicker(webhookWorkerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := webhookService.DeliverPendingWebhooks(ctx); err != nil {
+				log.Println("failed to deliver webhooks:", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package services
+
+import (
+	models "app/models/generated"
+	apis "app/openapi"
+	"app/test/factories"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type TestWebhookServiceSuite struct {
+	WithDBSuite
+}
+
+type receivedWebhook struct {
+	header http.Header
+	body   string
+}
+
+var (
+	testWebhookService WebhookService
+	receiver           *httptest.Server
+	receivedWebhooks   []receivedWebhook
+	receiverStatusCode int
+)
+
+const testWebhookSecret = "test-webhook-secret"
+
+func (s *TestWebhookServiceSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	// NOTE: Webhookの受信側のスタブ
+	receivedWebhooks = nil
+	receiverStatusCode = http.StatusOK
+	receiver = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		receivedWebhooks = append(receivedWebhooks, receivedWebhook{header: r.Header, body: string(body)})
+		w.WriteHeader(receiverStatusCode)
+	}))
+
+	testWebhookService = NewWebhookService(DBCon)
+}
+
+func (s *TestWebhookServiceSuite) TearDownTest() {
+	receiver.Close()
+	s.CloseDB()
+}
+
+func (s *TestWebhookServiceSuite) createWebhook(eventTypes []string) *models.Webhook {
+	requestParams := apis.PostWebhooksJSONRequestBody{Url: receiver.URL, Secret: testWebhookSecret, EventTypes: eventTypes}
+	statusCode, webhook, err := testWebhookService.CreateWebhook(ctx, requestParams, int64(user.ID))
+	if statusCode != http.StatusOK {
+		s.T().Fatalf("failed to create test webhook %v", err)
+	}
+	return webhook
+}
+
+func (s *TestWebhookServiceSuite) createTodo() {
+	NewTodoService(DBCon).CreateTodo(ctx, apis.PostTodosJSONRequestBody{Title: "test title 1", Content: "test content 1"}, int64(user.ID))
+}
+
+func (s *TestWebhookServiceSuite) fetchDelivery(webhook *models.Webhook) *models.WebhookDelivery {
+	delivery, err := models.WebhookDeliveries(qm.Where("webhook_id = ?", webhook.ID)).One(ctx, DBCon)
+	if err != nil {
+		s.T().Fatalf("failed to fetch webhook delivery %v", err)
+	}
+	return delivery
+}
+
+func (s *TestWebhookServiceSuite) TestCreateWebhook_BadRequest() {
+	requestParams := apis.PostWebhooksJSONRequestBody{Url: "ftp://example.com", Secret: "short", EventTypes: []string{"unknown"}}
+
+	statusCode, _, err := testWebhookService.CreateWebhook(ctx, requestParams, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusBadRequest), statusCode)
+	assert.Equal(s.T(), "eventTypes: (0: 存在しないイベントが指定されています。.); secret: シークレットは16 ~ 255文字での入力をお願いします。; url: URLはhttpまたはhttpsから始まる形式で入力してください。.", err.Error())
+}
+
+func (s *TestWebhookServiceSuite) TestDeliverPendingWebhooks_Signed() {
+	webhook := s.createWebhook([]string{ActivityTodoCreated})
+	s.createTodo()
+
+	err := testWebhookService.DeliverPendingWebhooks(ctx)
+
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), receivedWebhooks, 1)
+	received := receivedWebhooks[0]
+	assert.Equal(s.T(), ActivityTodoCreated, received.header.Get("X-Webhook-Event"))
+	// NOTE: タイムスタンプとペイロードから署名を検証できること
+	timestamp := received.header.Get("X-Webhook-Timestamp")
+	assert.Equal(s.T(), "sha256="+signWebhookPayload(testWebhookSecret, timestamp, received.body), received.header.Get("X-Webhook-Signature"))
+
+	var payload webhookPayload
+	json.Unmarshal([]byte(received.body), &payload)
+	assert.Equal(s.T(), ActivityTodoCreated, payload.Type)
+	assert.Equal(s.T(), "test title 1", payload.Todo.Title)
+
+	delivery := s.fetchDelivery(webhook)
+	assert.Equal(s.T(), webhookDeliverySucceeded, delivery.Status)
+	assert.Equal(s.T(), 1, delivery.Attempts)
+	assert.Equal(s.T(), http.StatusOK, delivery.ResponseStatus.Int)
+}
+
+func (s *TestWebhookServiceSuite) TestDeliverPendingWebhooks_EventFilter() {
+	webhook := s.createWebhook([]string{ActivityTodoDeleted})
+	s.createTodo()
+
+	testWebhookService.DeliverPendingWebhooks(ctx)
+
+	// NOTE: 購読していないイベントは配信されないこと
+	assert.Len(s.T(), receivedWebhooks, 0)
+	isExistDelivery, _ := models.WebhookDeliveries(qm.Where("webhook_id = ?", webhook.ID)).Exists(ctx, DBCon)
+	assert.False(s.T(), isExistDelivery)
+}
+
+func (s *TestWebhookServiceSuite) TestDeliverPendingWebhooks_Retry() {
+	webhook := s.createWebhook([]string{ActivityTodoCreated})
+	s.createTodo()
+	receiverStatusCode = http.StatusInternalServerError
+
+	testWebhookService.DeliverPendingWebhooks(ctx)
+
+	delivery := s.fetchDelivery(webhook)
+	assert.Equal(s.T(), webhookDeliveryPending, delivery.Status)
+	assert.Equal(s.T(), 1, delivery.Attempts)
+	assert.Equal(s.T(), http.StatusInternalServerError, delivery.ResponseStatus.Int)
+	assert.True(s.T(), delivery.NextAttemptAt.After(time.Now()))
+
+	// NOTE: 再送日時になるまでは送信されないこと
+	testWebhookService.DeliverPendingWebhooks(ctx)
+	assert.Len(s.T(), receivedWebhooks, 1)
+
+	delivery.NextAttemptAt = time.Now().Add(-time.Second)
+	delivery.Update(ctx, DBCon, boil.Infer())
+	receiverStatusCode = http.StatusOK
+
+	testWebhookService.DeliverPendingWebhooks(ctx)
+
+	assert.Len(s.T(), receivedWebhooks, 2)
+	delivery = s.fetchDelivery(webhook)
+	assert.Equal(s.T(), webhookDeliverySucceeded, delivery.Status)
+	assert.Equal(s.T(), 2, delivery.Attempts)
+	assert.False(s.T(), delivery.ErrorMessage.Valid)
+}
+
+func (s *TestWebhookServiceSuite) TestDeliverPendingWebhooks_GiveUp() {
+	webhook := s.createWebhook([]string{ActivityTodoCreated})
+	s.createTodo()
+	receiverStatusCode = http.StatusInternalServerError
+
+	delivery := s.fetchDelivery(webhook)
+	delivery.Attempts = webhookMaxAttempts - 1
+	delivery.Update(ctx, DBCon, boil.Infer())
+
+	testWebhookService.DeliverPendingWebhooks(ctx)
+
+	// NOTE: 最大送信回数に達した場合は再送を打ち切ること
+	delivery = s.fetchDelivery(webhook)
+	assert.Equal(s.T(), webhookDeliveryFailed, delivery.Status)
+	assert.Equal(s.T(), webhookMaxAttempts, delivery.Attempts)
+}
+
+func (s *TestWebhookServiceSuite) TestRedeliverWebhook() {
+	webhook := s.createWebhook([]string{ActivityTodoCreated})
+	s.createTodo()
+	testWebhookService.DeliverPendingWebhooks(ctx)
+	original := s.fetchDelivery(webhook)
+
+	statusCode, delivery, err := testWebhookService.RedeliverWebhook(ctx, webhook.ID, original.ID, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), webhookDeliveryPending, delivery.Status)
+	assert.Equal(s.T(), original.Payload, delivery.Payload)
+
+	testWebhookService.DeliverPendingWebhooks(ctx)
+	assert.Len(s.T(), receivedWebhooks, 2)
+	assert.Equal(s.T(), receivedWebhooks[0].body, receivedWebhooks[1].body)
+}
+
+func (s *TestWebhookServiceSuite) TestDeleteWebhook() {
+	webhook := s.createWebhook([]string{ActivityTodoCreated})
+	s.createTodo()
+
+	statusCode, err := testWebhookService.DeleteWebhook(ctx, webhook.ID, int64(user.ID))
+
+	assert.Equal(s.T(), int64(http.StatusOK), statusCode)
+	assert.Nil(s.T(), err)
+	// NOTE: 未送信の配信も削除され送信されないこと
+	testWebhookService.DeliverPendingWebhooks(ctx)
+	assert.Len(s.T(), receivedWebhooks, 0)
+}
+
+func TestWebhookService(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestWebhookServiceSuite))
+}
@@ -0,0 +1,181 @@
+package authenticators
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+)
+
+const (
+	flagUserPresent            = 0x01
+	flagUserVerified           = 0x04
+	flagAttestedCredentialData = 0x40
+)
+
+// SoftwareAuthenticator ... テストでブラウザと認証器の代わりにパスキーを作成・利用する
+type SoftwareAuthenticator struct {
+	Origin string
+	// NOTE: trueの場合は署名回数を増やさず、複製された認証器として振る舞う
+	ReplayCounter bool
+	credentials   []*softwareCredential
+}
+
+type softwareCredential struct {
+	id         []byte
+	rpID       string
+	userHandle []byte
+	privateKey *ecdsa.PrivateKey
+	signCount  uint32
+}
+
+func NewSoftwareAuthenticator(origin string) *SoftwareAuthenticator {
+	return &SoftwareAuthenticator{Origin: origin}
+}
+
+// Register ... navigator.credentials.create()と同様に、登録用のオプションから"none"形式のアテステーションを返す
+func (a *SoftwareAuthenticator) Register(options map[string]interface{}) (map[string]interface{}, error) {
+	var creationOptions struct {
+		Challenge string `json:"challenge"`
+		RP        struct {
+			ID string `json:"id"`
+		} `json:"rp"`
+		User struct {
+			ID string `json:"id"`
+		} `json:"user"`
+	}
+	if err := remarshal(options, &creationOptions); err != nil {
+		return nil, err
+	}
+	userHandle, err := base64.RawURLEncoding.DecodeString(creationOptions.User.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	credential := &softwareCredential{id: make([]byte, 16), rpID: creationOptions.RP.ID, userHandle: userHandle, privateKey: privateKey}
+	if _, err := rand.Read(credential.id); err != nil {
+		return nil, err
+	}
+
+	publicKey, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{KeyType: int64(webauthncose.EllipticKey), Algorithm: int64(webauthncose.AlgES256)},
+		Curve:         int64(webauthncose.P256),
+		XCoord:        privateKey.PublicKey.X.FillBytes(make([]byte, 32)),
+		YCoord:        privateKey.PublicKey.Y.FillBytes(make([]byte, 32)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: 認証器データの後ろに、AAGUID・クレデンシャルID・公開鍵を付加する
+	authData := authenticatorData(credential.rpID, flagUserPresent|flagUserVerified|flagAttestedCredentialData, credential.signCount)
+	authData = append(authData, make([]byte, 16)...)
+	authData = binary.BigEndian.AppendUint16(authData, uint16(len(credential.id)))
+	authData = append(authData, credential.id...)
+	authData = append(authData, publicKey...)
+
+	attestationObject, err := webauthncbor.Marshal(map[string]interface{}{
+		"fmt":      "none",
+		"attStmt":  map[string]interface{}{},
+		"authData": authData,
+	})
+	if err != nil {
+		return nil, err
+	}
+	clientDataJSON, err := a.clientDataJSON("webauthn.create", creationOptions.Challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	a.credentials = append(a.credentials, credential)
+	return map[string]interface{}{
+		"id":    base64.RawURLEncoding.EncodeToString(credential.id),
+		"rawId": base64.RawURLEncoding.EncodeToString(credential.id),
+		"type":  "public-key",
+		"response": map[string]interface{}{
+			"clientDataJSON":    base64.RawURLEncoding.EncodeToString(clientDataJSON),
+			"attestationObject": base64.RawURLEncoding.EncodeToString(attestationObject),
+			"transports":        []string{"internal"},
+		},
+		"authenticatorAttachment": "platform",
+		"clientExtensionResults":  map[string]interface{}{},
+	}, nil
+}
+
+// SignIn ... navigator.credentials.get()と同様に、最後に登録したパスキーで署名したアサーションを返す
+func (a *SoftwareAuthenticator) SignIn(options map[string]interface{}) (map[string]interface{}, error) {
+	var requestOptions struct {
+		Challenge string `json:"challenge"`
+		RPID      string `json:"rpId"`
+	}
+	if err := remarshal(options, &requestOptions); err != nil {
+		return nil, err
+	}
+	if len(a.credentials) == 0 {
+		return nil, errors.New("no credential registered")
+	}
+	credential := a.credentials[len(a.credentials)-1]
+
+	if !a.ReplayCounter {
+		credential.signCount++
+	}
+	authData := authenticatorData(requestOptions.RPID, flagUserPresent|flagUserVerified, credential.signCount)
+	clientDataJSON, err := a.clientDataJSON("webauthn.get", requestOptions.Challenge)
+	if err != nil {
+		return nil, err
+	}
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(authData, clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, credential.privateKey, digest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"id":    base64.RawURLEncoding.EncodeToString(credential.id),
+		"rawId": base64.RawURLEncoding.EncodeToString(credential.id),
+		"type":  "public-key",
+		"response": map[string]interface{}{
+			"clientDataJSON":    base64.RawURLEncoding.EncodeToString(clientDataJSON),
+			"authenticatorData": base64.RawURLEncoding.EncodeToString(authData),
+			"signature":         base64.RawURLEncoding.EncodeToString(signature),
+			"userHandle":        base64.RawURLEncoding.EncodeToString(credential.userHandle),
+		},
+		"authenticatorAttachment": "platform",
+		"clientExtensionResults":  map[string]interface{}{},
+	}, nil
+}
+
+func (a *SoftwareAuthenticator) clientDataJSON(ceremonyType string, challenge string) ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"type":        ceremonyType,
+		"challenge":   challenge,
+		"origin":      a.Origin,
+		"crossOrigin": false,
+	})
+}
+
+func authenticatorData(rpID string, flags byte, signCount uint32) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	authData := append(rpIDHash[:], flags)
+	return binary.BigEndian.AppendUint32(authData, signCount)
+}
+
+func remarshal(src interface{}, dst interface{}) error {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, dst)
+}
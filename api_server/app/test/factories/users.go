@@ -3,9 +3,11 @@ package factories
 import (
 	models "app/models/generated"
 	"log"
+	"time"
 
 	"github.com/Pallinder/go-randomdata"
 	"github.com/bluele/factory-go/factory"
+	"github.com/volatiletech/null/v8"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -14,6 +16,7 @@ var UserFactory = factory.NewFactory(
 		FirstName: randomdata.FirstName(randomdata.RandomGender),
 		LastName: randomdata.LastName(),
 		Email: randomdata.Email(),
+		EmailVerifiedAt: null.TimeFrom(time.Now()),
 		FrontIdentification: randomdata.StringSample(),
 		BackIdentification: randomdata.StringSample(),
 	},
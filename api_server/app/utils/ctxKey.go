@@ -1,13 +1,31 @@
 package utils
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type key string
 
 const (
-	ctxKey key = "UserID"
+	ctxKey          key = "UserID"
+	authTokenCtxKey key = "AuthToken"
+	clientCtxKey    key = "Client"
 )
 
+// AuthToken ... サインアウト時にトークンを失効させるため、検証済みトークンの識別子と有効期限を保持する
+type AuthToken struct {
+	SessionID int64
+	ID        string
+	ExpiresAt time.Time
+}
+
+// Client ... セッションに記録するため、リクエスト元の端末の情報を保持する
+type Client struct {
+	UserAgent string
+	IPAddress string
+}
+
 func NewContext(ctx context.Context, v int) context.Context {
 	return context.WithValue(ctx, ctxKey, v)
 }
@@ -16,3 +34,21 @@ func ContextValue(ctx context.Context) (int64, bool) {
 	v, ok := ctx.Value(ctxKey).(int)
 	return int64(v), ok
 }
+
+func NewAuthTokenContext(ctx context.Context, v AuthToken) context.Context {
+	return context.WithValue(ctx, authTokenCtxKey, v)
+}
+
+func AuthTokenContextValue(ctx context.Context) (AuthToken, bool) {
+	v, ok := ctx.Value(authTokenCtxKey).(AuthToken)
+	return v, ok
+}
+
+func NewClientContext(ctx context.Context, v Client) context.Context {
+	return context.WithValue(ctx, clientCtxKey, v)
+}
+
+func ClientContextValue(ctx context.Context) (Client, bool) {
+	v, ok := ctx.Value(clientCtxKey).(Client)
+	return v, ok
+}
@@ -2,15 +2,23 @@ package routers
 
 import (
 	"app/middlewares"
+	"log"
+	"net"
 	"net/http"
+	"os"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 )
 
 func ApplyMiddlewares(e *echo.Echo) *echo.Echo {
+	// NOTE: クライアントのIPアドレスの取得方法の設定
+	e.IPExtractor = newIPExtractor(os.Getenv("TRUSTED_PROXIES"))
+
 	// NOTE: CORSの設定
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
+		Skipper: middlewares.IsCalDAVRequest,
 		AllowOrigins: []string{"http://localhost:5173"},
 		AllowMethods: []string{http.MethodGet, http.MethodPatch, http.MethodPost, http.MethodDelete},
 		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAccessControlAllowHeaders, echo.HeaderXCSRFToken},
@@ -18,6 +26,7 @@ func ApplyMiddlewares(e *echo.Echo) *echo.Echo {
 
 	// NOTE: CSRF対策
 	csrfConfig := middleware.CSRFConfig{
+		Skipper: middlewares.IsCalDAVRequest,
 		TokenLookup: "header:"+echo.HeaderXCSRFToken,
 		CookieMaxAge: 3600,
 	}
@@ -29,3 +38,20 @@ func ApplyMiddlewares(e *echo.Echo) *echo.Echo {
 
 	return e
 }
+
+// NOTE: X-Forwarded-Forは偽装できるため、信頼するプロキシが設定されている場合のみ参照する
+// 	   : 未設定の場合は接続元のIPアドレスをそのまま使う
+func newIPExtractor(trustedProxies string) echo.IPExtractor {
+	if strings.TrimSpace(trustedProxies) == "" {
+		return echo.ExtractIPDirect()
+	}
+	options := []echo.TrustOption{echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false)}
+	for _, cidr := range strings.Split(trustedProxies, ",") {
+		_, ipRange, err := net.ParseCIDR(strings.TrimSpace(cidr))
+		if err != nil {
+			log.Fatalf("invalid TRUSTED_PROXIES %q: %v", cidr, err)
+		}
+		options = append(options, echo.TrustIPRange(ipRange))
+	}
+	return echo.ExtractIPFromXFFHeader(options...)
+}
@@ -0,0 +1,17 @@
+package validator
+
+import (
+	apis "app/openapi"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func ValidateCreateAppPassword(input apis.PostAppPasswordsJSONRequestBody) error {
+	return validation.ValidateStruct(&input,
+		validation.Field(
+			&input.Name,
+			validation.Required.Error("名前は必須入力です。"),
+			validation.RuneLength(1, 50).Error("名前は1 ~ 50文字での入力をお願いします。"),
+		),
+	)
+}
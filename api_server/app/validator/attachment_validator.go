@@ -0,0 +1,63 @@
+package validator
+
+import (
+	apis "app/openapi"
+	"errors"
+	"fmt"
+	"path"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"golang.org/x/exp/slices"
+
+	"github.com/gabriel-vasile/mimetype"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// NOTE: 添付ファイルの上限サイズ(10MB)
+const MaxAttachmentSize = 10 * 1024 * 1024
+
+var allowedAttachmentMIMEType = []string{"image/webp", "image/png", "image/jpeg", "image/gif", "application/pdf", "text/plain", "text/csv"}
+
+func ValidateCreateAttachment(input *apis.PostTodoAttachmentsMultipartRequestBody) error {
+	return validation.ValidateStruct(input,
+		validation.Field(
+			&input.File,
+			validation.By(isValidAttachment("添付ファイル")),
+		),
+	)
+}
+
+func isValidAttachment(field string) validation.RuleFunc {
+	return func(value interface{}) error {
+		fileInput, ok := value.(openapi_types.File)
+		if !ok {
+			return fmt.Errorf("%sが正しい形式ではありません", field)
+		}
+		if fileInput.FileSize() == 0 {
+			return errors.New(field + "は必須入力です。")
+		}
+		// NOTE: 保存先のパスに使うため、ファイル名として使えない値は受け付けない
+		if fileName := path.Base(fileInput.Filename()); fileName == "." || fileName == ".." || fileName == "/" {
+			return errors.New(field + "のファイル名を指定してください。")
+		}
+		if fileInput.FileSize() > MaxAttachmentSize {
+			return errors.New(field + "のサイズは10MB以下でお願いします。")
+		}
+
+		reader, err := fileInput.Reader()
+		if err != nil {
+			return fmt.Errorf("%sのリーダーを開く際にエラーが発生しました: %v", field, err)
+		}
+
+		mtype, err := mimetype.DetectReader(reader)
+		if err != nil {
+			return fmt.Errorf("%sのMIMEタイプを判別できません: %v", field, err)
+		}
+
+		// NOTE: text/plain; charset=utf-8 などのパラメータ付きも許可するためIsで比較
+		if isValid := slices.ContainsFunc(allowedAttachmentMIMEType, mtype.Is); !isValid {
+			return fmt.Errorf("%sの形式は画像(webp, png, jpeg, gif), pdf, テキスト, csvのいずれかでお願いします。", field)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,27 @@
+package validator
+
+import (
+	apis "app/openapi"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func ValidateCreateComment(input apis.PostTodoCommentsJSONRequestBody) error {
+	return validation.ValidateStruct(&input,
+		validation.Field(
+			&input.Body,
+			validation.Required.Error("コメントは必須入力です。"),
+			validation.RuneLength(1, 1000).Error("コメントは1 ~ 1000文字での入力をお願いします。"),
+		),
+	)
+}
+
+func ValidateUpdateComment(input apis.PatchTodoCommentJSONRequestBody) error {
+	return validation.ValidateStruct(&input,
+		validation.Field(
+			&input.Body,
+			validation.Required.Error("コメントは必須入力です。"),
+			validation.RuneLength(1, 1000).Error("コメントは1 ~ 1000文字での入力をお願いします。"),
+		),
+	)
+}
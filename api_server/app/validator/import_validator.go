@@ -0,0 +1,58 @@
+package validator
+
+import (
+	apis "app/openapi"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// NOTE: インポートファイルの上限サイズ(5MB)
+const MaxImportSize = 5 * 1024 * 1024
+
+func ValidateCreateImport(input *apis.PostImportsMultipartRequestBody, formats []string) error {
+	allowedFormats := make([]interface{}, 0, len(formats))
+	for _, format := range formats {
+		allowedFormats = append(allowedFormats, format)
+	}
+
+	return validation.ValidateStruct(input,
+		validation.Field(
+			&input.Format,
+			validation.Required.Error("形式は必須入力です。"),
+			validation.In(allowedFormats...).Error("形式はcsv, json, todoist, trelloのいずれかでお願いします。"),
+		),
+		validation.Field(
+			&input.File,
+			validation.By(isValidImportFile("インポートファイル")),
+		),
+	)
+}
+
+func isValidImportFile(field string) validation.RuleFunc {
+	return func(value interface{}) error {
+		fileInput, ok := value.(openapi_types.File)
+		if !ok {
+			return fmt.Errorf("%sが正しい形式ではありません", field)
+		}
+		if fileInput.FileSize() == 0 {
+			return errors.New(field + "は必須入力です。")
+		}
+		if fileInput.FileSize() > MaxImportSize {
+			return errors.New(field + "のサイズは5MB以下でお願いします。")
+		}
+
+		content, err := fileInput.Bytes()
+		if err != nil {
+			return fmt.Errorf("%sの読み込み時にエラーが発生しました: %v", field, err)
+		}
+		if !utf8.Valid(content) {
+			return errors.New(field + "はUTF-8のテキストでお願いします。")
+		}
+		return nil
+	}
+}
@@ -0,0 +1,38 @@
+package validator
+
+import (
+	apis "app/openapi"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var (
+	ceremonyTokenRules = []validation.Rule{
+		validation.Required.Error("トークンは必須入力です。"),
+	}
+	passkeyCredentialRules = []validation.Rule{
+		validation.Required.Error("パスキーの認証情報は必須入力です。"),
+	}
+)
+
+// NOTE: 認証コードは2段階認証を有効にしている場合のみ必要なため、照合時に判定する
+func ValidateBeginPasskeyRegistration(input *apis.PostAuthPasskeysRegisterBeginJSONRequestBody) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.Password, validation.Required.Error("パスワードは必須入力です。")),
+	)
+}
+
+func ValidateRegisterPasskey(input *apis.PostAuthPasskeysRegisterFinishJSONRequestBody) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.CeremonyToken, ceremonyTokenRules...),
+		validation.Field(&input.Name, validation.RuneLength(0, 50).Error("名前は50文字以下でお願いします。")),
+		validation.Field(&input.Credential, passkeyCredentialRules...),
+	)
+}
+
+func ValidateSignInPasskey(input *apis.PostAuthPasskeysSignInFinishJSONRequestBody) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.CeremonyToken, ceremonyTokenRules...),
+		validation.Field(&input.Credential, passkeyCredentialRules...),
+	)
+}
@@ -0,0 +1,23 @@
+package validator
+
+import (
+	apis "app/openapi"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// NOTE: クエリの構文はservices側で解析して検証する
+func ValidateSavedFilter(input apis.PostSavedFiltersJSONRequestBody) error {
+	return validation.ValidateStruct(&input,
+		validation.Field(
+			&input.Name,
+			validation.Required.Error("名前は必須入力です。"),
+			validation.RuneLength(1, 50).Error("名前は1 ~ 50文字での入力をお願いします。"),
+		),
+		validation.Field(
+			&input.Query,
+			validation.Required.Error("クエリは必須入力です。"),
+			validation.RuneLength(1, 1000).Error("クエリは1000文字以下でお願いします。"),
+		),
+	)
+}
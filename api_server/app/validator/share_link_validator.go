@@ -0,0 +1,36 @@
+package validator
+
+import (
+	apis "app/openapi"
+	"errors"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func ValidateCreateShareLink(input apis.PostTodoShareLinksJSONRequestBody) error {
+	return validation.ValidateStruct(&input,
+		validation.Field(
+			&input.ExpiresAt,
+			validation.By(isFutureTime("有効期限")),
+		),
+		validation.Field(
+			&input.Password,
+			validation.NilOrNotEmpty.Error("パスワードは8 ~ 24文字での入力をお願いします。"),
+			validation.Length(8, 24).Error("パスワードは8 ~ 24文字での入力をお願いします。"),
+		),
+	)
+}
+
+func isFutureTime(field string) validation.RuleFunc {
+	return func(value interface{}) error {
+		t, ok := value.(*time.Time)
+		if !ok || t == nil {
+			return nil
+		}
+		if !t.After(time.Now()) {
+			return errors.New(field + "は現在より後の日時を指定してください。")
+		}
+		return nil
+	}
+}
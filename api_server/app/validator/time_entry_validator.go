@@ -0,0 +1,49 @@
+package validator
+
+import (
+	apis "app/openapi"
+	"errors"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// NOTE: 1件の記録として入力できる時間の上限
+const MaxTimeEntryDuration = 24 * time.Hour
+
+func ValidateCreateTimeEntry(input apis.PostTimeEntriesJSONRequestBody, now time.Time) error {
+	return validation.ValidateStruct(&input,
+		validation.Field(
+			&input.TodoId,
+			validation.Required.Error("Todoは必須入力です。"),
+		),
+		validation.Field(
+			&input.StartedAt,
+			validation.Required.Error("開始日時は必須入力です。"),
+		),
+		validation.Field(
+			&input.StoppedAt,
+			validation.Required.Error("終了日時は必須入力です。"),
+			validation.By(func(value interface{}) error {
+				stoppedAt, _ := value.(time.Time)
+				if input.StartedAt.IsZero() {
+					return nil
+				}
+				if !stoppedAt.After(input.StartedAt) {
+					return errors.New("終了日時は開始日時より後を指定してください。")
+				}
+				if stoppedAt.Sub(input.StartedAt) > MaxTimeEntryDuration {
+					return errors.New("1件の記録は24時間以内でお願いします。")
+				}
+				if stoppedAt.After(now) {
+					return errors.New("終了日時に未来の日時は指定できません。")
+				}
+				return nil
+			}),
+		),
+		validation.Field(
+			&input.Note,
+			validation.RuneLength(0, 255).Error("メモは255文字以下でお願いします。"),
+		),
+	)
+}
@@ -0,0 +1,45 @@
+package validator
+
+import (
+	apis "app/openapi"
+	"fmt"
+	"unicode/utf8"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// NOTE: 1つのテンプレートから作成できるTodoの上限
+const MaxTodoTemplateItems = 100
+
+func ValidateTodoTemplate(input apis.PostTodoTemplatesJSONRequestBody) error {
+	return validation.ValidateStruct(&input,
+		validation.Field(
+			&input.Name,
+			validation.Required.Error("名前は必須入力です。"),
+			validation.RuneLength(1, 50).Error("名前は1 ~ 50文字での入力をお願いします。"),
+		),
+		validation.Field(
+			&input.Items,
+			validation.Required.Error("項目は1つ以上入力してください。"),
+			validation.Length(1, MaxTodoTemplateItems).Error(fmt.Sprintf("項目は%d件以下でお願いします。", MaxTodoTemplateItems)),
+			validation.By(isValidTodoTemplateItems),
+		),
+	)
+}
+
+// NOTE: プレースホルダを含むため、展開後の長さはテンプレートからの作成時に改めて検証する
+func isValidTodoTemplateItems(value interface{}) error {
+	items, _ := value.([]apis.TodoTemplateItem)
+	for i, item := range items {
+		if item.Title == "" {
+			return fmt.Errorf("%d件目のタイトルは必須入力です。", i+1)
+		}
+		if utf8.RuneCountInString(item.Title) > 255 {
+			return fmt.Errorf("%d件目のタイトルは255文字以下でお願いします。", i+1)
+		}
+		if utf8.RuneCountInString(item.Content) > 1000 {
+			return fmt.Errorf("%d件目の内容は1000文字以下でお願いします。", i+1)
+		}
+	}
+	return nil
+}
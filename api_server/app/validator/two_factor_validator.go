@@ -0,0 +1,39 @@
+package validator
+
+import (
+	apis "app/openapi"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// NOTE: リカバリーコードも受け付けるため、桁数は照合時に判定する
+var twoFactorCodeRules = []validation.Rule{
+	validation.Required.Error("認証コードは必須入力です。"),
+}
+
+func ValidateSignInTwoFactor(input *apis.PostAuthSignInTwoFactorJSONRequestBody) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.TwoFactorToken, validation.Required.Error("トークンは必須入力です。")),
+		validation.Field(&input.Code, twoFactorCodeRules...),
+	)
+}
+
+func ValidateConfirmTwoFactor(input *apis.PostAuthTwoFactorConfirmJSONRequestBody) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.Code, twoFactorCodeRules...),
+	)
+}
+
+func ValidateDisableTwoFactor(input *apis.PostAuthTwoFactorDisableJSONRequestBody) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.Password, validation.Required.Error("パスワードは必須入力です。")),
+		validation.Field(&input.Code, twoFactorCodeRules...),
+	)
+}
+
+func ValidateRegenerateRecoveryCodes(input *apis.PostAuthTwoFactorRecoveryCodesJSONRequestBody) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.Password, validation.Required.Error("パスワードは必須入力です。")),
+		validation.Field(&input.Code, twoFactorCodeRules...),
+	)
+}
@@ -3,6 +3,7 @@ package validator
 import (
 	apis "app/openapi"
 	"fmt"
+	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
@@ -14,28 +15,33 @@ import (
 
 var allowedMIMEType = []string{"image/webp", "image/png", "image/jpeg"}
 
+// NOTE: 各項目のルールはサインアップ・プロフィール更新・パスワード再設定・メールアドレス変更で共通とする
+var firstNameRules = []validation.Rule{
+	validation.Required.Error("名は必須入力です。"),
+	validation.RuneLength(1, 20).Error("名は1 ~ 20文字での入力をお願いします。"),
+}
+
+var lastNameRules = []validation.Rule{
+	validation.Required.Error("姓は必須入力です。"),
+	validation.RuneLength(1, 20).Error("姓は1 ~ 20文字での入力をお願いします。"),
+}
+
+var emailRules = []validation.Rule{
+	validation.Required.Error("Emailは必須入力です。"),
+	is.Email.Error("Emailの形式での入力をお願いします。"),
+}
+
+var passwordRules = []validation.Rule{
+	validation.Required.Error("パスワードは必須入力です。"),
+	validation.Length(8, 24).Error("パスワードは8 ~ 24文字での入力をお願いします。"),
+}
+
 func ValidateSignUp(input *apis.PostAuthValidateSignUpMultipartRequestBody) error {
 	return validation.ValidateStruct(input,
-		validation.Field(
-			&input.FirstName,
-			validation.Required.Error("名は必須入力です。"),
-			validation.RuneLength(1, 20).Error("名は1 ~ 20文字での入力をお願いします。"),
-		),
-		validation.Field(
-			&input.LastName,
-			validation.Required.Error("姓は必須入力です。"),
-			validation.RuneLength(1, 20).Error("姓は1 ~ 20文字での入力をお願いします。"),
-		),
-		validation.Field(
-			&input.Email,
-			validation.Required.Error("Emailは必須入力です。"),
-			is.Email.Error("Emailの形式での入力をお願いします。"),
-		),
-		validation.Field(
-			&input.Password,
-			validation.Required.Error("パスワードは必須入力です。"),
-			validation.Length(8, 24).Error("パスワードは8 ~ 24文字での入力をお願いします。"),
-		),
+		validation.Field(&input.FirstName, firstNameRules...),
+		validation.Field(&input.LastName, lastNameRules...),
+		validation.Field(&input.Email, emailRules...),
+		validation.Field(&input.Password, passwordRules...),
 		validation.Field(
 			&input.FrontIdentification,
 			validation.By(isValidFileMimeType("身分証明書(表)")),
@@ -47,6 +53,69 @@ func ValidateSignUp(input *apis.PostAuthValidateSignUpMultipartRequestBody) erro
 	)
 }
 
+// NOTE: 指定された項目のみ更新するため、未指定の項目はチェックしない
+func ValidateUpdateMe(input *apis.PatchUsersMeJSONRequestBody) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.FirstName, validation.When(input.FirstName != nil, firstNameRules...)),
+		validation.Field(&input.LastName, validation.When(input.LastName != nil, lastNameRules...)),
+		validation.Field(&input.Birthday, validation.By(isPastDate("誕生日"))),
+	)
+}
+
+func ValidateForgotPassword(input *apis.PostAuthPasswordForgotJSONRequestBody) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.Email, emailRules...),
+	)
+}
+
+func ValidateResetPassword(input *apis.PostAuthPasswordResetJSONRequestBody) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.Token, validation.Required.Error("トークンは必須入力です。")),
+		validation.Field(&input.Password, passwordRules...),
+	)
+}
+
+func ValidateVerifyEmail(input *apis.PostAuthEmailVerifyJSONRequestBody) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.Token, validation.Required.Error("トークンは必須入力です。")),
+	)
+}
+
+func ValidateResendEmailVerification(input *apis.PostAuthEmailResendJSONRequestBody) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.Email, emailRules...),
+	)
+}
+
+func ValidateUnlockAccount(input *apis.PostAuthUnlockJSONRequestBody) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.Token, validation.Required.Error("トークンは必須入力です。")),
+	)
+}
+
+func ValidateChangeEmail(input *apis.PostUsersMeEmailJSONRequestBody) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.Email, emailRules...),
+		validation.Field(&input.Password, validation.Required.Error("パスワードは必須入力です。")),
+	)
+}
+
+func isPastDate(field string) validation.RuleFunc {
+	return func(value interface{}) error {
+		date, ok := value.(*openapi_types.Date)
+		if !ok {
+			return fmt.Errorf("%sが正しい形式ではありません", field)
+		}
+		if date == nil {
+			return nil
+		}
+		if date.Time.After(time.Now()) {
+			return fmt.Errorf("%sに未来の日付は指定できません。", field)
+		}
+		return nil
+	}
+}
+
 func isValidFileMimeType(field string) validation.RuleFunc {
 	return func(value interface{}) error {
 		fileInput, ok := value.(*openapi_types.File)
@@ -0,0 +1,47 @@
+package validator
+
+import (
+	apis "app/openapi"
+	"errors"
+	"net/url"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func ValidateCreateWebhook(input apis.PostWebhooksJSONRequestBody, eventTypes []string) error {
+	allowedEventTypes := make([]interface{}, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		allowedEventTypes = append(allowedEventTypes, eventType)
+	}
+
+	return validation.ValidateStruct(&input,
+		validation.Field(
+			&input.Url,
+			validation.Required.Error("URLは必須入力です。"),
+			validation.RuneLength(1, 2048).Error("URLは2048文字以下での入力をお願いします。"),
+			validation.By(isHTTPURL),
+		),
+		validation.Field(
+			&input.Secret,
+			validation.Required.Error("シークレットは必須入力です。"),
+			validation.RuneLength(16, 255).Error("シークレットは16 ~ 255文字での入力をお願いします。"),
+		),
+		validation.Field(
+			&input.EventTypes,
+			validation.Required.Error("イベントは1つ以上選択してください。"),
+			validation.Each(validation.In(allowedEventTypes...).Error("存在しないイベントが指定されています。")),
+		),
+	)
+}
+
+func isHTTPURL(value interface{}) error {
+	rawURL, _ := value.(string)
+	if rawURL == "" {
+		return nil
+	}
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" {
+		return errors.New("URLはhttpまたはhttpsから始まる形式で入力してください。")
+	}
+	return nil
+}